@@ -38,6 +38,7 @@ func (r *AWSClusterTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.Template.ObjectMeta = restored.Spec.Template.ObjectMeta
+	dst.Spec.Template.Spec.ControlPlaneVolumeEncryption = restored.Spec.Template.Spec.ControlPlaneVolumeEncryption
 
 	return nil
 }
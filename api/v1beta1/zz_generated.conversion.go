@@ -948,6 +948,7 @@ func autoConvert_v1beta2_AWSClusterSpec_To_v1beta1_AWSClusterSpec(in *v1beta2.AW
 	} else {
 		out.S3Bucket = nil
 	}
+	// WARNING: in.ControlPlaneVolumeEncryption requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1242,6 +1243,7 @@ func autoConvert_v1beta2_AWSLoadBalancerSpec_To_v1beta1_AWSLoadBalancerSpec(in *
 	// WARNING: in.DisableHostsRewrite requires manual conversion: does not exist in peer-type
 	// WARNING: in.PreserveClientIP requires manual conversion: does not exist in peer-type
 	// WARNING: in.TargetGroupIPType requires manual conversion: does not exist in peer-type
+	// WARNING: in.AccessLogs requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -111,6 +111,13 @@ type AWSClusterSpec struct {
 	// BootstrapFormatIgnition feature flag to be enabled).
 	// +optional
 	S3Bucket *S3Bucket `json:"s3Bucket,omitempty"`
+
+	// ControlPlaneVolumeEncryption specifies the encryption of the EBS volumes of the control
+	// plane machines. A volume that sets encrypted or encryptionKey in its AWSMachine keeps its
+	// own settings. Volumes can't be re-encrypted in place, so a change rolls out a
+	// KubeadmControlPlane; other control plane providers have to be rolled out manually.
+	// +optional
+	ControlPlaneVolumeEncryption *VolumeEncryption `json:"controlPlaneVolumeEncryption,omitempty"`
 }
 
 // AWSIdentityKind defines allowed AWS identity types.
@@ -262,6 +269,35 @@ type AWSLoadBalancerSpec struct {
 	// +kubebuilder:validation:Enum=ipv4;ipv6
 	// +optional
 	TargetGroupIPType *TargetGroupIPType `json:"targetGroupIPType,omitempty"`
+
+	// AccessLogs configures the delivery of the load balancer access logs to an S3 bucket.
+	// Access logging is disabled when this field is removed.
+	// This field cannot be set if LoadBalancerType is disabled.
+	// +optional
+	AccessLogs *LoadBalancerAccessLogs `json:"accessLogs,omitempty"`
+}
+
+// LoadBalancerAccessLogs defines the access logging configuration of a load balancer.
+type LoadBalancerAccessLogs struct {
+	// Enabled specifies whether access logs are delivered to the S3 bucket.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// S3BucketName is the name of the S3 bucket the access logs are delivered to.
+	// +kubebuilder:validation:MinLength:=3
+	// +kubebuilder:validation:MaxLength:=63
+	S3BucketName string `json:"s3BucketName"`
+
+	// S3BucketPrefix is the prefix of the location in the S3 bucket the access logs are delivered to.
+	// +optional
+	S3BucketPrefix string `json:"s3BucketPrefix,omitempty"`
+
+	// ManageBucketPolicy, when true, adds the statements that allow the load balancer to
+	// deliver access logs to the S3 bucket to the bucket policy. The other statements of
+	// the bucket policy are kept. The controller needs the s3:GetBucketPolicy and
+	// s3:PutBucketPolicy permissions on the bucket.
+	// +optional
+	ManageBucketPolicy bool `json:"manageBucketPolicy,omitempty"`
 }
 
 // AdditionalListenerSpec defines the desired state of an
@@ -275,6 +275,12 @@ var (
 	LoadBalancerAttributeIdleTimeTimeoutSeconds = "idle_timeout.timeout_seconds"
 	// LoadBalancerAttributeIdleTimeDefaultTimeoutSecondsInSeconds defines the default idle timeout in seconds.
 	LoadBalancerAttributeIdleTimeDefaultTimeoutSecondsInSeconds = "60"
+	// LoadBalancerAttributeAccessLogsEnabled defines the attribute key for enabling access logs.
+	LoadBalancerAttributeAccessLogsEnabled = "access_logs.s3.enabled"
+	// LoadBalancerAttributeAccessLogsS3Bucket defines the attribute key for the access logs S3 bucket.
+	LoadBalancerAttributeAccessLogsS3Bucket = "access_logs.s3.bucket"
+	// LoadBalancerAttributeAccessLogsS3Prefix defines the attribute key for the access logs S3 bucket prefix.
+	LoadBalancerAttributeAccessLogsS3Prefix = "access_logs.s3.prefix"
 )
 
 // TargetGroupSpec specifies target group settings for a given listener.
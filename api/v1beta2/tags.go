@@ -201,6 +201,11 @@ const (
 	// user data which only references the S3 object. We store this tag on launch template versions
 	// so that S3 bootstrap data objects can be deleted when they get outdated.
 	LaunchTemplateBootstrapDataHash = NameAWSProviderPrefix + "bootstrap-data-hash"
+
+	// NodeBootstrapReadyTagKey is the tag set on an instance by its bootstrap data once the
+	// kubelet reports healthy. It allows node readiness to be determined without access
+	// to the workload cluster.
+	NodeBootstrapReadyTagKey = NameAWSProviderPrefix + "node-bootstrap-ready"
 )
 
 // ClusterTagKey generates the key for resources associated with a cluster.
@@ -86,6 +86,18 @@ const (
 	// ExternalResourceGCTasksAnnotation is the name of an annotation that indicates what
 	// external resources tasks should be executed by garbage collector for the cluster.
 	ExternalResourceGCTasksAnnotation = "aws.cluster.x-k8s.io/external-resource-tasks-gc"
+
+	// OrphanOnDeleteAnnotation is the name of an annotation that, when set to true on an
+	// AWSManagedControlPlane, AWSManagedMachinePool or AWSFargateProfile, leaves the AWS
+	// resources intact when the object is deleted and only removes its finalizer.
+	OrphanOnDeleteAnnotation = "aws.cluster.x-k8s.io/orphan-on-delete"
+
+	// AdoptAnnotation is the name of an annotation that, when set to true on an
+	// AWSManagedControlPlane, adopts an existing EKS cluster that isn't tagged as owned
+	// by tagging it as owned. The adopted cluster is deleted with the control plane,
+	// unless the control plane also has the OrphanOnDeleteAnnotation. It adopts an
+	// existing fargate profile the same way when set on an AWSFargateProfile.
+	AdoptAnnotation = "aws.cluster.x-k8s.io/adopt"
 )
 
 // GCTask defines a task to be executed by the garbage collector.
@@ -520,6 +532,20 @@ type Volume struct {
 	EncryptionKey string `json:"encryptionKey,omitempty"`
 }
 
+// VolumeEncryption defines the encryption of EBS volumes.
+type VolumeEncryption struct {
+	// Encrypted is whether the volumes should be encrypted or not.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+
+	// EncryptionKey is the KMS key to use to encrypt the volumes. Can be a KMS key ID, key ARN,
+	// alias name prefixed with alias/ or alias ARN. If Encrypted is set and this is omitted,
+	// the default AWS key will be used. The key must already exist and the controller's
+	// identity must be allowed to use it.
+	// +optional
+	EncryptionKey string `json:"encryptionKey,omitempty"`
+}
+
 // VolumeType describes the EBS volume type.
 // See: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ebs-volume-types.html
 type VolumeType string
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	// kmsKeyIDPattern matches the IDs of single and multi-Region KMS keys.
+	kmsKeyIDPattern = regexp.MustCompile(`^([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}|mrk-[0-9a-f]{32})$`)
+	// kmsAliasPattern matches the names of KMS aliases.
+	kmsAliasPattern = regexp.MustCompile(`^alias/[a-zA-Z0-9/_-]+$`)
+)
+
+// Validate validates VolumeEncryption fields, fldPath being the path of the VolumeEncryption.
+func (e *VolumeEncryption) Validate(fldPath *field.Path) []*field.Error {
+	var errs field.ErrorList
+
+	if e == nil || e.EncryptionKey == "" {
+		return errs
+	}
+
+	path := fldPath.Child("encryptionKey")
+	if e.Encrypted != nil && !*e.Encrypted {
+		errs = append(errs, field.Invalid(path, e.EncryptionKey, "encryptionKey cannot be set when encrypted is false"))
+	}
+	if !IsKMSKeyReference(e.EncryptionKey) {
+		errs = append(errs, field.Invalid(path, e.EncryptionKey, "must be a KMS key ID, key ARN, alias name or alias ARN"))
+	}
+
+	return errs
+}
+
+// IsKMSKeyReference returns true if key is a KMS key ID, key ARN, alias name prefixed with
+// alias/ or alias ARN, the forms EC2 accepts to encrypt EBS volumes.
+func IsKMSKeyReference(key string) bool {
+	if !arn.IsARN(key) {
+		return kmsKeyIDPattern.MatchString(key) || kmsAliasPattern.MatchString(key)
+	}
+
+	parsed, err := arn.Parse(key)
+	if err != nil || parsed.Service != "kms" || parsed.Region == "" || parsed.AccountID == "" {
+		return false
+	}
+	if id, ok := strings.CutPrefix(parsed.Resource, "key/"); ok {
+		return kmsKeyIDPattern.MatchString(id)
+	}
+	return kmsAliasPattern.MatchString(parsed.Resource)
+}
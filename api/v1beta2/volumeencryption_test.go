@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+func TestVolumeEncryptionValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		encryption *VolumeEncryption
+		wantErrs   int
+	}{
+		{
+			name: "nil encryption",
+		},
+		{
+			name:       "encrypted with the default key",
+			encryption: &VolumeEncryption{Encrypted: ptr.To(true)},
+		},
+		{
+			name:       "key ID",
+			encryption: &VolumeEncryption{EncryptionKey: "1234abcd-12ab-34cd-56ef-1234567890ab"},
+		},
+		{
+			name:       "multi-Region key ID",
+			encryption: &VolumeEncryption{EncryptionKey: "mrk-1234abcd12ab34cd56ef1234567890ab"},
+		},
+		{
+			name:       "key ARN",
+			encryption: &VolumeEncryption{EncryptionKey: "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"},
+		},
+		{
+			name:       "alias name",
+			encryption: &VolumeEncryption{Encrypted: ptr.To(true), EncryptionKey: "alias/control-plane"},
+		},
+		{
+			name:       "alias ARN in another partition",
+			encryption: &VolumeEncryption{EncryptionKey: "arn:aws-us-gov:kms:us-gov-west-1:123456789012:alias/control-plane"},
+		},
+		{
+			name:       "key with encryption disabled",
+			encryption: &VolumeEncryption{Encrypted: ptr.To(false), EncryptionKey: "alias/control-plane"},
+			wantErrs:   1,
+		},
+		{
+			name:       "key name without the alias prefix",
+			encryption: &VolumeEncryption{EncryptionKey: "control-plane"},
+			wantErrs:   1,
+		},
+		{
+			name:       "ARN of another service",
+			encryption: &VolumeEncryption{EncryptionKey: "arn:aws:iam::123456789012:role/control-plane"},
+			wantErrs:   1,
+		},
+		{
+			name:       "key ARN with an invalid key ID",
+			encryption: &VolumeEncryption{EncryptionKey: "arn:aws:kms:us-east-1:123456789012:key/control-plane"},
+			wantErrs:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := tt.encryption.Validate(field.NewPath("spec", "template", "spec", "controlPlaneVolumeEncryption"))
+			g.Expect(errs).To(HaveLen(tt.wantErrs))
+			for _, err := range errs {
+				g.Expect(err.Field).To(Equal("spec.template.spec.controlPlaneVolumeEncryption.encryptionKey"))
+			}
+		})
+	}
+}
@@ -62,6 +62,15 @@ func (r *EKSConfig) ConvertTo(dstRaw conversion.Hub) error {
 	if restored.Spec.NTP != nil {
 		dst.Spec.NTP = restored.Spec.NTP
 	}
+	if restored.Spec.ReportBootstrapReadiness != nil {
+		dst.Spec.ReportBootstrapReadiness = restored.Spec.ReportBootstrapReadiness
+	}
+	if restored.Spec.OperatingSystem != nil {
+		dst.Spec.OperatingSystem = restored.Spec.OperatingSystem
+	}
+	if restored.Spec.KubeletConfig != nil {
+		dst.Spec.KubeletConfig = restored.Spec.KubeletConfig
+	}
 
 	return nil
 }
@@ -129,6 +138,15 @@ func (r *EKSConfigTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	if restored.Spec.Template.Spec.NTP != nil {
 		dst.Spec.Template.Spec.NTP = restored.Spec.Template.Spec.NTP
 	}
+	if restored.Spec.Template.Spec.ReportBootstrapReadiness != nil {
+		dst.Spec.Template.Spec.ReportBootstrapReadiness = restored.Spec.Template.Spec.ReportBootstrapReadiness
+	}
+	if restored.Spec.Template.Spec.OperatingSystem != nil {
+		dst.Spec.Template.Spec.OperatingSystem = restored.Spec.Template.Spec.OperatingSystem
+	}
+	if restored.Spec.Template.Spec.KubeletConfig != nil {
+		dst.Spec.Template.Spec.KubeletConfig = restored.Spec.Template.Spec.KubeletConfig
+	}
 
 	return nil
 }
@@ -20,12 +20,30 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/randfill"
 
 	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
 	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 )
 
+func fuzzFuncs(_ runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		EKSConfigSpecFuzzer,
+	}
+}
+
+// EKSConfigSpecFuzzer uses a canonical kubelet config, as the raw JSON is re-encoded
+// with its keys sorted when it's stored in the conversion annotation.
+func EKSConfigSpecFuzzer(obj *eksbootstrapv1.EKSConfigSpec, c randfill.Continue) {
+	c.FillNoCustom(obj)
+	if obj.KubeletConfig != nil {
+		obj.KubeletConfig = &runtime.RawExtension{Raw: []byte(`{"kind":"KubeletConfiguration","maxPods":20}`)}
+	}
+}
+
 func TestFuzzyConversion(t *testing.T) {
 	g := NewWithT(t)
 	scheme := runtime.NewScheme()
@@ -33,14 +51,16 @@ func TestFuzzyConversion(t *testing.T) {
 	g.Expect(eksbootstrapv1.AddToScheme(scheme)).To(Succeed())
 
 	t.Run("for EKSConfig", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
-		Scheme: scheme,
-		Hub:    &eksbootstrapv1.EKSConfig{},
-		Spoke:  &EKSConfig{},
+		Scheme:      scheme,
+		Hub:         &eksbootstrapv1.EKSConfig{},
+		Spoke:       &EKSConfig{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
 	}))
 
 	t.Run("for EKSConfigTemplate", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
-		Scheme: scheme,
-		Hub:    &eksbootstrapv1.EKSConfigTemplate{},
-		Spoke:  &EKSConfigTemplate{},
+		Scheme:      scheme,
+		Hub:         &eksbootstrapv1.EKSConfigTemplate{},
+		Spoke:       &EKSConfigTemplate{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
 	}))
 }
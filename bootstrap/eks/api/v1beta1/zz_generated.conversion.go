@@ -223,6 +223,7 @@ func Convert_v1beta1_EKSConfigSpec_To_v1beta2_EKSConfigSpec(in *EKSConfigSpec, o
 
 func autoConvert_v1beta2_EKSConfigSpec_To_v1beta1_EKSConfigSpec(in *v1beta2.EKSConfigSpec, out *EKSConfigSpec, s conversion.Scope) error {
 	out.KubeletExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.KubeletExtraArgs))
+	// WARNING: in.KubeletConfig requires manual conversion: does not exist in peer-type
 	out.ContainerRuntime = (*string)(unsafe.Pointer(in.ContainerRuntime))
 	out.DNSClusterIP = (*string)(unsafe.Pointer(in.DNSClusterIP))
 	out.DockerConfigJSON = (*string)(unsafe.Pointer(in.DockerConfigJSON))
@@ -238,6 +239,8 @@ func autoConvert_v1beta2_EKSConfigSpec_To_v1beta1_EKSConfigSpec(in *v1beta2.EKSC
 	// WARNING: in.Mounts requires manual conversion: does not exist in peer-type
 	// WARNING: in.Users requires manual conversion: does not exist in peer-type
 	// WARNING: in.NTP requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReportBootstrapReadiness requires manual conversion: does not exist in peer-type
+	// WARNING: in.OperatingSystem requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -18,6 +18,7 @@ package v1beta2
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 )
@@ -27,6 +28,12 @@ type EKSConfigSpec struct {
 	// KubeletExtraArgs passes the specified kubelet args into the Amazon EKS machine bootstrap script
 	// +optional
 	KubeletExtraArgs map[string]string `json:"kubeletExtraArgs,omitempty"`
+	// KubeletConfig is a KubeletConfiguration that is merged into the node's kubelet config
+	// file before bootstrapping, for settings that can't be passed as kubelet args such as
+	// eviction thresholds.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	KubeletConfig *runtime.RawExtension `json:"kubeletConfig,omitempty"`
 	// ContainerRuntime specify the container runtime to use when bootstrapping EKS.
 	// +optional
 	ContainerRuntime *string `json:"containerRuntime,omitempty"`
@@ -74,6 +81,17 @@ type EKSConfigSpec struct {
 	// NTP specifies NTP configuration
 	// +optional
 	NTP *NTP `json:"ntp,omitempty"`
+	// ReportBootstrapReadiness, when true, tags the instance once the kubelet is healthy so
+	// that node readiness can be reported without access to the workload cluster.
+	// The node IAM role must be allowed to call ec2:CreateTags on the instance.
+	// +optional
+	ReportBootstrapReadiness *bool `json:"reportBootstrapReadiness,omitempty"`
+	// OperatingSystem is the operating system of the nodes being bootstrapped. Windows nodes are
+	// bootstrapped with the EKS Windows bootstrap script instead of cloud-init, and only support
+	// kubeletExtraArgs, containerRuntime, dnsClusterIP, the bootstrap commands and the bootstrap
+	// command override. Defaults to linux.
+	// +optional
+	OperatingSystem *OperatingSystem `json:"operatingSystem,omitempty"`
 }
 
 // PauseContainer contains details of pause container.
@@ -26,6 +26,10 @@ import (
 const (
 	// NodeadmConfigKind is the Kind for the NodeadmConfig resource.
 	NodeadmConfigKind = "NodeadmConfig"
+
+	// ControlPlaneEndpointAnnotation is set on the bootstrap data secret to the control plane
+	// endpoint that was embedded in the userdata, so that a change of endpoint can be detected.
+	ControlPlaneEndpointAnnotation = "eks.bootstrap.cluster.x-k8s.io/control-plane-endpoint"
 )
 
 // NodeadmConfigSpec defines the desired state of NodeadmConfig.
@@ -65,6 +69,12 @@ type NodeadmConfigSpec struct {
 	// Mounts specifies a list of mount points to be setup.
 	// +optional
 	Mounts []MountPoints `json:"mounts,omitempty"`
+
+	// ReportBootstrapReadiness, when true, tags the instance once the kubelet is healthy so
+	// that node readiness can be reported without access to the workload cluster.
+	// The node IAM role must be allowed to call ec2:CreateTags on the instance.
+	// +optional
+	ReportBootstrapReadiness *bool `json:"reportBootstrapReadiness,omitempty"`
 }
 
 // KubeletOptions are additional parameters passed to kubelet.
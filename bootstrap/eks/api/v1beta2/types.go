@@ -29,6 +29,17 @@ const (
 	GzipBase64 Encoding = "gzip+base64"
 )
 
+// OperatingSystem is the operating system of a node.
+// +kubebuilder:validation:Enum=linux;windows
+type OperatingSystem string
+
+const (
+	// OperatingSystemLinux is used for nodes running an EKS optimized Linux AMI.
+	OperatingSystemLinux OperatingSystem = "linux"
+	// OperatingSystemWindows is used for nodes running an EKS optimized Windows AMI.
+	OperatingSystemWindows OperatingSystem = "windows"
+)
+
 // File defines the input for generating write_files in cloud-init.
 type File struct {
 	// Path specifies the full path on disk where to store the file.
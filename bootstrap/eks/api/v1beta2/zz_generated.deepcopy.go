@@ -143,6 +143,11 @@ func (in *EKSConfigSpec) DeepCopyInto(out *EKSConfigSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.KubeletConfig != nil {
+		in, out := &in.KubeletConfig, &out.KubeletConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ContainerRuntime != nil {
 		in, out := &in.ContainerRuntime, &out.ContainerRuntime
 		*out = new(string)
@@ -228,6 +233,16 @@ func (in *EKSConfigSpec) DeepCopyInto(out *EKSConfigSpec) {
 		*out = new(NTP)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReportBootstrapReadiness != nil {
+		in, out := &in.ReportBootstrapReadiness, &out.ReportBootstrapReadiness
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OperatingSystem != nil {
+		in, out := &in.OperatingSystem, &out.OperatingSystem
+		*out = new(OperatingSystem)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSConfigSpec.
@@ -631,6 +646,11 @@ func (in *NodeadmConfigSpec) DeepCopyInto(out *NodeadmConfigSpec) {
 			}
 		}
 	}
+	if in.ReportBootstrapReadiness != nil {
+		in, out := &in.ReportBootstrapReadiness, &out.ReportBootstrapReadiness
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeadmConfigSpec.
@@ -205,6 +205,7 @@ func (r *EKSConfigReconciler) joinWorker(ctx context.Context, cluster *clusterv1
 		// AWSManagedControlPlane webhooks default and validate EKSClusterName
 		ClusterName:              controlPlane.Spec.EKSClusterName,
 		KubeletExtraArgs:         config.Spec.KubeletExtraArgs,
+		KubeletConfig:            config.Spec.KubeletConfig,
 		ContainerRuntime:         config.Spec.ContainerRuntime,
 		DNSClusterIP:             config.Spec.DNSClusterIP,
 		DockerConfigJSON:         config.Spec.DockerConfigJSON,
@@ -218,6 +219,7 @@ func (r *EKSConfigReconciler) joinWorker(ctx context.Context, cluster *clusterv1
 		DiskSetup:                config.Spec.DiskSetup,
 		Mounts:                   config.Spec.Mounts,
 		Files:                    files,
+		ReportBootstrapReadiness: ptr.Deref(config.Spec.ReportBootstrapReadiness, false),
 	}
 	if config.Spec.PauseContainer != nil {
 		nodeInput.PauseContainerAccount = &config.Spec.PauseContainer.AccountNumber
@@ -239,7 +241,12 @@ func (r *EKSConfigReconciler) joinWorker(ctx context.Context, cluster *clusterv1
 	}
 
 	// generate userdata
-	userDataScript, err := userdata.NewNode(nodeInput)
+	var userDataScript []byte
+	if ptr.Deref(config.Spec.OperatingSystem, eksbootstrapv1.OperatingSystemLinux) == eksbootstrapv1.OperatingSystemWindows {
+		userDataScript, err = userdata.NewWindowsNode(nodeInput)
+	} else {
+		userDataScript, err = userdata.NewNode(nodeInput)
+	}
 	if err != nil {
 		log.Error(err, "Failed to create a worker join configuration")
 		v1beta1conditions.MarkFalse(config, eksbootstrapv1.DataSecretAvailableCondition, eksbootstrapv1.DataSecretGenerationFailedReason, clusterv1beta1.ConditionSeverityWarning, "")
@@ -34,7 +34,9 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
@@ -151,7 +153,8 @@ func (r *NodeadmConfigReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 func (r *NodeadmConfigReconciler) joinWorker(ctx context.Context, cluster *clusterv1.Cluster, config *eksbootstrapv1.NodeadmConfig, configOwner *bsutil.ConfigOwner) (ctrl.Result, error) {
 	log := logger.FromContext(ctx)
 
-	// only need to reconcile the secret for Machine kinds once, but MachinePools need updates for new launch templates
+	// only need to reconcile the secret for Machine kinds once, unless the control plane endpoint it
+	// embeds has changed, but MachinePools need updates for new launch templates
 	if config.Status.DataSecretName != nil && configOwner.GetKind() == "Machine" {
 		secretKey := client.ObjectKey{Namespace: config.Namespace, Name: *config.Status.DataSecretName}
 		log = log.WithValues("data-secret-name", secretKey.Name)
@@ -162,10 +165,14 @@ func (r *NodeadmConfigReconciler) joinWorker(ctx context.Context, cluster *clust
 			log.Error(err, "unable to check for existing bootstrap secret")
 			return ctrl.Result{}, err
 		}
-		if err == nil {
+		if err == nil && !controlPlaneEndpointChanged(existingSecret, cluster) {
 			// We already have a secret that we don't need to regenerate
 			return ctrl.Result{}, nil
 		}
+		if err == nil {
+			log.Info("Control plane endpoint has changed, regenerating bootstrap data",
+				"endpoint", cluster.Spec.ControlPlaneEndpoint.Host)
+		}
 	}
 
 	if cluster.Spec.ControlPlaneRef.Kind != "AWSManagedControlPlane" {
@@ -229,6 +236,8 @@ func (r *NodeadmConfigReconciler) joinWorker(ctx context.Context, cluster *clust
 		Files:              files,
 		ServiceCIDR:        serviceCIDR,
 		APIServerEndpoint:  cluster.Spec.ControlPlaneEndpoint.Host,
+
+		ReportBootstrapReadiness: ptr.Deref(config.Spec.ReportBootstrapReadiness, false),
 	}
 	if config.Spec.Kubelet != nil {
 		nodeInput.KubeletFlags = config.Spec.Kubelet.Flags
@@ -305,7 +314,7 @@ func (r *NodeadmConfigReconciler) storeBootstrapData(ctx context.Context, cluste
 			return errors.Wrap(err, "failed to get data secret for NodeadmConfig")
 		}
 	} else {
-		updated, err := r.updateBootstrapSecret(ctx, secret, data)
+		updated, err := r.updateBootstrapSecret(ctx, secret, data, cluster.Spec.ControlPlaneEndpoint.Host)
 		if err != nil {
 			return errors.Wrap(err, "failed to update data secret for NodeadmConfig")
 		}
@@ -332,6 +341,9 @@ func (r *NodeadmConfigReconciler) createBootstrapSecret(ctx context.Context, clu
 			Labels: map[string]string{
 				clusterv1.ClusterNameLabel: cluster.Name,
 			},
+			Annotations: map[string]string{
+				eksbootstrapv1.ControlPlaneEndpointAnnotation: cluster.Spec.ControlPlaneEndpoint.Host,
+			},
 			OwnerReferences: []metav1.OwnerReference{
 				{
 					APIVersion: eksbootstrapv1.GroupVersion.String(),
@@ -350,18 +362,28 @@ func (r *NodeadmConfigReconciler) createBootstrapSecret(ctx context.Context, clu
 	return secret, r.Client.Create(ctx, secret)
 }
 
-// Update the userdata in the bootstrap Secret.
-func (r *NodeadmConfigReconciler) updateBootstrapSecret(ctx context.Context, secret *corev1.Secret, data []byte) (bool, error) {
+// Update the userdata in the bootstrap Secret, along with the control plane endpoint it embeds.
+func (r *NodeadmConfigReconciler) updateBootstrapSecret(ctx context.Context, secret *corev1.Secret, data []byte, endpoint string) (bool, error) {
 	if secret.Data == nil {
 		secret.Data = make(map[string][]byte)
 	}
-	if !bytes.Equal(secret.Data["value"], data) {
+	if !bytes.Equal(secret.Data["value"], data) || secret.Annotations[eksbootstrapv1.ControlPlaneEndpointAnnotation] != endpoint {
 		secret.Data["value"] = data
+		if secret.Annotations == nil {
+			secret.Annotations = make(map[string]string)
+		}
+		secret.Annotations[eksbootstrapv1.ControlPlaneEndpointAnnotation] = endpoint
 		return true, r.Client.Update(ctx, secret)
 	}
 	return false, nil
 }
 
+// controlPlaneEndpointChanged returns true if the bootstrap data secret was generated for a
+// different control plane endpoint than the one currently set on the Cluster.
+func controlPlaneEndpointChanged(secret *corev1.Secret, cluster *clusterv1.Cluster) bool {
+	return secret.Annotations[eksbootstrapv1.ControlPlaneEndpointAnnotation] != cluster.Spec.ControlPlaneEndpoint.Host
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *NodeadmConfigReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, option controller.Options) error {
 	b := ctrl.NewControllerManagedBy(mgr).
@@ -388,7 +410,10 @@ func (r *NodeadmConfigReconciler) SetupWithManager(ctx context.Context, mgr ctrl
 	err = c.Watch(
 		source.Kind[client.Object](mgr.GetCache(), &clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc((r.ClusterToNodeadmConfigs)),
-			predicates.ClusterPausedTransitionsOrInfrastructureProvisioned(mgr.GetScheme(), logger.FromContext(ctx).GetLogger())),
+			predicate.Or(
+				predicates.ClusterPausedTransitionsOrInfrastructureProvisioned(mgr.GetScheme(), logger.FromContext(ctx).GetLogger()),
+				clusterControlPlaneEndpointChanged(),
+			)),
 	)
 	if err != nil {
 		return errors.Wrap(err, "failed adding watch for Clusters to controller manager")
@@ -464,9 +489,41 @@ func (r *NodeadmConfigReconciler) ClusterToNodeadmConfigs(_ context.Context, o c
 		}
 	}
 
+	if feature.Gates.Enabled(feature.MachinePool) {
+		machinePoolList := &clusterv1.MachinePoolList{}
+		if err := r.Client.List(context.Background(), machinePoolList, selectors...); err != nil {
+			return nil
+		}
+
+		for _, mp := range machinePoolList.Items {
+			result = append(result, r.MachinePoolToBootstrapMapFunc(context.Background(), &mp)...)
+		}
+	}
+
 	return result
 }
 
+// clusterControlPlaneEndpointChanged returns a predicate that is true when the control plane
+// endpoint of a Cluster is set or changed, as the endpoint is embedded in the nodeadm userdata.
+func clusterControlPlaneEndpointChanged() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldCluster, ok := e.ObjectOld.(*clusterv1.Cluster)
+			if !ok {
+				return false
+			}
+			newCluster, ok := e.ObjectNew.(*clusterv1.Cluster)
+			if !ok {
+				return false
+			}
+			return oldCluster.Spec.ControlPlaneEndpoint != newCluster.Spec.ControlPlaneEndpoint
+		},
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
 func extractCAFromSecret(ctx context.Context, c client.Client, obj client.ObjectKey) (string, error) {
 	data, err := kubeconfigutil.FromSecret(ctx, c, obj)
 	if err != nil {
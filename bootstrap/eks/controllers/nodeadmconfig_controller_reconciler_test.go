@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
@@ -191,6 +192,121 @@ func TestNodeadmConfigReconciler_ResolvesSecretFileReference(t *testing.T) {
 	}
 }
 
+func TestNodeadmConfigReconciler_UpdateSecret_OnControlPlaneEndpointChange(t *testing.T) {
+	g := NewWithT(t)
+
+	amcp := newAMCP("test-cluster")
+	cluster := newCluster(amcp.Name)
+	cluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{Host: "https://9.9.9.9", Port: 6443}
+	newStatus := cluster.Status
+	amcpStatus := amcp.Status
+	g.Expect(testEnv.Client.Create(ctx, amcp)).To(Succeed())
+	g.Expect(testEnv.Client.Create(ctx, cluster)).To(Succeed())
+	cluster.Status = newStatus
+	g.Expect(testEnv.Client.Status().Update(ctx, cluster)).To(Succeed())
+	amcp.Status = amcpStatus
+	g.Expect(testEnv.Client.Status().Update(ctx, amcp)).To(Succeed())
+	kubeconfigSecret := newKubeconfigSecret("https://9.9.9.9:6443", cluster)
+	g.Expect(testEnv.Client.Create(ctx, kubeconfigSecret)).To(Succeed())
+
+	machine := newMachine(cluster, "test-machine-endpoint")
+	cfg := newNodeadmConfig(machine)
+	g.Expect(testEnv.Client.Create(ctx, cfg)).To(Succeed())
+
+	reconciler := NodeadmConfigReconciler{Client: testEnv.Client}
+
+	g.Eventually(func(gomega Gomega) {
+		_, err := reconciler.joinWorker(ctx, cluster, cfg, configOwner("Machine"))
+		gomega.Expect(err).NotTo(HaveOccurred())
+	}, time.Minute, time.Second*5).Should(Succeed())
+
+	secret := &corev1.Secret{}
+	g.Eventually(func(gomega Gomega) {
+		gomega.Expect(testEnv.Client.Get(ctx, client.ObjectKey{Name: cfg.Name, Namespace: "default"}, secret)).To(Succeed())
+		gomega.Expect(secret.Annotations).To(HaveKeyWithValue(eksbootstrapv1.ControlPlaneEndpointAnnotation, "https://9.9.9.9"))
+	}, time.Minute, time.Second*5).Should(Succeed())
+
+	// the secret of a Machine is only regenerated when the endpoint it embeds is stale
+	cluster.Spec.ControlPlaneEndpoint.Host = "https://8.8.8.8"
+
+	g.Eventually(func(gomega Gomega) {
+		_, err := reconciler.joinWorker(ctx, cluster, cfg, configOwner("Machine"))
+		gomega.Expect(err).NotTo(HaveOccurred())
+	}, time.Minute, time.Second*5).Should(Succeed())
+
+	g.Eventually(func(gomega Gomega) {
+		gomega.Expect(testEnv.Client.Get(ctx, client.ObjectKey{Name: cfg.Name, Namespace: "default"}, secret)).To(Succeed())
+		gomega.Expect(secret.Annotations).To(HaveKeyWithValue(eksbootstrapv1.ControlPlaneEndpointAnnotation, "https://8.8.8.8"))
+		gomega.Expect(string(secret.Data["value"])).To(ContainSubstring("apiServerEndpoint: https://8.8.8.8"))
+	}, time.Minute, time.Second*5).Should(Succeed())
+}
+
+func TestClusterControlPlaneEndpointChanged(t *testing.T) {
+	oldCluster := &clusterv1.Cluster{
+		Spec: clusterv1.ClusterSpec{
+			ControlPlaneEndpoint: clusterv1.APIEndpoint{Host: "https://9.9.9.9", Port: 443},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		endpoint clusterv1.APIEndpoint
+		expected bool
+	}{
+		{
+			name:     "endpoint unchanged",
+			endpoint: clusterv1.APIEndpoint{Host: "https://9.9.9.9", Port: 443},
+			expected: false,
+		},
+		{
+			name:     "endpoint host changed",
+			endpoint: clusterv1.APIEndpoint{Host: "https://8.8.8.8", Port: 443},
+			expected: true,
+		},
+		{
+			name:     "endpoint port changed",
+			endpoint: clusterv1.APIEndpoint{Host: "https://9.9.9.9", Port: 6443},
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			newCluster := oldCluster.DeepCopy()
+			newCluster.Spec.ControlPlaneEndpoint = tc.endpoint
+			g.Expect(clusterControlPlaneEndpointChanged().Update(event.UpdateEvent{ObjectOld: oldCluster, ObjectNew: newCluster})).To(Equal(tc.expected))
+		})
+	}
+
+	t.Run("cluster creation is ignored", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(clusterControlPlaneEndpointChanged().Create(event.CreateEvent{Object: oldCluster})).To(BeFalse())
+	})
+}
+
+func TestControlPlaneEndpointChanged(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{
+		Spec: clusterv1.ClusterSpec{
+			ControlPlaneEndpoint: clusterv1.APIEndpoint{Host: "https://9.9.9.9", Port: 443},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{eksbootstrapv1.ControlPlaneEndpointAnnotation: "https://9.9.9.9"},
+		},
+	}
+	g.Expect(controlPlaneEndpointChanged(secret, cluster)).To(BeFalse())
+
+	cluster.Spec.ControlPlaneEndpoint.Host = "https://8.8.8.8"
+	g.Expect(controlPlaneEndpointChanged(secret, cluster)).To(BeTrue())
+
+	// secrets generated before the endpoint was recorded are regenerated
+	g.Expect(controlPlaneEndpointChanged(&corev1.Secret{}, cluster)).To(BeTrue())
+}
+
 func TestNodeadmConfigReconcilerReturnEarlyIfClusterInfraNotReady(t *testing.T) {
 	g := NewWithT(t)
 
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// kubeletConfigPath is the kubelet config file the EKS bootstrap script starts the kubelet with.
+	kubeletConfigPath = "/etc/kubernetes/kubelet/kubelet-config.json"
+	// kubeletConfigOverridesPath is where the user supplied kubelet config is written to be merged.
+	kubeletConfigOverridesPath = "/etc/kubernetes/kubelet/kubelet-config-overrides.json"
+
+	kubeletConfigKind       = "KubeletConfiguration"
+	kubeletConfigAPIVersion = "kubelet.config.k8s.io/v1beta1"
+)
+
+// kubeletConfigMergeCommand merges the user supplied kubelet config into the AMI's kubelet config.
+// The bootstrap script edits the settings it manages in place, so the merged settings are kept.
+var kubeletConfigMergeCommand = fmt.Sprintf(`jq -s '.[0] * .[1]' %[1]s %[2]s > %[1]s.tmp && mv %[1]s.tmp %[1]s`,
+	kubeletConfigPath, kubeletConfigOverridesPath)
+
+// KubeletConfigJSON validates that the config is a KubeletConfiguration object and returns it as JSON.
+// The kind and apiVersion can be omitted, as only the settings are merged into the node's config.
+func KubeletConfigJSON(config *runtime.RawExtension) (string, error) {
+	if config == nil {
+		return "", nil
+	}
+
+	raw := config.Raw
+	if config.Object != nil {
+		b, err := json.Marshal(config.Object)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal kubelet config")
+		}
+		raw = b
+	}
+
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(raw, &values); err != nil {
+		return "", errors.Wrap(err, "failed to parse kubelet config")
+	}
+	if values == nil {
+		return "", errors.New("kubelet config must be an object")
+	}
+	if kind, ok := values["kind"]; ok && kind != kubeletConfigKind {
+		return "", errors.Errorf("kubelet config must be a %s, got %v", kubeletConfigKind, kind)
+	}
+	if apiVersion, ok := values["apiVersion"]; ok && apiVersion != kubeletConfigAPIVersion {
+		return "", errors.Errorf("kubelet config must use apiVersion %s, got %v", kubeletConfigAPIVersion, apiVersion)
+	}
+
+	b, err := json.Marshal(values)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal kubelet config")
+	}
+	return string(b), nil
+}
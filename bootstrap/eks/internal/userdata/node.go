@@ -22,21 +22,39 @@ import (
 	"text/template"
 
 	"github.com/alessio/shellescape"
+	"k8s.io/apimachinery/pkg/runtime"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
 )
 
 const (
 	defaultBootstrapCommand = "/etc/eks/bootstrap.sh"
 
+	// bootstrapReadinessCommand waits for the kubelet to become healthy and then tags
+	// the instance so that the controllers can observe node readiness.
+	bootstrapReadinessCommand = `for i in $(seq 1 60); do ` +
+		`if curl -sf http://localhost:10248/healthz >/dev/null; then ` +
+		`TOKEN=$(curl -sf -X PUT -H 'X-aws-ec2-metadata-token-ttl-seconds: 300' http://169.254.169.254/latest/api/token); ` +
+		`INSTANCE_ID=$(curl -sf -H "X-aws-ec2-metadata-token: $TOKEN" http://169.254.169.254/latest/meta-data/instance-id); ` +
+		`REGION=$(curl -sf -H "X-aws-ec2-metadata-token: $TOKEN" http://169.254.169.254/latest/meta-data/placement/region); ` +
+		`aws ec2 create-tags --region "$REGION" --resources "$INSTANCE_ID" --tags Key=%s,Value=true && break; ` +
+		`fi; sleep 10; done`
+
 	nodeUserData = `#cloud-config
-{{- if .Files }}
-{{template "files" .Files}}
+{{- with .WriteFiles }}
+{{template "files" .}}
 {{- end }}
 runcmd:
 {{- template "commands" .PreBootstrapCommands }}
+{{- if .KubeletConfig }}
+  - {{ printf "%q" .KubeletConfigMergeCommand }}
+{{- end }}
   - {{ .BootstrapCommand }} {{.ClusterName}} {{- template "args" . }}
 {{- template "commands" .PostBootstrapCommands }}
+{{- if .ReportBootstrapReadiness }}
+  - {{ printf "%q" .BootstrapReadinessCommand }}
+{{- end }}
 {{- template "ntp" .NTP }}
 {{- template "users" .Users }}
 {{- template "disk_setup" .DiskSetup}}
@@ -49,6 +67,7 @@ runcmd:
 type NodeInput struct {
 	ClusterName           string
 	KubeletExtraArgs      map[string]string
+	KubeletConfig         *runtime.RawExtension
 	ContainerRuntime      *string
 	DNSClusterIP          *string
 	DockerConfigJSON      *string
@@ -68,6 +87,7 @@ type NodeInput struct {
 	Mounts                   []eksbootstrapv1.MountPoints
 	Users                    []eksbootstrapv1.User
 	NTP                      *eksbootstrapv1.NTP
+	ReportBootstrapReadiness bool
 }
 
 // DockerConfigJSONEscaped returns the DockerConfigJSON escaped for use in cloud-init.
@@ -88,8 +108,42 @@ func (ni *NodeInput) BootstrapCommand() string {
 	return defaultBootstrapCommand
 }
 
+// WriteFiles returns the files to write on the node instance, including the kubelet config
+// to merge into the node's kubelet config file.
+func (ni *NodeInput) WriteFiles() ([]eksbootstrapv1.File, error) {
+	if ni.KubeletConfig == nil {
+		return ni.Files, nil
+	}
+
+	kubeletConfig, err := KubeletConfigJSON(ni.KubeletConfig)
+	if err != nil {
+		return nil, err
+	}
+	files := append([]eksbootstrapv1.File{}, ni.Files...)
+	return append(files, eksbootstrapv1.File{
+		Path:        kubeletConfigOverridesPath,
+		Owner:       "root:root",
+		Permissions: "0644",
+		Content:     kubeletConfig,
+	}), nil
+}
+
+// KubeletConfigMergeCommand returns the command that merges the kubelet config into the node's kubelet config file.
+func (ni *NodeInput) KubeletConfigMergeCommand() string {
+	return kubeletConfigMergeCommand
+}
+
+// BootstrapReadinessCommand returns the command that tags the instance once the kubelet is healthy.
+func (ni *NodeInput) BootstrapReadinessCommand() string {
+	return fmt.Sprintf(bootstrapReadinessCommand, infrav1.NodeBootstrapReadyTagKey)
+}
+
 // NewNode returns the user data string to be used on a node instance.
 func NewNode(input *NodeInput) ([]byte, error) {
+	if _, err := KubeletConfigJSON(input.KubeletConfig); err != nil {
+		return nil, fmt.Errorf("invalid kubelet config: %w", err)
+	}
+
 	tm := template.New("Node").Funcs(defaultTemplateFuncMap)
 
 	if _, err := tm.Parse(filesTemplate); err != nil {
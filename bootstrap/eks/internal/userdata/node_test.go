@@ -22,6 +22,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/format"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 
 	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
@@ -224,6 +225,22 @@ runcmd:
   - "echo \"testing pre\""
   - /etc/eks/bootstrap.sh test-cluster
   - "echo \"testing post\""
+`),
+		},
+		{
+			name: "with bootstrap readiness reporting",
+			args: args{
+				input: &NodeInput{
+					ClusterName:              "test-cluster",
+					PostBootstrapCommands:    []string{"date"},
+					ReportBootstrapReadiness: true,
+				},
+			},
+			expectedBytes: []byte(`#cloud-config
+runcmd:
+  - /etc/eks/bootstrap.sh test-cluster
+  - "date"
+  - "for i in $(seq 1 60); do if curl -sf http://localhost:10248/healthz >/dev/null; then TOKEN=$(curl -sf -X PUT -H 'X-aws-ec2-metadata-token-ttl-seconds: 300' http://169.254.169.254/latest/api/token); INSTANCE_ID=$(curl -sf -H \"X-aws-ec2-metadata-token: $TOKEN\" http://169.254.169.254/latest/meta-data/instance-id); REGION=$(curl -sf -H \"X-aws-ec2-metadata-token: $TOKEN\" http://169.254.169.254/latest/meta-data/placement/region); aws ec2 create-tags --region \"$REGION\" --resources \"$INSTANCE_ID\" --tags Key=sigs.k8s.io/cluster-api-provider-aws/node-bootstrap-ready,Value=true && break; fi; sleep 10; done"
 `),
 		},
 		{
@@ -310,6 +327,51 @@ runcmd:
   - /etc/eks/bootstrap.sh test-cluster
 `),
 		},
+		{
+			name: "with kubelet config",
+			args: args{
+				input: &NodeInput{
+					ClusterName: "test-cluster",
+					Files: []eksbootstrapv1.File{
+						{
+							Path:    "/etc/sysctl.d/91-fs-inotify.conf",
+							Content: "fs.inotify.max_user_instances=256",
+						},
+					},
+					PreBootstrapCommands: []string{"date"},
+					KubeletConfig: &runtime.RawExtension{
+						Raw: []byte(`{"evictionHard":{"memory.available":"200Mi"},"kind":"KubeletConfiguration"}`),
+					},
+				},
+			},
+			expectedBytes: []byte(`#cloud-config
+write_files:
+  - path: /etc/sysctl.d/91-fs-inotify.conf
+    content: |
+      fs.inotify.max_user_instances=256
+  - path: /etc/kubernetes/kubelet/kubelet-config-overrides.json
+    owner: root:root
+    permissions: '0644'
+    content: |
+      {"evictionHard":{"memory.available":"200Mi"},"kind":"KubeletConfiguration"}
+runcmd:
+  - "date"
+  - "jq -s '.[0] * .[1]' /etc/kubernetes/kubelet/kubelet-config.json /etc/kubernetes/kubelet/kubelet-config-overrides.json > /etc/kubernetes/kubelet/kubelet-config.json.tmp && mv /etc/kubernetes/kubelet/kubelet-config.json.tmp /etc/kubernetes/kubelet/kubelet-config.json"
+  - /etc/eks/bootstrap.sh test-cluster
+`),
+		},
+		{
+			name: "with invalid kubelet config",
+			args: args{
+				input: &NodeInput{
+					ClusterName: "test-cluster",
+					KubeletConfig: &runtime.RawExtension{
+						Raw: []byte(`["evictionHard"]`),
+					},
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "with empty files",
 			args: args{
@@ -394,3 +456,59 @@ users:
 		})
 	}
 }
+
+func TestKubeletConfigJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    *runtime.RawExtension
+		expected  string
+		expectErr bool
+	}{
+		{
+			name: "no config",
+		},
+		{
+			name:     "json config",
+			config:   &runtime.RawExtension{Raw: []byte(`{"maxPods": 20, "evictionHard": {"nodefs.available": "10%"}}`)},
+			expected: `{"evictionHard":{"nodefs.available":"10%"},"maxPods":20}`,
+		},
+		{
+			name:     "yaml config",
+			config:   &runtime.RawExtension{Raw: []byte("apiVersion: kubelet.config.k8s.io/v1beta1\nkind: KubeletConfiguration\nmaxPods: 20\n")},
+			expected: `{"apiVersion":"kubelet.config.k8s.io/v1beta1","kind":"KubeletConfiguration","maxPods":20}`,
+		},
+		{
+			name:      "config that doesn't parse",
+			config:    &runtime.RawExtension{Raw: []byte(`{"maxPods": `)},
+			expectErr: true,
+		},
+		{
+			name:      "config that isn't an object",
+			config:    &runtime.RawExtension{Raw: []byte(`null`)},
+			expectErr: true,
+		},
+		{
+			name:      "config of another kind",
+			config:    &runtime.RawExtension{Raw: []byte(`{"kind": "KubeProxyConfiguration"}`)},
+			expectErr: true,
+		},
+		{
+			name:      "config with another apiVersion",
+			config:    &runtime.RawExtension{Raw: []byte(`{"apiVersion": "kubelet.config.k8s.io/v1alpha1"}`)},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config, err := KubeletConfigJSON(tc.config)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(config).To(Equal(tc.expected))
+		})
+	}
+}
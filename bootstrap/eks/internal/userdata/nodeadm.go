@@ -23,6 +23,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
 )
 
@@ -71,6 +72,9 @@ set -o nounset
 {{- range .PreNodeadmCommands}}
 {{.}}
 {{- end}}
+{{- if .ReportBootstrapReadiness }}
+(set +o errexit +o nounset; {{ .BootstrapReadinessCommand }}) >/dev/null 2>&1 &
+{{- end}}
 --{{ .Boundary }}`
 
 	// Node config part template for nodeadm.
@@ -137,6 +141,10 @@ type NodeadmInput struct {
 	Users              []eksbootstrapv1.User
 	NTP                *eksbootstrapv1.NTP
 
+	// ReportBootstrapReadiness tags the instance in the background once the kubelet started by
+	// nodeadm is healthy.
+	ReportBootstrapReadiness bool
+
 	AMIImageID        string
 	APIServerEndpoint string
 	Boundary          string
@@ -145,6 +153,11 @@ type NodeadmInput struct {
 	ClusterDNS        string
 }
 
+// BootstrapReadinessCommand returns the command that tags the instance once the kubelet is healthy.
+func (ni *NodeadmInput) BootstrapReadinessCommand() string {
+	return fmt.Sprintf(bootstrapReadinessCommand, infrav1.NodeBootstrapReadyTagKey)
+}
+
 // validateNodeInput validates the input for nodeadm user data generation.
 func validateNodeadmInput(input *NodeadmInput) error {
 	if input.APIServerEndpoint == "" {
@@ -177,7 +190,7 @@ func NewNodeadmUserdata(input *NodeadmInput) ([]byte, error) {
 	}
 
 	// Write shell script part if needed
-	if len(input.PreNodeadmCommands) > 0 {
+	if len(input.PreNodeadmCommands) > 0 || input.ReportBootstrapReadiness {
 		shellScriptTemplate := template.Must(template.New("shell").Parse(shellScriptPartTemplate))
 		if err := shellScriptTemplate.Execute(&buf, input); err != nil {
 			return nil, fmt.Errorf("failed to execute shell script template: %v", err)
@@ -143,6 +143,24 @@ evictionHard:
 					strings.Contains(output, "apiVersion: node.eks.aws/v1alpha1")
 			},
 		},
+		{
+			name: "with bootstrap readiness reporting",
+			args: args{
+				input: &NodeadmInput{
+					ClusterName:              "test-cluster",
+					APIServerEndpoint:        "https://example.com",
+					CACert:                   "test-ca-cert",
+					ReportBootstrapReadiness: true,
+				},
+			},
+			expectErr: false,
+			verifyOutput: func(output string) bool {
+				return strings.Contains(output, "#!/bin/bash") &&
+					strings.Contains(output, "(set +o errexit +o nounset; for i in $(seq 1 60)") &&
+					strings.Contains(output, "--tags Key=sigs.k8s.io/cluster-api-provider-aws/node-bootstrap-ready,Value=true") &&
+					strings.Contains(output, ") >/dev/null 2>&1 &")
+			},
+		},
 		{
 			name: "with custom AMI",
 			args: args{
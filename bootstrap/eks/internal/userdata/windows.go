@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+const (
+	defaultWindowsBootstrapCommand = `$env:ProgramFiles\Amazon\EKS\Start-EKSBootstrap.ps1`
+
+	// defaultWindowsContainerRuntime is the only container runtime supported by the
+	// EKS optimized Windows AMIs since Kubernetes 1.24.
+	defaultWindowsContainerRuntime = "containerd"
+
+	windowsArgsTemplate = `{{- define "windowsArgs" -}}
+{{- if .KubeletExtraArgs }} -KubeletExtraArgs {{ psQuote (kubeletArgs .KubeletExtraArgs) }}{{- end -}}
+{{- if .DNSClusterIP }} -DNSClusterIP {{ psQuote .DNSClusterIP }}{{- end }} -ContainerRuntime {{ psQuote .WindowsContainerRuntime }}
+{{- end -}}`
+
+	windowsCommandsTemplate = `{{- define "windowsCommands" -}}
+{{- range . }}
+{{ . }}
+{{- end -}}
+{{- end -}}`
+
+	windowsNodeUserData = `<powershell>
+{{- template "windowsCommands" .PreBootstrapCommands }}
+[string]$EKSBootstrapScriptFile = "{{ .WindowsBootstrapCommand }}"
+& $EKSBootstrapScriptFile -EKSClusterName {{ psQuote .ClusterName }}{{ template "windowsArgs" . }} 3>&1 4>&1 5>&1 6>&1
+{{- template "windowsCommands" .PostBootstrapCommands }}
+</powershell>
+`
+)
+
+// WindowsBootstrapCommand returns the bootstrap script to be used on a Windows node instance.
+func (ni *NodeInput) WindowsBootstrapCommand() string {
+	if ni.BootstrapCommandOverride != nil && *ni.BootstrapCommandOverride != "" {
+		return *ni.BootstrapCommandOverride
+	}
+
+	return defaultWindowsBootstrapCommand
+}
+
+// WindowsContainerRuntime returns the container runtime to be used on a Windows node instance.
+func (ni *NodeInput) WindowsContainerRuntime() string {
+	if ni.ContainerRuntime != nil && *ni.ContainerRuntime != "" {
+		return *ni.ContainerRuntime
+	}
+
+	return defaultWindowsContainerRuntime
+}
+
+// validateWindows returns an error if the input uses options that can't be
+// applied by the EKS Windows bootstrap script.
+func (ni *NodeInput) validateWindows() error {
+	unsupported := []string{}
+	if len(ni.Files) > 0 {
+		unsupported = append(unsupported, "files")
+	}
+	if ni.DiskSetup != nil {
+		unsupported = append(unsupported, "diskSetup")
+	}
+	if len(ni.Mounts) > 0 {
+		unsupported = append(unsupported, "mounts")
+	}
+	if len(ni.Users) > 0 {
+		unsupported = append(unsupported, "users")
+	}
+	if ni.NTP != nil {
+		unsupported = append(unsupported, "ntp")
+	}
+	if ni.KubeletConfig != nil {
+		unsupported = append(unsupported, "kubeletConfig")
+	}
+	if ni.DockerConfigJSON != nil {
+		unsupported = append(unsupported, "dockerConfigJson")
+	}
+	if ni.APIRetryAttempts != nil {
+		unsupported = append(unsupported, "apiRetryAttempts")
+	}
+	if ni.PauseContainerAccount != nil || ni.PauseContainerVersion != nil {
+		unsupported = append(unsupported, "pauseContainer")
+	}
+	if ni.UseMaxPods != nil {
+		unsupported = append(unsupported, "useMaxPods")
+	}
+	if ni.IPFamily != nil && *ni.IPFamily == "ipv6" {
+		unsupported = append(unsupported, "ipv6")
+	}
+	if ni.ReportBootstrapReadiness {
+		unsupported = append(unsupported, "reportBootstrapReadiness")
+	}
+	if len(unsupported) > 0 {
+		return fmt.Errorf("%s not supported on Windows nodes", strings.Join(unsupported, ", "))
+	}
+	return nil
+}
+
+// templatePowerShellQuote quotes a value as a PowerShell single quoted string.
+func templatePowerShellQuote(value any) string {
+	var s string
+	switch v := value.(type) {
+	case *string:
+		s = *v
+	default:
+		s = fmt.Sprint(v)
+	}
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// templateKubeletArgs renders the kubelet args in the same format as the Linux bootstrap.
+func templateKubeletArgs(args map[string]string) (string, error) {
+	t, err := template.New("kubeletArgs").Parse(kubeletArgsTemplate + `{{ template "kubeletArgsTemplate" . }}`)
+	if err != nil {
+		return "", err
+	}
+	var out bytes.Buffer
+	if err := t.Execute(&out, args); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// NewWindowsNode returns the user data string to be used on a Windows node instance.
+func NewWindowsNode(input *NodeInput) ([]byte, error) {
+	if err := input.validateWindows(); err != nil {
+		return nil, err
+	}
+
+	tm := template.New("WindowsNode").Funcs(template.FuncMap{
+		"psQuote":     templatePowerShellQuote,
+		"kubeletArgs": templateKubeletArgs,
+	})
+
+	if _, err := tm.Parse(windowsArgsTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse windows args template: %w", err)
+	}
+
+	if _, err := tm.Parse(windowsCommandsTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse windows commands template: %w", err)
+	}
+
+	t, err := tm.Parse(windowsNodeUserData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse WindowsNode template: %w", err)
+	}
+
+	var out bytes.Buffer
+	if err := t.Execute(&out, input); err != nil {
+		return nil, fmt.Errorf("failed to generate WindowsNode template: %w", err)
+	}
+
+	return out.Bytes(), nil
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/format"
+	"k8s.io/utils/ptr"
+
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+)
+
+func TestNewWindowsNode(t *testing.T) {
+	format.TruncatedDiff = false
+
+	tests := []struct {
+		name          string
+		input         *NodeInput
+		expectedBytes []byte
+		expectErr     bool
+	}{
+		{
+			name: "only cluster name",
+			input: &NodeInput{
+				ClusterName: "test-cluster",
+			},
+			expectedBytes: []byte(`<powershell>
+[string]$EKSBootstrapScriptFile = "$env:ProgramFiles\Amazon\EKS\Start-EKSBootstrap.ps1"
+& $EKSBootstrapScriptFile -EKSClusterName 'test-cluster' -ContainerRuntime 'containerd' 3>&1 4>&1 5>&1 6>&1
+</powershell>
+`),
+		},
+		{
+			name: "with kubelet args, dns cluster ip and commands",
+			input: &NodeInput{
+				ClusterName: "test-cluster",
+				KubeletExtraArgs: map[string]string{
+					"node-labels":          "os=windows",
+					"register-with-taints": "os=windows:NoSchedule",
+				},
+				DNSClusterIP:          ptr.To("10.100.0.10"),
+				PreBootstrapCommands:  []string{"Write-Host 'joining'"},
+				PostBootstrapCommands: []string{"Write-Host 'joined'"},
+			},
+			expectedBytes: []byte(`<powershell>
+Write-Host 'joining'
+[string]$EKSBootstrapScriptFile = "$env:ProgramFiles\Amazon\EKS\Start-EKSBootstrap.ps1"
+& $EKSBootstrapScriptFile -EKSClusterName 'test-cluster' -KubeletExtraArgs '--node-labels=os=windows --register-with-taints=os=windows:NoSchedule' -DNSClusterIP '10.100.0.10' -ContainerRuntime 'containerd' 3>&1 4>&1 5>&1 6>&1
+Write-Host 'joined'
+</powershell>
+`),
+		},
+		{
+			name: "with bootstrap command override and quotes in the cluster name",
+			input: &NodeInput{
+				ClusterName:              "it's-a-cluster",
+				BootstrapCommandOverride: ptr.To(`C:\bootstrap.ps1`),
+			},
+			expectedBytes: []byte(`<powershell>
+[string]$EKSBootstrapScriptFile = "C:\bootstrap.ps1"
+& $EKSBootstrapScriptFile -EKSClusterName 'it''s-a-cluster' -ContainerRuntime 'containerd' 3>&1 4>&1 5>&1 6>&1
+</powershell>
+`),
+		},
+		{
+			name: "cloud-init options are rejected",
+			input: &NodeInput{
+				ClusterName: "test-cluster",
+				Users:       []eksbootstrapv1.User{{Name: "admin"}},
+				NTP:         &eksbootstrapv1.NTP{Enabled: ptr.To(true)},
+			},
+			expectErr: true,
+		},
+		{
+			name: "ipv6 is rejected",
+			input: &NodeInput{
+				ClusterName: "test-cluster",
+				IPFamily:    ptr.To("ipv6"),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			bytes, err := NewWindowsNode(tt.input)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(string(bytes)).To(Equal(string(tt.expectedBytes)))
+		})
+	}
+}
@@ -18,13 +18,17 @@ package webhooks
 
 import (
 	"context"
+	"fmt"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/internal/userdata"
 )
 
 // EKSConfig implements a custom validation webhook for EKSConfig.
@@ -46,13 +50,38 @@ var _ webhook.CustomDefaulter = &EKSConfig{}
 var _ webhook.CustomValidator = &EKSConfig{}
 
 // ValidateCreate will do any extra validation when creating a EKSConfig.
-func (w *EKSConfig) ValidateCreate(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
-	return nil, nil
+func (w *EKSConfig) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, w.validate(obj)
 }
 
 // ValidateUpdate will do any extra validation when updating a EKSConfig.
-func (w *EKSConfig) ValidateUpdate(_ context.Context, _, _ runtime.Object) (admission.Warnings, error) {
-	return nil, nil
+func (w *EKSConfig) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, w.validate(newObj)
+}
+
+func (w *EKSConfig) validate(obj runtime.Object) error {
+	r, ok := obj.(*eksbootstrapv1.EKSConfig)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected an EKSConfig object but got %T", obj))
+	}
+
+	allErrs := validateKubeletConfig(field.NewPath("spec"), &r.Spec)
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(eksbootstrapv1.GroupVersion.WithKind("EKSConfig").GroupKind(), r.Name, allErrs)
+}
+
+// validateKubeletConfig ensures the kubelet config can be merged into the node's kubelet config file.
+func validateKubeletConfig(specPath *field.Path, spec *eksbootstrapv1.EKSConfigSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if _, err := userdata.KubeletConfigJSON(spec.KubeletConfig); err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("kubeletConfig"), string(spec.KubeletConfig.Raw), err.Error()))
+	}
+
+	return allErrs
 }
 
 // ValidateDelete allows you to add any extra validation when deleting.
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+)
+
+func TestEKSConfigValidateKubeletConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		kubeletConfig *runtime.RawExtension
+		expectError   bool
+	}{
+		{
+			name: "no kubelet config",
+		},
+		{
+			name:          "valid kubelet config",
+			kubeletConfig: &runtime.RawExtension{Raw: []byte(`{"kind":"KubeletConfiguration","evictionHard":{"memory.available":"200Mi"}}`)},
+		},
+		{
+			name:          "kubelet config of another kind",
+			kubeletConfig: &runtime.RawExtension{Raw: []byte(`{"kind":"KubeProxyConfiguration"}`)},
+			expectError:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := eksbootstrapv1.EKSConfigSpec{KubeletConfig: tc.kubeletConfig}
+
+			_, err := (&EKSConfig{}).ValidateCreate(context.Background(), &eksbootstrapv1.EKSConfig{Spec: spec})
+			_, templateErr := (&EKSConfigTemplate{}).ValidateCreate(context.Background(), &eksbootstrapv1.EKSConfigTemplate{
+				Spec: eksbootstrapv1.EKSConfigTemplateSpec{
+					Template: eksbootstrapv1.EKSConfigTemplateResource{Spec: spec},
+				},
+			})
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring("spec.kubeletConfig"))
+				g.Expect(templateErr).To(HaveOccurred())
+				g.Expect(templateErr.Error()).To(ContainSubstring("spec.template.spec.kubeletConfig"))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(templateErr).NotTo(HaveOccurred())
+		})
+	}
+}
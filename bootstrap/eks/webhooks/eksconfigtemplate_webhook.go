@@ -18,8 +18,11 @@ package webhooks
 
 import (
 	"context"
+	"fmt"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -46,13 +49,27 @@ var _ webhook.CustomDefaulter = &EKSConfigTemplate{}
 var _ webhook.CustomValidator = &EKSConfigTemplate{}
 
 // ValidateCreate will do any extra validation when creating a EKSConfigTemplate.
-func (w *EKSConfigTemplate) ValidateCreate(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
-	return nil, nil
+func (w *EKSConfigTemplate) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, w.validate(obj)
 }
 
 // ValidateUpdate will do any extra validation when updating a EKSConfigTemplate.
-func (w *EKSConfigTemplate) ValidateUpdate(_ context.Context, _, _ runtime.Object) (admission.Warnings, error) {
-	return nil, nil
+func (w *EKSConfigTemplate) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, w.validate(newObj)
+}
+
+func (w *EKSConfigTemplate) validate(obj runtime.Object) error {
+	r, ok := obj.(*eksbootstrapv1.EKSConfigTemplate)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected an EKSConfigTemplate object but got %T", obj))
+	}
+
+	allErrs := validateKubeletConfig(field.NewPath("spec", "template", "spec"), &r.Spec.Template.Spec)
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(eksbootstrapv1.GroupVersion.WithKind("EKSConfigTemplate").GroupKind(), r.Name, allErrs)
 }
 
 // ValidateDelete allows you to add any extra validation when deleting.
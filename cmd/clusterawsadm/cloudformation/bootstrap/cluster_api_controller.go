@@ -152,6 +152,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:RevokeSecurityGroupIngress",
 				"ec2:RunInstances",
 				"ec2:TerminateInstances",
+				"ec2:UpdateSecurityGroupRuleDescriptionsIngress",
 				"ec2:GetSecurityGroupsForVpc",
 				"tag:GetResources",
 				"elasticloadbalancing:AddTags",
@@ -191,6 +192,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DeleteLaunchTemplateVersions",
 				"ec2:DescribeKeyPairs",
 				"ec2:ModifyInstanceMetadataOptions",
+				"kms:DescribeKey",
 				"eks:CreateAccessEntry",
 				"eks:DeleteAccessEntry",
 				"eks:DescribeAccessEntry",
@@ -214,6 +216,8 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"autoscaling:StartInstanceRefresh",
 				"autoscaling:DeleteAutoScalingGroup",
 				"autoscaling:DeleteTags",
+				"autoscaling:SuspendProcesses",
+				"autoscaling:ResumeProcesses",
 			},
 		},
 		{
@@ -307,6 +311,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"s3:CreateBucket",
 				"s3:DeleteBucket",
 				"s3:DeleteObject",
+				"s3:GetBucketPolicy",
 				"s3:GetObject",
 				"s3:ListBucket",
 				"s3:PutBucketPolicy",
@@ -418,6 +423,7 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 				"iam:UpdateOpenIDConnectProviderThumbprint",
 				"iam:DeleteOpenIDConnectProvider",
 				"iam:TagOpenIDConnectProvider",
+				"iam:UntagOpenIDConnectProvider",
 			},
 			Resource: iamv1.Resources{
 				"*",
@@ -434,6 +440,15 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 			},
 			Effect: iamv1.EffectAllow,
 		},
+		{
+			Action: iamv1.Actions{
+				"iam:GetInstanceProfile",
+			},
+			Resource: iamv1.Resources{
+				"arn:*:iam::*:instance-profile/*",
+			},
+			Effect: iamv1.EffectAllow,
+		},
 		{
 			Action: iamv1.Actions{
 				"iam:GetPolicy",
@@ -459,6 +474,7 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 				"eks:UpdateNodegroupConfig",
 				"eks:CreateNodegroup",
 				"eks:AssociateEncryptionConfig",
+				"eks:DescribeUpdate",
 				"eks:ListIdentityProviderConfigs",
 				"eks:AssociateIdentityProviderConfig",
 				"eks:DescribeIdentityProviderConfig",
@@ -490,6 +506,17 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 			},
 			Effect: iamv1.EffectAllow,
 		},
+		{
+			Action: iamv1.Actions{
+				"ecr:DescribePullThroughCacheRules",
+				"ecr:CreatePullThroughCacheRule",
+				"ecr:UpdatePullThroughCacheRule",
+			},
+			Resource: iamv1.Resources{
+				"*",
+			},
+			Effect: iamv1.EffectAllow,
+		},
 		{
 			Action: iamv1.Actions{
 				"iam:PassRole",
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fargate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	ekssvc "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks"
+)
+
+func importCmd() *cobra.Command {
+	eksClusterName := ""
+	clusterName := ""
+	namespace := ""
+	region := ""
+
+	newCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import existing EKS fargate profiles",
+		Long:  "Prints an AWSFargateProfile for each fargate profile of an EKS cluster, so that existing profiles can be adopted by a cluster",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return importFargateProfiles(region, eksClusterName, clusterName, namespace)
+		},
+	}
+
+	newCmd.Flags().StringVarP(&region, "region", "r", "", "The AWS region containing the EKS cluster")
+	newCmd.Flags().StringVarP(&eksClusterName, "eks-cluster-name", "e", "", "The name of the EKS cluster to import the fargate profiles of")
+	newCmd.Flags().StringVarP(&clusterName, "cluster-name", "c", "", "The name of the Cluster the fargate profiles will belong to")
+	newCmd.Flags().StringVarP(&namespace, "namespace", "n", "default", "The namespace of the Cluster the fargate profiles will belong to")
+	newCmd.MarkFlagRequired("eks-cluster-name") //nolint: errcheck
+	newCmd.MarkFlagRequired("cluster-name")     //nolint: errcheck
+
+	return newCmd
+}
+
+func importFargateProfiles(region, eksClusterName, clusterName, namespace string) error {
+	ctx := context.TODO()
+
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(region))
+	if err != nil {
+		return err
+	}
+
+	eksClient := &ekssvc.EKSClient{Client: eks.NewFromConfig(cfg)}
+
+	profiles, err := ekssvc.ImportFargateProfiles(ctx, eksClient, eksClusterName, clusterName, namespace)
+	if err != nil {
+		return fmt.Errorf("importing fargate profiles: %w", err)
+	}
+
+	if len(profiles) == 0 {
+		fmt.Println("No EKS fargate profiles found")
+		return nil
+	}
+
+	for i := range profiles {
+		out, err := yaml.Marshal(&profiles[i])
+		if err != nil {
+			return fmt.Errorf("marshalling fargate profile %s: %w", profiles[i].Spec.ProfileName, err)
+		}
+		fmt.Printf("---\n%s", out)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+)
+
+const (
+	// ControlPlaneVolumeEncryptionLastAppliedAnnotation is the key for the
+	// AWSCluster annotation which tracks the control plane volume encryption
+	// that the current control plane machines were created with.
+	ControlPlaneVolumeEncryptionLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-aws-last-applied-control-plane-volume-encryption"
+)
+
+// reconcileControlPlaneVolumeEncryption rolls out a KubeadmControlPlane when
+// the control plane volume encryption changes, as the volumes of existing
+// machines cannot be re-encrypted in place. Other control plane providers
+// have to be rolled out manually, which is reported with an event.
+func (r *AWSClusterReconciler) reconcileControlPlaneVolumeEncryption(ctx context.Context, clusterScope *scope.ClusterScope) error {
+	awsCluster := clusterScope.AWSCluster
+
+	b, err := json.Marshal(awsCluster.Spec.ControlPlaneVolumeEncryption)
+	if err != nil {
+		return err
+	}
+	desired := string(b)
+
+	lastApplied, found := awsCluster.GetAnnotations()[ControlPlaneVolumeEncryptionLastAppliedAnnotation]
+	if found && lastApplied != desired {
+		if err := r.rolloutControlPlane(ctx, clusterScope); err != nil {
+			return err
+		}
+	}
+
+	annotations := awsCluster.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ControlPlaneVolumeEncryptionLastAppliedAnnotation] = desired
+	awsCluster.SetAnnotations(annotations)
+	return nil
+}
+
+func (r *AWSClusterReconciler) rolloutControlPlane(ctx context.Context, clusterScope *scope.ClusterScope) error {
+	awsCluster := clusterScope.AWSCluster
+	ref := clusterScope.Cluster.Spec.ControlPlaneRef
+
+	if !ref.IsDefined() || ref.Kind != "KubeadmControlPlane" || ref.APIGroup != controlplanev1.GroupVersion.Group {
+		r.Recorder.Eventf(awsCluster, corev1.EventTypeWarning, "ControlPlaneRolloutRequired",
+			"Control plane volume encryption changed, the control plane machines need to be rolled out to apply it")
+		return nil
+	}
+
+	kcp := &controlplanev1.KubeadmControlPlane{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: clusterScope.Cluster.Namespace, Name: ref.Name}, kcp); err != nil {
+		return errors.Wrapf(err, "failed to get KubeadmControlPlane %s/%s", clusterScope.Cluster.Namespace, ref.Name)
+	}
+
+	original := kcp.DeepCopy()
+	kcp.Spec.Rollout.After = metav1.Now()
+	if err := r.Patch(ctx, kcp, client.MergeFrom(original)); err != nil {
+		return errors.Wrapf(err, "failed to roll out KubeadmControlPlane %s/%s", kcp.Namespace, kcp.Name)
+	}
+
+	r.Recorder.Eventf(awsCluster, corev1.EventTypeNormal, "ControlPlaneRolloutTriggered",
+		"Control plane volume encryption changed, rolling out KubeadmControlPlane %s", kcp.Name)
+	return nil
+}
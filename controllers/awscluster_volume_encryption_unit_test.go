@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileControlPlaneVolumeEncryption(t *testing.T) {
+	testCases := []struct {
+		name          string
+		annotations   map[string]string
+		kind          string
+		expectRollout bool
+		expectEvent   string
+	}{
+		{
+			name: "new cluster only records the encryption",
+			kind: "KubeadmControlPlane",
+		},
+		{
+			name:        "unchanged encryption does nothing",
+			annotations: map[string]string{ControlPlaneVolumeEncryptionLastAppliedAnnotation: `{"encrypted":true}`},
+			kind:        "KubeadmControlPlane",
+		},
+		{
+			name:          "changed encryption rolls out the KubeadmControlPlane",
+			annotations:   map[string]string{ControlPlaneVolumeEncryptionLastAppliedAnnotation: "null"},
+			kind:          "KubeadmControlPlane",
+			expectRollout: true,
+			expectEvent:   "ControlPlaneRolloutTriggered",
+		},
+		{
+			name:        "changed encryption of another control plane provider is reported",
+			annotations: map[string]string{ControlPlaneVolumeEncryptionLastAppliedAnnotation: "null"},
+			kind:        "OtherControlPlane",
+			expectEvent: "ControlPlaneRolloutRequired",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = clusterv1.AddToScheme(scheme)
+			_ = controlplanev1.AddToScheme(scheme)
+
+			kcp := &controlplanev1.KubeadmControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "test-cp", Namespace: "default"}}
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", Annotations: tc.annotations},
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneVolumeEncryption: &infrav1.VolumeEncryption{Encrypted: ptr.To(true)},
+				},
+			}
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec: clusterv1.ClusterSpec{
+					ControlPlaneRef: clusterv1.ContractVersionedObjectReference{
+						APIGroup: controlplanev1.GroupVersion.Group,
+						Kind:     tc.kind,
+						Name:     "test-cp",
+					},
+				},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(kcp, awsCluster).Build()
+			recorder := record.NewFakeRecorder(1)
+			r := AWSClusterReconciler{Client: c, Recorder: recorder}
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     c,
+				Cluster:    cluster,
+				AWSCluster: awsCluster,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			g.Expect(r.reconcileControlPlaneVolumeEncryption(context.TODO(), clusterScope)).To(Succeed())
+			g.Expect(awsCluster.Annotations).To(HaveKeyWithValue(ControlPlaneVolumeEncryptionLastAppliedAnnotation, `{"encrypted":true}`))
+
+			got := &controlplanev1.KubeadmControlPlane{}
+			g.Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(kcp), got)).To(Succeed())
+			g.Expect(got.Spec.Rollout.After.IsZero()).To(Equal(!tc.expectRollout))
+
+			if tc.expectEvent == "" {
+				g.Expect(recorder.Events).To(BeEmpty())
+			} else {
+				g.Expect(recorder.Events).To(Receive(ContainSubstring(tc.expectEvent)))
+			}
+		})
+	}
+}
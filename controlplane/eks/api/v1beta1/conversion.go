@@ -120,8 +120,18 @@ func (r *AWSManagedControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.RolePath = restored.Spec.RolePath
 	dst.Spec.RolePermissionsBoundary = restored.Spec.RolePermissionsBoundary
 	dst.Status.Version = restored.Status.Version
+	dst.Status.EncryptionConfigUpdateID = restored.Status.EncryptionConfigUpdateID
 	dst.Spec.BootstrapSelfManagedAddons = restored.Spec.BootstrapSelfManagedAddons
 	dst.Spec.UpgradePolicy = restored.Spec.UpgradePolicy
+	dst.Spec.DefaultNodeLabels = restored.Spec.DefaultNodeLabels
+	dst.Spec.DefaultNodeTaints = restored.Spec.DefaultNodeTaints
+	dst.Spec.DefaultNodeVolumeEncryption = restored.Spec.DefaultNodeVolumeEncryption
+	dst.Spec.AdditionalSecurityGroupIDs = restored.Spec.AdditionalSecurityGroupIDs
+	dst.Spec.ExclusiveAdditionalSecurityGroups = restored.Spec.ExclusiveAdditionalSecurityGroups
+	dst.Spec.DefaultNodeInstanceType = restored.Spec.DefaultNodeInstanceType
+	dst.Spec.NodegroupAPIBackoff = restored.Spec.NodegroupAPIBackoff
+	dst.Spec.ECRPullThroughCache = restored.Spec.ECRPullThroughCache
+	dst.Spec.RoleSessionName = restored.Spec.RoleSessionName
 	return nil
 }
 
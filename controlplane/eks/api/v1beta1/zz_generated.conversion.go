@@ -345,6 +345,7 @@ func autoConvert_v1beta1_AWSManagedControlPlaneSpec_To_v1beta2_AWSManagedControl
 func autoConvert_v1beta2_AWSManagedControlPlaneSpec_To_v1beta1_AWSManagedControlPlaneSpec(in *v1beta2.AWSManagedControlPlaneSpec, out *AWSManagedControlPlaneSpec, s conversion.Scope) error {
 	out.EKSClusterName = in.EKSClusterName
 	out.IdentityRef = (*apiv1beta2.AWSIdentityReference)(unsafe.Pointer(in.IdentityRef))
+	// WARNING: in.RoleSessionName requires manual conversion: does not exist in peer-type
 	out.NetworkSpec = in.NetworkSpec
 	out.SecondaryCidrBlock = (*string)(unsafe.Pointer(in.SecondaryCidrBlock))
 	out.Region = in.Region
@@ -362,6 +363,8 @@ func autoConvert_v1beta2_AWSManagedControlPlaneSpec_To_v1beta1_AWSManagedControl
 	if err := Convert_v1beta2_EndpointAccess_To_v1beta1_EndpointAccess(&in.EndpointAccess, &out.EndpointAccess, s); err != nil {
 		return err
 	}
+	// WARNING: in.AdditionalSecurityGroupIDs requires manual conversion: does not exist in peer-type
+	// WARNING: in.ExclusiveAdditionalSecurityGroups requires manual conversion: does not exist in peer-type
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	out.ImageLookupFormat = in.ImageLookupFormat
 	out.ImageLookupOrg = in.ImageLookupOrg
@@ -382,6 +385,12 @@ func autoConvert_v1beta2_AWSManagedControlPlaneSpec_To_v1beta1_AWSManagedControl
 		return err
 	}
 	// WARNING: in.UpgradePolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.DefaultNodeLabels requires manual conversion: does not exist in peer-type
+	// WARNING: in.DefaultNodeTaints requires manual conversion: does not exist in peer-type
+	// WARNING: in.DefaultNodeVolumeEncryption requires manual conversion: does not exist in peer-type
+	// WARNING: in.DefaultNodeInstanceType requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodegroupAPIBackoff requires manual conversion: does not exist in peer-type
+	// WARNING: in.ECRPullThroughCache requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -426,6 +435,7 @@ func autoConvert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedContr
 		return err
 	}
 	// WARNING: in.Version requires manual conversion: does not exist in peer-type
+	// WARNING: in.EncryptionConfigUpdateID requires manual conversion: does not exist in peer-type
 	return nil
 }
 
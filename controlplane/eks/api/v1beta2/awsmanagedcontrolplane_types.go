@@ -21,6 +21,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 )
 
@@ -30,6 +31,13 @@ const (
 
 	// AWSManagedControlPlaneKind is the Kind of AWSManagedControlPlane.
 	AWSManagedControlPlaneKind = "AWSManagedControlPlane"
+
+	// PreUpgradeHookAnnotationPrefix is the prefix of the annotations registering hooks that
+	// must succeed before the Kubernetes version of the control plane is upgraded. The name of
+	// the hook follows the prefix, and the hook reports its success for an upgrade by setting
+	// the value of its annotation to the version being upgraded to, for instance
+	// pre-upgrade.hook.eks.controlplane.cluster.x-k8s.io/snapshot: "1.31".
+	PreUpgradeHookAnnotationPrefix = "pre-upgrade.hook.eks.controlplane.cluster.x-k8s.io/"
 )
 
 // AWSManagedControlPlaneSpec defines the desired state of an Amazon EKS Cluster.
@@ -45,6 +53,16 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	IdentityRef *infrav1.AWSIdentityReference `json:"identityRef,omitempty"`
 
+	// RoleSessionName is the session name used when assuming the role of an AWSClusterRoleIdentity
+	// to reconcile the control plane and its node groups, so that their calls can be traced in
+	// CloudTrail. It takes precedence over the session name of the identity. When neither is set,
+	// it defaults to capa-<namespace>-<name> of the control plane, truncated to 64 characters.
+	// +kubebuilder:validation:MinLength:=2
+	// +kubebuilder:validation:MaxLength:=64
+	// +kubebuilder:validation:Pattern:=`^[\w+=,.@-]+$`
+	// +optional
+	RoleSessionName string `json:"roleSessionName,omitempty"`
+
 	// NetworkSpec encapsulates all things related to AWS network.
 	NetworkSpec infrav1.NetworkSpec `json:"network,omitempty"`
 
@@ -135,6 +153,21 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	EndpointAccess EndpointAccess `json:"endpointAccess,omitempty"`
 
+	// AdditionalSecurityGroupIDs are the IDs of additional security groups to attach to the
+	// network interfaces of the EKS control plane, on top of the security group created for
+	// the nodes. The security groups must exist in the cluster's VPC. Security groups added to
+	// the list are attached to existing clusters, security groups attached out of band are
+	// left in place unless ExclusiveAdditionalSecurityGroups is set.
+	// +optional
+	AdditionalSecurityGroupIDs []string `json:"additionalSecurityGroupIDs,omitempty"`
+
+	// ExclusiveAdditionalSecurityGroups makes the controller manage the complete set of security
+	// groups attached to the EKS control plane: security groups that are neither the node
+	// security group nor listed in AdditionalSecurityGroupIDs are detached, including the ones
+	// attached out of band or removed from AdditionalSecurityGroupIDs.
+	// +optional
+	ExclusiveAdditionalSecurityGroups bool `json:"exclusiveAdditionalSecurityGroups,omitempty"`
+
 	// ControlPlaneEndpoint represents the endpoint used to communicate with the control plane.
 	// +optional
 	ControlPlaneEndpoint clusterv1beta1.APIEndpoint `json:"controlPlaneEndpoint"`
@@ -226,6 +259,100 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +kubebuilder:validation:Enum=extended;standard
 	// +optional
 	UpgradePolicy UpgradePolicy `json:"upgradePolicy,omitempty"`
+
+	// DefaultNodeLabels specifies labels to apply to the Kubernetes nodes of every
+	// managed node group in the cluster. Labels set on an AWSManagedMachinePool take
+	// precedence over a default label with the same key.
+	// +optional
+	DefaultNodeLabels map[string]string `json:"defaultNodeLabels,omitempty"`
+
+	// DefaultNodeTaints specifies taints to apply to the Kubernetes nodes of every
+	// managed node group in the cluster. Taints set on an AWSManagedMachinePool take
+	// precedence over a default taint with the same key and effect.
+	// +optional
+	DefaultNodeTaints expinfrav1.Taints `json:"defaultNodeTaints,omitempty"`
+
+	// DefaultNodeVolumeEncryption specifies the encryption of the EBS volumes of every
+	// managed node group that uses a launch template. A volume that sets encrypted or
+	// encryptionKey in the AWSManagedMachinePool's launch template keeps its own settings.
+	// The root volume of the AMI is encrypted as well when the launch template doesn't
+	// declare one. Changing it creates a new launch template version, which replaces the nodes.
+	// +optional
+	DefaultNodeVolumeEncryption *infrav1.VolumeEncryption `json:"defaultNodeVolumeEncryption,omitempty"`
+
+	// DefaultNodeInstanceType specifies the instance type of the nodes of every managed
+	// node group that doesn't set an instance type and doesn't use a launch template,
+	// instead of relying on the EKS default. Changing it only affects node groups created
+	// afterwards, as the instance type of an existing node group can't be changed.
+	// +optional
+	DefaultNodeInstanceType *string `json:"defaultNodeInstanceType,omitempty"`
+
+	// NodegroupAPIBackoff specifies how the calls creating, updating and deleting the
+	// managed node groups of the cluster are retried when AWS throttles them. Fields that
+	// aren't set keep their defaults of 10 steps and a factor of 1.71 without a cap.
+	// +optional
+	NodegroupAPIBackoff *APIBackoff `json:"nodegroupAPIBackoff,omitempty"`
+
+	// ECRPullThroughCache specifies the ECR pull-through cache rules letting nodes pull
+	// the images of upstream registries from the account's private registry. When the VPC
+	// is managed, the VPC endpoints of ECR and S3 are also created so that nodes in private
+	// subnets can pull the images without internet access.
+	// +optional
+	ECRPullThroughCache *ECRPullThroughCache `json:"ecrPullThroughCache,omitempty"`
+}
+
+// ECRPullThroughCache defines the ECR pull-through cache of a cluster.
+type ECRPullThroughCache struct {
+	// Rules are the pull-through cache rules, one for each upstream registry. The rules
+	// belong to the account and region rather than to the cluster, so they are left in
+	// place when they are removed from the list or when the cluster is deleted.
+	// +listType=map
+	// +listMapKey=repositoryPrefix
+	// +kubebuilder:validation:MinItems=1
+	Rules []ECRPullThroughCacheRule `json:"rules"`
+}
+
+// ECRPullThroughCacheRule caches the images of an upstream registry in the private
+// repositories starting with a prefix.
+type ECRPullThroughCacheRule struct {
+	// RepositoryPrefix is the prefix of the private repositories caching the images of
+	// the upstream registry, such as "docker-hub" for images pulled as
+	// <account>.dkr.ecr.<region>.amazonaws.com/docker-hub/library/nginx.
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=30
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]+(?:[._-][a-z0-9]+)*$`
+	RepositoryPrefix string `json:"repositoryPrefix"`
+
+	// UpstreamRegistryURL is the URL of the upstream registry, such as "public.ecr.aws",
+	// "registry.k8s.io" or "registry-1.docker.io".
+	// +kubebuilder:validation:MinLength=1
+	UpstreamRegistryURL string `json:"upstreamRegistryURL"`
+
+	// CredentialARN is the ARN of the Secrets Manager secret holding the credentials of
+	// the upstream registry, which registries such as Docker Hub require. The name of the
+	// secret must start with "ecr-pullthroughcache/".
+	// +optional
+	CredentialARN *string `json:"credentialARN,omitempty"`
+}
+
+// APIBackoff defines the exponential backoff of retried AWS API calls. The first retry
+// waits for one second.
+type APIBackoff struct {
+	// Steps is the maximum number of attempts of a call.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Steps *int32 `json:"steps,omitempty"`
+
+	// Factor is the decimal number, such as "1.5", that the wait between attempts is
+	// multiplied by after each attempt. It must be at least 1.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	// +optional
+	Factor *string `json:"factor,omitempty"`
+
+	// Cap is the longest wait between attempts. Once the wait reaches it, the call is
+	// attempted one last time.
+	// +optional
+	Cap *metav1.Duration `json:"cap,omitempty"`
 }
 
 // KubeProxy specifies how the kube-proxy daemonset is managed.
@@ -402,6 +529,10 @@ type AWSManagedControlPlaneStatus struct {
 	// in the cluster.
 	// +optional
 	Version *string `json:"version,omitempty"`
+	// EncryptionConfigUpdateID is the ID of the in progress EKS update that
+	// associates the encryption configuration with the cluster.
+	// +optional
+	EncryptionConfigUpdateID *string `json:"encryptionConfigUpdateID,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -29,6 +29,30 @@ const (
 	EKSControlPlaneUpdatingCondition clusterv1beta1.ConditionType = "EKSControlPlaneUpdating"
 	// EKSControlPlaneReconciliationFailedReason used to report failures while reconciling EKS control plane.
 	EKSControlPlaneReconciliationFailedReason = "EKSControlPlaneReconciliationFailed"
+	// EKSControlPlaneCreatingReason used to report that the EKS control plane is being created.
+	EKSControlPlaneCreatingReason = "EKSControlPlaneCreating"
+	// EKSControlPlaneCreationTimedOutReason used to report that the EKS control plane creation is taking
+	// longer than allowed.
+	EKSControlPlaneCreationTimedOutReason = "EKSControlPlaneCreationTimedOut"
+)
+
+const (
+	// EKSControlPlanePreUpgradeHooksSucceededCondition condition reports on whether the pre-upgrade
+	// hooks of the eks control plane succeeded for the next version upgrade.
+	EKSControlPlanePreUpgradeHooksSucceededCondition clusterv1beta1.ConditionType = "EKSControlPlanePreUpgradeHooksSucceeded"
+	// EKSControlPlanePreUpgradeHooksPendingReason used to report that the version upgrade waits for
+	// pre-upgrade hooks to succeed.
+	EKSControlPlanePreUpgradeHooksPendingReason = "PreUpgradeHooksPending"
+)
+
+const (
+	// EKSEncryptionConfigAssociatedCondition condition reports on the association of the
+	// encryption configuration with the eks control plane.
+	EKSEncryptionConfigAssociatedCondition clusterv1beta1.ConditionType = "EKSEncryptionConfigAssociated"
+	// EKSEncryptionConfigAssociatingReason used to report that the encryption configuration is being associated.
+	EKSEncryptionConfigAssociatingReason = "EKSEncryptionConfigAssociating"
+	// EKSEncryptionConfigAssociationFailedReason used to report failures while associating the encryption configuration.
+	EKSEncryptionConfigAssociationFailedReason = "EKSEncryptionConfigAssociationFailed"
 )
 
 const (
@@ -58,3 +82,10 @@ const (
 	// EKSIdentityProviderConfiguredFailedReason used to report failures while reconciling the identity provider config association.
 	EKSIdentityProviderConfiguredFailedReason = "EKSIdentityProviderConfiguredFailed"
 )
+
+const (
+	// EKSPullThroughCacheReadyCondition condition reports on the reconciliation of the ECR pull-through cache rules and VPC endpoints.
+	EKSPullThroughCacheReadyCondition clusterv1beta1.ConditionType = "EKSPullThroughCacheReady"
+	// EKSPullThroughCacheReconciliationFailedReason used to report failures while reconciling the ECR pull-through cache.
+	EKSPullThroughCacheReconciliationFailedReason = "EKSPullThroughCacheReconciliationFailed"
+)
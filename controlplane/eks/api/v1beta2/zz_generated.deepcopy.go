@@ -21,12 +21,44 @@ limitations under the License.
 package v1beta2
 
 import (
-	"k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	apiv1beta2 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	expapiv1beta2 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api/api/core/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIBackoff) DeepCopyInto(out *APIBackoff) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Factor != nil {
+		in, out := &in.Factor, &out.Factor
+		*out = new(string)
+		**out = **in
+	}
+	if in.Cap != nil {
+		in, out := &in.Cap, &out.Cap
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIBackoff.
+func (in *APIBackoff) DeepCopy() *APIBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(APIBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSManagedControlPlane) DeepCopyInto(out *AWSManagedControlPlane) {
 	*out = *in
@@ -147,6 +179,11 @@ func (in *AWSManagedControlPlaneSpec) DeepCopyInto(out *AWSManagedControlPlaneSp
 		(*in).DeepCopyInto(*out)
 	}
 	in.EndpointAccess.DeepCopyInto(&out.EndpointAccess)
+	if in.AdditionalSecurityGroupIDs != nil {
+		in, out := &in.AdditionalSecurityGroupIDs, &out.AdditionalSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	in.Bastion.DeepCopyInto(&out.Bastion)
 	if in.TokenMethod != nil {
@@ -184,6 +221,38 @@ func (in *AWSManagedControlPlaneSpec) DeepCopyInto(out *AWSManagedControlPlaneSp
 	}
 	in.VpcCni.DeepCopyInto(&out.VpcCni)
 	out.KubeProxy = in.KubeProxy
+	if in.DefaultNodeLabels != nil {
+		in, out := &in.DefaultNodeLabels, &out.DefaultNodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DefaultNodeTaints != nil {
+		in, out := &in.DefaultNodeTaints, &out.DefaultNodeTaints
+		*out = make(expapiv1beta2.Taints, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultNodeVolumeEncryption != nil {
+		in, out := &in.DefaultNodeVolumeEncryption, &out.DefaultNodeVolumeEncryption
+		*out = new(apiv1beta2.VolumeEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultNodeInstanceType != nil {
+		in, out := &in.DefaultNodeInstanceType, &out.DefaultNodeInstanceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodegroupAPIBackoff != nil {
+		in, out := &in.NodegroupAPIBackoff, &out.NodegroupAPIBackoff
+		*out = new(APIBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ECRPullThroughCache != nil {
+		in, out := &in.ECRPullThroughCache, &out.ECRPullThroughCache
+		*out = new(ECRPullThroughCache)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSManagedControlPlaneSpec.
@@ -243,6 +312,11 @@ func (in *AWSManagedControlPlaneStatus) DeepCopyInto(out *AWSManagedControlPlane
 		*out = new(string)
 		**out = **in
 	}
+	if in.EncryptionConfigUpdateID != nil {
+		in, out := &in.EncryptionConfigUpdateID, &out.EncryptionConfigUpdateID
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSManagedControlPlaneStatus.
@@ -532,6 +606,48 @@ func (in *ControlPlaneLoggingSpec) DeepCopy() *ControlPlaneLoggingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ECRPullThroughCache) DeepCopyInto(out *ECRPullThroughCache) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ECRPullThroughCacheRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECRPullThroughCache.
+func (in *ECRPullThroughCache) DeepCopy() *ECRPullThroughCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ECRPullThroughCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ECRPullThroughCacheRule) DeepCopyInto(out *ECRPullThroughCacheRule) {
+	*out = *in
+	if in.CredentialARN != nil {
+		in, out := &in.CredentialARN, &out.CredentialARN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECRPullThroughCacheRule.
+func (in *ECRPullThroughCacheRule) DeepCopy() *ECRPullThroughCacheRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ECRPullThroughCacheRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
 	*out = *in
@@ -779,7 +895,7 @@ func (in *VpcCni) DeepCopyInto(out *VpcCni) {
 	*out = *in
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
-		*out = make([]v1.EnvVar, len(*in))
+		*out = make([]corev1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -40,6 +40,7 @@ import (
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/awsnode"
@@ -52,6 +53,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/network"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/securitygroup"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/utils"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/util/paused"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -65,6 +67,22 @@ const (
 	// has dependencies during deletion.
 	deleteRequeueAfter = 20 * time.Second
 
+	// clusterCreatingRequeueAfter is how long to wait before checking again to see if the
+	// EKS cluster has been created.
+	clusterCreatingRequeueAfter = 30 * time.Second
+
+	// encryptionConfigRequeueAfter is how long to wait before checking again to see if the
+	// association of the encryption config with the EKS cluster has completed.
+	encryptionConfigRequeueAfter = 1 * time.Minute
+
+	// clusterUpdatingRequeueAfter is how long to wait before checking again to see if an
+	// update of the EKS cluster configuration has completed.
+	clusterUpdatingRequeueAfter = 30 * time.Second
+
+	// upgradeHooksRequeueAfter is how long to wait before checking again to see if the pre-upgrade
+	// hooks of the control plane succeeded.
+	upgradeHooksRequeueAfter = 1 * time.Minute
+
 	awsManagedControlPlaneKind = "AWSManagedControlPlane"
 )
 
@@ -105,6 +123,7 @@ type AWSManagedControlPlaneReconciler struct {
 	WaitInfraPeriod              time.Duration
 	MaxWaitActiveUpdateDelete    time.Duration
 	TagUnmanagedNetworkResources bool
+	ReconcileJitter              float64
 }
 
 // getAWSNodeService factory func is added for testing purpose so that we can inject mocked AWSNodeInterface to the AWSManagedControlPlaneReconciler.
@@ -192,6 +211,13 @@ func (r *AWSManagedControlPlaneReconciler) SetupWithManager(ctx context.Context,
 		return fmt.Errorf("failed adding a watch for AWSManagedCluster")
 	}
 
+	if err = c.Watch(
+		source.Kind[client.Object](mgr.GetCache(), &expinfrav1.AWSManagedMachinePool{},
+			handler.EnqueueRequestsFromMapFunc(r.managedMachinePoolToManagedControlPlane(ctx, log))),
+	); err != nil {
+		return fmt.Errorf("failed adding a watch for AWSManagedMachinePool: %w", err)
+	}
+
 	return nil
 }
 
@@ -316,7 +342,7 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 		// Wait for the cluster infrastructure to be ready before creating machines
 		if !ptr.Deref(managedScope.Cluster.Status.Initialization.InfrastructureProvisioned, false) {
 			managedScope.Info("Cluster infrastructure is not ready yet")
-			return ctrl.Result{RequeueAfter: r.WaitInfraPeriod}, nil
+			return ctrl.Result{RequeueAfter: utils.Jitter(r.WaitInfraPeriod, r.ReconcileJitter)}, nil
 		}
 	}
 
@@ -350,8 +376,27 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile bastion host for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
 	}
 
+	var result reconcile.Result
 	if err := ekssvc.ReconcileControlPlane(ctx); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+		if errors.Is(err, eks.ErrClusterCreating) {
+			managedScope.Info("Waiting for the EKS cluster to be created")
+			return reconcile.Result{RequeueAfter: utils.Jitter(clusterCreatingRequeueAfter, r.ReconcileJitter)}, nil
+		}
+		// The rest of the control plane is still reconciled while the cluster config is
+		// updated, the encryption config is associated or the upgrade waits.
+		switch {
+		case errors.Is(err, eks.ErrClusterUpdating):
+			managedScope.Info("Waiting for the EKS cluster update to complete")
+			result.RequeueAfter = utils.Jitter(clusterUpdatingRequeueAfter, r.ReconcileJitter)
+		case errors.Is(err, eks.ErrEncryptionConfigUpdateInProgress):
+			managedScope.Info("Waiting for the encryption config association to complete")
+			result.RequeueAfter = utils.Jitter(encryptionConfigRequeueAfter, r.ReconcileJitter)
+		case errors.Is(err, eks.ErrClusterUpgradeHooksPending):
+			managedScope.Info("Waiting for the pre-upgrade hooks to succeed", "reason", err.Error())
+			result.RequeueAfter = utils.Jitter(upgradeHooksRequeueAfter, r.ReconcileJitter)
+		default:
+			return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+		}
 	}
 
 	if err := awsnodeService.ReconcileCNI(ctx); err != nil {
@@ -382,7 +427,7 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 		})
 	}
 
-	return reconcile.Result{}, nil
+	return result, nil
 }
 
 func (r *AWSManagedControlPlaneReconciler) reconcileDelete(ctx context.Context, managedScope *scope.ManagedControlPlaneScope) (_ ctrl.Result, reterr error) {
@@ -399,10 +444,16 @@ func (r *AWSManagedControlPlaneReconciler) reconcileDelete(ctx context.Context,
 	}
 	if numDependencies > 0 {
 		log.Info("EKS cluster still has dependencies - requeue needed", "dependencyCount", numDependencies)
-		return reconcile.Result{RequeueAfter: deleteRequeueAfter}, nil
+		return reconcile.Result{RequeueAfter: utils.Jitter(deleteRequeueAfter, r.ReconcileJitter)}, nil
 	}
 	log.Info("EKS cluster has no dependencies")
 
+	if annotations.IsTrue(controlPlane, infrav1.OrphanOnDeleteAnnotation) {
+		log.Info("Orphaning AWS resources of AWSManagedControlPlane", "annotation", infrav1.OrphanOnDeleteAnnotation)
+		controllerutil.RemoveFinalizer(controlPlane, ekscontrolplanev1.ManagedControlPlaneFinalizer)
+		return reconcile.Result{}, nil
+	}
+
 	ekssvc := eks.NewService(managedScope)
 	ec2svc := ec2.NewService(managedScope)
 	networkSvc := network.NewService(managedScope)
@@ -539,3 +590,40 @@ func (r *AWSManagedControlPlaneReconciler) managedClusterToManagedControlPlane(_
 		}
 	}
 }
+
+// managedMachinePoolToManagedControlPlane maps the Windows AWSManagedMachinePools to their control
+// plane, which enables Windows support in the VPC CNI config as soon as such a pool is added.
+func (r *AWSManagedControlPlaneReconciler) managedMachinePoolToManagedControlPlane(_ context.Context, log *logger.Logger) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []ctrl.Request {
+		managedMachinePool, ok := o.(*expinfrav1.AWSManagedMachinePool)
+		if !ok {
+			log.Error(fmt.Errorf("expected a AWSManagedMachinePool but got a %T", o), "Expected AWSManagedMachinePool")
+			return nil
+		}
+
+		if managedMachinePool.Spec.AMIType == nil || !managedMachinePool.Spec.AMIType.IsWindows() {
+			return nil
+		}
+
+		cluster, err := util.GetClusterFromMetadata(ctx, r.Client, managedMachinePool.ObjectMeta)
+		if err != nil {
+			log.Debug("Failed to get the cluster of AWSManagedMachinePool, skipping mapping", "error", err.Error())
+			return nil
+		}
+
+		controlPlaneRef := cluster.Spec.ControlPlaneRef
+		if controlPlaneRef.Kind != awsManagedControlPlaneKind {
+			log.Debug("ControlPlaneRef is not defined or not AWSManagedControlPlane, skipping mapping")
+			return nil
+		}
+
+		return []ctrl.Request{
+			{
+				NamespacedName: types.NamespacedName{
+					Name:      controlPlaneRef.Name,
+					Namespace: cluster.Namespace,
+				},
+			},
+		}
+	}
+}
@@ -910,27 +910,19 @@ func mockedEKSCluster(ctx context.Context, g *WithT, eksRec *mock_eksiface.MockE
 		},
 	}
 
-	createClusterCall := eksRec.CreateCluster(ctx, gomock.Any()).After(getRoleCall).DoAndReturn(func(ctx context.Context, input *eks.CreateClusterInput, optFns ...func(*eks.Options)) (*eks.CreateClusterOutput, error) {
-		g.Expect(input.Name).To(BeComparableTo(aws.String("test-cluster")))
-		return &eks.CreateClusterOutput{
-			Cluster: &clusterCreating,
-		}, nil
-	})
-
-	waitUntilClusterActiveCall := eksRec.WaitUntilClusterActive(ctx, &eks.DescribeClusterInput{
-		Name: aws.String("test-cluster"),
-	}, maxActiveUpdateDeleteWait).After(createClusterCall).Return(nil)
-
 	clusterActive := clusterCreating // copy
 	clusterActive.Status = ekstypes.ClusterStatusActive
 	clusterActive.Endpoint = aws.String("https://F00D133712341337.gr7.us-east-1.eks.amazonaws.com")
 	clusterActive.Version = aws.String("1.24")
 
-	eksRec.DescribeCluster(ctx, &eks.DescribeClusterInput{
-		Name: aws.String("test-cluster"),
-	}).After(waitUntilClusterActiveCall).Return(&eks.DescribeClusterOutput{
-		Cluster: &clusterActive,
-	}, nil)
+	// A creating cluster is requeued until it becomes active, so return the cluster
+	// as active straight away to reconcile the rest of the control plane in one pass.
+	createClusterCall := eksRec.CreateCluster(ctx, gomock.Any()).After(getRoleCall).DoAndReturn(func(ctx context.Context, input *eks.CreateClusterInput, optFns ...func(*eks.Options)) (*eks.CreateClusterOutput, error) {
+		g.Expect(input.Name).To(BeComparableTo(aws.String("test-cluster")))
+		return &eks.CreateClusterOutput{
+			Cluster: &clusterActive,
+		}, nil
+	})
 
 	// AWS precreates a default security group together with the cluster
 	// (https://docs.aws.amazon.com/eks/latest/userguide/sec-group-reqs.html)
@@ -978,7 +970,7 @@ func mockedEKSCluster(ctx context.Context, g *WithT, eksRec *mock_eksiface.MockE
 		ClusterName: aws.String("test-cluster"),
 	}).Return(&eks.ListAddonsOutput{}, nil)
 
-	eksRec.UpdateClusterConfig(ctx, gomock.AssignableToTypeOf(&eks.UpdateClusterConfigInput{})).After(waitUntilClusterActiveCall).Return(&eks.UpdateClusterConfigOutput{}, nil)
+	eksRec.UpdateClusterConfig(ctx, gomock.AssignableToTypeOf(&eks.UpdateClusterConfigInput{})).After(createClusterCall).Return(&eks.UpdateClusterConfigOutput{}, nil)
 
 	awsNodeRec.ReconcileCNI(gomock.Any()).Return(nil)
 	kubeProxyRec.ReconcileKubeProxy(gomock.Any()).Return(nil)
@@ -20,8 +20,12 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/apparentlymart/go-cidr/cidr"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -34,6 +38,8 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/endpoints"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
 )
 
@@ -48,6 +54,13 @@ const (
 // log is for logging in this package.
 var mcpLog = ctrl.Log.WithName("awsmanagedcontrolplane-resource")
 
+// eksClusterNameRegex matches the names accepted by EKS: alphanumeric characters,
+// hyphens and underscores, starting with an alphanumeric character.
+var eksClusterNameRegex = regexp.MustCompile(`^[0-9A-Za-z][A-Za-z0-9\-_]*$`)
+
+// instanceTypeRegex matches EC2 instance type names, such as m5.large or u-6tb1.metal.
+var instanceTypeRegex = regexp.MustCompile(`^[a-z0-9-]+\.[a-z0-9-]+$`)
+
 const (
 	cidrSizeMax    = 65536
 	cidrSizeMin    = 16
@@ -92,9 +105,7 @@ func (w *AWSManagedControlPlane) ValidateCreate(_ context.Context, obj runtime.O
 
 	var allErrs field.ErrorList
 
-	if r.Spec.EKSClusterName == "" {
-		allErrs = append(allErrs, field.Required(field.NewPath("spec.eksClusterName"), "eksClusterName is required"))
-	}
+	allErrs = append(allErrs, w.validateEKSClusterName(r)...)
 
 	// TODO: Add ipv6 validation things in these validations.
 	allErrs = append(allErrs, w.validateEKSVersion(r, nil)...)
@@ -110,6 +121,11 @@ func (w *AWSManagedControlPlane) ValidateCreate(_ context.Context, obj runtime.O
 	allErrs = append(allErrs, w.validatePrivateDNSHostnameTypeOnLaunch(r)...)
 	allErrs = append(allErrs, w.validateAccessConfigCreate(r)...)
 	allErrs = append(allErrs, w.validateAccessEntries(r)...)
+	allErrs = append(allErrs, w.validateDefaultNodeVolumeEncryption(r)...)
+	allErrs = append(allErrs, w.validateDefaultNodeTaints(r)...)
+	allErrs = append(allErrs, w.validateDefaultNodeInstanceType(r)...)
+	allErrs = append(allErrs, w.validateNodegroupAPIBackoff(r)...)
+	allErrs = append(allErrs, w.validateECRPullThroughCache(r)...)
 
 	if len(allErrs) == 0 {
 		return nil, nil
@@ -153,6 +169,11 @@ func (w *AWSManagedControlPlane) ValidateUpdate(ctx context.Context, oldObj, new
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, w.validatePrivateDNSHostnameTypeOnLaunch(r)...)
 	allErrs = append(allErrs, w.validateAccessEntries(r)...)
+	allErrs = append(allErrs, w.validateDefaultNodeVolumeEncryption(r)...)
+	allErrs = append(allErrs, w.validateDefaultNodeTaints(r)...)
+	allErrs = append(allErrs, w.validateDefaultNodeInstanceType(r)...)
+	allErrs = append(allErrs, w.validateNodegroupAPIBackoff(r)...)
+	allErrs = append(allErrs, w.validateECRPullThroughCache(r)...)
 
 	if r.Spec.Region != oldAWSManagedControlplane.Spec.Region {
 		allErrs = append(allErrs,
@@ -160,6 +181,12 @@ func (w *AWSManagedControlPlane) ValidateUpdate(ctx context.Context, oldObj, new
 		)
 	}
 
+	if !endpoints.IsPartitionUpdateAllowed(oldAWSManagedControlplane.Spec.Partition, r.Spec.Partition, r.Spec.Region) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "partition"), r.Spec.Partition, "field is immutable"),
+		)
+	}
+
 	// If encryptionConfig is already set, do not allow removal of it.
 	if oldAWSManagedControlplane.Spec.EncryptionConfig != nil && r.Spec.EncryptionConfig == nil {
 		allErrs = append(allErrs,
@@ -212,6 +239,15 @@ func (w *AWSManagedControlPlane) validateEKSClusterName(r *ekscontrolplanev1.AWS
 
 	if r.Spec.EKSClusterName == "" {
 		allErrs = append(allErrs, field.Required(field.NewPath("spec.eksClusterName"), "eksClusterName is required"))
+		return allErrs
+	}
+
+	if len(r.Spec.EKSClusterName) > maxClusterNameLength {
+		allErrs = append(allErrs, field.TooLong(field.NewPath("spec.eksClusterName"), r.Spec.EKSClusterName, maxClusterNameLength))
+	}
+	if !eksClusterNameRegex.MatchString(r.Spec.EKSClusterName) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.eksClusterName"), r.Spec.EKSClusterName,
+			"eksClusterName must start with an alphanumeric character and can only contain alphanumeric characters, hyphens and underscores"))
 	}
 
 	return allErrs
@@ -370,6 +406,79 @@ func (w *AWSManagedControlPlane) validateAccessConfigCreate(r *ekscontrolplanev1
 	return allErrs
 }
 
+func (w *AWSManagedControlPlane) validateDefaultNodeTaints(r *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if n := len(r.Spec.DefaultNodeTaints); n > expinfrav1.MaxNodegroupTaints {
+		allErrs = append(allErrs, field.TooMany(field.NewPath("spec", "defaultNodeTaints"), n, expinfrav1.MaxNodegroupTaints))
+	}
+
+	return allErrs
+}
+
+func (w *AWSManagedControlPlane) validateDefaultNodeInstanceType(r *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+
+	instanceType := r.Spec.DefaultNodeInstanceType
+	if instanceType != nil && !instanceTypeRegex.MatchString(*instanceType) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "defaultNodeInstanceType"), *instanceType, "must be an EC2 instance type, such as m5.large"),
+		)
+	}
+
+	return allErrs
+}
+
+func (w *AWSManagedControlPlane) validateNodegroupAPIBackoff(r *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+
+	backoff := r.Spec.NodegroupAPIBackoff
+	if backoff == nil {
+		return allErrs
+	}
+	if backoff.Factor != nil {
+		if factor, err := strconv.ParseFloat(*backoff.Factor, 64); err != nil || factor < 1 {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec", "nodegroupAPIBackoff", "factor"), *backoff.Factor, "must be a decimal number of at least 1"),
+			)
+		}
+	}
+	if backoff.Cap != nil && backoff.Cap.Duration <= 0 {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "nodegroupAPIBackoff", "cap"), backoff.Cap.Duration.String(), "must be a positive duration"),
+		)
+	}
+
+	return allErrs
+}
+
+func (w *AWSManagedControlPlane) validateECRPullThroughCache(r *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.ECRPullThroughCache == nil {
+		return allErrs
+	}
+	rulesPath := field.NewPath("spec", "ecrPullThroughCache", "rules")
+	for i, rule := range r.Spec.ECRPullThroughCache.Rules {
+		if rule.CredentialARN == nil {
+			continue
+		}
+		// ECR only reads the credentials of secrets named with its prefix.
+		credentialARN, err := arn.Parse(*rule.CredentialARN)
+		if err != nil || credentialARN.Service != "secretsmanager" || !strings.HasPrefix(credentialARN.Resource, "secret:ecr-pullthroughcache/") {
+			allErrs = append(allErrs,
+				field.Invalid(rulesPath.Index(i).Child("credentialARN"), *rule.CredentialARN, "must be the ARN of a Secrets Manager secret whose name starts with ecr-pullthroughcache/"),
+			)
+		}
+	}
+
+	return allErrs
+}
+
+func (w *AWSManagedControlPlane) validateDefaultNodeVolumeEncryption(r *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
+	return r.Spec.DefaultNodeVolumeEncryption.Validate(field.NewPath("spec", "defaultNodeVolumeEncryption"))
+}
+
 func (w *AWSManagedControlPlane) validateAccessEntries(r *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
 	var allErrs field.ErrorList
 
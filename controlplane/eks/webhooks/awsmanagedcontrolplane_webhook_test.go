@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	. "github.com/onsi/gomega"
@@ -29,6 +30,8 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/helpers"
 	utildefaulting "sigs.k8s.io/cluster-api-provider-aws/v2/util/defaulting"
 )
 
@@ -213,6 +216,27 @@ func TestWebhookCreate(t *testing.T) {
 			hasAddons:      false,
 			vpcCNI:         ekscontrolplanev1.VpcCni{Disable: false},
 		},
+		{
+			name:                 "ekscluster name starting with a hyphen",
+			eksClusterName:       "-cluster1",
+			expectError:          true,
+			expectErrorToContain: "spec.eksClusterName",
+			vpcCNI:               ekscontrolplanev1.VpcCni{Disable: false},
+		},
+		{
+			name:                 "ekscluster name with invalid characters",
+			eksClusterName:       "default.cluster1",
+			expectError:          true,
+			expectErrorToContain: "spec.eksClusterName",
+			vpcCNI:               ekscontrolplanev1.VpcCni{Disable: false},
+		},
+		{
+			name:                 "ekscluster name too long",
+			eksClusterName:       strings.Repeat("a", 101),
+			expectError:          true,
+			expectErrorToContain: "spec.eksClusterName",
+			vpcCNI:               ekscontrolplanev1.VpcCni{Disable: false},
+		},
 		{
 			name:           "invalid version",
 			eksClusterName: "default_cluster1",
@@ -626,6 +650,58 @@ func TestWebhookUpdate(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "region change is rejected",
+			oldClusterSpec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				Region:         "us-east-1",
+			},
+			newClusterSpec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				Region:         "eu-west-1",
+			},
+			expectError: true,
+		},
+		{
+			name: "partition change is rejected",
+			oldClusterSpec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				Region:         "us-east-1",
+				Partition:      "aws",
+			},
+			newClusterSpec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				Region:         "us-east-1",
+				Partition:      "aws-us-gov",
+			},
+			expectError: true,
+		},
+		{
+			name: "empty partition can be set to the region's partition",
+			oldClusterSpec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				Region:         "us-gov-west-1",
+			},
+			newClusterSpec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				Region:         "us-gov-west-1",
+				Partition:      "aws-us-gov",
+			},
+			expectError: false,
+		},
+		{
+			name: "empty partition can't be set to another partition",
+			oldClusterSpec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				Region:         "us-east-1",
+			},
+			newClusterSpec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				Region:         "us-east-1",
+				Partition:      "aws-cn",
+			},
+			expectError: true,
+		},
 		{
 			name: "older version",
 			oldClusterSpec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
@@ -1220,3 +1296,281 @@ func TestWebhookValidateAccessEntries(t *testing.T) {
 		})
 	}
 }
+
+func TestWebhookValidateDefaultNodeVolumeEncryption(t *testing.T) {
+	tests := []struct {
+		name        string
+		encryption  *infrav1.VolumeEncryption
+		expectError bool
+	}{
+		{
+			name:        "no default encryption",
+			expectError: false,
+		},
+		{
+			name: "encryption with a KMS key",
+			encryption: &infrav1.VolumeEncryption{
+				Encrypted:     ptr.To(true),
+				EncryptionKey: "alias/nodes",
+			},
+			expectError: false,
+		},
+		{
+			name: "KMS key without encrypted set",
+			encryption: &infrav1.VolumeEncryption{
+				EncryptionKey: "alias/nodes",
+			},
+			expectError: false,
+		},
+		{
+			name: "KMS key with encryption disabled",
+			encryption: &infrav1.VolumeEncryption{
+				Encrypted:     ptr.To(false),
+				EncryptionKey: "alias/nodes",
+			},
+			expectError: true,
+		},
+		{
+			name: "KMS key name without the alias prefix",
+			encryption: &infrav1.VolumeEncryption{
+				EncryptionKey: "nodes",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName:              "default_cluster1",
+					DefaultNodeVolumeEncryption: tc.encryption,
+				},
+			}
+
+			_, err := (&AWSManagedControlPlane{}).ValidateCreate(context.Background(), mcp)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Error()).To(ContainSubstring("defaultNodeVolumeEncryption"))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestWebhookValidateDefaultNodeTaints(t *testing.T) {
+	tests := []struct {
+		name        string
+		taints      expinfrav1.Taints
+		expectError bool
+	}{
+		{
+			name:        "taints within the EKS limit",
+			taints:      helpers.Taints("taint", expinfrav1.MaxNodegroupTaints),
+			expectError: false,
+		},
+		{
+			name:        "more taints than EKS allows",
+			taints:      helpers.Taints("taint", expinfrav1.MaxNodegroupTaints+1),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName:    "default_cluster1",
+					DefaultNodeTaints: tc.taints,
+				},
+			}
+
+			_, err := (&AWSManagedControlPlane{}).ValidateCreate(context.Background(), mcp)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Error()).To(ContainSubstring("defaultNodeTaints"))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestWebhookValidateDefaultNodeInstanceType(t *testing.T) {
+	tests := []struct {
+		name         string
+		instanceType *string
+		expectError  bool
+	}{
+		{
+			name:        "no default instance type",
+			expectError: false,
+		},
+		{
+			name:         "valid instance type",
+			instanceType: ptr.To("m5.large"),
+			expectError:  false,
+		},
+		{
+			name:         "valid instance type with a dash",
+			instanceType: ptr.To("u-6tb1.metal"),
+			expectError:  false,
+		},
+		{
+			name:         "missing size",
+			instanceType: ptr.To("m5"),
+			expectError:  true,
+		},
+		{
+			name:         "empty instance type",
+			instanceType: ptr.To(""),
+			expectError:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName:          "default_cluster1",
+					DefaultNodeInstanceType: tc.instanceType,
+				},
+			}
+
+			_, err := (&AWSManagedControlPlane{}).ValidateCreate(context.Background(), mcp)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Error()).To(ContainSubstring("defaultNodeInstanceType"))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestWebhookValidateNodegroupAPIBackoff(t *testing.T) {
+	tests := []struct {
+		name        string
+		backoff     *ekscontrolplanev1.APIBackoff
+		expectError bool
+	}{
+		{
+			name:        "no backoff",
+			expectError: false,
+		},
+		{
+			name: "valid backoff",
+			backoff: &ekscontrolplanev1.APIBackoff{
+				Steps:  ptr.To[int32](5),
+				Factor: ptr.To("1.5"),
+				Cap:    &metav1.Duration{Duration: time.Minute},
+			},
+			expectError: false,
+		},
+		{
+			name:        "factor below 1",
+			backoff:     &ekscontrolplanev1.APIBackoff{Factor: ptr.To("0.5")},
+			expectError: true,
+		},
+		{
+			name:        "factor isn't a number",
+			backoff:     &ekscontrolplanev1.APIBackoff{Factor: ptr.To("fast")},
+			expectError: true,
+		},
+		{
+			name:        "negative cap",
+			backoff:     &ekscontrolplanev1.APIBackoff{Cap: &metav1.Duration{Duration: -time.Second}},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName:      "default_cluster1",
+					NodegroupAPIBackoff: tc.backoff,
+				},
+			}
+
+			_, err := (&AWSManagedControlPlane{}).ValidateCreate(context.Background(), mcp)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Error()).To(ContainSubstring("nodegroupAPIBackoff"))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestWebhookValidateECRPullThroughCache(t *testing.T) {
+	tests := []struct {
+		name          string
+		credentialARN *string
+		expectError   bool
+	}{
+		{
+			name:        "rule without credentials",
+			expectError: false,
+		},
+		{
+			name:          "rule with a pull-through cache secret",
+			credentialARN: ptr.To("arn:aws:secretsmanager:us-east-1:123456789012:secret:ecr-pullthroughcache/docker-hub-AbCdEf"),
+			expectError:   false,
+		},
+		{
+			name:          "secret without the pull-through cache prefix",
+			credentialARN: ptr.To("arn:aws:secretsmanager:us-east-1:123456789012:secret:docker-hub-AbCdEf"),
+			expectError:   true,
+		},
+		{
+			name:          "credentials that aren't a secret",
+			credentialARN: ptr.To("arn:aws:ssm:us-east-1:123456789012:parameter/ecr-pullthroughcache/docker-hub"),
+			expectError:   true,
+		},
+		{
+			name:          "credentials that aren't an ARN",
+			credentialARN: ptr.To("ecr-pullthroughcache/docker-hub"),
+			expectError:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					ECRPullThroughCache: &ekscontrolplanev1.ECRPullThroughCache{
+						Rules: []ekscontrolplanev1.ECRPullThroughCacheRule{
+							{
+								RepositoryPrefix:    "docker-hub",
+								UpstreamRegistryURL: "registry-1.docker.io",
+								CredentialARN:       tc.credentialARN,
+							},
+						},
+					},
+				},
+			}
+
+			_, err := (&AWSManagedControlPlane{}).ValidateCreate(context.Background(), mcp)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Error()).To(ContainSubstring("spec.ecrPullThroughCache.rules[0].credentialARN"))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/endpoints"
 )
 
 // templateLog is used for logging in this package.
@@ -124,6 +125,12 @@ func (w *AWSManagedControlPlaneTemplate) ValidateUpdate(ctx context.Context, old
 		)
 	}
 
+	if !endpoints.IsPartitionUpdateAllowed(oldAWSManagedControlplaneTemplate.Spec.Template.Spec.Partition, r.Spec.Template.Spec.Partition, r.Spec.Template.Spec.Region) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "partition"), r.Spec.Template.Spec.Partition, "field is immutable"),
+		)
+	}
+
 	// If encryptionConfig is already set, do not allow removal of it.
 	if oldAWSManagedControlplaneTemplate.Spec.Template.Spec.EncryptionConfig != nil && r.Spec.Template.Spec.EncryptionConfig == nil {
 		allErrs = append(allErrs,
@@ -121,8 +121,19 @@ func (w *ROSAControlPlane) ValidateUpdate(_ context.Context, oldObj, newObj runt
 		return nil, fmt.Errorf("expected an ROSAControlPlane object but got %T", r)
 	}
 
+	oldR, ok := oldObj.(*rosacontrolplanev1.ROSAControlPlane)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an ROSAControlPlane but got a %T", oldObj))
+	}
+
 	var allErrs field.ErrorList
 
+	if r.Spec.Region != oldR.Spec.Region {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "region"), r.Spec.Region, "field is immutable"),
+		)
+	}
+
 	if err := w.validateVersion(r); err != nil {
 		allErrs = append(allErrs, err)
 	}
@@ -17,6 +17,7 @@ limitations under the License.
 package webhooks
 
 import (
+	"context"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -83,3 +84,27 @@ func TestValidateROSANetwork(t *testing.T) {
 		g.Expect(err).NotTo(HaveOccurred())
 	})
 }
+
+func TestValidateUpdateRegion(t *testing.T) {
+	oldCP := &rosacontrolplanev1.ROSAControlPlane{
+		Spec: rosacontrolplanev1.RosaControlPlaneSpec{Region: "us-east-1"},
+	}
+	w := &ROSAControlPlane{}
+
+	t.Run("Validation error when the region is changed", func(t *testing.T) {
+		g := NewWithT(t)
+		newCP := oldCP.DeepCopy()
+		newCP.Spec.Region = "us-west-2"
+		_, err := w.ValidateUpdate(context.Background(), oldCP, newCP)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("spec.region: Invalid value: \"us-west-2\": field is immutable"))
+	})
+
+	t.Run("No region error when the region is unchanged", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := w.ValidateUpdate(context.Background(), oldCP, oldCP.DeepCopy())
+		if err != nil {
+			g.Expect(err.Error()).ToNot(ContainSubstring("spec.region"))
+		}
+	})
+}
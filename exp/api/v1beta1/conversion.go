@@ -42,6 +42,7 @@ func (src *AWSMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 	}
 	if restored.Spec.RefreshPreferences != nil {
 		dst.Spec.RefreshPreferences.Disable = restored.Spec.RefreshPreferences.Disable
+		dst.Spec.RefreshPreferences.ForceUpdateEnabled = restored.Spec.RefreshPreferences.ForceUpdateEnabled
 		dst.Spec.RefreshPreferences.MaxHealthyPercentage = restored.Spec.RefreshPreferences.MaxHealthyPercentage
 	}
 	if restored.Spec.AWSLaunchTemplate.InstanceMetadataOptions != nil {
@@ -76,6 +77,7 @@ func (src *AWSMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.DefaultInstanceWarmup = restored.Spec.DefaultInstanceWarmup
 	dst.Spec.AWSLaunchTemplate.NonRootVolumes = restored.Spec.AWSLaunchTemplate.NonRootVolumes
+	dst.Spec.HealthCheckType = restored.Spec.HealthCheckType
 	return nil
 }
 
@@ -146,10 +148,43 @@ func (src *AWSManagedMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.RolePath = restored.Spec.RolePath
 	dst.Spec.RolePermissionsBoundary = restored.Spec.RolePermissionsBoundary
+	dst.Spec.CreateRoleIfMissing = restored.Spec.CreateRoleIfMissing
 
 	if restored.Spec.NodeRepairConfig != nil {
 		dst.Spec.NodeRepairConfig = restored.Spec.NodeRepairConfig
 	}
+	if restored.Spec.HealthCheckGracePeriod != nil {
+		dst.Spec.HealthCheckGracePeriod = restored.Spec.HealthCheckGracePeriod
+	}
+	if restored.Spec.DefaultInstanceWarmup != nil {
+		dst.Spec.DefaultInstanceWarmup = restored.Spec.DefaultInstanceWarmup
+	}
+	if restored.Spec.ClusterSecurityGroupOverride != nil {
+		dst.Spec.ClusterSecurityGroupOverride = restored.Spec.ClusterSecurityGroupOverride
+	}
+	if restored.Spec.ExternalLaunchTemplate != nil {
+		dst.Spec.ExternalLaunchTemplate = restored.Spec.ExternalLaunchTemplate
+	}
+	dst.Spec.InstanceTypes = restored.Spec.InstanceTypes
+	dst.Spec.SpotAllocationStrategy = restored.Spec.SpotAllocationStrategy
+	dst.Spec.OnDemandBaseCapacity = restored.Spec.OnDemandBaseCapacity
+	dst.Spec.ForceUpdate = restored.Spec.ForceUpdate
+	dst.Spec.InstanceTypeFallback = restored.Spec.InstanceTypeFallback
+	dst.Spec.NodeJoinTimeout = restored.Spec.NodeJoinTimeout
+	dst.Spec.SuspendProcesses = restored.Spec.SuspendProcesses
+	dst.Spec.LaunchTemplateVersion = restored.Spec.LaunchTemplateVersion
+	dst.Spec.LaunchTemplateVersionDriftPolicy = restored.Spec.LaunchTemplateVersionDriftPolicy
+	dst.Spec.DegradedPolicy = restored.Spec.DegradedPolicy
+	dst.Spec.PodDisruptionBudgetPolicy = restored.Spec.PodDisruptionBudgetPolicy
+	dst.Spec.ExcludeFromClusterAutoscaler = restored.Spec.ExcludeFromClusterAutoscaler
+
+	dst.Status.BootstrapReadyReplicas = restored.Status.BootstrapReadyReplicas
+	dst.Status.ScalingConfig = restored.Status.ScalingConfig
+	dst.Status.InstanceTypeFallback = restored.Status.InstanceTypeFallback
+	dst.Status.AutoScalingGroupName = restored.Status.AutoScalingGroupName
+	dst.Status.RemoteAccessSecurityGroupID = restored.Status.RemoteAccessSecurityGroupID
+	dst.Status.EstimatedHourlyCost = restored.Status.EstimatedHourlyCost
+	dst.Status.AppliedLaunchTemplateVersion = restored.Status.AppliedLaunchTemplateVersion
 
 	return nil
 }
@@ -170,6 +205,11 @@ func Convert_v1beta2_AWSManagedMachinePoolSpec_To_v1beta1_AWSManagedMachinePoolS
 	return autoConvert_v1beta2_AWSManagedMachinePoolSpec_To_v1beta1_AWSManagedMachinePoolSpec(in, out, s)
 }
 
+// Convert_v1beta2_AWSManagedMachinePoolStatus_To_v1beta1_AWSManagedMachinePoolStatus is a conversion function.
+func Convert_v1beta2_AWSManagedMachinePoolStatus_To_v1beta1_AWSManagedMachinePoolStatus(in *expinfrav1.AWSManagedMachinePoolStatus, out *AWSManagedMachinePoolStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_AWSManagedMachinePoolStatus_To_v1beta1_AWSManagedMachinePoolStatus(in, out, s)
+}
+
 func Convert_v1beta2_AWSMachinePoolStatus_To_v1beta1_AWSMachinePoolStatus(in *expinfrav1.AWSMachinePoolStatus, out *AWSMachinePoolStatus, s apiconversion.Scope) error {
 	return autoConvert_v1beta2_AWSMachinePoolStatus_To_v1beta1_AWSMachinePoolStatus(in, out, s)
 }
@@ -203,6 +243,9 @@ func (src *AWSFargateProfile) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.RolePath = restored.Spec.RolePath
 	dst.Spec.RolePermissionsBoundary = restored.Spec.RolePermissionsBoundary
+	dst.Spec.PodExecutionRoleARN = restored.Spec.PodExecutionRoleARN
+
+	dst.Status.CreatingSince = restored.Status.CreatingSince
 
 	return nil
 }
@@ -255,3 +298,8 @@ func Convert_v1beta2_RefreshPreferences_To_v1beta1_RefreshPreferences(in *expinf
 func Convert_v1beta2_FargateProfileSpec_To_v1beta1_FargateProfileSpec(in *expinfrav1.FargateProfileSpec, out *FargateProfileSpec, s apiconversion.Scope) error {
 	return autoConvert_v1beta2_FargateProfileSpec_To_v1beta1_FargateProfileSpec(in, out, s)
 }
+
+// Convert_v1beta2_FargateProfileStatus_To_v1beta1_FargateProfileStatus is a conversion function.
+func Convert_v1beta2_FargateProfileStatus_To_v1beta1_FargateProfileStatus(in *expinfrav1.FargateProfileStatus, out *FargateProfileStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_FargateProfileStatus_To_v1beta1_FargateProfileStatus(in, out, s)
+}
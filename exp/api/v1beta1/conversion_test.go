@@ -20,12 +20,38 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
 	"k8s.io/apimachinery/pkg/runtime"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/randfill"
 
 	"sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 )
 
+func fuzzFuncs(_ runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		AWSManagedMachinePoolFuzzer,
+		AWSFargateProfileFuzzer,
+	}
+}
+
+func AWSManagedMachinePoolFuzzer(obj *v1beta2.AWSManagedMachinePool, c randfill.Continue) {
+	c.FillNoCustom(obj)
+	// A pointer to a nil list doesn't survive the JSON round trip of the annotation.
+	if obj.Spec.SuspendProcesses != nil && *obj.Spec.SuspendProcesses == nil {
+		obj.Spec.SuspendProcesses = &[]v1beta2.ScalingProcess{}
+	}
+}
+
+func AWSFargateProfileFuzzer(obj *v1beta2.AWSFargateProfile, c randfill.Continue) {
+	c.FillNoCustom(obj)
+	// A zero time is marshalled as null, so it doesn't survive the JSON round trip of the annotation.
+	if obj.Status.CreatingSince != nil && obj.Status.CreatingSince.IsZero() {
+		obj.Status.CreatingSince = nil
+	}
+}
+
 func TestFuzzyConversion(t *testing.T) {
 	g := NewWithT(t)
 	scheme := runtime.NewScheme()
@@ -39,14 +65,16 @@ func TestFuzzyConversion(t *testing.T) {
 	}))
 
 	t.Run("for AWSManagedMachinePool", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
-		Scheme: scheme,
-		Hub:    &v1beta2.AWSManagedMachinePool{},
-		Spoke:  &AWSManagedMachinePool{},
+		Scheme:      scheme,
+		Hub:         &v1beta2.AWSManagedMachinePool{},
+		Spoke:       &AWSManagedMachinePool{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
 	}))
 
 	t.Run("for AWSFargateProfile", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
-		Scheme: scheme,
-		Hub:    &v1beta2.AWSFargateProfile{},
-		Spoke:  &AWSFargateProfile{},
+		Scheme:      scheme,
+		Hub:         &v1beta2.AWSFargateProfile{},
+		Spoke:       &AWSFargateProfile{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
 	}))
 }
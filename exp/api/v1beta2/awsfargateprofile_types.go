@@ -44,6 +44,9 @@ type FargateProfileSpec struct {
 
 	// SubnetIDs specifies which subnets are used for the
 	// auto scaling group of this nodegroup.
+	// Changing the subnets recreates the fargate profile.
+	// When empty, one private subnet is picked in each availability zone
+	// of the cluster, skipping local and wavelength zones.
 	// +optional
 	SubnetIDs []string `json:"subnetIDs,omitempty"`
 
@@ -83,10 +86,20 @@ type FargateProfileSpec struct {
 	// +optional
 	RolePermissionsBoundary string `json:"rolePermissionsBoundary,omitempty"`
 
+	// PodExecutionRoleARN is the ARN of an existing IAM role to use as the pod execution role of
+	// the fargate profile. The role is used as is: it isn't created, updated or deleted, so it
+	// must already trust eks-fargate-pods.amazonaws.com and have the required policies attached.
+	// It can't be set along with RoleName, RolePath or RolePermissionsBoundary.
+	// +optional
+	PodExecutionRoleARN *string `json:"podExecutionRoleARN,omitempty"`
+
 	// Selectors specify fargate pod selectors.
 	Selectors []FargateSelector `json:"selectors,omitempty"`
 }
 
+// MaxFargateProfileSelectors is the maximum number of selectors EKS allows on a fargate profile.
+const MaxFargateProfileSelectors = 5
+
 // FargateSelector specifies a selector for pods that should run on this fargate pool.
 type FargateSelector struct {
 	// Labels specifies which pod labels this selector should match.
@@ -140,6 +153,11 @@ type FargateProfileStatus struct {
 	// +optional
 	FailureMessage *string `json:"failureMessage,omitempty"`
 
+	// CreatingSince is when the controller first saw the Fargate profile in the creating
+	// status. It is cleared once the profile leaves that status.
+	// +optional
+	CreatingSince *metav1.Time `json:"creatingSince,omitempty"`
+
 	// Conditions defines current state of the Fargate profile.
 	// +optional
 	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
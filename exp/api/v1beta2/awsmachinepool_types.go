@@ -30,6 +30,9 @@ import (
 const (
 	// LaunchTemplateLatestVersion defines the launching of the latest version of the template.
 	LaunchTemplateLatestVersion = "$Latest"
+
+	// LaunchTemplateDefaultVersion defines the launching of the default version of the template.
+	LaunchTemplateDefaultVersion = "$Default"
 )
 
 // AWSMachinePoolSpec defines the desired state of AWSMachinePool.
@@ -97,6 +100,13 @@ type AWSMachinePoolSpec struct {
 	// +optional
 	CapacityRebalance bool `json:"capacityRebalance,omitempty"`
 
+	// HealthCheckType is the service the ASG uses to check the health of its instances,
+	// either EC2 or ELB. ELB health checks can only be switched to once the ASG is attached
+	// to a load balancer or target group. Defaults to EC2.
+	// +kubebuilder:validation:Enum=EC2;ELB
+	// +optional
+	HealthCheckType *HealthCheckType `json:"healthCheckType,omitempty"`
+
 	// SuspendProcesses defines a list of processes to suspend for the given ASG. This is constantly reconciled.
 	// If a process is removed from this list it will automatically be resumed.
 	SuspendProcesses *SuspendProcessesTypes `json:"suspendProcesses,omitempty"`
@@ -163,6 +173,12 @@ type RefreshPreferences struct {
 	// +optional
 	Disable bool `json:"disable,omitempty"`
 
+	// ForceUpdateEnabled, if true, cancels an unfinished instance refresh when the launch template
+	// changes and starts a new one straight away, instead of delaying the update until the
+	// previous refresh has finished. It can't be used together with Disable.
+	// +optional
+	ForceUpdateEnabled bool `json:"forceUpdateEnabled,omitempty"`
+
 	// The strategy to use for the instance refresh. The only valid value is Rolling.
 	// A rolling update is an update that is applied to all instances in an Auto
 	// Scaling group until all instances have been updated.
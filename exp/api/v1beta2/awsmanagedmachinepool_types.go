@@ -26,6 +26,19 @@ import (
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 )
 
+// MaintenanceWindowAnnotation is the name of an annotation that confines the disruptive changes of
+// the nodegroup of an AWSManagedMachinePool, version and launch template updates replacing its nodes
+// and scale-downs, to a weekly maintenance window. Its value is a list of days and a UTC time range,
+// such as "Sat,Sun 02:00-06:00". Outside the window, these changes are deferred and reported by the
+// EKSNodegroupDisruptiveChangesApplied condition.
+const MaintenanceWindowAnnotation = "aws.cluster.x-k8s.io/maintenance-window"
+
+// NodegroupConfigDryRunAnnotation is the name of an annotation that, when set to true on an
+// AWSManagedMachinePool, only plans the updates of the nodegroup config, its labels, taints,
+// scaling, update and node repair configs, without applying them. The planned updates are
+// reported by an event and the EKSNodegroupConfigUpToDate condition.
+const NodegroupConfigDryRunAnnotation = "aws.cluster.x-k8s.io/nodegroup-config-dry-run"
+
 // ManagedMachineAMIType specifies which AWS AMI to use for a managed MachinePool.
 // Source of truth can be found using the link below:
 // https://docs.aws.amazon.com/eks/latest/APIReference/API_CreateNodegroup.html#AmazonEKS-CreateNodegroup-request-amiType
@@ -72,6 +85,112 @@ const (
 	Al2023Arm64Nvidia ManagedMachineAMIType = "AL2023_ARM_64_NVIDIA"
 )
 
+// ManagedMachineAMITypes lists the supported AMI types, it must match the enum of
+// AWSManagedMachinePoolSpec.AMIType.
+var ManagedMachineAMITypes = []ManagedMachineAMIType{
+	Al2x86_64,
+	Al2x86_64GPU,
+	Al2Arm64,
+	Custom,
+	BottleRocketArm64,
+	BottleRocketx86_64,
+	BottleRocketArm64Fips,
+	BottleRocketx86_64Fips,
+	BottleRocketArm64Nvidia,
+	BottleRocketx86_64Nvidia,
+	WindowsCore2019x86_64,
+	WindowsFull2019x86_64,
+	WindowsCore2022x86_64,
+	WindowsFull2022x86_64,
+	Al2023x86_64,
+	Al2023Arm64,
+	Al2023x86_64Neuron,
+	Al2023x86_64Nvidia,
+	Al2023Arm64Nvidia,
+}
+
+// ScalingProcess is a process of an Auto Scaling group that can be suspended.
+// +kubebuilder:validation:Enum:=Launch;Terminate;AddToLoadBalancer;AlarmNotification;AZRebalance;HealthCheck;InstanceRefresh;ReplaceUnhealthy;ScheduledActions
+type ScalingProcess string
+
+// Scaling processes.
+const (
+	ScalingProcessLaunch            ScalingProcess = "Launch"
+	ScalingProcessTerminate         ScalingProcess = "Terminate"
+	ScalingProcessAddToLoadBalancer ScalingProcess = "AddToLoadBalancer"
+	ScalingProcessAlarmNotification ScalingProcess = "AlarmNotification"
+	ScalingProcessAZRebalance       ScalingProcess = "AZRebalance"
+	ScalingProcessHealthCheck       ScalingProcess = "HealthCheck"
+	ScalingProcessInstanceRefresh   ScalingProcess = "InstanceRefresh"
+	ScalingProcessReplaceUnhealthy  ScalingProcess = "ReplaceUnhealthy"
+	ScalingProcessScheduledActions  ScalingProcess = "ScheduledActions"
+)
+
+// ScalingProcesses lists the processes that can be suspended, it must match the enum of
+// ScalingProcess.
+var ScalingProcesses = []ScalingProcess{
+	ScalingProcessLaunch,
+	ScalingProcessTerminate,
+	ScalingProcessAddToLoadBalancer,
+	ScalingProcessAlarmNotification,
+	ScalingProcessAZRebalance,
+	ScalingProcessHealthCheck,
+	ScalingProcessInstanceRefresh,
+	ScalingProcessReplaceUnhealthy,
+	ScalingProcessScheduledActions,
+}
+
+// LaunchTemplateVersionDriftPolicy is what the controller does when the nodegroup was moved to
+// another launch template version than the one it applied, for instance from the AWS console.
+// +kubebuilder:validation:Enum:=Reconcile;Adopt
+type LaunchTemplateVersionDriftPolicy string
+
+const (
+	// LaunchTemplateVersionDriftPolicyReconcile updates the nodegroup back to the launch template
+	// version the controller applied.
+	LaunchTemplateVersionDriftPolicyReconcile LaunchTemplateVersionDriftPolicy = "Reconcile"
+	// LaunchTemplateVersionDriftPolicyAdopt leaves the nodegroup on the launch template version it
+	// was moved to, until the controller has a new launch template version to roll out.
+	LaunchTemplateVersionDriftPolicyAdopt LaunchTemplateVersionDriftPolicy = "Adopt"
+)
+
+// PodDisruptionBudgetPolicy is what the controller does when PodDisruptionBudgets would block
+// draining the nodes removed by a scale-down of the nodegroup.
+// +kubebuilder:validation:Enum:=Defer;Proceed
+type PodDisruptionBudgetPolicy string
+
+const (
+	// PodDisruptionBudgetPolicyDefer keeps the desired size of the nodegroup until the budgets
+	// allow the scale-down.
+	PodDisruptionBudgetPolicyDefer PodDisruptionBudgetPolicy = "Defer"
+	// PodDisruptionBudgetPolicyProceed scales the nodegroup down anyway and reports the budgets
+	// that would block it with a warning event.
+	PodDisruptionBudgetPolicyProceed PodDisruptionBudgetPolicy = "Proceed"
+)
+
+// NodegroupDegradedPolicy is what the controller reports on the pool when EKS puts the nodegroup
+// in the DEGRADED status.
+// +kubebuilder:validation:Enum:=Ready;NotReady
+type NodegroupDegradedPolicy string
+
+const (
+	// NodegroupDegradedPolicyReady keeps the pool ready, as its nodes keep running, and only
+	// reports the health issues on the EKSNodegroupHealthy condition.
+	NodegroupDegradedPolicyReady NodegroupDegradedPolicy = "Ready"
+	// NodegroupDegradedPolicyNotReady marks the pool not ready until the nodegroup is no longer
+	// degraded.
+	NodegroupDegradedPolicyNotReady NodegroupDegradedPolicy = "NotReady"
+)
+
+// IsWindows returns true if the AMI type is one of the Windows AMI types.
+func (t ManagedMachineAMIType) IsWindows() bool {
+	switch t {
+	case WindowsCore2019x86_64, WindowsFull2019x86_64, WindowsCore2022x86_64, WindowsFull2022x86_64:
+		return true
+	}
+	return false
+}
+
 // ManagedMachinePoolCapacityType specifies the capacity type to be used for the managed MachinePool.
 type ManagedMachinePoolCapacityType string
 
@@ -98,7 +217,10 @@ type AWSManagedMachinePoolSpec struct {
 	// +optional
 	EKSNodegroupName string `json:"eksNodegroupName,omitempty"`
 
-	// AvailabilityZones is an array of availability zones instances can run in
+	// AvailabilityZones is an array of availability zones instances can run in. The nodegroup
+	// uses the subnets of the cluster network in these availability zones or, when SubnetIDs is
+	// set, only the subnets of SubnetIDs that the cluster network has in these availability zones.
+	// Previous releases ignored AvailabilityZones when SubnetIDs was set.
 	AvailabilityZones []string `json:"availabilityZones,omitempty"`
 
 	// AvailabilityZoneSubnetType specifies which type of subnets to use when an availability zone is specified.
@@ -126,6 +248,7 @@ type AWSManagedMachinePoolSpec struct {
 	// If the role is pre-existing we will treat it as unmanaged
 	// and not delete it on deletion. If the EKSEnableIAM feature
 	// flag is true and no name is supplied then a role is created.
+	// A missing role is also created when CreateRoleIfMissing is set.
 	// +optional
 	RoleName string `json:"roleName,omitempty"`
 
@@ -151,9 +274,21 @@ type AWSManagedMachinePoolSpec struct {
 	// in the IAM User Guide.
 	RolePermissionsBoundary string `json:"rolePermissionsBoundary,omitempty"`
 
+	// CreateRoleIfMissing specifies that the node group IAM role should be created
+	// when it doesn't exist, even if the EKSEnableIAM feature flag is disabled.
+	// The created role is tagged as owned by the cluster, has the policies required
+	// by EKS nodes attached and is deleted together with the machine pool.
+	// Pre-existing roles are never modified or deleted.
+	// The controller policy must allow IAM role creation, which clusterawsadm
+	// grants with iamRoleCreation set to true.
+	// +optional
+	CreateRoleIfMissing bool `json:"createRoleIfMissing,omitempty"`
+
 	// AMIVersion defines the desired AMI release version. If no version number
 	// is supplied then the latest version for the Kubernetes version
-	// will be used
+	// will be used. Except for Bottlerocket and Windows AMIs, the release version
+	// must be for the Kubernetes version of the nodegroup, such as 1.30.4-20240917
+	// for a 1.30 nodegroup.
 	// +kubebuilder:validation:MinLength:=2
 	// +optional
 	AMIVersion *string `json:"amiVersion,omitempty"`
@@ -177,13 +312,30 @@ type AWSManagedMachinePoolSpec struct {
 	DiskSize *int32 `json:"diskSize,omitempty"`
 
 	// InstanceType specifies the AWS instance type
+	//
+	// Deprecated: use InstanceTypes instead.
 	// +optional
 	InstanceType *string `json:"instanceType,omitempty"`
 
+	// InstanceTypes specifies the AWS instance types of the nodes. Spot capacity is more
+	// likely to be available when several instance types are specified. EKS can't change
+	// the instance types of an existing node group, it has to be recreated to use others.
+	// +optional
+	InstanceTypes []string `json:"instanceTypes,omitempty"`
+
 	// Scaling specifies scaling for the ASG behind this pool
 	// +optional
 	Scaling *ManagedMachinePoolScaling `json:"scaling,omitempty"`
 
+	// PodDisruptionBudgetPolicy enables checking the PodDisruptionBudgets of the workload
+	// cluster before decreasing the desired size of the nodegroup. The Auto Scaling group picks
+	// the instances to terminate, so a budget blocks the scale-down if it doesn't allow evicting
+	// its pods from the nodes running most of them. Defer keeps the desired size until the
+	// budgets allow the scale-down, Proceed scales down anyway and reports the blocking budgets
+	// with a warning event. When it isn't set, the budgets aren't checked.
+	// +optional
+	PodDisruptionBudgetPolicy *PodDisruptionBudgetPolicy `json:"podDisruptionBudgetPolicy,omitempty"`
+
 	// RemoteAccess specifies how machines can be accessed remotely
 	// +optional
 	RemoteAccess *ManagedRemoteAccess `json:"remoteAccess,omitempty"`
@@ -200,17 +352,70 @@ type AWSManagedMachinePoolSpec struct {
 	// +optional
 	CapacityType *ManagedMachinePoolCapacityType `json:"capacityType,omitempty"`
 
+	// SpotAllocationStrategy specifies how the Auto Scaling group backing a spot nodegroup
+	// allocates instances across Spot Instance pools. EKS doesn't expose the allocation
+	// strategy, so it's set on the Auto Scaling group once the nodegroup is active.
+	// It can only be specified when CapacityType is spot.
+	// +kubebuilder:validation:Enum=lowest-price;capacity-optimized;capacity-optimized-prioritized;price-capacity-optimized
+	// +optional
+	SpotAllocationStrategy *SpotAllocationStrategy `json:"spotAllocationStrategy,omitempty"`
+
+	// OnDemandBaseCapacity is the number of on-demand instances the Auto Scaling group backing a
+	// spot nodegroup launches before launching spot instances. Like SpotAllocationStrategy, it's
+	// set on the Auto Scaling group once the nodegroup is active, and can only be specified when
+	// CapacityType is spot.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	OnDemandBaseCapacity *int32 `json:"onDemandBaseCapacity,omitempty"`
+
 	// UpdateConfig holds the optional config to control the behaviour of the update
 	// to the nodegroup.
 	// +optional
 	UpdateConfig *UpdateConfig `json:"updateConfig,omitempty"`
 
+	// ForceUpdate, when true, forces version updates of the nodegroup even if pods can't be
+	// drained from the nodes because of a PodDisruptionBudget. The pods are evicted anyway, so
+	// workloads relying on the budget for availability or for data safety, such as replicated
+	// databases, can have downtime or lose data. Only set it to unblock a stuck update.
+	// +optional
+	ForceUpdate *bool `json:"forceUpdate,omitempty"`
+
 	// AWSLaunchTemplate specifies the launch template to use to create the managed node group.
 	// If AWSLaunchTemplate is specified, certain node group configuraions outside of launch template
 	// are prohibited (https://docs.aws.amazon.com/eks/latest/userguide/launch-templates.html).
 	// +optional
 	AWSLaunchTemplate *AWSLaunchTemplate `json:"awsLaunchTemplate,omitempty"`
 
+	// LaunchTemplateVersion pins the nodegroup to a version of the launch template created
+	// from AWSLaunchTemplate, for instance to roll back to the previous version when a launch
+	// template change breaks the nodes. Only the launch template is rolled back, the Kubernetes
+	// version of the nodegroup is never downgraded. When it isn't set, the nodegroup follows
+	// the latest version of the launch template. It requires AWSLaunchTemplate.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	LaunchTemplateVersion *int64 `json:"launchTemplateVersion,omitempty"`
+
+	// LaunchTemplateVersionDriftPolicy specifies what to do when the nodegroup was moved to
+	// another version of the launch template outside of the controller. Reconcile, the default,
+	// updates the nodegroup back to the version the controller applied. Adopt keeps the version
+	// the nodegroup was moved to until the controller rolls out a new launch template version.
+	// +kubebuilder:default:=Reconcile
+	// +optional
+	LaunchTemplateVersionDriftPolicy LaunchTemplateVersionDriftPolicy `json:"launchTemplateVersionDriftPolicy,omitempty"`
+
+	// DegradedPolicy specifies how a nodegroup in the DEGRADED status is reported. Ready, the
+	// default, keeps the pool ready and reports the health issues on the EKSNodegroupHealthy
+	// condition. NotReady also marks the pool not ready until the nodegroup recovers.
+	// +kubebuilder:default:=Ready
+	// +optional
+	DegradedPolicy NodegroupDegradedPolicy `json:"degradedPolicy,omitempty"`
+
+	// InstanceTypeFallback specifies instance types to switch the launch template to when
+	// the nodegroup can't launch instances because of insufficient capacity of the launch
+	// template's instance type. It requires AWSLaunchTemplate with an instance type.
+	// +optional
+	InstanceTypeFallback *InstanceTypeFallback `json:"instanceTypeFallback,omitempty"`
+
 	// AWSLifecycleHooks specifies lifecycle hooks for the managed node group.
 	// +optional
 	AWSLifecycleHooks []AWSLifecycleHook `json:"lifecycleHooks,omitempty"`
@@ -218,6 +423,52 @@ type AWSManagedMachinePoolSpec struct {
 	// NodeRepairConfig specifies the node auto repair configuration for the managed node group.
 	// +optional
 	NodeRepairConfig *NodeRepairConfig `json:"nodeRepairConfig,omitempty"`
+
+	// HealthCheckGracePeriod is the amount of time the Auto Scaling group backing the
+	// nodegroup waits before checking the health status of an instance that has come into service.
+	// +optional
+	HealthCheckGracePeriod *metav1.Duration `json:"healthCheckGracePeriod,omitempty"`
+
+	// DefaultInstanceWarmup is the amount of time until a new instance in the Auto Scaling
+	// group backing the nodegroup is considered to have finished initializing.
+	// +optional
+	DefaultInstanceWarmup *metav1.Duration `json:"defaultInstanceWarmup,omitempty"`
+
+	// ClusterSecurityGroupOverride is a reference, by ID or filters, to a security group used
+	// instead of the EKS cluster security group for remote access and, when AWSLaunchTemplate
+	// is specified, for node networking. The security group must be in the cluster VPC.
+	// +optional
+	ClusterSecurityGroupOverride *infrav1.AWSResourceReference `json:"clusterSecurityGroupOverride,omitempty"`
+
+	// ExternalLaunchTemplate references an existing launch template, managed outside of
+	// CAPA, to use for the managed node group. It is mutually exclusive with AWSLaunchTemplate
+	// and the same node group configurations are prohibited.
+	// +optional
+	ExternalLaunchTemplate *ExternalLaunchTemplate `json:"externalLaunchTemplate,omitempty"`
+
+	// NodeJoinTimeout enables checking that at least the minimum size of the nodegroup
+	// has joined the workload cluster as ready nodes once the nodegroup is active. If they
+	// haven't joined within the timeout, for example because of misconfigured security
+	// groups or aws-auth, the EKSNodegroupNodesJoined condition is set to false.
+	// +optional
+	NodeJoinTimeout *metav1.Duration `json:"nodeJoinTimeout,omitempty"`
+
+	// SuspendProcesses lists the processes of the Auto Scaling group backing the nodegroup
+	// to suspend, for example AZRebalance so that instances aren't terminated to balance
+	// them across availability zones, or Terminate and ReplaceUnhealthy during controlled
+	// maintenance. The processes of the group that aren't listed are resumed, so an empty
+	// list resumes all of them. When not set, the suspended processes of the group are left
+	// as they are.
+	// +listType=set
+	// +optional
+	SuspendProcesses *[]ScalingProcess `json:"suspendProcesses,omitempty"`
+
+	// ExcludeFromClusterAutoscaler removes the Auto Scaling group backing the nodegroup from
+	// the cluster autoscaler auto-discovery, for statically sized pools. The
+	// k8s.io/cluster-autoscaler/enabled tag of the group is set to false and the
+	// k8s.io/cluster-autoscaler/<cluster name> tag is removed. Both are restored when unset.
+	// +optional
+	ExcludeFromClusterAutoscaler bool `json:"excludeFromClusterAutoscaler,omitempty"`
 }
 
 // ManagedMachinePoolScaling specifies scaling options.
@@ -250,6 +501,18 @@ type AWSManagedMachinePoolStatus struct {
 	// +optional
 	Replicas int32 `json:"replicas"`
 
+	// BootstrapReadyReplicas is the number of instances that have tagged themselves as
+	// bootstrapped once their kubelet became healthy. It is only populated for nodes whose
+	// bootstrap configuration enables readiness reporting.
+	// +optional
+	BootstrapReadyReplicas int32 `json:"bootstrapReadyReplicas,omitempty"`
+
+	// ScalingConfig is the scaling configuration of the EKS nodegroup as last observed. It
+	// can differ from the spec, for instance when an external autoscaler changes the size of
+	// the nodegroup.
+	// +optional
+	ScalingConfig *NodegroupScalingStatus `json:"scalingConfig,omitempty"`
+
 	// The ID of the launch template
 	// +optional
 	LaunchTemplateID *string `json:"launchTemplateID,omitempty"`
@@ -258,6 +521,34 @@ type AWSManagedMachinePoolStatus struct {
 	// +optional
 	LaunchTemplateVersion *string `json:"launchTemplateVersion,omitempty"`
 
+	// AppliedLaunchTemplateVersion is the version of the launch template the controller last
+	// applied to the nodegroup. The nodegroup using another version means it was changed
+	// outside of the controller.
+	// +optional
+	AppliedLaunchTemplateVersion *string `json:"appliedLaunchTemplateVersion,omitempty"`
+
+	// InstanceTypeFallback is the fallback instance type the launch template currently uses
+	// instead of its own instance type because of insufficient capacity.
+	// +optional
+	InstanceTypeFallback *InstanceTypeFallbackStatus `json:"instanceTypeFallback,omitempty"`
+
+	// AutoScalingGroupName is the name of the Auto Scaling group backing the nodegroup,
+	// once EKS has created it.
+	// +optional
+	AutoScalingGroupName *string `json:"autoScalingGroupName,omitempty"`
+
+	// RemoteAccessSecurityGroupID is the ID of the security group EKS created to allow
+	// remote access to the nodes, when remote access is enabled.
+	// +optional
+	RemoteAccessSecurityGroupID *string `json:"remoteAccessSecurityGroupID,omitempty"`
+
+	// EstimatedHourlyCost is a rough estimate of the hourly cost in USD of the nodes of the
+	// nodegroup, from their instance types, capacity type and the desired size. It's only
+	// set when the controller has cost estimates enabled and knows the price of the instance
+	// types, and doesn't account for storage, data transfer or discounts.
+	// +optional
+	EstimatedHourlyCost *string `json:"estimatedHourlyCost,omitempty"`
+
 	// FailureReason will be set in the event that there is a terminal problem
 	// reconciling the MachinePool and will contain a succinct value suitable
 	// for machine interpretation.
@@ -301,6 +592,55 @@ type AWSManagedMachinePoolStatus struct {
 	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
 }
 
+// ExternalLaunchTemplate references a launch template which isn't managed by CAPA.
+type ExternalLaunchTemplate struct {
+	// ID is the ID of the launch template.
+	// +kubebuilder:validation:Pattern:=`^lt-[0-9a-f]+$`
+	ID string `json:"id"`
+
+	// Version is the version of the launch template to use: a version number, `$Latest`
+	// or `$Default`. With `$Latest` or `$Default` the node group follows that version of
+	// the launch template as it changes. Defaults to `$Default`.
+	// +kubebuilder:validation:Pattern:=`^(\$Latest|\$Default|[0-9]+)$`
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// InstanceTypeFallback defines the instance types used when the launch template's
+// instance type doesn't have enough capacity.
+type InstanceTypeFallback struct {
+	// InstanceTypes are the instance types to fall back to, in order of preference. The
+	// next one is used each time the nodegroup reports insufficient capacity.
+	// +kubebuilder:validation:MinItems=1
+	InstanceTypes []string `json:"instanceTypes"`
+
+	// Cooldown is how long a fallback instance type is used before the launch template's
+	// instance type is tried again. Defaults to 30m.
+	// +optional
+	Cooldown *metav1.Duration `json:"cooldown,omitempty"`
+}
+
+// InstanceTypeFallbackStatus is the fallback instance type in use by a nodegroup.
+type InstanceTypeFallbackStatus struct {
+	// InstanceType is the fallback instance type in use.
+	InstanceType string `json:"instanceType"`
+
+	// LastTransitionTime is the time the fallback instance type was selected.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+// NodegroupScalingStatus is the observed scaling configuration of an EKS nodegroup.
+type NodegroupScalingStatus struct {
+	// MinSize is the minimum number of nodes of the nodegroup.
+	MinSize int32 `json:"minSize"`
+
+	// MaxSize is the maximum number of nodes of the nodegroup.
+	MaxSize int32 `json:"maxSize"`
+
+	// DesiredSize is the number of nodes the nodegroup should have.
+	DesiredSize int32 `json:"desiredSize"`
+}
+
 // NodeRepairConfig defines the node auto repair configuration for managed node groups.
 type NodeRepairConfig struct {
 	// Enabled specifies whether node auto repair is enabled for the node group.
@@ -76,11 +76,114 @@ const (
 	EKSNodegroupReadyCondition clusterv1beta1.ConditionType = "EKSNodegroupReady"
 	// EKSNodegroupReconciliationFailedReason used to report failures while reconciling EKS control plane.
 	EKSNodegroupReconciliationFailedReason = "EKSNodegroupReconciliationFailed"
+	// EKSNodegroupSubnetsRemovedReason used when subnets used by the nodegroup are no longer part of the cluster network.
+	EKSNodegroupSubnetsRemovedReason = "EKSNodegroupSubnetsRemoved"
+	// EKSNodegroupFailureDomainsChangedReason used when the failure domains of the MachinePool differ from
+	// the availability zones of the nodegroup subnets, which EKS can't change without recreating the nodegroup.
+	EKSNodegroupFailureDomainsChangedReason = "EKSNodegroupFailureDomainsChanged"
+	// EKSNodegroupInstanceTypesChangedReason used when the instance types of the nodegroup differ from
+	// the spec, which EKS can't change without recreating the nodegroup.
+	EKSNodegroupInstanceTypesChangedReason = "EKSNodegroupInstanceTypesChanged"
+	// EKSNodegroupAMITypeChangedReason used when the AMI type of the nodegroup differs from the spec,
+	// which EKS can't change without recreating the nodegroup.
+	EKSNodegroupAMITypeChangedReason = "EKSNodegroupAMITypeChanged"
+	// EKSNodegroupAMIVersionMismatchReason used when the AMI release version of the spec is for
+	// another Kubernetes version than the nodegroup's.
+	EKSNodegroupAMIVersionMismatchReason = "EKSNodegroupAMIVersionMismatch"
+	// EKSNodegroupTaintLimitExceededReason used when the nodegroup would have more taints than EKS allows
+	// once the cluster's default taints are merged in.
+	EKSNodegroupTaintLimitExceededReason = "EKSNodegroupTaintLimitExceeded"
+	// EKSNodegroupInstanceProfileMismatchReason used when the instance profile set in the nodegroup's
+	// launch template doesn't contain the nodegroup's node role.
+	EKSNodegroupInstanceProfileMismatchReason = "EKSNodegroupInstanceProfileMismatch"
+	// EKSNodegroupLaunchTemplateAMIMissingReason used when the nodegroup uses a custom AMI type
+	// but its launch template doesn't specify an AMI.
+	EKSNodegroupLaunchTemplateAMIMissingReason = "EKSNodegroupLaunchTemplateAMIMissing"
+	// EKSNodegroupWindowsSupportDisabledReason used when the nodegroup uses a Windows AMI type
+	// but Windows support isn't enabled in the cluster.
+	EKSNodegroupWindowsSupportDisabledReason = "EKSNodegroupWindowsSupportDisabled"
+	// EKSNodegroupClusterNotFoundReason used when EKS can't find the cluster of the nodegroup
+	// being created, for example because the cluster is still being created.
+	EKSNodegroupClusterNotFoundReason = "EKSClusterNotFound"
+	// EKSNodegroupUpdatingReason used while an update of the nodegroup is in progress.
+	EKSNodegroupUpdatingReason = "EKSNodegroupUpdating"
+	// EKSNodegroupUnknownStatusReason used when the nodegroup is in a status that isn't known
+	// to the controller.
+	EKSNodegroupUnknownStatusReason = "EKSNodegroupUnknownStatus"
 	// WaitingForEKSControlPlaneReason used when the machine pool is waiting for
 	// EKS control plane infrastructure to be ready before proceeding.
 	WaitingForEKSControlPlaneReason = "WaitingForEKSControlPlane"
 )
 
+const (
+	// EKSNodegroupReplicasAvailableCondition reports whether the nodegroup has as many InService
+	// instances as the MachinePool's desired replicas.
+	EKSNodegroupReplicasAvailableCondition clusterv1beta1.ConditionType = "EKSNodegroupReplicasAvailable"
+	// EKSNodegroupWaitingForReplicasReason used while the nodegroup has fewer InService instances
+	// than desired, but not for longer than the grace period.
+	EKSNodegroupWaitingForReplicasReason = "WaitingForReplicas"
+	// EKSNodegroupReplicaShortfallReason used when the nodegroup has had fewer InService instances
+	// than desired for longer than the grace period, e.g. because Spot capacity is unavailable.
+	EKSNodegroupReplicaShortfallReason = "ReplicaShortfall"
+
+	// EKSNodegroupReplicasSourceCondition reports whether the nodegroup's desired size has a single
+	// source of truth when the MachinePool's replicas are managed by an external autoscaler.
+	EKSNodegroupReplicasSourceCondition clusterv1beta1.ConditionType = "EKSNodegroupReplicasSource"
+	// EKSNodegroupReplicasSourceConflictReason used when the MachinePool's replicas were changed while
+	// they're managed by an external autoscaler.
+	EKSNodegroupReplicasSourceConflictReason = "ReplicasSourceConflict"
+)
+
+const (
+	// EKSNodegroupHealthyCondition reports whether EKS reports health issues for the nodegroup.
+	EKSNodegroupHealthyCondition clusterv1beta1.ConditionType = "EKSNodegroupHealthy"
+	// EKSNodegroupHealthIssuesReason used when EKS reports health issues for the nodegroup, such as
+	// instance launch failures or an invalid subnet configuration.
+	EKSNodegroupHealthIssuesReason = "EKSNodegroupHealthIssues"
+	// EKSNodegroupStatusDegradedReason used when EKS put the nodegroup in the DEGRADED status.
+	EKSNodegroupStatusDegradedReason = "EKSNodegroupStatusDegraded"
+)
+
+const (
+	// EKSNodegroupNodesJoinedCondition reports whether at least the nodegroup's minimum size has
+	// joined the workload cluster as ready nodes, when NodeJoinTimeout is set.
+	EKSNodegroupNodesJoinedCondition clusterv1beta1.ConditionType = "EKSNodegroupNodesJoined"
+	// EKSNodegroupWaitingForNodesReason used while fewer ready nodes than the nodegroup's minimum
+	// size have joined the workload cluster, but not for longer than NodeJoinTimeout.
+	EKSNodegroupWaitingForNodesReason = "WaitingForNodes"
+	// EKSNodegroupNodesNotJoinedReason used when fewer ready nodes than the nodegroup's minimum size
+	// have joined the workload cluster for longer than NodeJoinTimeout.
+	EKSNodegroupNodesNotJoinedReason = "NodesNotJoined"
+)
+
+const (
+	// EKSNodegroupDisruptiveChangesAppliedCondition reports whether the disruptive changes of the
+	// nodegroup are applied, when the pool has the MaintenanceWindowAnnotation.
+	EKSNodegroupDisruptiveChangesAppliedCondition clusterv1beta1.ConditionType = "EKSNodegroupDisruptiveChangesApplied"
+	// EKSNodegroupOutsideMaintenanceWindowReason used when disruptive changes of the nodegroup are
+	// deferred until its maintenance window opens.
+	EKSNodegroupOutsideMaintenanceWindowReason = "OutsideMaintenanceWindow"
+)
+
+const (
+	// EKSNodegroupConfigUpToDateCondition reports whether the nodegroup config matches the spec,
+	// when the pool has the NodegroupConfigDryRunAnnotation.
+	EKSNodegroupConfigUpToDateCondition clusterv1beta1.ConditionType = "EKSNodegroupConfigUpToDate"
+	// EKSNodegroupConfigDryRunReason used when updates of the nodegroup config are planned but not
+	// applied because of the NodegroupConfigDryRunAnnotation.
+	EKSNodegroupConfigDryRunReason = "DryRun"
+)
+
+const (
+	// EKSNodegroupScaleDownAllowedCondition reports whether the PodDisruptionBudgets of the workload
+	// cluster allow the pending scale-down of the nodegroup, when the pool has a
+	// PodDisruptionBudgetPolicy.
+	EKSNodegroupScaleDownAllowedCondition clusterv1beta1.ConditionType = "EKSNodegroupScaleDownAllowed"
+	// EKSNodegroupPodDisruptionBudgetsBlockingReason used when PodDisruptionBudgets would block
+	// draining the nodes removed by a scale-down of the nodegroup.
+	EKSNodegroupPodDisruptionBudgetsBlockingReason = "PodDisruptionBudgetsBlocking"
+)
+
 const (
 	// EKSFargateProfileReadyCondition condition reports on the successful reconciliation of eks control plane.
 	EKSFargateProfileReadyCondition clusterv1beta1.ConditionType = "EKSFargateProfileReady"
@@ -101,6 +204,12 @@ const (
 	EKSFargateDeletedReason = "Deleted"
 	// EKSFargateFailedReason used when the profile failed.
 	EKSFargateFailedReason = "Failed"
+	// EKSFargateRecreatingReason used when the profile is recreated because of a change
+	// to an immutable field.
+	EKSFargateRecreatingReason = "Recreating"
+	// EKSFargateCreateTimeoutReason used when the profile has been creating for longer than
+	// the controller waits for it, for instance because of an unusable subnet or role.
+	EKSFargateCreateTimeoutReason = "CreateTimeout"
 )
 
 const (
@@ -110,6 +219,12 @@ const (
 	// IAMNodegroupRolesReconciliationFailedReason used to report failures while
 	// reconciling EKS nodegroup iam roles.
 	IAMNodegroupRolesReconciliationFailedReason = "IAMNodegroupRolesReconciliationFailed"
+	// IAMNodegroupRoleCreationDeniedReason used to report that the controller isn't allowed to create
+	// the missing nodegroup role, as its IAM policy doesn't grant the IAM role creation actions.
+	IAMNodegroupRoleCreationDeniedReason = "IAMNodegroupRoleCreationDenied"
+	// IAMNodegroupRolePoliciesMissingReason used to report that the nodegroup role, which isn't
+	// managed by the controller, doesn't have all the required policies attached.
+	IAMNodegroupRolePoliciesMissingReason = "IAMNodegroupRolePoliciesMissing"
 	// IAMFargateRolesReadyCondition condition reports on the successful
 	// reconciliation of EKS nodegroup iam roles.
 	IAMFargateRolesReadyCondition clusterv1beta1.ConditionType = "IAMFargateRolesReady"
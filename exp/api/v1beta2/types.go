@@ -240,6 +240,9 @@ type AutoScalingGroup struct {
 	DefaultCoolDown       metav1.Duration `json:"defaultCoolDown,omitempty"`
 	DefaultInstanceWarmup metav1.Duration `json:"defaultInstanceWarmup,omitempty"`
 	CapacityRebalance     bool            `json:"capacityRebalance,omitempty"`
+	HealthCheckType       HealthCheckType `json:"healthCheckType,omitempty"`
+	LoadBalancerNames     []string        `json:"loadBalancerNames,omitempty"`
+	TargetGroupARNs       []string        `json:"targetGroupARNs,omitempty"`
 
 	MixedInstancesPolicy      *MixedInstancesPolicy `json:"mixedInstancesPolicy,omitempty"`
 	Status                    ASGStatus
@@ -359,6 +362,9 @@ func (t *Taint) Equals(other *Taint) bool {
 		t.Value == other.Value
 }
 
+// MaxNodegroupTaints is the maximum number of taints EKS allows on a managed node group.
+const MaxNodegroupTaints = 50
+
 // Taints is an array of Taints.
 type Taints []Taint
 
@@ -403,6 +409,17 @@ const (
 	AZSubnetTypeAll AZSubnetType = "all"
 )
 
+// HealthCheckType is the service an autoscaling group uses to check the health of its instances.
+type HealthCheckType string
+
+const (
+	// HealthCheckTypeEC2 uses the EC2 status checks of the instances.
+	HealthCheckTypeEC2 HealthCheckType = "EC2"
+	// HealthCheckTypeELB uses the health checks of the load balancers and target groups
+	// the autoscaling group is attached to, in addition to the EC2 status checks.
+	HealthCheckTypeELB HealthCheckType = "ELB"
+)
+
 // NewAZSubnetType returns a pointer to an AZSubnetType.
 func NewAZSubnetType(t AZSubnetType) *AZSubnetType {
 	return &t
@@ -318,6 +318,11 @@ func (in *AWSMachinePoolSpec) DeepCopyInto(out *AWSMachinePoolSpec) {
 		*out = new(RefreshPreferences)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HealthCheckType != nil {
+		in, out := &in.HealthCheckType, &out.HealthCheckType
+		*out = new(HealthCheckType)
+		**out = **in
+	}
 	if in.SuspendProcesses != nil {
 		in, out := &in.SuspendProcesses, &out.SuspendProcesses
 		*out = new(SuspendProcessesTypes)
@@ -517,11 +522,21 @@ func (in *AWSManagedMachinePoolSpec) DeepCopyInto(out *AWSManagedMachinePoolSpec
 		*out = new(string)
 		**out = **in
 	}
+	if in.InstanceTypes != nil {
+		in, out := &in.InstanceTypes, &out.InstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Scaling != nil {
 		in, out := &in.Scaling, &out.Scaling
 		*out = new(ManagedMachinePoolScaling)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodDisruptionBudgetPolicy != nil {
+		in, out := &in.PodDisruptionBudgetPolicy, &out.PodDisruptionBudgetPolicy
+		*out = new(PodDisruptionBudgetPolicy)
+		**out = **in
+	}
 	if in.RemoteAccess != nil {
 		in, out := &in.RemoteAccess, &out.RemoteAccess
 		*out = new(ManagedRemoteAccess)
@@ -537,16 +552,41 @@ func (in *AWSManagedMachinePoolSpec) DeepCopyInto(out *AWSManagedMachinePoolSpec
 		*out = new(ManagedMachinePoolCapacityType)
 		**out = **in
 	}
+	if in.SpotAllocationStrategy != nil {
+		in, out := &in.SpotAllocationStrategy, &out.SpotAllocationStrategy
+		*out = new(SpotAllocationStrategy)
+		**out = **in
+	}
+	if in.OnDemandBaseCapacity != nil {
+		in, out := &in.OnDemandBaseCapacity, &out.OnDemandBaseCapacity
+		*out = new(int32)
+		**out = **in
+	}
 	if in.UpdateConfig != nil {
 		in, out := &in.UpdateConfig, &out.UpdateConfig
 		*out = new(UpdateConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ForceUpdate != nil {
+		in, out := &in.ForceUpdate, &out.ForceUpdate
+		*out = new(bool)
+		**out = **in
+	}
 	if in.AWSLaunchTemplate != nil {
 		in, out := &in.AWSLaunchTemplate, &out.AWSLaunchTemplate
 		*out = new(AWSLaunchTemplate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LaunchTemplateVersion != nil {
+		in, out := &in.LaunchTemplateVersion, &out.LaunchTemplateVersion
+		*out = new(int64)
+		**out = **in
+	}
+	if in.InstanceTypeFallback != nil {
+		in, out := &in.InstanceTypeFallback, &out.InstanceTypeFallback
+		*out = new(InstanceTypeFallback)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AWSLifecycleHooks != nil {
 		in, out := &in.AWSLifecycleHooks, &out.AWSLifecycleHooks
 		*out = make([]AWSLifecycleHook, len(*in))
@@ -559,6 +599,40 @@ func (in *AWSManagedMachinePoolSpec) DeepCopyInto(out *AWSManagedMachinePoolSpec
 		*out = new(NodeRepairConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HealthCheckGracePeriod != nil {
+		in, out := &in.HealthCheckGracePeriod, &out.HealthCheckGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DefaultInstanceWarmup != nil {
+		in, out := &in.DefaultInstanceWarmup, &out.DefaultInstanceWarmup
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ClusterSecurityGroupOverride != nil {
+		in, out := &in.ClusterSecurityGroupOverride, &out.ClusterSecurityGroupOverride
+		*out = new(apiv1beta2.AWSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalLaunchTemplate != nil {
+		in, out := &in.ExternalLaunchTemplate, &out.ExternalLaunchTemplate
+		*out = new(ExternalLaunchTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeJoinTimeout != nil {
+		in, out := &in.NodeJoinTimeout, &out.NodeJoinTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SuspendProcesses != nil {
+		in, out := &in.SuspendProcesses, &out.SuspendProcesses
+		*out = new([]ScalingProcess)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]ScalingProcess, len(*in))
+			copy(*out, *in)
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSManagedMachinePoolSpec.
@@ -574,6 +648,11 @@ func (in *AWSManagedMachinePoolSpec) DeepCopy() *AWSManagedMachinePoolSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSManagedMachinePoolStatus) DeepCopyInto(out *AWSManagedMachinePoolStatus) {
 	*out = *in
+	if in.ScalingConfig != nil {
+		in, out := &in.ScalingConfig, &out.ScalingConfig
+		*out = new(NodegroupScalingStatus)
+		**out = **in
+	}
 	if in.LaunchTemplateID != nil {
 		in, out := &in.LaunchTemplateID, &out.LaunchTemplateID
 		*out = new(string)
@@ -584,6 +663,31 @@ func (in *AWSManagedMachinePoolStatus) DeepCopyInto(out *AWSManagedMachinePoolSt
 		*out = new(string)
 		**out = **in
 	}
+	if in.AppliedLaunchTemplateVersion != nil {
+		in, out := &in.AppliedLaunchTemplateVersion, &out.AppliedLaunchTemplateVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceTypeFallback != nil {
+		in, out := &in.InstanceTypeFallback, &out.InstanceTypeFallback
+		*out = new(InstanceTypeFallbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoScalingGroupName != nil {
+		in, out := &in.AutoScalingGroupName, &out.AutoScalingGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RemoteAccessSecurityGroupID != nil {
+		in, out := &in.RemoteAccessSecurityGroupID, &out.RemoteAccessSecurityGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EstimatedHourlyCost != nil {
+		in, out := &in.EstimatedHourlyCost, &out.EstimatedHourlyCost
+		*out = new(string)
+		**out = **in
+	}
 	if in.FailureReason != nil {
 		in, out := &in.FailureReason, &out.FailureReason
 		*out = new(string)
@@ -666,6 +770,16 @@ func (in *AutoScalingGroup) DeepCopyInto(out *AutoScalingGroup) {
 	}
 	out.DefaultCoolDown = in.DefaultCoolDown
 	out.DefaultInstanceWarmup = in.DefaultInstanceWarmup
+	if in.LoadBalancerNames != nil {
+		in, out := &in.LoadBalancerNames, &out.LoadBalancerNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetGroupARNs != nil {
+		in, out := &in.TargetGroupARNs, &out.TargetGroupARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.MixedInstancesPolicy != nil {
 		in, out := &in.MixedInstancesPolicy, &out.MixedInstancesPolicy
 		*out = new(MixedInstancesPolicy)
@@ -741,6 +855,26 @@ func (in *EBS) DeepCopy() *EBS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalLaunchTemplate) DeepCopyInto(out *ExternalLaunchTemplate) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalLaunchTemplate.
+func (in *ExternalLaunchTemplate) DeepCopy() *ExternalLaunchTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalLaunchTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FargateProfileSpec) DeepCopyInto(out *FargateProfileSpec) {
 	*out = *in
@@ -756,6 +890,11 @@ func (in *FargateProfileSpec) DeepCopyInto(out *FargateProfileSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.PodExecutionRoleARN != nil {
+		in, out := &in.PodExecutionRoleARN, &out.PodExecutionRoleARN
+		*out = new(string)
+		**out = **in
+	}
 	if in.Selectors != nil {
 		in, out := &in.Selectors, &out.Selectors
 		*out = make([]FargateSelector, len(*in))
@@ -788,6 +927,10 @@ func (in *FargateProfileStatus) DeepCopyInto(out *FargateProfileStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CreatingSince != nil {
+		in, out := &in.CreatingSince, &out.CreatingSince
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(v1beta1.Conditions, len(*in))
@@ -829,6 +972,47 @@ func (in *FargateSelector) DeepCopy() *FargateSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTypeFallback) DeepCopyInto(out *InstanceTypeFallback) {
+	*out = *in
+	if in.InstanceTypes != nil {
+		in, out := &in.InstanceTypes, &out.InstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Cooldown != nil {
+		in, out := &in.Cooldown, &out.Cooldown
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTypeFallback.
+func (in *InstanceTypeFallback) DeepCopy() *InstanceTypeFallback {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTypeFallback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTypeFallbackStatus) DeepCopyInto(out *InstanceTypeFallbackStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTypeFallbackStatus.
+func (in *InstanceTypeFallbackStatus) DeepCopy() *InstanceTypeFallbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTypeFallbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstancesDistribution) DeepCopyInto(out *InstancesDistribution) {
 	*out = *in
@@ -949,6 +1133,21 @@ func (in *NodeRepairConfig) DeepCopy() *NodeRepairConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodegroupScalingStatus) DeepCopyInto(out *NodegroupScalingStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodegroupScalingStatus.
+func (in *NodegroupScalingStatus) DeepCopy() *NodegroupScalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodegroupScalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatorRoleConfig) DeepCopyInto(out *OperatorRoleConfig) {
 	*out = *in
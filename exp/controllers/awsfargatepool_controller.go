@@ -18,8 +18,10 @@ package controllers
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
@@ -30,8 +32,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
@@ -47,6 +51,15 @@ type AWSFargateProfileReconciler struct {
 	Recorder         record.EventRecorder
 	EnableIAM        bool
 	WatchFilterValue string
+	// RequeueInterval is how long to wait before checking again on a profile being created
+	// or deleted.
+	RequeueInterval time.Duration
+	// MaxRequeueInterval caps the requeue interval backing off while a profile stays in the
+	// creating or deleting status.
+	MaxRequeueInterval time.Duration
+	// CreateTimeout is how long a profile can stay in the creating status before its ready
+	// condition reports the timeout.
+	CreateTimeout time.Duration
 }
 
 // SetupWithManager is used to setup the controller.
@@ -106,6 +119,10 @@ func (r *AWSFargateProfileReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		ControlPlane:   controlPlane,
 		FargateProfile: fargateProfile,
 		EnableIAM:      r.EnableIAM,
+
+		RequeueInterval:    r.RequeueInterval,
+		MaxRequeueInterval: r.MaxRequeueInterval,
+		CreateTimeout:      r.CreateTimeout,
 	})
 	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "failed to create scope")
@@ -165,6 +182,13 @@ func (r *AWSFargateProfileReconciler) reconcileDelete(
 ) (ctrl.Result, error) {
 	fargateProfileScope.Info("Reconciling deletion of AWSFargateProfile")
 
+	if annotations.IsTrue(fargateProfileScope.FargateProfile, infrav1.OrphanOnDeleteAnnotation) {
+		fargateProfileScope.Info("Orphaning AWS resources of AWSFargateProfile", "annotation", infrav1.OrphanOnDeleteAnnotation)
+		r.Recorder.Eventf(fargateProfileScope.FargateProfile, corev1.EventTypeNormal, "OrphanedResources", "Left EKS fargate profile %s intact on deletion", fargateProfileScope.FargateProfile.Spec.ProfileName)
+		controllerutil.RemoveFinalizer(fargateProfileScope.FargateProfile, expinfrav1.FargateProfileFinalizer)
+		return ctrl.Result{}, nil
+	}
+
 	ekssvc := eks.NewFargateService(fargateProfileScope)
 
 	res, err := ekssvc.ReconcileDelete(ctx)
@@ -34,6 +34,7 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -227,12 +228,28 @@ func (r *AWSMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 }
 
 func (r *AWSMachinePoolReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	log := logger.FromContext(ctx)
+	gvk := expinfrav1.GroupVersion.WithKind("AWSMachinePool")
+
+	// The tags of the infrastructure cluster are merged into the tags of the pool's resources,
+	// so a change to them is propagated to all the pools of the cluster straight away.
+	infraClusterToAWSMachinePoolMap := infraClusterToMachinePoolMapFunc(r.Client, gvk, log)
 	return ctrl.NewControllerManagedBy(mgr).
 		WithOptions(options).
 		For(&expinfrav1.AWSMachinePool{}).
 		Watches(
 			&clusterv1.MachinePool{},
-			handler.EnqueueRequestsFromMapFunc(machinePoolToInfrastructureMapFunc(expinfrav1.GroupVersion.WithKind("AWSMachinePool"))),
+			handler.EnqueueRequestsFromMapFunc(machinePoolToInfrastructureMapFunc(gvk)),
+		).
+		Watches(
+			&infrav1.AWSCluster{},
+			handler.EnqueueRequestsFromMapFunc(infraClusterToAWSMachinePoolMap),
+			builder.WithPredicates(infraClusterAdditionalTagsChanged()),
+		).
+		Watches(
+			&ekscontrolplanev1.AWSManagedControlPlane{},
+			handler.EnqueueRequestsFromMapFunc(infraClusterToAWSMachinePoolMap),
+			builder.WithPredicates(infraClusterAdditionalTagsChanged()),
 		).
 		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(mgr.GetScheme(), logger.FromContext(ctx).GetLogger(), r.WatchFilterValue)).
 		WithEventFilter(
@@ -314,7 +331,20 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 			// But we want to update the LaunchTemplate because an error in the LaunchTemplate may be blocking the ASG creation.
 			return true, nil, nil
 		}
-		return asgsvc.CanStartASGInstanceRefresh(machinePoolScope)
+		canStart, unfinishedRefreshStatus, err := asgsvc.CanStartASGInstanceRefresh(machinePoolScope)
+		if err != nil || canStart || !machinePoolScope.InstanceRefreshForceUpdateEnabled() {
+			return canStart, unfinishedRefreshStatus, err
+		}
+		// Forced updates don't wait for the unfinished refresh. It's cancelled here and the
+		// new refresh is started once the cancellation has gone through.
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeNormal, "ForcingInstanceRefresh",
+			"Cancelling unfinished instance refresh (status %s) to roll out launch template changes", *unfinishedRefreshStatus)
+		if *unfinishedRefreshStatus != autoscalingtypes.InstanceRefreshStatusCancelling {
+			if err := asgsvc.CancelASGInstanceRefresh(machinePoolScope); err != nil {
+				return false, nil, err
+			}
+		}
+		return true, nil, nil
 	}
 	cancelInstanceRefresh := func() error {
 		machinePoolScope.Info("cancelling instance refresh")
@@ -545,6 +575,11 @@ func (r *AWSMachinePoolReconciler) updatePool(machinePoolScope *scope.MachinePoo
 		machinePoolScope.Debug("asg diff detected", "asgDiff", asgDiff, "subnetDiff", subnetDiff)
 	}
 	if asgDiff != "" || subnetDiff != "" {
+		if err := validateHealthCheckType(machinePoolScope, existingASG); err != nil {
+			r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "InvalidHealthCheckType", "Failed to update ASG: %v", err)
+			return err
+		}
+
 		machinePoolScope.Info("updating AutoScalingGroup")
 
 		if err := asgSvc.UpdateASG(machinePoolScope); err != nil {
@@ -646,6 +681,9 @@ func diffASG(machinePoolScope *scope.MachinePoolScope, existingASG *expinfrav1.A
 	detectedAWSMachinePoolSpec.MaxSize = existingASG.MaxSize
 	detectedAWSMachinePoolSpec.MinSize = existingASG.MinSize
 	detectedAWSMachinePoolSpec.CapacityRebalance = existingASG.CapacityRebalance
+	if machinePoolScope.AWSMachinePool.Spec.HealthCheckType != nil {
+		detectedAWSMachinePoolSpec.HealthCheckType = ptr.To(existingASG.HealthCheckType)
+	}
 	{
 		mixedInstancesPolicy := machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy
 		// InstancesDistribution is optional, and the default values come from AWS, so
@@ -664,6 +702,87 @@ func diffASG(machinePoolScope *scope.MachinePoolScope, existingASG *expinfrav1.A
 	return cmp.Diff(machinePoolScope.AWSMachinePool.Spec, *detectedAWSMachinePoolSpec)
 }
 
+// validateHealthCheckType checks that an ASG switched to ELB health checks is attached to a load
+// balancer or target group, as there would otherwise be nothing to check the instances against.
+func validateHealthCheckType(machinePoolScope *scope.MachinePoolScope, existingASG *expinfrav1.AutoScalingGroup) error {
+	healthCheckType := machinePoolScope.AWSMachinePool.Spec.HealthCheckType
+	if healthCheckType == nil || *healthCheckType != expinfrav1.HealthCheckTypeELB || existingASG.HealthCheckType == expinfrav1.HealthCheckTypeELB {
+		return nil
+	}
+	if len(existingASG.LoadBalancerNames) == 0 && len(existingASG.TargetGroupARNs) == 0 {
+		return errors.Errorf("ASG %q is not attached to a load balancer or target group and can't use %s health checks", existingASG.Name, expinfrav1.HealthCheckTypeELB)
+	}
+	return nil
+}
+
+// infraClusterToMachinePoolMapFunc returns a handler.MapFunc that maps an AWSCluster or
+// AWSManagedControlPlane to the infrastructure machine pools of its cluster.
+func infraClusterToMachinePoolMapFunc(c client.Client, gvk schema.GroupVersionKind, log logger.Wrapper) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		if !o.GetDeletionTimestamp().IsZero() {
+			return nil
+		}
+
+		clusterKey, err := GetOwnerClusterKey(metav1.ObjectMeta{OwnerReferences: o.GetOwnerReferences()})
+		if err != nil {
+			log.Error(err, "couldn't get infrastructure cluster owner ObjectKey")
+			return nil
+		}
+		if clusterKey == nil {
+			return nil
+		}
+
+		machinePoolList := clusterv1.MachinePoolList{}
+		if err := c.List(
+			ctx, &machinePoolList, client.InNamespace(clusterKey.Namespace), client.MatchingLabels{clusterv1.ClusterNameLabel: clusterKey.Name},
+		); err != nil {
+			log.Error(err, "couldn't list pools for cluster")
+			return nil
+		}
+
+		mapFunc := machinePoolToInfrastructureMapFunc(gvk)
+
+		var results []ctrl.Request
+		for i := range machinePoolList.Items {
+			results = append(results, mapFunc(ctx, &machinePoolList.Items[i])...)
+		}
+
+		return results
+	}
+}
+
+// infraClusterAdditionalTagsChanged returns a predicate that is true when the additional tags
+// of an AWSCluster or AWSManagedControlPlane are changed.
+func infraClusterAdditionalTagsChanged() predicate.Funcs {
+	additionalTags := func(o client.Object) (infrav1.Tags, bool) {
+		switch infraCluster := o.(type) {
+		case *infrav1.AWSCluster:
+			return infraCluster.Spec.AdditionalTags, true
+		case *ekscontrolplanev1.AWSManagedControlPlane:
+			return infraCluster.Spec.AdditionalTags, true
+		default:
+			return nil, false
+		}
+	}
+
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldTags, ok := additionalTags(e.ObjectOld)
+			if !ok {
+				return false
+			}
+			newTags, ok := additionalTags(e.ObjectNew)
+			if !ok {
+				return false
+			}
+			return !cmp.Equal(oldTags, newTags)
+		},
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
 // getOwnerMachinePool returns the MachinePool object owning the current resource.
 func getOwnerMachinePool(ctx context.Context, c client.Client, obj metav1.ObjectMeta) (*clusterv1.MachinePool, error) {
 	for _, ref := range obj.OwnerReferences {
@@ -1789,6 +1789,48 @@ func TestDiffASG(t *testing.T) {
 			},
 			wantDifference: false,
 		},
+		{
+			name: "HealthCheckType != asg.HealthCheckType",
+			args: args{
+				machinePoolScope: &scope.MachinePoolScope{
+					MachinePool: &clusterv1.MachinePool{
+						Spec: clusterv1.MachinePoolSpec{
+							Replicas: ptr.To[int32](1),
+						},
+					},
+					AWSMachinePool: &expinfrav1.AWSMachinePool{
+						Spec: expinfrav1.AWSMachinePoolSpec{
+							HealthCheckType: ptr.To(expinfrav1.HealthCheckTypeELB),
+						},
+					},
+				},
+				existingASG: &expinfrav1.AutoScalingGroup{
+					DesiredCapacity: ptr.To[int32](1),
+					HealthCheckType: expinfrav1.HealthCheckTypeEC2,
+				},
+			},
+			wantDifference: true,
+		},
+		{
+			name: "HealthCheckType not set ignores asg.HealthCheckType",
+			args: args{
+				machinePoolScope: &scope.MachinePoolScope{
+					MachinePool: &clusterv1.MachinePool{
+						Spec: clusterv1.MachinePoolSpec{
+							Replicas: ptr.To[int32](1),
+						},
+					},
+					AWSMachinePool: &expinfrav1.AWSMachinePool{
+						Spec: expinfrav1.AWSMachinePoolSpec{},
+					},
+				},
+				existingASG: &expinfrav1.AutoScalingGroup{
+					DesiredCapacity: ptr.To[int32](1),
+					HealthCheckType: expinfrav1.HealthCheckTypeELB,
+				},
+			},
+			wantDifference: false,
+		},
 		{
 			name: "externally managed annotation ignores difference between desiredCapacity and replicas",
 			args: args{
@@ -1844,3 +1886,73 @@ func TestDiffASG(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateHealthCheckType(t *testing.T) {
+	tests := []struct {
+		name            string
+		healthCheckType *expinfrav1.HealthCheckType
+		existingASG     *expinfrav1.AutoScalingGroup
+		wantErr         bool
+	}{
+		{
+			name:            "health check type not set",
+			healthCheckType: nil,
+			existingASG:     &expinfrav1.AutoScalingGroup{HealthCheckType: expinfrav1.HealthCheckTypeELB},
+			wantErr:         false,
+		},
+		{
+			name:            "switching to EC2",
+			healthCheckType: ptr.To(expinfrav1.HealthCheckTypeEC2),
+			existingASG:     &expinfrav1.AutoScalingGroup{HealthCheckType: expinfrav1.HealthCheckTypeELB},
+			wantErr:         false,
+		},
+		{
+			name:            "switching to ELB without a load balancer",
+			healthCheckType: ptr.To(expinfrav1.HealthCheckTypeELB),
+			existingASG:     &expinfrav1.AutoScalingGroup{HealthCheckType: expinfrav1.HealthCheckTypeEC2},
+			wantErr:         true,
+		},
+		{
+			name:            "switching to ELB with a target group",
+			healthCheckType: ptr.To(expinfrav1.HealthCheckTypeELB),
+			existingASG: &expinfrav1.AutoScalingGroup{
+				HealthCheckType: expinfrav1.HealthCheckTypeEC2,
+				TargetGroupARNs: []string{"arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/nodes/0123456789abcdef"},
+			},
+			wantErr: false,
+		},
+		{
+			name:            "switching to ELB with a classic load balancer",
+			healthCheckType: ptr.To(expinfrav1.HealthCheckTypeELB),
+			existingASG: &expinfrav1.AutoScalingGroup{
+				HealthCheckType:   expinfrav1.HealthCheckTypeEC2,
+				LoadBalancerNames: []string{"nodes"},
+			},
+			wantErr: false,
+		},
+		{
+			name:            "already using ELB",
+			healthCheckType: ptr.To(expinfrav1.HealthCheckTypeELB),
+			existingASG:     &expinfrav1.AutoScalingGroup{HealthCheckType: expinfrav1.HealthCheckTypeELB},
+			wantErr:         false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			machinePoolScope := &scope.MachinePoolScope{
+				AWSMachinePool: &expinfrav1.AWSMachinePool{
+					Spec: expinfrav1.AWSMachinePoolSpec{
+						HealthCheckType: tt.healthCheckType,
+					},
+				},
+			}
+			err := validateHealthCheckType(machinePoolScope, tt.existingASG)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -36,13 +36,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks"
+	ekspkg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/utils"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/util/paused"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -51,6 +55,26 @@ import (
 	"sigs.k8s.io/cluster-api/util/predicates"
 )
 
+// nodegroupUpdatingRequeueAfter is how long to wait before checking again to see if an
+// update of the EKS nodegroup has completed.
+const nodegroupUpdatingRequeueAfter = 30 * time.Second
+
+// nodegroupUnknownStatusRequeueAfter is how long to wait before checking again to see if
+// the EKS nodegroup has left a status the controller doesn't know.
+const nodegroupUnknownStatusRequeueAfter = time.Minute
+
+// nodegroupChangesDeferredRequeueAfter is how long to wait before checking again to see if
+// the maintenance window of the pool has opened to apply the deferred changes.
+const nodegroupChangesDeferredRequeueAfter = 5 * time.Minute
+
+// nodegroupScaleDownBlockedRequeueAfter is how long to wait before checking again to see if
+// the PodDisruptionBudgets of the workload cluster allow the deferred scale-down.
+const nodegroupScaleDownBlockedRequeueAfter = time.Minute
+
+// nodegroupClusterNotFoundRequeueAfter is how long to wait before trying again to create an
+// EKS nodegroup whose cluster wasn't found.
+const nodegroupClusterNotFoundRequeueAfter = time.Minute
+
 // AWSManagedMachinePoolReconciler reconciles a AWSManagedMachinePool object.
 type AWSManagedMachinePoolReconciler struct {
 	client.Client
@@ -60,6 +84,14 @@ type AWSManagedMachinePoolReconciler struct {
 	WatchFilterValue             string
 	TagUnmanagedNetworkResources bool
 	MaxWaitActiveUpdateDelete    time.Duration
+	MaxWaitNodegroupCreateUpdate time.Duration
+	MaxWaitNodegroupDelete       time.Duration
+	ReconcileJitter              float64
+	RequiredNodeRolePolicies     []string
+	WaitForNodegroupUpdates      bool
+	PriceTable                   *ekspkg.PriceTable
+
+	nodegroupServiceFactory func(*scope.ManagedMachinePoolScope) *eks.NodegroupService
 }
 
 // SetupWithManager is used to setup the controller.
@@ -106,8 +138,19 @@ func (r *AWSManagedMachinePoolReconciler) Reconcile(ctx context.Context, req ctr
 
 	machinePool, err := getOwnerMachinePool(ctx, r.Client, awsPool.ObjectMeta)
 	if err != nil {
-		log.Error(err, "Failed to retrieve owner MachinePool from the API Server")
-		return ctrl.Result{}, err
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "Failed to retrieve owner MachinePool from the API Server")
+			return ctrl.Result{}, err
+		}
+		if awsPool.ObjectMeta.DeletionTimestamp.IsZero() {
+			// The garbage collector deletes the pool once its owner is gone.
+			log.Info("Owner MachinePool not found, waiting for the AWSManagedMachinePool to be deleted")
+			return ctrl.Result{}, nil
+		}
+		// The MachinePool was removed before the pool, tear down the nodegroup anyway
+		// so that it isn't left behind.
+		log.Info("Owner MachinePool not found, deleting the nodegroup of the AWSManagedMachinePool without it")
+		machinePool = deletedOwnerMachinePool(awsPool)
 	}
 	if machinePool == nil {
 		log.Info("MachinePool Controller has not yet set OwnerRef")
@@ -150,7 +193,9 @@ func (r *AWSManagedMachinePoolReconciler) Reconcile(ctx context.Context, req ctr
 		return ctrl.Result{}, errors.New("error getting managed control plane scope")
 	}
 
-	if !controlPlane.Status.Ready {
+	// Deleting the nodegroup doesn't need a ready control plane, which may never become
+	// ready again while the cluster is being deleted.
+	if !controlPlane.Status.Ready && awsPool.ObjectMeta.DeletionTimestamp.IsZero() {
 		log.Info("Control plane is not ready yet")
 		v1beta1conditions.MarkFalse(awsPool, expinfrav1.EKSNodegroupReadyCondition, expinfrav1.WaitingForEKSControlPlaneReason, clusterv1beta1.ConditionSeverityInfo, "")
 		return ctrl.Result{}, nil
@@ -166,8 +211,13 @@ func (r *AWSManagedMachinePoolReconciler) Reconcile(ctx context.Context, req ctr
 		ManagedMachinePool:        awsPool,
 		EnableIAM:                 r.EnableIAM,
 		AllowAdditionalRoles:      r.AllowAdditionalRoles,
+		RequiredRolePolicies:      r.RequiredNodeRolePolicies,
+		WaitForNodegroupUpdates:   r.WaitForNodegroupUpdates,
+		PriceTable:                r.PriceTable,
 		InfraCluster:              managedControlPlaneScope,
 		MaxWaitActiveUpdateDelete: r.MaxWaitActiveUpdateDelete,
+		MaxWaitCreateUpdate:       r.MaxWaitNodegroupCreateUpdate,
+		MaxWaitDelete:             r.MaxWaitNodegroupDelete,
 	})
 	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "failed to create scope")
@@ -208,7 +258,7 @@ func (r *AWSManagedMachinePoolReconciler) reconcileNormal(
 		}
 	}
 
-	ekssvc := eks.NewNodegroupService(machinePoolScope)
+	ekssvc := r.getNodegroupService(machinePoolScope)
 	ec2svc := r.getEC2Service(ec2Scope)
 	reconSvc := r.getReconcileService(ec2Scope)
 
@@ -231,6 +281,7 @@ func (r *AWSManagedMachinePoolReconciler) reconcileNormal(
 			return ctrl.Result{}, err
 		}
 		if res != nil {
+			res.RequeueAfter = utils.Jitter(res.RequeueAfter, r.ReconcileJitter)
 			return *res, nil
 		}
 
@@ -248,12 +299,35 @@ func (r *AWSManagedMachinePoolReconciler) reconcileNormal(
 	}
 
 	if err := ekssvc.ReconcilePool(ctx); err != nil {
+		if requeueAfter, ok := nodegroupRequeueAfter(err); ok {
+			machinePoolScope.Info("Waiting for the EKS nodegroup", "reason", err.Error())
+			return ctrl.Result{RequeueAfter: utils.Jitter(requeueAfter, r.ReconcileJitter)}, nil
+		}
 		return ctrl.Result{}, errors.Wrapf(err, "failed to reconcile machine pool for AWSManagedMachinePool %s/%s", machinePoolScope.ManagedMachinePool.Namespace, machinePoolScope.ManagedMachinePool.Name)
 	}
 
 	return ctrl.Result{}, nil
 }
 
+// nodegroupRequeueAfter returns how long to wait before reconciling again when err only
+// means that the EKS nodegroup isn't in a status allowing to reconcile it yet.
+func nodegroupRequeueAfter(err error) (time.Duration, bool) {
+	switch {
+	case errors.Is(err, eks.ErrNodegroupUpdating):
+		return nodegroupUpdatingRequeueAfter, true
+	case errors.Is(err, eks.ErrNodegroupStatusUnknown):
+		return nodegroupUnknownStatusRequeueAfter, true
+	case errors.Is(err, eks.ErrNodegroupChangesDeferred):
+		return nodegroupChangesDeferredRequeueAfter, true
+	case errors.Is(err, eks.ErrNodegroupScaleDownBlocked):
+		return nodegroupScaleDownBlockedRequeueAfter, true
+	case errors.Is(err, eks.ErrNodegroupClusterNotFound):
+		return nodegroupClusterNotFoundRequeueAfter, true
+	default:
+		return 0, false
+	}
+}
+
 func (r *AWSManagedMachinePoolReconciler) reconcileDelete(
 	ctx context.Context,
 	machinePoolScope *scope.ManagedMachinePoolScope,
@@ -261,7 +335,14 @@ func (r *AWSManagedMachinePoolReconciler) reconcileDelete(
 ) error {
 	machinePoolScope.Info("Reconciling deletion of AWSManagedMachinePool")
 
-	ekssvc := eks.NewNodegroupService(machinePoolScope)
+	if annotations.IsTrue(machinePoolScope.ManagedMachinePool, infrav1.OrphanOnDeleteAnnotation) {
+		machinePoolScope.Info("Orphaning AWS resources of AWSManagedMachinePool", "annotation", infrav1.OrphanOnDeleteAnnotation)
+		r.Recorder.Eventf(machinePoolScope.ManagedMachinePool, corev1.EventTypeNormal, "OrphanedResources", "Left EKS nodegroup %s and its launch template intact on deletion", machinePoolScope.NodegroupName())
+		controllerutil.RemoveFinalizer(machinePoolScope.ManagedMachinePool, expinfrav1.ManagedMachinePoolFinalizer)
+		return nil
+	}
+
+	ekssvc := r.getNodegroupService(machinePoolScope)
 	ec2Svc := ec2.NewService(ec2Scope)
 
 	if err := ekssvc.ReconcilePoolDelete(ctx); err != nil {
@@ -356,6 +437,30 @@ func managedControlPlaneToManagedMachinePoolMapFunc(c client.Client, gvk schema.
 	}
 }
 
+// deletedOwnerMachinePool stands in for the owner MachinePool of a pool being deleted after
+// its MachinePool, which the deletion of the nodegroup doesn't need.
+func deletedOwnerMachinePool(awsPool *expinfrav1.AWSManagedMachinePool) *clusterv1.MachinePool {
+	machinePool := &clusterv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: awsPool.Namespace,
+			Labels:    awsPool.Labels,
+		},
+	}
+	for _, ref := range awsPool.OwnerReferences {
+		if ref.Kind == "MachinePool" {
+			machinePool.Name = ref.Name
+		}
+	}
+	return machinePool
+}
+
+func (r *AWSManagedMachinePoolReconciler) getNodegroupService(scope *scope.ManagedMachinePoolScope) *eks.NodegroupService {
+	if r.nodegroupServiceFactory != nil {
+		return r.nodegroupServiceFactory(scope)
+	}
+	return eks.NewNodegroupService(scope)
+}
+
 func (r *AWSManagedMachinePoolReconciler) getEC2Service(scope scope.EC2Scope) services.EC2Interface {
 	return ec2.NewService(scope)
 }
@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestAWSManagedMachinePoolReconcileDeleteOrphan(t *testing.T) {
+	g := NewWithT(t)
+
+	// The scope has no AWS clients configured, so any attempt to delete AWS
+	// resources would fail the test.
+	machinePoolScope := &scope.ManagedMachinePoolScope{
+		Logger:       *logger.NewLogger(klog.Background()),
+		ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{},
+		ManagedMachinePool: &expinfrav1.AWSManagedMachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "pool",
+				Namespace:   "default",
+				Annotations: map[string]string{infrav1.OrphanOnDeleteAnnotation: "true"},
+				Finalizers:  []string{expinfrav1.ManagedMachinePoolFinalizer},
+			},
+			Spec: expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName:  "nodegroup",
+				AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{Name: "template"},
+			},
+		},
+	}
+
+	r := &AWSManagedMachinePoolReconciler{Recorder: record.NewFakeRecorder(1)}
+	g.Expect(r.reconcileDelete(context.TODO(), machinePoolScope, nil)).To(Succeed())
+	g.Expect(machinePoolScope.ManagedMachinePool.Finalizers).To(BeEmpty())
+}
+
+func TestAWSManagedMachinePoolReconcileDeletesNodegroup(t *testing.T) {
+	testCases := []struct {
+		name string
+		// machinePool returns the owner MachinePool to create, if any.
+		machinePool func() *clusterv1.MachinePool
+	}{
+		{
+			name: "machine pool deleted first",
+			machinePool: func() *clusterv1.MachinePool {
+				return &clusterv1.MachinePool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "pool",
+						Namespace:         "default",
+						Labels:            map[string]string{clusterv1.ClusterNameLabel: "cluster"},
+						DeletionTimestamp: &metav1.Time{Time: time.Now()},
+						Finalizers:        []string{clusterv1.MachinePoolFinalizer},
+					},
+				}
+			},
+		},
+		{
+			name: "machine pool still there",
+			machinePool: func() *clusterv1.MachinePool {
+				return &clusterv1.MachinePool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pool",
+						Namespace: "default",
+						Labels:    map[string]string{clusterv1.ClusterNameLabel: "cluster"},
+					},
+				}
+			},
+		},
+		{
+			name:        "machine pool already gone",
+			machinePool: func() *clusterv1.MachinePool { return nil },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scheme := runtime.NewScheme()
+			_ = clusterv1.AddToScheme(scheme)
+			_ = expinfrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"},
+				Spec: clusterv1.ClusterSpec{
+					ControlPlaneRef: clusterv1.ContractVersionedObjectReference{
+						APIGroup: ekscontrolplanev1.GroupVersion.Group,
+						Kind:     "AWSManagedControlPlane",
+						Name:     "cp",
+					},
+				},
+			}
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "cp", Namespace: "default"},
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName: "eks-cluster",
+					Region:         "us-east-1",
+				},
+			}
+			awsPool := &expinfrav1.AWSManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pool",
+					Namespace: "default",
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: "cluster"},
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "MachinePool",
+						Name:       "pool",
+					}},
+					DeletionTimestamp: &metav1.Time{Time: time.Now()},
+					Finalizers:        []string{expinfrav1.ManagedMachinePoolFinalizer},
+				},
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{EKSNodegroupName: "nodegroup"},
+			}
+			objects := []client.Object{cluster, controlPlane, awsPool}
+			if machinePool := tc.machinePool(); machinePool != nil {
+				objects = append(objects, machinePool)
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).WithStatusSubresource(awsPool).Build()
+
+			mockCtrl := gomock.NewController(t)
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			eksMock.EXPECT().DescribeNodegroup(gomock.Any(), gomock.Any()).Return(&awseks.DescribeNodegroupOutput{
+				Nodegroup: &ekstypes.Nodegroup{NodegroupName: aws.String("nodegroup"), Status: ekstypes.NodegroupStatusActive},
+			}, nil)
+			eksMock.EXPECT().DeleteNodegroup(gomock.Any(), gomock.Any()).Return(&awseks.DeleteNodegroupOutput{}, nil)
+			eksMock.EXPECT().WaitUntilNodegroupDeleted(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+			r := &AWSManagedMachinePoolReconciler{
+				Client:   c,
+				Recorder: record.NewFakeRecorder(10),
+				nodegroupServiceFactory: func(machinePoolScope *scope.ManagedMachinePoolScope) *eks.NodegroupService {
+					svc := eks.NewNodegroupService(machinePoolScope)
+					svc.EKSClient = eksMock
+					return svc
+				},
+			}
+			// The first reconcile only reports the pool isn't paused.
+			for range 2 {
+				_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(awsPool)})
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+
+			// The finalizer is removed once the nodegroup is deleted, which deletes the pool.
+			err := c.Get(context.TODO(), client.ObjectKeyFromObject(awsPool), &expinfrav1.AWSManagedMachinePool{})
+			g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	}
+}
+
+func TestAWSManagedMachinePoolReconcileWithoutMachinePool(t *testing.T) {
+	g := NewWithT(t)
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+	_ = expinfrav1.AddToScheme(scheme)
+
+	// The MachinePool is gone but the garbage collector hasn't deleted the pool yet.
+	awsPool := &expinfrav1.AWSManagedMachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pool",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "MachinePool",
+				Name:       "pool",
+			}},
+			Finalizers: []string{expinfrav1.ManagedMachinePoolFinalizer},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsPool).Build()
+
+	r := &AWSManagedMachinePoolReconciler{Client: c, Recorder: record.NewFakeRecorder(1)}
+	res, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(awsPool)})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.IsZero()).To(BeTrue())
+
+	pool := &expinfrav1.AWSManagedMachinePool{}
+	g.Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(awsPool), pool)).To(Succeed())
+	g.Expect(pool.Finalizers).To(ConsistOf(expinfrav1.ManagedMachinePoolFinalizer))
+}
+
+func TestNodegroupRequeueAfter(t *testing.T) {
+	g := NewWithT(t)
+
+	requeueAfter, ok := nodegroupRequeueAfter(errors.Wrap(eks.ErrNodegroupStatusUnknown, "failed to set status"))
+	g.Expect(ok).To(BeTrue())
+	g.Expect(requeueAfter).To(Equal(nodegroupUnknownStatusRequeueAfter))
+
+	requeueAfter, ok = nodegroupRequeueAfter(eks.ErrNodegroupUpdating)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(requeueAfter).To(Equal(nodegroupUpdatingRequeueAfter))
+
+	requeueAfter, ok = nodegroupRequeueAfter(errors.Wrap(eks.ErrNodegroupChangesDeferred, "update to version 1.31 deferred"))
+	g.Expect(ok).To(BeTrue())
+	g.Expect(requeueAfter).To(Equal(nodegroupChangesDeferredRequeueAfter))
+
+	requeueAfter, ok = nodegroupRequeueAfter(errors.Wrap(eks.ErrNodegroupScaleDownBlocked, "scale down from 3 to 2 nodes deferred"))
+	g.Expect(ok).To(BeTrue())
+	g.Expect(requeueAfter).To(Equal(nodegroupScaleDownBlockedRequeueAfter))
+
+	requeueAfter, ok = nodegroupRequeueAfter(errors.Wrap(eks.ErrNodegroupClusterNotFound, "EKS cluster eks-cluster not found"))
+	g.Expect(ok).To(BeTrue())
+	g.Expect(requeueAfter).To(Equal(nodegroupClusterNotFoundRequeueAfter))
+
+	_, ok = nodegroupRequeueAfter(errors.New("failed to describe nodegroup"))
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestAWSFargateProfileReconcileDeleteOrphan(t *testing.T) {
+	g := NewWithT(t)
+
+	fargateProfileScope := &scope.FargateProfileScope{
+		Logger:       *logger.NewLogger(klog.Background()),
+		ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{},
+		FargateProfile: &expinfrav1.AWSFargateProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "profile",
+				Namespace:   "default",
+				Annotations: map[string]string{infrav1.OrphanOnDeleteAnnotation: "true"},
+				Finalizers:  []string{expinfrav1.FargateProfileFinalizer},
+			},
+			Spec: expinfrav1.FargateProfileSpec{
+				ProfileName: "profile",
+			},
+		},
+	}
+
+	r := &AWSFargateProfileReconciler{Recorder: record.NewFakeRecorder(1)}
+	res, err := r.reconcileDelete(context.TODO(), fargateProfileScope)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.IsZero()).To(BeTrue())
+	g.Expect(fargateProfileScope.FargateProfile.Finalizers).To(BeEmpty())
+}
@@ -19,11 +19,15 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -129,10 +133,17 @@ func (w *AWSFargateProfile) ValidateUpdate(_ context.Context, oldObj, newObj run
 		}
 	}
 
+	if len(old.Spec.SubnetIDs) > 0 && len(r.Spec.SubnetIDs) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "subnetIDs"), "subnetIDs cannot be removed once set"))
+	}
+
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
-	// remove additionalTags from equal check since they are mutable
+	// remove additionalTags and subnetIDs from equal check since they are mutable,
+	// changing the subnets recreates the profile
 	old.Spec.AdditionalTags = nil
 	r.Spec.AdditionalTags = nil
+	old.Spec.SubnetIDs = nil
+	r.Spec.SubnetIDs = nil
 
 	if !cmp.Equal(old.Spec, r.Spec) {
 		allErrs = append(
@@ -161,6 +172,8 @@ func (w *AWSFargateProfile) ValidateCreate(_ context.Context, obj runtime.Object
 
 	var allErrs field.ErrorList
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, validatePodExecutionRoleARN(&r.Spec)...)
+	allErrs = append(allErrs, validateFargateSelectors(r.Spec.Selectors)...)
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -171,6 +184,79 @@ func (w *AWSFargateProfile) ValidateCreate(_ context.Context, obj runtime.Object
 	)
 }
 
+// validatePodExecutionRoleARN checks the pod execution role ARN is the ARN of an IAM role, and
+// isn't set along with the fields of the role managed by the controller.
+func validatePodExecutionRoleARN(spec *expinfrav1.FargateProfileSpec) field.ErrorList {
+	if spec.PodExecutionRoleARN == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	roleARN, err := arn.Parse(*spec.PodExecutionRoleARN)
+	if err != nil || roleARN.Service != "iam" || !strings.HasPrefix(roleARN.Resource, "role/") {
+		allErrs = append(allErrs,
+			field.Invalid(specPath.Child("podExecutionRoleARN"), *spec.PodExecutionRoleARN, "must be the ARN of an IAM role, such as arn:aws:iam::123456789012:role/fargate"),
+		)
+	}
+	if spec.RoleName != "" {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("roleName"), "cannot be set along with podExecutionRoleARN"))
+	}
+	if spec.RolePath != "" {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("rolePath"), "cannot be set along with podExecutionRoleARN"))
+	}
+	if spec.RolePermissionsBoundary != "" {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("rolePermissionsBoundary"), "cannot be set along with podExecutionRoleARN"))
+	}
+	return allErrs
+}
+
+// validateFargateSelectors ensures there are no more selectors than EKS allows, and that each
+// selector has a valid namespace and valid labels, as CreateFargateProfile would only fail on
+// them later. The errors name the index of the offending selector.
+func validateFargateSelectors(selectors []expinfrav1.FargateSelector) field.ErrorList {
+	var allErrs field.ErrorList
+
+	selectorsPath := field.NewPath("spec", "selectors")
+	if len(selectors) > expinfrav1.MaxFargateProfileSelectors {
+		allErrs = append(allErrs, field.TooMany(selectorsPath, len(selectors), expinfrav1.MaxFargateProfileSelectors))
+	}
+
+	for i, selector := range selectors {
+		selectorPath := selectorsPath.Index(i)
+		if selector.Namespace == "" {
+			allErrs = append(allErrs, field.Required(selectorPath.Child("namespace"), "namespace is required"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(withoutWildcards(selector.Namespace)) {
+				allErrs = append(allErrs, field.Invalid(selectorPath.Child("namespace"), selector.Namespace, msg))
+			}
+		}
+
+		keys := make([]string, 0, len(selector.Labels))
+		for k := range selector.Labels {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			labelPath := selectorPath.Child("labels").Key(k)
+			for _, msg := range validation.IsQualifiedName(withoutWildcards(k)) {
+				allErrs = append(allErrs, field.Invalid(labelPath, k, msg))
+			}
+			for _, msg := range validation.IsValidLabelValue(withoutWildcards(selector.Labels[k])) {
+				allErrs = append(allErrs, field.Invalid(labelPath, selector.Labels[k], msg))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// withoutWildcards replaces the * and ? wildcards EKS supports in the namespaces and labels
+// of selectors with a letter, so that what they match is validated.
+func withoutWildcards(s string) string {
+	return strings.NewReplacer("*", "x", "?", "x").Replace(s)
+}
+
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
 func (w *AWSFargateProfile) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
 	return nil, nil
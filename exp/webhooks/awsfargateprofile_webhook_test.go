@@ -21,6 +21,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -81,6 +82,12 @@ func TestAWSFargateProfileValidateRoleNameUpdate(t *testing.T) {
 	beforeWithDifferentRoleName := before.DeepCopy()
 	beforeWithDifferentRoleName.Spec.RoleName = "different-role-name"
 
+	beforeWithSubnets := before.DeepCopy()
+	beforeWithSubnets.Spec.SubnetIDs = []string{"subnet-1"}
+
+	subnetsUpdate := before.DeepCopy()
+	subnetsUpdate.Spec.SubnetIDs = []string{"subnet-2"}
+
 	tests := []struct {
 		name           string
 		expectErr      bool
@@ -117,6 +124,18 @@ func TestAWSFargateProfileValidateRoleNameUpdate(t *testing.T) {
 			before:         before,
 			fargateProfile: invalidTagsUpdate,
 		},
+		{
+			name:           "update subnetIDs should succeed",
+			expectErr:      false,
+			before:         beforeWithSubnets,
+			fargateProfile: subnetsUpdate,
+		},
+		{
+			name:           "removing subnetIDs should fail",
+			expectErr:      true,
+			before:         beforeWithSubnets,
+			fargateProfile: before,
+		},
 	}
 
 	for _, tt := range tests {
@@ -180,6 +199,47 @@ func TestAWSFargateProfileValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "pod execution role ARN is accepted",
+			profile: &expinfrav1.AWSFargateProfile{
+				Spec: expinfrav1.FargateProfileSpec{
+					ClusterName:         "cluster-1",
+					PodExecutionRoleARN: aws.String("arn:aws:iam::123456789012:role/team/fargate"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "pod execution role ARN of another resource is rejected",
+			profile: &expinfrav1.AWSFargateProfile{
+				Spec: expinfrav1.FargateProfileSpec{
+					ClusterName:         "cluster-1",
+					PodExecutionRoleARN: aws.String("arn:aws:iam::123456789012:policy/fargate"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "malformed pod execution role ARN is rejected",
+			profile: &expinfrav1.AWSFargateProfile{
+				Spec: expinfrav1.FargateProfileSpec{
+					ClusterName:         "cluster-1",
+					PodExecutionRoleARN: aws.String("fargate"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "pod execution role ARN along with a role name is rejected",
+			profile: &expinfrav1.AWSFargateProfile{
+				Spec: expinfrav1.FargateProfileSpec{
+					ClusterName:         "cluster-1",
+					RoleName:            "fargate",
+					PodExecutionRoleARN: aws.String("arn:aws:iam::123456789012:role/fargate"),
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -194,3 +254,98 @@ func TestAWSFargateProfileValidateCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSFargateProfileValidateCreateSelectors(t *testing.T) {
+	tests := []struct {
+		name         string
+		selectors    []expinfrav1.FargateSelector
+		expectedErrs []string
+	}{
+		{
+			name: "valid selectors are accepted",
+			selectors: []expinfrav1.FargateSelector{
+				{Namespace: "default"},
+				{Namespace: "kube-system", Labels: map[string]string{"app.kubernetes.io/name": "coredns", "tier": ""}},
+			},
+		},
+		{
+			name: "selectors with wildcards are accepted",
+			selectors: []expinfrav1.FargateSelector{
+				{Namespace: "*"},
+				{Namespace: "prod-*", Labels: map[string]string{"app.kubernetes.io/*": "web-?", "tier": "*"}},
+			},
+		},
+		{
+			name: "selector with wildcards that can't match is rejected",
+			selectors: []expinfrav1.FargateSelector{
+				{Namespace: "team_*"},
+			},
+			expectedErrs: []string{`spec.selectors[0].namespace: Invalid value: "team_*"`},
+		},
+		{
+			name: "selector without namespace is rejected",
+			selectors: []expinfrav1.FargateSelector{
+				{Namespace: "default"},
+				{Labels: map[string]string{"app": "web"}},
+			},
+			expectedErrs: []string{"spec.selectors[1].namespace: Required value"},
+		},
+		{
+			name: "namespace that isn't a DNS-1123 label is rejected",
+			selectors: []expinfrav1.FargateSelector{
+				{Namespace: "Team_A"},
+			},
+			expectedErrs: []string{`spec.selectors[0].namespace: Invalid value: "Team_A"`},
+		},
+		{
+			name: "malformed labels are rejected",
+			selectors: []expinfrav1.FargateSelector{
+				{Namespace: "default", Labels: map[string]string{"app/web/v1": "web", "tier": "front end"}},
+			},
+			expectedErrs: []string{
+				`spec.selectors[0].labels[app/web/v1]: Invalid value: "app/web/v1"`,
+				`spec.selectors[0].labels[tier]: Invalid value: "front end"`,
+			},
+		},
+		{
+			name: "more selectors than EKS allows are rejected",
+			selectors: []expinfrav1.FargateSelector{
+				{Namespace: "ns-1"}, {Namespace: "ns-2"}, {Namespace: "ns-3"}, {Namespace: "ns-4"}, {Namespace: "ns-5"}, {Namespace: "ns-6"},
+			},
+			expectedErrs: []string{"spec.selectors: Too many: 6: must have at most 5 items"},
+		},
+		{
+			name: "each invalid selector is listed",
+			selectors: []expinfrav1.FargateSelector{
+				{},
+				{Namespace: "default"},
+				{Namespace: "-invalid"},
+			},
+			expectedErrs: []string{
+				"spec.selectors[0].namespace: Required value",
+				`spec.selectors[2].namespace: Invalid value: "-invalid"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			profile := &expinfrav1.AWSFargateProfile{
+				Spec: expinfrav1.FargateProfileSpec{
+					ClusterName: "cluster-1",
+					Selectors:   tt.selectors,
+				},
+			}
+
+			_, err := (&AWSFargateProfile{}).ValidateCreate(context.Background(), profile)
+			if len(tt.expectedErrs) == 0 {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(HaveOccurred())
+			for _, expected := range tt.expectedErrs {
+				g.Expect(err.Error()).To(ContainSubstring(expected))
+			}
+		})
+	}
+}
@@ -71,19 +71,7 @@ func (w *AWSMachinePool) validateRootVolume(r *expinfrav1.AWSMachinePool) field.
 		return allErrs
 	}
 
-	if infrav1.VolumeTypesProvisioned.Has(string(r.Spec.AWSLaunchTemplate.RootVolume.Type)) && r.Spec.AWSLaunchTemplate.RootVolume.IOPS == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("spec.awsLaunchTemplate.rootVolume.iops"), "iops required if type is 'io1' or 'io2'"))
-	}
-
-	if r.Spec.AWSLaunchTemplate.RootVolume.Throughput != nil {
-		if r.Spec.AWSLaunchTemplate.RootVolume.Type != infrav1.VolumeTypeGP3 {
-			allErrs = append(allErrs, field.Required(field.NewPath("spec.awsLaunchTemplate.rootVolume.throughput"), "throughput is valid only for type 'gp3'"))
-		}
-		// See https://aws.amazon.com/ebs/general-purpose/ for gp3 limits
-		if *r.Spec.AWSLaunchTemplate.RootVolume.Throughput < 125 || *r.Spec.AWSLaunchTemplate.RootVolume.Throughput > 2000 {
-			allErrs = append(allErrs, field.Required(field.NewPath("spec.awsLaunchTemplate.rootVolume.throughput"), "throughput must be between 125 Mib/s and 2000 MiB/s"))
-		}
-	}
+	allErrs = append(allErrs, validateVolumePerformance(field.NewPath("spec", "awsLaunchTemplate", "rootVolume"), r.Spec.AWSLaunchTemplate.RootVolume)...)
 
 	if r.Spec.AWSLaunchTemplate.RootVolume.DeviceName != "" {
 		log.Info("root volume shouldn't have a device name (this can be ignored if performing a `clusterctl move`)")
@@ -95,19 +83,8 @@ func (w *AWSMachinePool) validateRootVolume(r *expinfrav1.AWSMachinePool) field.
 func (w *AWSMachinePool) validateNonRootVolumes(r *expinfrav1.AWSMachinePool) field.ErrorList {
 	var allErrs field.ErrorList
 
-	for _, volume := range r.Spec.AWSLaunchTemplate.NonRootVolumes {
-		if infrav1.VolumeTypesProvisioned.Has(string(volume.Type)) && volume.IOPS == 0 {
-			allErrs = append(allErrs, field.Required(field.NewPath("spec.template.spec.nonRootVolumes.iops"), "iops required if type is 'io1' or 'io2'"))
-		}
-
-		if volume.Throughput != nil {
-			if volume.Type != infrav1.VolumeTypeGP3 {
-				allErrs = append(allErrs, field.Required(field.NewPath("spec.template.spec.nonRootVolumes.throughput"), "throughput is valid only for type 'gp3'"))
-			}
-			if *volume.Throughput < 0 {
-				allErrs = append(allErrs, field.Required(field.NewPath("spec.template.spec.nonRootVolumes.throughput"), "throughput must be nonnegative"))
-			}
-		}
+	for i, volume := range r.Spec.AWSLaunchTemplate.NonRootVolumes {
+		allErrs = append(allErrs, validateVolumePerformance(field.NewPath("spec", "awsLaunchTemplate", "nonRootVolumes").Index(i), &r.Spec.AWSLaunchTemplate.NonRootVolumes[i])...)
 
 		if volume.DeviceName == "" {
 			allErrs = append(allErrs, field.Required(field.NewPath("spec.template.spec.nonRootVolumes.deviceName"), "non root volume should have device name"))
@@ -159,6 +136,10 @@ func (w *AWSMachinePool) validateRefreshPreferences(r *expinfrav1.AWSMachinePool
 		return allErrs
 	}
 
+	if r.Spec.RefreshPreferences.ForceUpdateEnabled && r.Spec.RefreshPreferences.Disable {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.refreshPreferences.forceUpdateEnabled"), "spec.refreshPreferences.forceUpdateEnabled cannot be used when instance refresh is disabled"))
+	}
+
 	if r.Spec.RefreshPreferences.MaxHealthyPercentage != nil && r.Spec.RefreshPreferences.MinHealthyPercentage == nil {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.refreshPreferences.maxHealthyPercentage"), "If you specify spec.refreshPreferences.maxHealthyPercentage, you must also specify spec.refreshPreferences.minHealthyPercentage"))
 	}
@@ -142,6 +142,37 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErrToContain: nil,
 		},
+		{
+			name: "Should fail if throughput is set for a non gp3 root volume",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{
+						RootVolume: &infrav1.Volume{
+							Type:       "gp2",
+							Size:       *aws.Int64(8),
+							Throughput: aws.Int64(250),
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.awsLaunchTemplate.rootVolume.throughput"),
+		},
+		{
+			name: "Should fail if a non root gp3 volume has a throughput out of range",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					AWSLaunchTemplate: expinfrav1.AWSLaunchTemplate{
+						NonRootVolumes: []infrav1.Volume{{
+							DeviceName: "/dev/sdb",
+							Type:       "gp3",
+							Size:       *aws.Int64(8),
+							Throughput: aws.Int64(100),
+						}},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.awsLaunchTemplate.nonRootVolumes[0].throughput"),
+		},
 		{
 			name: "Should fail if both spot market options or mixed instances policy are set",
 			pool: &expinfrav1.AWSMachinePool{
@@ -165,6 +196,26 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErrToContain: ptr.To[string]("minHealthyPercentage"),
 		},
+		{
+			name: "Should fail if ForceUpdateEnabled is set while instance refresh is disabled",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					RefreshPreferences: &expinfrav1.RefreshPreferences{
+						Disable:            true,
+						ForceUpdateEnabled: true,
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("forceUpdateEnabled"),
+		},
+		{
+			name: "Should pass if ForceUpdateEnabled is set",
+			pool: &expinfrav1.AWSMachinePool{
+				Spec: expinfrav1.AWSMachinePoolSpec{
+					RefreshPreferences: &expinfrav1.RefreshPreferences{ForceUpdateEnabled: true},
+				},
+			},
+		},
 		{
 			name: "Should fail if the difference between MaxHealthyPercentage and MinHealthyPercentage is greater than 100",
 			pool: &expinfrav1.AWSMachinePool{
@@ -20,6 +20,9 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -32,6 +35,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
 )
@@ -127,7 +131,21 @@ func (w *AWSManagedMachinePool) validateRemoteAccess(r *expinfrav1.AWSManagedMac
 
 func (w *AWSManagedMachinePool) validateLaunchTemplate(r *expinfrav1.AWSManagedMachinePool) field.ErrorList {
 	var allErrs field.ErrorList
+	if r.Spec.ExternalLaunchTemplate != nil {
+		if r.Spec.AWSLaunchTemplate != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "externalLaunchTemplate"), r.Spec.ExternalLaunchTemplate.ID, "externalLaunchTemplate cannot be specified when AWSLaunchTemplate is specified"))
+		}
+		if r.Spec.InstanceType != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "InstanceType"), r.Spec.InstanceType, "InstanceType cannot be specified when externalLaunchTemplate is specified"))
+		}
+		if r.Spec.DiskSize != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "DiskSize"), r.Spec.DiskSize, "DiskSize cannot be specified when externalLaunchTemplate is specified"))
+		}
+	}
 	if r.Spec.AWSLaunchTemplate == nil {
+		if r.Spec.LaunchTemplateVersion != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "launchTemplateVersion"), "launchTemplateVersion can only be set when awsLaunchTemplate is specified"))
+		}
 		return allErrs
 	}
 
@@ -142,13 +160,285 @@ func (w *AWSManagedMachinePool) validateLaunchTemplate(r *expinfrav1.AWSManagedM
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "AWSLaunchTemplate", "IamInstanceProfile"), r.Spec.AWSLaunchTemplate.IamInstanceProfile, "IAM instance profile in launch template is prohibited in EKS managed node group"))
 	}
 
+	// A volume that sets its own encryption doesn't inherit the control plane's
+	// default, so its settings have to be consistent on their own.
+	if v := r.Spec.AWSLaunchTemplate.RootVolume; v != nil {
+		rootVolumePath := field.NewPath("spec", "awsLaunchTemplate", "rootVolume")
+		allErrs = append(allErrs, validateVolumeEncryption(rootVolumePath, v)...)
+		allErrs = append(allErrs, validateVolumePerformance(rootVolumePath, v)...)
+	}
+	for i := range r.Spec.AWSLaunchTemplate.NonRootVolumes {
+		volumePath := field.NewPath("spec", "awsLaunchTemplate", "nonRootVolumes").Index(i)
+		allErrs = append(allErrs, validateVolumeEncryption(volumePath, &r.Spec.AWSLaunchTemplate.NonRootVolumes[i])...)
+		allErrs = append(allErrs, validateVolumePerformance(volumePath, &r.Spec.AWSLaunchTemplate.NonRootVolumes[i])...)
+	}
+
+	// Spot options in the launch template only make sense for pools launching spot capacity,
+	// an on-demand pool would otherwise end up with a launch template asking for spot instances.
+	if r.Spec.CapacityType == nil || *r.Spec.CapacityType != expinfrav1.ManagedMachinePoolCapacityTypeSpot {
+		if r.Spec.AWSLaunchTemplate.SpotMarketOptions != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "awsLaunchTemplate", "spotMarketOptions"), "spotMarketOptions can only be set when capacityType is spot"))
+		}
+		if r.Spec.AWSLaunchTemplate.MarketType == infrav1.MarketTypeSpot {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "awsLaunchTemplate", "marketType"), "marketType Spot can only be set when capacityType is spot"))
+		}
+	}
+
+	// A targeted capacity reservation holds on-demand capacity, the nodes have to launch into it.
+	if r.Spec.AWSLaunchTemplate.CapacityReservationID != nil {
+		switch r.Spec.AWSLaunchTemplate.CapacityReservationPreference {
+		case "", infrav1.CapacityReservationPreferenceOnly:
+		default:
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "awsLaunchTemplate", "capacityReservationPreference"), "when capacityReservationId is specified, capacityReservationPreference may only be `CapacityReservationsOnly` or empty"))
+		}
+		if r.Spec.CapacityType != nil && *r.Spec.CapacityType == expinfrav1.ManagedMachinePoolCapacityTypeSpot {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "awsLaunchTemplate", "capacityReservationId"), "capacityReservationId can't be set when capacityType is spot"))
+		}
+	}
+
+	return allErrs
+}
+
+func (w *AWSManagedMachinePool) validateSpotAllocationStrategy(r *expinfrav1.AWSManagedMachinePool) field.ErrorList {
+	if r.Spec.CapacityType != nil && *r.Spec.CapacityType == expinfrav1.ManagedMachinePoolCapacityTypeSpot {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	if r.Spec.SpotAllocationStrategy != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "spotAllocationStrategy"), "spotAllocationStrategy can only be set when capacityType is spot"))
+	}
+	if r.Spec.OnDemandBaseCapacity != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "onDemandBaseCapacity"), "onDemandBaseCapacity can only be set when capacityType is spot"))
+	}
+	return allErrs
+}
+
+// validateInstanceTypes checks that instance types are set only once, either with the
+// deprecated InstanceType or with InstanceTypes, and that they don't conflict with the
+// instance type of the launch template.
+func (w *AWSManagedMachinePool) validateInstanceTypes(r *expinfrav1.AWSManagedMachinePool) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(r.Spec.InstanceTypes) == 0 {
+		return allErrs
+	}
+	instanceTypesPath := field.NewPath("spec", "instanceTypes")
+
+	if r.Spec.InstanceType != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "instanceType"), r.Spec.InstanceType, "instanceType cannot be specified when instanceTypes is specified"))
+	}
+	if r.Spec.AWSLaunchTemplate != nil && r.Spec.AWSLaunchTemplate.InstanceType != "" {
+		allErrs = append(allErrs, field.Invalid(instanceTypesPath, r.Spec.InstanceTypes, "instanceTypes cannot be specified when awsLaunchTemplate.instanceType is specified"))
+	}
+
+	seen := make(map[string]struct{}, len(r.Spec.InstanceTypes))
+	for i, instanceType := range r.Spec.InstanceTypes {
+		if instanceType == "" {
+			allErrs = append(allErrs, field.Required(instanceTypesPath.Index(i), "instance type cannot be empty"))
+			continue
+		}
+		if _, ok := seen[instanceType]; ok {
+			allErrs = append(allErrs, field.Duplicate(instanceTypesPath.Index(i), instanceType))
+			continue
+		}
+		seen[instanceType] = struct{}{}
+	}
+
+	return allErrs
+}
+
+// validateInstanceTypeFallback checks that the fallback instance types can be applied through
+// the launch template, as EKS can't change the instance types of an existing node group.
+func (w *AWSManagedMachinePool) validateInstanceTypeFallback(r *expinfrav1.AWSManagedMachinePool) field.ErrorList {
+	var allErrs field.ErrorList
+	fallback := r.Spec.InstanceTypeFallback
+	if fallback == nil {
+		return allErrs
+	}
+	fallbackPath := field.NewPath("spec", "instanceTypeFallback")
+
+	if r.Spec.AWSLaunchTemplate == nil || r.Spec.AWSLaunchTemplate.InstanceType == "" {
+		allErrs = append(allErrs, field.Forbidden(fallbackPath, "instanceTypeFallback can only be set when awsLaunchTemplate.instanceType is specified"))
+		return allErrs
+	}
+	if fallback.Cooldown != nil && fallback.Cooldown.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fallbackPath.Child("cooldown"), fallback.Cooldown.Duration.String(), "cooldown must be positive"))
+	}
+
+	instanceTypesPath := fallbackPath.Child("instanceTypes")
+	seen := map[string]struct{}{r.Spec.AWSLaunchTemplate.InstanceType: {}}
+	for i, instanceType := range fallback.InstanceTypes {
+		if instanceType == "" {
+			allErrs = append(allErrs, field.Required(instanceTypesPath.Index(i), "instance type cannot be empty"))
+			continue
+		}
+		if _, ok := seen[instanceType]; ok {
+			allErrs = append(allErrs, field.Duplicate(instanceTypesPath.Index(i), instanceType))
+			continue
+		}
+		seen[instanceType] = struct{}{}
+	}
+
+	return allErrs
+}
+
+// instanceTypesWarnings recommends diversifying the instance types of spot node groups
+// that set a single instance type.
+func (w *AWSManagedMachinePool) instanceTypesWarnings(r *expinfrav1.AWSManagedMachinePool) admission.Warnings {
+	if r.Spec.CapacityType == nil || *r.Spec.CapacityType != expinfrav1.ManagedMachinePoolCapacityTypeSpot {
+		return nil
+	}
+	singleInstanceType := len(r.Spec.InstanceTypes) == 1 || (len(r.Spec.InstanceTypes) == 0 && r.Spec.InstanceType != nil)
+	if !singleInstanceType {
+		return nil
+	}
+	return admission.Warnings{"spec.instanceTypes: specifying at least two instance types is recommended for spot capacity, as it makes it more likely that spot capacity is available"}
+}
+
+// validateAMIType checks that the AMI type is supported and can be used with the launch template of the pool.
+// A custom AMI has to be provided by a launch template. The launch template generated from
+// AWSLaunchTemplate looks up EKS optimized Linux AMIs, so Windows nodes have to set the AMI ID
+// and Bottlerocket nodes, which the EKS bootstrap provider doesn't support, need an external
+// launch template.
+func (w *AWSManagedMachinePool) validateAMIType(r *expinfrav1.AWSManagedMachinePool) field.ErrorList {
+	if r.Spec.AMIType == nil {
+		return nil
+	}
+
+	switch amiType := *r.Spec.AMIType; {
+	case !slices.Contains(expinfrav1.ManagedMachineAMITypes, amiType):
+		supported := make([]string, 0, len(expinfrav1.ManagedMachineAMITypes))
+		for _, t := range expinfrav1.ManagedMachineAMITypes {
+			supported = append(supported, string(t))
+		}
+		return field.ErrorList{field.NotSupported(field.NewPath("spec", "amiType"), amiType, supported)}
+	case amiType == expinfrav1.Custom:
+		if r.Spec.AWSLaunchTemplate == nil && r.Spec.ExternalLaunchTemplate == nil {
+			return field.ErrorList{field.Invalid(field.NewPath("spec", "amiType"), amiType, "a launch template providing the AMI is required when amiType is CUSTOM, set awsLaunchTemplate or externalLaunchTemplate")}
+		}
+	case amiType.IsWindows():
+		if r.Spec.AWSLaunchTemplate != nil && r.Spec.AWSLaunchTemplate.AMI.ID == nil {
+			return field.ErrorList{field.Required(field.NewPath("spec", "awsLaunchTemplate", "ami", "id"), fmt.Sprintf("a Windows AMI ID is required when awsLaunchTemplate is used with amiType %s", amiType))}
+		}
+	case strings.HasPrefix(string(amiType), "BOTTLEROCKET_"):
+		if r.Spec.AWSLaunchTemplate != nil {
+			return field.ErrorList{field.Forbidden(field.NewPath("spec", "awsLaunchTemplate"), fmt.Sprintf("awsLaunchTemplate can't bootstrap amiType %s, use externalLaunchTemplate instead", amiType))}
+		}
+	}
+	return nil
+}
+
+// validateWindowsInstanceTypes checks that Windows nodegroups only use x86-64 instance types,
+// as there are no Windows AMIs for Arm instances.
+func (w *AWSManagedMachinePool) validateWindowsInstanceTypes(r *expinfrav1.AWSManagedMachinePool) field.ErrorList {
+	if r.Spec.AMIType == nil || !r.Spec.AMIType.IsWindows() {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	if r.Spec.InstanceType != nil && isArmInstanceType(*r.Spec.InstanceType) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "instanceType"), *r.Spec.InstanceType, "Arm instance types can't be used with Windows AMI types"))
+	}
+	for i, instanceType := range r.Spec.InstanceTypes {
+		if isArmInstanceType(instanceType) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "instanceTypes").Index(i), instanceType, "Arm instance types can't be used with Windows AMI types"))
+		}
+	}
+	if r.Spec.AWSLaunchTemplate != nil && isArmInstanceType(r.Spec.AWSLaunchTemplate.InstanceType) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "awsLaunchTemplate", "instanceType"), r.Spec.AWSLaunchTemplate.InstanceType, "Arm instance types can't be used with Windows AMI types"))
+	}
 	return allErrs
 }
 
+// armInstanceFamily matches the families of the AWS Graviton instance types, which have a "g"
+// processor suffix after the generation, e.g. m6g, c7gn or im4gn.
+var armInstanceFamily = regexp.MustCompile(`^[a-z]+[0-9]+g[a-z]*$`)
+
+// isArmInstanceType returns true if the instance type runs on Arm processors.
+func isArmInstanceType(instanceType string) bool {
+	family, _, _ := strings.Cut(instanceType, ".")
+	return family == "a1" || armInstanceFamily.MatchString(family)
+}
+
 func (w *AWSManagedMachinePool) validateLifecycleHooks(r *expinfrav1.AWSManagedMachinePool) field.ErrorList {
 	return validateLifecycleHooks(r.Spec.AWSLifecycleHooks)
 }
 
+func (w *AWSManagedMachinePool) validateASGHealthCheckConfig(r *expinfrav1.AWSManagedMachinePool) field.ErrorList {
+	var allErrs field.ErrorList
+	if d := r.Spec.HealthCheckGracePeriod; d != nil && d.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "healthCheckGracePeriod"), d.Duration.String(), "must be greater or equal zero"))
+	}
+	if d := r.Spec.DefaultInstanceWarmup; d != nil && d.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "defaultInstanceWarmup"), d.Duration.String(), "must be greater or equal zero"))
+	}
+	return allErrs
+}
+
+// validateSuspendProcesses checks that the processes to suspend are Auto Scaling processes
+// and are listed once.
+func (w *AWSManagedMachinePool) validateSuspendProcesses(r *expinfrav1.AWSManagedMachinePool) field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.SuspendProcesses == nil {
+		return allErrs
+	}
+	processesPath := field.NewPath("spec", "suspendProcesses")
+
+	supported := make([]string, 0, len(expinfrav1.ScalingProcesses))
+	for _, p := range expinfrav1.ScalingProcesses {
+		supported = append(supported, string(p))
+	}
+	seen := map[expinfrav1.ScalingProcess]bool{}
+	for i, p := range *r.Spec.SuspendProcesses {
+		switch {
+		case !slices.Contains(expinfrav1.ScalingProcesses, p):
+			allErrs = append(allErrs, field.NotSupported(processesPath.Index(i), p, supported))
+		case seen[p]:
+			allErrs = append(allErrs, field.Duplicate(processesPath.Index(i), p))
+		}
+		seen[p] = true
+	}
+	return allErrs
+}
+
+// validateMaintenanceWindow checks that the maintenance window annotation can be parsed, so that
+// a typo doesn't block the reconciliation of the nodegroup.
+func (w *AWSManagedMachinePool) validateMaintenanceWindow(r *expinfrav1.AWSManagedMachinePool) field.ErrorList {
+	value, ok := r.GetAnnotations()[expinfrav1.MaintenanceWindowAnnotation]
+	if !ok {
+		return nil
+	}
+	if _, err := eks.ParseMaintenanceWindow(value); err != nil {
+		return field.ErrorList{field.Invalid(field.NewPath("metadata", "annotations").Key(expinfrav1.MaintenanceWindowAnnotation), value, err.Error())}
+	}
+	return nil
+}
+
+func (w *AWSManagedMachinePool) validateClusterSecurityGroupOverride(r *expinfrav1.AWSManagedMachinePool) field.ErrorList {
+	var allErrs field.ErrorList
+	ref := r.Spec.ClusterSecurityGroupOverride
+	if ref == nil {
+		return allErrs
+	}
+	overridePath := field.NewPath("spec", "clusterSecurityGroupOverride")
+
+	switch {
+	case ref.ID != nil && len(ref.Filters) > 0:
+		allErrs = append(allErrs, field.Invalid(overridePath, ref, "only one of id or filters may be specified"))
+	case ref.ID == nil && len(ref.Filters) == 0:
+		allErrs = append(allErrs, field.Required(overridePath, "one of id or filters must be specified"))
+	}
+
+	return allErrs
+}
+
+func (w *AWSManagedMachinePool) validateLabelsAndTaints(r *expinfrav1.AWSManagedMachinePool) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateLabels(field.NewPath("spec", "labels"), r.Spec.Labels)...)
+	allErrs = append(allErrs, validateTaints(field.NewPath("spec", "taints"), r.Spec.Taints)...)
+	return allErrs
+}
+
 // ValidateCreate will do any extra validation when creating a AWSManagedMachinePool.
 func (w *AWSManagedMachinePool) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
 	r, ok := obj.(*expinfrav1.AWSManagedMachinePool)
@@ -175,17 +465,48 @@ func (w *AWSManagedMachinePool) ValidateCreate(_ context.Context, obj runtime.Ob
 	if errs := w.validateLaunchTemplate(r); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
+	if errs := w.validateAMIType(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateInstanceTypes(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateInstanceTypeFallback(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateSpotAllocationStrategy(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateWindowsInstanceTypes(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
 	if errs := w.validateLifecycleHooks(r); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
+	if errs := w.validateLabelsAndTaints(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateASGHealthCheckConfig(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateSuspendProcesses(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateMaintenanceWindow(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateClusterSecurityGroupOverride(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
 
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 
+	warnings := w.instanceTypesWarnings(r)
 	if len(allErrs) == 0 {
-		return nil, nil
+		return warnings, nil
 	}
 
-	return nil, apierrors.NewInvalid(
+	return warnings, apierrors.NewInvalid(
 		r.GroupVersionKind().GroupKind(),
 		r.Name,
 		allErrs,
@@ -220,15 +541,46 @@ func (w *AWSManagedMachinePool) ValidateUpdate(_ context.Context, oldObj, newObj
 	if errs := w.validateLaunchTemplate(r); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
+	if errs := w.validateAMIType(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateInstanceTypes(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateInstanceTypeFallback(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateSpotAllocationStrategy(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateWindowsInstanceTypes(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
 	if errs := w.validateLifecycleHooks(r); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
+	if errs := w.validateLabelsAndTaints(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateASGHealthCheckConfig(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateSuspendProcesses(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateMaintenanceWindow(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateClusterSecurityGroupOverride(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
 
+	warnings := w.instanceTypesWarnings(r)
 	if len(allErrs) == 0 {
-		return nil, nil
+		return warnings, nil
 	}
 
-	return nil, apierrors.NewInvalid(
+	return warnings, apierrors.NewInvalid(
 		r.GroupVersionKind().GroupKind(),
 		r.Name,
 		allErrs,
@@ -268,6 +620,10 @@ func (w *AWSManagedMachinePool) validateImmutable(r *expinfrav1.AWSManagedMachin
 	appendErrorIfMutated(old.Spec.DiskSize, r.Spec.DiskSize, "diskSize")
 	appendErrorIfMutated(old.Spec.AMIType, r.Spec.AMIType, "amiType")
 	appendErrorIfMutated(old.Spec.RemoteAccess, r.Spec.RemoteAccess, "remoteAccess")
+	if r.Spec.RemoteAccess != nil {
+		// The override is part of the remote access configuration, which EKS doesn't allow to change.
+		appendErrorIfMutated(old.Spec.ClusterSecurityGroupOverride, r.Spec.ClusterSecurityGroupOverride, "clusterSecurityGroupOverride")
+	}
 	appendErrorIfSetAndMutated(old.Spec.CapacityType, r.Spec.CapacityType, "capacityType")
 	appendErrorIfMutated(old.Spec.AvailabilityZones, r.Spec.AvailabilityZones, "availabilityZones")
 	appendErrorIfMutated(old.Spec.AvailabilityZoneSubnetType, r.Spec.AvailabilityZoneSubnetType, "availabilityZoneSubnetType")
@@ -281,6 +637,15 @@ func (w *AWSManagedMachinePool) validateImmutable(r *expinfrav1.AWSManagedMachin
 	if old.Spec.AWSLaunchTemplate != nil && r.Spec.AWSLaunchTemplate != nil {
 		appendErrorIfMutated(old.Spec.AWSLaunchTemplate.Name, r.Spec.AWSLaunchTemplate.Name, "awsLaunchTemplate.name")
 	}
+	if (old.Spec.ExternalLaunchTemplate == nil) != (r.Spec.ExternalLaunchTemplate == nil) {
+		allErrs = append(
+			allErrs,
+			field.Invalid(field.NewPath("spec", "externalLaunchTemplate"), r.Spec.ExternalLaunchTemplate, "field is immutable"),
+		)
+	}
+	if old.Spec.ExternalLaunchTemplate != nil && r.Spec.ExternalLaunchTemplate != nil {
+		appendErrorIfMutated(old.Spec.ExternalLaunchTemplate.ID, r.Spec.ExternalLaunchTemplate.ID, "externalLaunchTemplate.id")
+	}
 
 	return allErrs
 }
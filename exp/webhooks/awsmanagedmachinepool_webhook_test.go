@@ -20,6 +20,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	. "github.com/onsi/gomega"
@@ -28,6 +29,7 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/helpers"
 	utildefaulting "sigs.k8s.io/cluster-api-provider-aws/v2/util/defaulting"
 )
 
@@ -56,106 +58,970 @@ func TestAWSManagedMachinePoolValidateCreate(t *testing.T) {
 	g := NewWithT(t)
 
 	tests := []struct {
-		name    string
-		pool    *expinfrav1.AWSManagedMachinePool
-		wantErr bool
+		name        string
+		pool        *expinfrav1.AWSManagedMachinePool
+		wantErr     bool
+		wantWarning bool
 	}{
 		{
-			name: "pool requires a EKS Node group name",
+			name: "pool requires a EKS Node group name",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "",
+				},
+			},
+
+			wantErr: true,
+		},
+		{
+			name: "pool with valid EKS Node group name",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+				},
+			},
+
+			wantErr: false,
+		},
+		{
+			name: "pool with valid tags is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-2",
+					AdditionalTags: infrav1.Tags{
+						"key-1": "value-1",
+						"key-2": "value-2",
+					},
+				},
+			},
+
+			wantErr: false,
+		},
+		{
+			name: "invalid tags are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AdditionalTags: infrav1.Tags{
+						"key-1":                    "value-1",
+						"":                         "value-2",
+						strings.Repeat("CAPI", 33): "value-3",
+						"key-4":                    strings.Repeat("CAPI", 65),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid update config",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					UpdateConfig: &expinfrav1.UpdateConfig{
+						MaxUnavailable: aws.Int(1),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "update config with no values",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					UpdateConfig:     &expinfrav1.UpdateConfig{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "update config with both values",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					UpdateConfig: &expinfrav1.UpdateConfig{
+						MaxUnavailable:           aws.Int(1),
+						MaxUnavailablePercentage: aws.Int(10),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "minSize 0 is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Scaling: &expinfrav1.ManagedMachinePoolScaling{
+						MinSize: ptr.To[int32](0),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "distinct labels are accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Labels: map[string]string{
+						"env":  "dev",
+						"team": "platform",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "labels differing only by case are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Labels: map[string]string{
+						"env": "dev",
+						"Env": "prod",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "labels differing only by whitespace are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Labels: map[string]string{
+						"env":  "dev",
+						" env": "prod",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "labels with a reserved prefix are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Labels: map[string]string{
+						"kubernetes.io/role": "worker",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "labels with a subdomain of a reserved prefix are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Labels: map[string]string{
+						"node-role.kubernetes.io/worker": "",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "labels with a k8s.io prefix are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Labels: map[string]string{
+						"k8s.io/cluster-autoscaler-enabled": "true",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "labels with other prefixes are accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Labels: map[string]string{
+						"example.com/team":           "platform",
+						"notkubernetes.io/team":      "platform",
+						"kubernetes.io.example/team": "platform",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "taints with same key and different effects are accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Taints: expinfrav1.Taints{
+						{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoSchedule},
+						{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoExecute},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "duplicate taints are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Taints: expinfrav1.Taints{
+						{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoSchedule},
+						{Key: "dedicated", Value: "gpu", Effect: expinfrav1.TaintEffectNoSchedule},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "more taints than EKS allows are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Taints:           helpers.Taints("taint", expinfrav1.MaxNodegroupTaints+1),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "health check grace period and instance warmup are accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:       "eks-node-group-3",
+					HealthCheckGracePeriod: &metav1.Duration{Duration: 5 * time.Minute},
+					DefaultInstanceWarmup:  &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative health check grace period is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:       "eks-node-group-3",
+					HealthCheckGracePeriod: &metav1.Duration{Duration: -time.Minute},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cluster security group override by id is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:             "eks-node-group-3",
+					ClusterSecurityGroupOverride: &infrav1.AWSResourceReference{ID: ptr.To("sg-1234")},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "cluster security group override with both id and filters is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					ClusterSecurityGroupOverride: &infrav1.AWSResourceReference{
+						ID:      ptr.To("sg-1234"),
+						Filters: []infrav1.Filter{{Name: "tag:role", Values: []string{"nodes"}}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty cluster security group override is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:             "eks-node-group-3",
+					ClusterSecurityGroupOverride: &infrav1.AWSResourceReference{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "external launch template is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:       "eks-node-group-3",
+					ExternalLaunchTemplate: &expinfrav1.ExternalLaunchTemplate{ID: "lt-0123456789abcdef0"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "external launch template with AWSLaunchTemplate is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:       "eks-node-group-3",
+					ExternalLaunchTemplate: &expinfrav1.ExternalLaunchTemplate{ID: "lt-0123456789abcdef0"},
+					AWSLaunchTemplate:      &expinfrav1.AWSLaunchTemplate{Name: "template"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "external launch template with instance type is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:       "eks-node-group-3",
+					ExternalLaunchTemplate: &expinfrav1.ExternalLaunchTemplate{ID: "lt-0123456789abcdef0"},
+					InstanceType:           ptr.To("m5.large"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "pinned launch template version is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:      "eks-node-group-3",
+					AWSLaunchTemplate:     &expinfrav1.AWSLaunchTemplate{Name: "template"},
+					LaunchTemplateVersion: ptr.To[int64](2),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "pinned launch template version without AWSLaunchTemplate is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:      "eks-node-group-3",
+					LaunchTemplateVersion: ptr.To[int64](2),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "launch template volume with a key and encryption disabled is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						RootVolume: &infrav1.Volume{
+							Size:          30,
+							Encrypted:     ptr.To(false),
+							EncryptionKey: "alias/nodes",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "launch template gp3 root volume with iops and throughput is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						RootVolume: &infrav1.Volume{
+							Size:       30,
+							Type:       infrav1.VolumeTypeGP3,
+							IOPS:       4000,
+							Throughput: ptr.To[int64](250),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "launch template gp2 root volume with throughput is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						RootVolume: &infrav1.Volume{
+							Size:       30,
+							Type:       infrav1.VolumeTypeGP2,
+							Throughput: ptr.To[int64](250),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "launch template gp2 root volume with iops is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						RootVolume: &infrav1.Volume{
+							Size: 30,
+							Type: infrav1.VolumeTypeGP2,
+							IOPS: 3000,
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "launch template io2 volume without iops is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						NonRootVolumes: []infrav1.Volume{
+							{DeviceName: "/dev/sdb", Size: 50, Type: infrav1.VolumeTypeIO2},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "launch template gp3 volume with throughput out of range is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						RootVolume: &infrav1.Volume{
+							Size:       30,
+							Type:       infrav1.VolumeTypeGP3,
+							Throughput: ptr.To[int64](100),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "launch template volume overriding the encryption is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						NonRootVolumes: []infrav1.Volume{
+							{DeviceName: "/dev/sdb", Size: 50, Encrypted: ptr.To(false)},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "spot market options with spot capacity are accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					CapacityType:     ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeSpot),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						SpotMarketOptions: &infrav1.SpotMarketOptions{MaxPrice: ptr.To("0.05")},
+						MarketType:        infrav1.MarketTypeSpot,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "on-demand capacity without spot options is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					CapacityType:     ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeOnDemand),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						MarketType: infrav1.MarketTypeOnDemand,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "spot market options with on-demand capacity are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					CapacityType:     ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeOnDemand),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						SpotMarketOptions: &infrav1.SpotMarketOptions{MaxPrice: ptr.To("0.05")},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "spot market options without a capacity type are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						SpotMarketOptions: &infrav1.SpotMarketOptions{},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "spot market type with on-demand capacity is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					CapacityType:     ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeOnDemand),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						MarketType: infrav1.MarketTypeSpot,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "taint with unsupported effect is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Taints: expinfrav1.Taints{
+						{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffect("NoSchedule")},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "multiple instance types are accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					InstanceTypes:    []string{"m5.large", "m5a.large"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "instance types with a launch template without instance type are accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "eks-node-group-3",
+					InstanceTypes:     []string{"m5.large", "m5a.large"},
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "instance types with a launch template instance type are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "eks-node-group-3",
+					InstanceTypes:     []string{"m5.large", "m5a.large"},
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{InstanceType: "m5.large"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "instance type and instance types are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					InstanceType:     ptr.To("m5.large"),
+					InstanceTypes:    []string{"m5.large", "m5a.large"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate instance types are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					InstanceTypes:    []string{"m5.large", "m5.large"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty instance type is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					InstanceTypes:    []string{"m5.large", ""},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "spot capacity with a single instance type warns",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					CapacityType:     ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeSpot),
+					InstanceTypes:    []string{"m5.large"},
+				},
+			},
+			wantErr:     false,
+			wantWarning: true,
+		},
+		{
+			name: "spot capacity with the deprecated instance type warns",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					CapacityType:     ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeSpot),
+					InstanceType:     ptr.To("m5.large"),
+				},
+			},
+			wantErr:     false,
+			wantWarning: true,
+		},
+		{
+			name: "spot capacity with multiple instance types doesn't warn",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					CapacityType:     ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeSpot),
+					InstanceTypes:    []string{"m5.large", "m5a.large"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "spot allocation strategy with spot capacity is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:       "eks-node-group-3",
+					CapacityType:           ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeSpot),
+					InstanceTypes:          []string{"m5.large", "m5a.large"},
+					SpotAllocationStrategy: ptr.To(expinfrav1.SpotAllocationStrategyPriceCapacityOptimized),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "on-demand base capacity with spot capacity is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:     "eks-node-group-3",
+					CapacityType:         ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeSpot),
+					InstanceTypes:        []string{"m5.large", "m5a.large"},
+					OnDemandBaseCapacity: ptr.To[int32](1),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "on-demand base capacity without spot capacity is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:     "eks-node-group-3",
+					OnDemandBaseCapacity: ptr.To[int32](1),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "spot allocation strategy without spot capacity is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:       "eks-node-group-3",
+					SpotAllocationStrategy: ptr.To(expinfrav1.SpotAllocationStrategyCapacityOptimized),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "instance type fallback with a launch template instance type is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "eks-node-group-3",
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{InstanceType: "m5.large"},
+					InstanceTypeFallback: &expinfrav1.InstanceTypeFallback{
+						InstanceTypes: []string{"m5a.large", "m6i.large"},
+						Cooldown:      &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "instance type fallback without a launch template instance type is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:     "eks-node-group-3",
+					InstanceTypes:        []string{"m5.large"},
+					InstanceTypeFallback: &expinfrav1.InstanceTypeFallback{InstanceTypes: []string{"m5a.large"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "instance type fallback repeating the launch template instance type is rejected",
 			pool: &expinfrav1.AWSManagedMachinePool{
 				Spec: expinfrav1.AWSManagedMachinePoolSpec{
-					EKSNodegroupName: "",
+					EKSNodegroupName:     "eks-node-group-3",
+					AWSLaunchTemplate:    &expinfrav1.AWSLaunchTemplate{InstanceType: "m5.large"},
+					InstanceTypeFallback: &expinfrav1.InstanceTypeFallback{InstanceTypes: []string{"m5a.large", "m5.large"}},
 				},
 			},
-
 			wantErr: true,
 		},
 		{
-			name: "pool with valid EKS Node group name",
+			name: "instance type fallback with a negative cooldown is rejected",
 			pool: &expinfrav1.AWSManagedMachinePool{
 				Spec: expinfrav1.AWSManagedMachinePoolSpec{
-					EKSNodegroupName: "eks-node-group-1",
+					EKSNodegroupName:  "eks-node-group-3",
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{InstanceType: "m5.large"},
+					InstanceTypeFallback: &expinfrav1.InstanceTypeFallback{
+						InstanceTypes: []string{"m5a.large"},
+						Cooldown:      &metav1.Duration{Duration: -time.Minute},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "custom AMI type without a launch template is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIType:          ptr.To(expinfrav1.Custom),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "custom AMI type with a launch template is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIType:          ptr.To(expinfrav1.Custom),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						AMI: infrav1.AMIReference{ID: ptr.To("ami-0123456789abcdef0")},
+					},
 				},
 			},
-
 			wantErr: false,
 		},
 		{
-			name: "pool with valid tags is accepted",
+			name: "custom AMI type with an external launch template is accepted",
 			pool: &expinfrav1.AWSManagedMachinePool{
 				Spec: expinfrav1.AWSManagedMachinePoolSpec{
-					EKSNodegroupName: "eks-node-group-2",
-					AdditionalTags: infrav1.Tags{
-						"key-1": "value-1",
-						"key-2": "value-2",
+					EKSNodegroupName:       "eks-node-group-3",
+					AMIType:                ptr.To(expinfrav1.Custom),
+					ExternalLaunchTemplate: &expinfrav1.ExternalLaunchTemplate{ID: "lt-0123456789abcdef0"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "windows AMI type with awsLaunchTemplate without an AMI ID is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "eks-node-group-3",
+					AMIType:           ptr.To(expinfrav1.WindowsCore2022x86_64),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "windows AMI type with awsLaunchTemplate and an AMI ID is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIType:          ptr.To(expinfrav1.WindowsCore2022x86_64),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						AMI:          infrav1.AMIReference{ID: ptr.To("ami-0123456789abcdef0")},
+						InstanceType: "m5.large",
 					},
 				},
 			},
-
 			wantErr: false,
 		},
 		{
-			name: "invalid tags are rejected",
+			name: "windows AMI type with x86-64 instance types is accepted",
 			pool: &expinfrav1.AWSManagedMachinePool{
 				Spec: expinfrav1.AWSManagedMachinePoolSpec{
 					EKSNodegroupName: "eks-node-group-3",
-					AdditionalTags: infrav1.Tags{
-						"key-1":                    "value-1",
-						"":                         "value-2",
-						strings.Repeat("CAPI", 33): "value-3",
-						"key-4":                    strings.Repeat("CAPI", 65),
+					AMIType:          ptr.To(expinfrav1.WindowsFull2022x86_64),
+					InstanceTypes:    []string{"m5.large", "g4dn.xlarge"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "windows AMI type with an Arm instance type is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIType:          ptr.To(expinfrav1.WindowsFull2022x86_64),
+					InstanceTypes:    []string{"m5.large", "m6g.large"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "windows AMI type with an Arm launch template instance type is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIType:          ptr.To(expinfrav1.WindowsCore2019x86_64),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						AMI:          infrav1.AMIReference{ID: ptr.To("ami-0123456789abcdef0")},
+						InstanceType: "c7gn.large",
 					},
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "valid update config",
+			name: "bottlerocket AMI type with awsLaunchTemplate is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "eks-node-group-3",
+					AMIType:           ptr.To(expinfrav1.BottleRocketx86_64),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "bottlerocket AMI type with an external launch template is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:       "eks-node-group-3",
+					AMIType:                ptr.To(expinfrav1.BottleRocketx86_64),
+					ExternalLaunchTemplate: &expinfrav1.ExternalLaunchTemplate{ID: "lt-0123456789abcdef0"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "linux AMI type with awsLaunchTemplate is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "eks-node-group-3",
+					AMIType:           ptr.To(expinfrav1.Al2023x86_64),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "bottlerocket nvidia AMI type is accepted",
 			pool: &expinfrav1.AWSManagedMachinePool{
 				Spec: expinfrav1.AWSManagedMachinePoolSpec{
 					EKSNodegroupName: "eks-node-group-3",
-					UpdateConfig: &expinfrav1.UpdateConfig{
-						MaxUnavailable: aws.Int(1),
+					AMIType:          ptr.To(expinfrav1.BottleRocketx86_64Nvidia),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "open capacity reservation preference is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						CapacityReservationPreference: infrav1.CapacityReservationPreferenceOpen,
 					},
 				},
 			},
 			wantErr: false,
 		},
 		{
-			name: "update config with no values",
+			name: "targeted capacity reservation is accepted",
 			pool: &expinfrav1.AWSManagedMachinePool{
 				Spec: expinfrav1.AWSManagedMachinePoolSpec{
 					EKSNodegroupName: "eks-node-group-3",
-					UpdateConfig:     &expinfrav1.UpdateConfig{},
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						CapacityReservationID:         ptr.To("cr-12345678901234567"),
+						CapacityReservationPreference: infrav1.CapacityReservationPreferenceOnly,
+					},
 				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
-			name: "update config with both values",
+			name: "targeted capacity reservation with an open preference is rejected",
 			pool: &expinfrav1.AWSManagedMachinePool{
 				Spec: expinfrav1.AWSManagedMachinePoolSpec{
 					EKSNodegroupName: "eks-node-group-3",
-					UpdateConfig: &expinfrav1.UpdateConfig{
-						MaxUnavailable:           aws.Int(1),
-						MaxUnavailablePercentage: aws.Int(10),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						CapacityReservationID:         ptr.To("cr-12345678901234567"),
+						CapacityReservationPreference: infrav1.CapacityReservationPreferenceOpen,
 					},
 				},
 			},
 			wantErr: true,
 		},
 		{
-			name: "minSize 0 is accepted",
+			name: "targeted capacity reservation with spot capacity is rejected",
 			pool: &expinfrav1.AWSManagedMachinePool{
 				Spec: expinfrav1.AWSManagedMachinePoolSpec{
 					EKSNodegroupName: "eks-node-group-3",
-					Scaling: &expinfrav1.ManagedMachinePoolScaling{
-						MinSize: ptr.To[int32](0),
+					CapacityType:     ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeSpot),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						CapacityReservationID: ptr.To("cr-12345678901234567"),
 					},
 				},
 			},
+			wantErr: true,
+		},
+		{
+			name: "unknown AMI type is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIType:          ptr.To(expinfrav1.ManagedMachineAMIType("BOTTLEROCKET_x86_64_UNKNOWN")),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "suspended processes are accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					SuspendProcesses: &[]expinfrav1.ScalingProcess{expinfrav1.ScalingProcessTerminate, expinfrav1.ScalingProcessReplaceUnhealthy},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown suspended process is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					SuspendProcesses: &[]expinfrav1.ScalingProcess{"Rebalance"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "maintenance window is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{expinfrav1.MaintenanceWindowAnnotation: "Sat,Sun 02:00-06:00"},
+				},
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+				},
+			},
 			wantErr: false,
 		},
+		{
+			name: "invalid maintenance window is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{expinfrav1.MaintenanceWindowAnnotation: "Saturday 02:00-06:00"},
+				},
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate suspended process is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					SuspendProcesses: &[]expinfrav1.ScalingProcess{expinfrav1.ScalingProcessTerminate, expinfrav1.ScalingProcessTerminate},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -165,8 +1031,11 @@ func TestAWSManagedMachinePoolValidateCreate(t *testing.T) {
 			} else {
 				g.Expect(err).To(Succeed())
 			}
-			// Nothing emits warnings yet
-			g.Expect(warn).To(BeEmpty())
+			if tt.wantWarning {
+				g.Expect(warn).NotTo(BeEmpty())
+			} else {
+				g.Expect(warn).To(BeEmpty())
+			}
 		})
 	}
 }
@@ -194,6 +1063,53 @@ func TestAWSManagedMachinePoolValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "changing the external launch template version is accepted",
+			old: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:       "eks-node-group-1",
+					ExternalLaunchTemplate: &expinfrav1.ExternalLaunchTemplate{ID: "lt-0123456789abcdef0"},
+				},
+			},
+			new: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:       "eks-node-group-1",
+					ExternalLaunchTemplate: &expinfrav1.ExternalLaunchTemplate{ID: "lt-0123456789abcdef0", Version: ptr.To("3")},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "changing the external launch template ID is rejected",
+			old: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:       "eks-node-group-1",
+					ExternalLaunchTemplate: &expinfrav1.ExternalLaunchTemplate{ID: "lt-0123456789abcdef0"},
+				},
+			},
+			new: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:       "eks-node-group-1",
+					ExternalLaunchTemplate: &expinfrav1.ExternalLaunchTemplate{ID: "lt-0fedcba9876543210"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "adding an external launch template is rejected",
+			old: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+				},
+			},
+			new: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:       "eks-node-group-1",
+					ExternalLaunchTemplate: &expinfrav1.ExternalLaunchTemplate{ID: "lt-0123456789abcdef0"},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "adding tags is accepted",
 			old: &expinfrav1.AWSManagedMachinePool{
@@ -708,3 +1624,112 @@ func TestAWSManagedMachinePoolValidateUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSManagedMachinePoolValidateTaints(t *testing.T) {
+	tests := []struct {
+		name    string
+		taints  expinfrav1.Taints
+		wantErr string
+	}{
+		{
+			name: "supported effects are accepted",
+			taints: expinfrav1.Taints{
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoSchedule},
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoExecute},
+				{Key: "gpu", Value: "true", Effect: expinfrav1.TaintEffectPreferNoSchedule},
+			},
+		},
+		{
+			name: "unsupported effect names the taint",
+			taints: expinfrav1.Taints{
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffect("NoSchedule")},
+			},
+			wantErr: `spec.taints[0]: Invalid value: "dedicated=infra:NoSchedule": unsupported taint effect "NoSchedule"`,
+		},
+		{
+			name: "duplicate key and effect names the taint",
+			taints: expinfrav1.Taints{
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoSchedule},
+				{Key: "gpu", Value: "true", Effect: expinfrav1.TaintEffectNoSchedule},
+				{Key: "dedicated", Value: "gpu", Effect: expinfrav1.TaintEffectNoSchedule},
+			},
+			wantErr: `spec.taints[2]: Invalid value: "dedicated=gpu:no-schedule": a taint with key "dedicated" and effect no-schedule is already set by spec.taints[0]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			pool := &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Taints:           tt.taints,
+				},
+			}
+			_, err := (&AWSManagedMachinePool{}).ValidateCreate(context.Background(), pool)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
+func TestAWSManagedMachinePoolValidateLabels(t *testing.T) {
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		wantErr string
+	}{
+		{
+			name: "labels without a reserved prefix are accepted",
+			labels: map[string]string{
+				"team":                        "platform",
+				"example.com/team":            "platform",
+				"eks.amazonaws.com.example/x": "y",
+			},
+		},
+		{
+			name: "eks.amazonaws.com prefix is rejected",
+			labels: map[string]string{
+				"eks.amazonaws.com/nodegroup": "workers",
+			},
+			wantErr: `spec.labels: Forbidden: labels eks.amazonaws.com/nodegroup use a prefix reserved by Kubernetes or EKS`,
+		},
+		{
+			name: "all reserved labels are listed",
+			labels: map[string]string{
+				"team":                           "platform",
+				"node-role.kubernetes.io/worker": "",
+				"k8s.io/cluster-autoscaler":      "true",
+				"eks.amazonaws.com/capacityType": "SPOT",
+			},
+			wantErr: `labels eks.amazonaws.com/capacityType, k8s.io/cluster-autoscaler, node-role.kubernetes.io/worker use a prefix reserved by Kubernetes or EKS (kubernetes.io, k8s.io, eks.amazonaws.com) and would be rejected by EKS, and the NodeRestriction admission plugin doesn't let the kubelet set them either`,
+		},
+		{
+			name: "labels the kubelet can set point to --node-labels",
+			labels: map[string]string{
+				"node.kubernetes.io/pool":        "workers",
+				"node-role.kubernetes.io/worker": "",
+			},
+			wantErr: `labels node.kubernetes.io/pool use a prefix reserved by Kubernetes or EKS (kubernetes.io, k8s.io, eks.amazonaws.com) and would be rejected by EKS, set them with the kubelet's --node-labels flag`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			pool := &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					Labels:           tt.labels,
+				},
+			}
+			_, err := (&AWSManagedMachinePool{}).ValidateCreate(context.Background(), pool)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
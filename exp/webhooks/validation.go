@@ -18,9 +18,13 @@ package webhooks
 
 import (
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 )
 
@@ -50,3 +54,146 @@ func validateLifecycleHooks(hooks []expinfrav1.AWSLifecycleHook) field.ErrorList
 
 	return allErrs
 }
+
+// reservedLabelDomains are the label prefixes reserved by Kubernetes and EKS, which EKS
+// rejects in nodegroup labels. Subdomains of them are reserved too.
+var reservedLabelDomains = []string{"kubernetes.io", "k8s.io", "eks.amazonaws.com"}
+
+// kubeletLabelDomains are the reserved label prefixes that the NodeRestriction admission plugin
+// still lets the kubelet set on its node, with its --node-labels flag.
+var kubeletLabelDomains = []string{"kubelet.kubernetes.io", "node.kubernetes.io"}
+
+// labelKeyInDomains returns true if the prefix of the label key is one of the domains or a
+// subdomain of them.
+func labelKeyInDomains(key string, domains []string) bool {
+	prefix, _, ok := strings.Cut(strings.TrimSpace(key), "/")
+	if !ok {
+		return false
+	}
+	prefix = strings.ToLower(prefix)
+	for _, domain := range domains {
+		if prefix == domain || strings.HasSuffix(prefix, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// isReservedLabelKey returns true if the prefix of the label key is reserved by Kubernetes or EKS.
+func isReservedLabelKey(key string) bool {
+	return labelKeyInDomains(key, reservedLabelDomains)
+}
+
+// validateLabels rejects label keys with a prefix reserved by Kubernetes or EKS, listing
+// all of them in a single error, and label keys that only differ by case or surrounding
+// whitespace, as they would be ambiguous once applied to the nodes.
+func validateLabels(labelsPath *field.Path, labels map[string]string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var kubeletLabels, nodeLabels []string
+	seen := make(map[string]string, len(keys))
+	for _, k := range keys {
+		if isReservedLabelKey(k) {
+			if labelKeyInDomains(k, kubeletLabelDomains) {
+				kubeletLabels = append(kubeletLabels, k)
+			} else {
+				nodeLabels = append(nodeLabels, k)
+			}
+			continue
+		}
+		normalized := strings.ToLower(strings.TrimSpace(k))
+		if existing, ok := seen[normalized]; ok {
+			allErrs = append(allErrs, field.Duplicate(labelsPath.Key(k), fmt.Sprintf("label key %q conflicts with %q", k, existing)))
+			continue
+		}
+		seen[normalized] = k
+	}
+
+	if len(kubeletLabels) > 0 {
+		allErrs = append(allErrs, field.Forbidden(labelsPath, fmt.Sprintf("labels %s use a prefix reserved by Kubernetes or EKS (%s) and would be rejected by EKS, set them with the kubelet's --node-labels flag in the bootstrap configuration instead", strings.Join(kubeletLabels, ", "), strings.Join(reservedLabelDomains, ", "))))
+	}
+	if len(nodeLabels) > 0 {
+		allErrs = append(allErrs, field.Forbidden(labelsPath, fmt.Sprintf("labels %s use a prefix reserved by Kubernetes or EKS (%s) and would be rejected by EKS, and the NodeRestriction admission plugin doesn't let the kubelet set them either; apply them to the Node objects once the nodes joined the cluster instead", strings.Join(nodeLabels, ", "), strings.Join(reservedLabelDomains, ", "))))
+	}
+
+	return allErrs
+}
+
+// taintString formats a taint the way kubectl does, as key=value:effect.
+func taintString(taint expinfrav1.Taint) string {
+	return fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect)
+}
+
+// validateTaints ensures there are no more taints than EKS allows, that each taint
+// has a supported effect and that no two taints share the same key and effect. The
+// errors name the offending taint, as CreateNodegroup would only fail on it later.
+func validateTaints(taintsPath *field.Path, taints expinfrav1.Taints) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(taints) > expinfrav1.MaxNodegroupTaints {
+		allErrs = append(allErrs, field.TooMany(taintsPath, len(taints), expinfrav1.MaxNodegroupTaints))
+	}
+
+	supported := []string{
+		string(expinfrav1.TaintEffectNoSchedule),
+		string(expinfrav1.TaintEffectNoExecute),
+		string(expinfrav1.TaintEffectPreferNoSchedule),
+	}
+	seen := make(map[string]int, len(taints))
+	for i, taint := range taints {
+		if !slices.Contains(supported, string(taint.Effect)) {
+			allErrs = append(allErrs, field.Invalid(taintsPath.Index(i), taintString(taint),
+				fmt.Sprintf("unsupported taint effect %q, supported values: %s", taint.Effect, strings.Join(supported, ", "))))
+			continue
+		}
+
+		id := taint.Key + ":" + string(taint.Effect)
+		if first, ok := seen[id]; ok {
+			allErrs = append(allErrs, field.Invalid(taintsPath.Index(i), taintString(taint),
+				fmt.Sprintf("a taint with key %q and effect %s is already set by %s", taint.Key, taint.Effect, taintsPath.Index(first))))
+			continue
+		}
+		seen[id] = i
+	}
+
+	return allErrs
+}
+
+// validateVolumeEncryption rejects a KMS key on a volume that is explicitly unencrypted.
+func validateVolumeEncryption(volumePath *field.Path, v *infrav1.Volume) field.ErrorList {
+	if v.Encrypted != nil && !*v.Encrypted && v.EncryptionKey != "" {
+		return field.ErrorList{field.Invalid(volumePath.Child("encryptionKey"), v.EncryptionKey, "encryptionKey cannot be set when encrypted is false")}
+	}
+	return nil
+}
+
+// validateVolumePerformance checks that the IOPS and throughput of a volume are only set
+// for the volume types supporting them. A volume without a type gets the EC2 default,
+// so it can't set either.
+func validateVolumePerformance(volumePath *field.Path, v *infrav1.Volume) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if infrav1.VolumeTypesProvisioned.Has(string(v.Type)) && v.IOPS == 0 {
+		allErrs = append(allErrs, field.Required(volumePath.Child("iops"), fmt.Sprintf("iops is required for volume type %s", v.Type)))
+	}
+	if v.IOPS != 0 && !infrav1.VolumeTypesProvisioned.Has(string(v.Type)) && v.Type != infrav1.VolumeTypeGP3 {
+		allErrs = append(allErrs, field.Invalid(volumePath.Child("iops"), v.IOPS, fmt.Sprintf("iops is only supported for volume types io1, io2 and gp3, not %q", v.Type)))
+	}
+
+	if v.Throughput != nil {
+		if v.Type != infrav1.VolumeTypeGP3 {
+			allErrs = append(allErrs, field.Invalid(volumePath.Child("throughput"), *v.Throughput, fmt.Sprintf("throughput is only supported for volume type gp3, not %q", v.Type)))
+		} else if *v.Throughput < 125 || *v.Throughput > 2000 {
+			// See https://aws.amazon.com/ebs/general-purpose/ for gp3 limits
+			allErrs = append(allErrs, field.Invalid(volumePath.Child("throughput"), *v.Throughput, "throughput must be between 125 MiB/s and 2000 MiB/s"))
+		}
+	}
+
+	return allErrs
+}
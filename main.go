@@ -64,9 +64,14 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/exp/instancestate"
 	expwebhooks "sigs.k8s.io/cluster-api-provider-aws/v2/exp/webhooks"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/endpoints"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/throttle"
+	ekspkg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	awscache "sigs.k8s.io/cluster-api-provider-aws/v2/util/cache"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/version"
 	capawebhooks "sigs.k8s.io/cluster-api-provider-aws/v2/webhooks"
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
@@ -109,12 +114,26 @@ var (
 	awsMachineConcurrency       int
 	waitInfraPeriod             time.Duration
 	maxWaitActiveUpdateDelete   time.Duration
+	maxWaitNodegroupCreate      time.Duration
+	maxWaitNodegroupDelete      time.Duration
+	fargateRequeueInterval      time.Duration
+	fargateMaxRequeueInterval   time.Duration
+	fargateCreateTimeout        time.Duration
 	syncPeriod                  time.Duration
+	describeCacheTTL            time.Duration
+	reconcileJitter             float64
 	webhookPort                 int
 	webhookCertDir              string
 	healthAddr                  string
 	serviceEndpoints            string
 	disabledControllers         []string
+	retryableAWSErrorCodes      []string
+	terminalAWSErrorCodes       []string
+	requiredNodeRolePolicies    []string
+	waitForNodegroupUpdates     bool
+	nodegroupCostEstimate       bool
+	nodegroupPriceTable         string
+	maxConcurrentAWSDeletes     int
 
 	// maxEKSSyncPeriod is the maximum allowed duration for the sync-period flag when using EKS. It is set to 10 minutes
 	// because during resync it will create a new AWS auth token which can a maximum life of 15 minutes and this ensures
@@ -148,6 +167,11 @@ func main() {
 	}
 	ctrl.SetLogger(klog.Background())
 
+	if nodegroupPriceTable != "" && !nodegroupCostEstimate {
+		setupLog.Error(errors.New("--eks-nodegroup-price-table requires --eks-nodegroup-cost-estimate"), "invalid node group cost estimate flags")
+		os.Exit(1)
+	}
+
 	tlsOptions, metricsOptions, err := flags.GetManagerOptions(managerOptions)
 	if err != nil {
 		setupLog.Error(err, "Unable to start manager: invalid flags")
@@ -239,6 +263,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	awserrors.DefaultRetryClassifier.SetRetryable(retryableAWSErrorCodes...)
+	awserrors.DefaultRetryClassifier.SetTerminal(terminalAWSErrorCodes...)
+	awscache.DefaultDescribeCache.SetTTL(describeCacheTTL)
+	throttle.DefaultDeleteLimiter.SetLimit(maxConcurrentAWSDeletes)
+
 	setupReconcilersAndWebhooks(ctx, mgr, externalResourceGC, alternativeGCStrategy)
 	if feature.Gates.Enabled(feature.EKS) {
 		setupEKSReconcilersAndWebhooks(ctx, mgr, externalResourceGC, alternativeGCStrategy, waitInfraPeriod)
@@ -482,6 +511,7 @@ func setupEKSReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager,
 		WaitInfraPeriod:              waitInfraPeriod,
 		MaxWaitActiveUpdateDelete:    maxWaitActiveUpdateDelete,
 		TagUnmanagedNetworkResources: feature.Gates.Enabled(feature.TagUnmanagedNetworkResources),
+		ReconcileJitter:              reconcileJitter,
 	}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: awsClusterConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AWSManagedControlPlane")
 		os.Exit(1)
@@ -517,10 +547,13 @@ func setupEKSReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager,
 	if feature.Gates.Enabled(feature.EKSFargate) {
 		setupLog.Debug("enabling EKS fargate profile controller")
 		if err := (&expcontrollers.AWSFargateProfileReconciler{
-			Client:           mgr.GetClient(),
-			Recorder:         mgr.GetEventRecorderFor("awsfargateprofile-reconciler"),
-			EnableIAM:        enableIAM,
-			WatchFilterValue: watchFilterValue,
+			Client:             mgr.GetClient(),
+			Recorder:           mgr.GetEventRecorderFor("awsfargateprofile-reconciler"),
+			EnableIAM:          enableIAM,
+			WatchFilterValue:   watchFilterValue,
+			RequeueInterval:    fargateRequeueInterval,
+			MaxRequeueInterval: fargateMaxRequeueInterval,
+			CreateTimeout:      fargateCreateTimeout,
 		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: awsClusterConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "AWSFargateProfile")
 		}
@@ -533,6 +566,17 @@ func setupEKSReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager,
 
 	if feature.Gates.Enabled(feature.MachinePool) {
 		setupLog.Debug("enabling EKS managed machine pool controller")
+		var priceTable *ekspkg.PriceTable
+		if nodegroupCostEstimate {
+			priceTable = ekspkg.DefaultPriceTable()
+			if nodegroupPriceTable != "" {
+				var err error
+				if priceTable, err = ekspkg.LoadPriceTable(nodegroupPriceTable); err != nil {
+					setupLog.Error(err, "unable to load the node group price table")
+					os.Exit(1)
+				}
+			}
+		}
 		if err := (&expcontrollers.AWSManagedMachinePoolReconciler{
 			AllowAdditionalRoles:         allowAddRoles,
 			Client:                       mgr.GetClient(),
@@ -541,6 +585,12 @@ func setupEKSReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager,
 			WatchFilterValue:             watchFilterValue,
 			TagUnmanagedNetworkResources: feature.Gates.Enabled(feature.TagUnmanagedNetworkResources),
 			MaxWaitActiveUpdateDelete:    maxWaitActiveUpdateDelete,
+			MaxWaitNodegroupCreateUpdate: maxWaitNodegroupCreate,
+			MaxWaitNodegroupDelete:       maxWaitNodegroupDelete,
+			ReconcileJitter:              reconcileJitter,
+			RequiredNodeRolePolicies:     requiredNodeRolePolicies,
+			WaitForNodegroupUpdates:      waitForNodegroupUpdates,
+			PriceTable:                   priceTable,
 		}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: instanceStateConcurrency, RecoverPanic: ptr.To[bool](true)}); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "AWSManagedMachinePool")
 			os.Exit(1)
@@ -643,12 +693,60 @@ func initFlags(fs *pflag.FlagSet) {
 		"The maximum duration to wait for managed AWS resources to be ready.",
 	)
 
+	fs.DurationVar(&maxWaitNodegroupCreate,
+		"max-wait-nodegroup-create-update",
+		0,
+		"The maximum duration to wait for an EKS node group to be active after it was created or updated. Defaults to --max-wait-managed-resources.",
+	)
+
+	fs.DurationVar(&maxWaitNodegroupDelete,
+		"max-wait-nodegroup-delete",
+		0,
+		"The maximum duration to wait for an EKS node group to be deleted. Defaults to --max-wait-managed-resources.",
+	)
+
+	fs.DurationVar(&fargateRequeueInterval,
+		"fargate-profile-requeue-interval",
+		scope.DefaultFargateRequeueInterval,
+		"The interval to check again on an EKS fargate profile being created or deleted.",
+	)
+
+	fs.DurationVar(&fargateMaxRequeueInterval,
+		"fargate-profile-max-requeue-interval",
+		0,
+		"The maximum interval to check again on an EKS fargate profile being created or deleted. The interval doubles up to this maximum while the profile stays in the same status. Defaults to --fargate-profile-requeue-interval, which doesn't back off.",
+	)
+
+	fs.DurationVar(&fargateCreateTimeout,
+		"fargate-profile-create-timeout",
+		scope.DefaultFargateCreateTimeout,
+		"The maximum duration an EKS fargate profile can stay in the creating status before its ready condition reports the timeout.",
+	)
+
 	fs.DurationVar(&syncPeriod,
 		"sync-period",
 		10*time.Minute,
 		fmt.Sprintf("The minimum interval at which watched resources are reconciled. If EKS is enabled the maximum allowed is %s", maxEKSSyncPeriod),
 	)
 
+	fs.DurationVar(&describeCacheTTL,
+		"aws-describe-cache-ttl",
+		0,
+		"How long the outputs of the EKS cluster, EKS nodegroup and Auto Scaling group describe calls are shared between reconciles. Outputs are dropped early after calls changing the described resources. Set to 0 to disable.",
+	)
+
+	fs.IntVar(&maxConcurrentAWSDeletes,
+		"max-concurrent-aws-deletes",
+		0,
+		"The maximum number of AWS delete, terminate, detach and release calls in flight at once across all the controllers. Calls over the limit wait for a slot. Set to 0 to disable.",
+	)
+
+	fs.Float64Var(&reconcileJitter,
+		"reconcile-jitter",
+		0.1,
+		"The maximum fraction of a requeue interval added as random jitter to managed control plane and machine pool requeues, to avoid reconciling many clusters at the same time. Set to 0 to disable.",
+	)
+
 	fs.IntVar(&webhookPort,
 		"webhook-port",
 		9443,
@@ -684,6 +782,48 @@ func initFlags(fs *pflag.FlagSet) {
 		fmt.Sprintf("Sets of controllers that should be disabled for this instance of the controller manager in a comma-separated list. Options are: %q", strings.Join(controllers.GetValidNames(), ",")),
 	)
 
+	fs.StringSliceVar(
+		&retryableAWSErrorCodes,
+		"retryable-aws-error-codes",
+		nil,
+		"AWS error codes that should be retried by the managed node group and Fargate profile services, in addition to the throttling errors retried by default.",
+	)
+
+	fs.StringSliceVar(
+		&terminalAWSErrorCodes,
+		"terminal-aws-error-codes",
+		nil,
+		"AWS error codes that should never be retried by the managed node group and Fargate profile services. Takes precedence over the retryable error codes.",
+	)
+
+	fs.StringSliceVar(
+		&requiredNodeRolePolicies,
+		"eks-required-node-role-policies",
+		nil,
+		"Policies, as ARNs or names of AWS managed policies, that the node role of every managed node group must have on top of the default EKS node policies. They're attached to roles created by the controller, and reported on the IAMNodegroupRolesReady condition when missing from other roles.",
+	)
+
+	fs.BoolVar(
+		&waitForNodegroupUpdates,
+		"eks-wait-for-nodegroup-updates",
+		false,
+		"Block the reconciliation of a managed machine pool until an update of its EKS node group has completed, instead of requeueing it until the node group is active again.",
+	)
+
+	fs.BoolVar(
+		&nodegroupCostEstimate,
+		"eks-nodegroup-cost-estimate",
+		false,
+		"Estimate the hourly cost of the nodes of each managed machine pool and report it in its status.",
+	)
+
+	fs.StringVar(
+		&nodegroupPriceTable,
+		"eks-nodegroup-price-table",
+		"",
+		"Path to a YAML or JSON file with the instance type prices used to estimate the cost of managed machine pools, instead of the bundled prices. Requires --eks-nodegroup-cost-estimate.",
+	)
+
 	logs.AddFlags(fs, logs.SkipLoggingConfigurationFlags())
 	v1.AddFlags(logOptions, fs)
 
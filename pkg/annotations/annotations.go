@@ -18,6 +18,8 @@ limitations under the License.
 package annotations
 
 import (
+	"strconv"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -65,3 +67,14 @@ func Has(obj metav1.Object, name string) bool {
 
 	return found
 }
+
+// IsTrue returns true if the supplied annotation is set to a value that parses as true.
+func IsTrue(obj metav1.Object, name string) bool {
+	value, found := Get(obj, name)
+	if !found {
+		return false
+	}
+
+	b, err := strconv.ParseBool(value)
+	return err == nil && b
+}
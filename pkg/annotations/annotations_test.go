@@ -52,3 +52,16 @@ func TestHasAnnotation(t *testing.T) {
 		t.Errorf("expected annotation to not be found, but it was")
 	}
 }
+
+func TestIsTrueAnnotation(t *testing.T) {
+	obj := &metav1.ObjectMeta{}
+	obj.SetAnnotations(map[string]string{"enabled": "true", "disabled": "false", "invalid": "yes"})
+	if !IsTrue(obj, "enabled") {
+		t.Errorf("expected annotation 'enabled' to be true, but it was not")
+	}
+	for _, name := range []string{"disabled", "invalid", "missing"} {
+		if IsTrue(obj, name) {
+			t.Errorf("expected annotation '%s' to not be true, but it was", name)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awserrors
+
+import (
+	"sync"
+)
+
+// Error codes returned by AWS services when requests are being throttled.
+const (
+	Throttling               = "Throttling"
+	ThrottlingException      = "ThrottlingException"
+	RequestLimitExceeded     = "RequestLimitExceeded"
+	TooManyRequestsException = "TooManyRequestsException"
+)
+
+// IsThrottlingError returns true if the error is an AWS error returned because
+// requests are being throttled.
+func IsThrottlingError(err error) bool {
+	code, ok := Code(err)
+	if !ok {
+		return false
+	}
+	switch code {
+	case Throttling, ThrottlingException, RequestLimitExceeded, TooManyRequestsException:
+		return true
+	}
+	return false
+}
+
+// DefaultRetryClassifier is the classifier shared by the services' retry wrappers.
+// It treats throttling errors as retryable and can be tuned by operators at startup.
+var DefaultRetryClassifier = NewRetryClassifier(
+	Throttling,
+	ThrottlingException,
+	RequestLimitExceeded,
+	TooManyRequestsException,
+)
+
+// RetryClassifier maps AWS error codes to retryable or terminal.
+// Error codes that haven't been classified are terminal.
+type RetryClassifier struct {
+	mu        sync.RWMutex
+	retryable map[string]bool
+}
+
+// NewRetryClassifier returns a classifier that treats the given error codes as retryable.
+func NewRetryClassifier(retryableCodes ...string) *RetryClassifier {
+	c := &RetryClassifier{retryable: map[string]bool{}}
+	c.SetRetryable(retryableCodes...)
+	return c
+}
+
+// SetRetryable marks the given error codes as retryable.
+func (c *RetryClassifier) SetRetryable(codes ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, code := range codes {
+		c.retryable[code] = true
+	}
+}
+
+// SetTerminal marks the given error codes as terminal.
+func (c *RetryClassifier) SetTerminal(codes ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, code := range codes {
+		c.retryable[code] = false
+	}
+}
+
+// IsRetryableCode returns true if the error code is retryable.
+func (c *RetryClassifier) IsRetryableCode(code string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.retryable[code]
+}
+
+// IsRetryable returns true if the error is an AWS error with a retryable code.
+func (c *RetryClassifier) IsRetryable(err error) bool {
+	code, ok := Code(err)
+	if !ok {
+		return false
+	}
+	return c.IsRetryableCode(code)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awserrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/gomega"
+)
+
+func TestDefaultRetryClassifier(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{
+			name:      "Throttling is retryable",
+			err:       &smithy.GenericAPIError{Code: Throttling},
+			retryable: true,
+		},
+		{
+			name:      "ThrottlingException is retryable",
+			err:       &smithy.GenericAPIError{Code: ThrottlingException},
+			retryable: true,
+		},
+		{
+			name:      "RequestLimitExceeded is retryable",
+			err:       &smithy.GenericAPIError{Code: RequestLimitExceeded},
+			retryable: true,
+		},
+		{
+			name:      "TooManyRequestsException is retryable",
+			err:       &smithy.GenericAPIError{Code: TooManyRequestsException},
+			retryable: true,
+		},
+		{
+			name:      "wrapped throttling error is retryable",
+			err:       fmt.Errorf("failed to create nodegroup: %w", &smithy.GenericAPIError{Code: Throttling}),
+			retryable: true,
+		},
+		{
+			name:      "ResourceInUseException is terminal",
+			err:       &ekstypes.ResourceInUseException{},
+			retryable: false,
+		},
+		{
+			name:      "InvalidParameterException is terminal",
+			err:       &ekstypes.InvalidParameterException{},
+			retryable: false,
+		},
+		{
+			name:      "UnauthorizedOperation is terminal",
+			err:       &smithy.GenericAPIError{Code: UnauthorizedOperation},
+			retryable: false,
+		},
+		{
+			name:      "non AWS error is terminal",
+			err:       errors.New("connection reset"),
+			retryable: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(DefaultRetryClassifier.IsRetryable(tc.err)).To(Equal(tc.retryable))
+		})
+	}
+}
+
+func TestRetryClassifierConfiguration(t *testing.T) {
+	resourceInUse := (&ekstypes.ResourceInUseException{}).ErrorCode()
+
+	t.Run("codes can be marked as retryable", func(t *testing.T) {
+		g := NewWithT(t)
+		c := NewRetryClassifier(Throttling)
+		c.SetRetryable(resourceInUse)
+		g.Expect(c.IsRetryable(&ekstypes.ResourceInUseException{})).To(BeTrue())
+	})
+
+	t.Run("codes can be marked as terminal", func(t *testing.T) {
+		g := NewWithT(t)
+		c := NewRetryClassifier(Throttling, RequestLimitExceeded)
+		c.SetTerminal(RequestLimitExceeded)
+		g.Expect(c.IsRetryableCode(Throttling)).To(BeTrue())
+		g.Expect(c.IsRetryableCode(RequestLimitExceeded)).To(BeFalse())
+	})
+}
+
+func TestIsThrottlingError(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(IsThrottlingError(&smithy.GenericAPIError{Code: ThrottlingException})).To(BeTrue())
+	g.Expect(IsThrottlingError(fmt.Errorf("failed to update nodegroup: %w", &smithy.GenericAPIError{Code: RequestLimitExceeded}))).To(BeTrue())
+	g.Expect(IsThrottlingError(&ekstypes.ResourceInUseException{})).To(BeFalse())
+	g.Expect(IsThrottlingError(errors.New("connection reset"))).To(BeFalse())
+}
@@ -20,6 +20,7 @@ package awserrors
 import (
 	"errors"
 	"net/http"
+	"strings"
 
 	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
 	"github.com/aws/smithy-go"
@@ -48,6 +49,7 @@ const (
 	//nolint:gosec
 	NoCredentialProviders                   = "NoCredentialProviders"
 	NoSuchKey                               = "NoSuchKey"
+	NoSuchBucketPolicy                      = "NoSuchBucketPolicy"
 	PermissionNotFound                      = "InvalidPermission.NotFound"
 	ResourceExists                          = "ResourceExistsException"
 	ResourceNotFound                        = "InvalidResourceID.NotFound"
@@ -95,6 +97,7 @@ type SmithyError struct {
 	errCode    string
 	errMessage string
 	statusCode int
+	requestID  string
 }
 
 // Error implements the Error interface.
@@ -257,6 +260,8 @@ func ParseSmithyError(err error) *SmithyError {
 		}
 	}
 
+	smithyErr.requestID, _ = RequestID(err)
+
 	return smithyErr
 }
 
@@ -274,3 +279,27 @@ func (s *SmithyError) ErrorMessage() string {
 func (s *SmithyError) StatusCode() int {
 	return s.statusCode
 }
+
+// RequestID returns the ID of the failed AWS request from the SmithyError.
+func (s *SmithyError) RequestID() string {
+	return s.requestID
+}
+
+// RequestID returns the ID of the failed AWS request, if the error carries one.
+func RequestID(err error) (string, bool) {
+	var re interface{ ServiceRequestID() string }
+	if errors.As(err, &re) && re.ServiceRequestID() != "" {
+		return re.ServiceRequestID(), true
+	}
+	return "", false
+}
+
+// MessageWithoutRequestID returns the error message without the ID of the failed
+// AWS request, which differs on every attempt. It keeps condition messages stable
+// across reconciliations, the request ID is still surfaced by events and logs.
+func MessageWithoutRequestID(err error) string {
+	if requestID, ok := RequestID(err); ok {
+		return strings.ReplaceAll(err.Error(), "RequestID: "+requestID+", ", "")
+	}
+	return err.Error()
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awserrors
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
+)
+
+func newResponseError(requestID string) error {
+	return &smithy.OperationError{
+		ServiceID:     "EKS",
+		OperationName: "CreateNodegroup",
+		Err: &awshttp.ResponseError{
+			ResponseError: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusBadRequest}},
+				Err:      &smithy.GenericAPIError{Code: "InvalidParameterException", Message: "invalid subnets"},
+			},
+			RequestID: requestID,
+		},
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name              string
+		err               error
+		expectedRequestID string
+	}{
+		{
+			name:              "request ID is extracted from the response error",
+			err:               newResponseError("a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"),
+			expectedRequestID: "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+		},
+		{
+			name:              "request ID is extracted from a wrapped error",
+			err:               pkgerrors.Wrap(newResponseError("a1b2c3d4-5678-90ab-cdef-EXAMPLE22222"), "failed to create nodegroup"),
+			expectedRequestID: "a1b2c3d4-5678-90ab-cdef-EXAMPLE22222",
+		},
+		{
+			name: "error without request ID",
+			err:  &smithy.GenericAPIError{Code: "InvalidParameterException"},
+		},
+		{
+			name: "non AWS error",
+			err:  errors.New("connection reset"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			requestID, ok := RequestID(tc.err)
+			g.Expect(ok).To(Equal(tc.expectedRequestID != ""))
+			g.Expect(requestID).To(Equal(tc.expectedRequestID))
+			g.Expect(ParseSmithyError(tc.err).RequestID()).To(Equal(tc.expectedRequestID))
+
+			if tc.expectedRequestID != "" {
+				g.Expect(tc.err.Error()).To(ContainSubstring(tc.expectedRequestID))
+				g.Expect(MessageWithoutRequestID(tc.err)).NotTo(ContainSubstring(tc.expectedRequestID))
+				g.Expect(MessageWithoutRequestID(tc.err)).To(ContainSubstring("StatusCode: 400, api error InvalidParameterException: invalid subnets"))
+			} else {
+				g.Expect(MessageWithoutRequestID(tc.err)).To(Equal(tc.err.Error()))
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package converters
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -63,3 +64,40 @@ func TestNodeRepairConfigToSDK(t *testing.T) {
 		})
 	}
 }
+
+func TestAMITypeToSDK(t *testing.T) {
+	tests := []struct {
+		input    expinfrav1.ManagedMachineAMIType
+		expected ekstypes.AMITypes
+	}{
+		{input: expinfrav1.Al2023x86_64, expected: ekstypes.AMITypesAl2023X8664Standard},
+		{input: expinfrav1.WindowsCore2019x86_64, expected: ekstypes.AMITypesWindowsCore2019X8664},
+		{input: expinfrav1.WindowsFull2019x86_64, expected: ekstypes.AMITypesWindowsFull2019X8664},
+		{input: expinfrav1.WindowsCore2022x86_64, expected: ekstypes.AMITypesWindowsCore2022X8664},
+		{input: expinfrav1.WindowsFull2022x86_64, expected: ekstypes.AMITypesWindowsFull2022X8664},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.input), func(t *testing.T) {
+			if result := AMITypeToSDK(tt.input); result != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestAMITypeToSDKIsDistinct(t *testing.T) {
+	converted := map[ekstypes.AMITypes]expinfrav1.ManagedMachineAMIType{}
+	for _, amiType := range expinfrav1.ManagedMachineAMITypes {
+		t.Run(string(amiType), func(t *testing.T) {
+			sdkType := AMITypeToSDK(amiType)
+			if !slices.Contains(sdkType.Values(), sdkType) {
+				t.Fatalf("%s is converted to %s, which isn't an SDK AMI type", amiType, sdkType)
+			}
+			if other, ok := converted[sdkType]; ok {
+				t.Fatalf("%s and %s are both converted to %s", other, amiType, sdkType)
+			}
+			converted[sdkType] = amiType
+		})
+	}
+}
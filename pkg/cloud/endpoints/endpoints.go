@@ -23,11 +23,14 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
 	rgapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
@@ -42,7 +45,7 @@ import (
 var (
 	errServiceEndpointFormat             = errors.New("must be formatted as ${ServiceID}=${URL}")
 	errServiceEndpointSigningRegion      = errors.New("must be formatted as ${SigningRegion}:${ServiceID1}=${URL1},${ServiceID2}=${URL2...}")
-	errServiceEndpointURL                = errors.New("must use a valid URL as a service-endpoint")
+	errServiceEndpointURL                = errors.New("must use a valid http or https URL as a service-endpoint")
 	errServiceEndpointServiceID          = errors.New("must use a valid serviceID from the AWS GO SDK")
 	errServiceEndpointDuplicateServiceID = errors.New("same serviceID defined twice for signing region")
 	serviceEndpointsMap                  = map[string]serviceEndpoint{}
@@ -57,6 +60,9 @@ var (
 		"ssm":                  ssm.ServiceID,
 		"sts":                  sts.ServiceID,
 		"secretsmanager":       secretsmanager.ServiceID,
+		"autoscaling":          autoscaling.ServiceID,
+		"iam":                  iam.ServiceID,
+		"kms":                  kms.ServiceID,
 	}
 )
 
@@ -108,7 +114,7 @@ func ParseFlag(serviceEndpoints string) error {
 			}
 
 			URL, err := url.ParseRequestURI(kv[1])
-			if err != nil {
+			if err != nil || (URL.Scheme != "http" && URL.Scheme != "https") || URL.Host == "" {
 				return errServiceEndpointURL
 			}
 			endpoint := serviceEndpoint{
@@ -145,6 +151,17 @@ func GetPartitionFromRegion(region string) string {
 	return defaultPartition
 }
 
+// IsPartitionUpdateAllowed returns true if the partition of an existing cluster may be
+// changed from oldPartition to newPartition. An empty partition may only be filled in
+// with the partition of the cluster's region, which is what the controllers do when it
+// isn't set.
+func IsPartitionUpdateAllowed(oldPartition, newPartition, region string) bool {
+	if newPartition == oldPartition {
+		return true
+	}
+	return oldPartition == "" && newPartition == GetPartitionFromRegion(region)
+}
+
 // Custom EndpointResolverV2 ResolveEndpoint handlers.
 
 // MultiServiceEndpointResolver implements EndpointResolverV2 interface for services.
@@ -357,6 +374,28 @@ func (s *SSMEndpointResolver) ResolveEndpoint(ctx context.Context, params ssm.En
 	return ssm.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
 }
 
+// KMSEndpointResolver implements EndpointResolverV2 interface for KMS.
+type KMSEndpointResolver struct {
+	*MultiServiceEndpointResolver
+}
+
+// ResolveEndpoint for KMS.
+func (s *KMSEndpointResolver) ResolveEndpoint(ctx context.Context, params kms.EndpointParameters) (smithyendpoints.Endpoint, error) {
+	// If custom endpoint not found, return default endpoint for the service
+	log := logger.FromContext(ctx)
+	endpoint, ok := s.endpoints[kms.ServiceID]
+
+	if !ok {
+		log.Debug("Custom endpoint not found, using default endpoint")
+		return kms.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+	}
+
+	log.Debug("Custom endpoint found, using custom endpoint", "endpoint", endpoint.URL)
+	params.Endpoint = &endpoint.URL
+	params.Region = &endpoint.SigningRegion
+	return kms.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+}
+
 // STSEndpointResolver implements EndpointResolverV2 interface for STS.
 type STSEndpointResolver struct {
 	*MultiServiceEndpointResolver
@@ -400,3 +439,47 @@ func (s *SecretsManagerEndpointResolver) ResolveEndpoint(ctx context.Context, pa
 	params.Region = &endpoint.SigningRegion
 	return secretsmanager.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
 }
+
+// AutoscalingEndpointResolver implements EndpointResolverV2 interface for Auto Scaling.
+type AutoscalingEndpointResolver struct {
+	*MultiServiceEndpointResolver
+}
+
+// ResolveEndpoint for Auto Scaling.
+func (s *AutoscalingEndpointResolver) ResolveEndpoint(ctx context.Context, params autoscaling.EndpointParameters) (smithyendpoints.Endpoint, error) {
+	// If custom endpoint not found, return default endpoint for the service
+	log := logger.FromContext(ctx)
+	endpoint, ok := s.endpoints[autoscaling.ServiceID]
+
+	if !ok {
+		log.Debug("Custom endpoint not found, using default endpoint")
+		return autoscaling.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+	}
+
+	log.Debug("Custom endpoint found, using custom endpoint", "endpoint", endpoint.URL)
+	params.Endpoint = &endpoint.URL
+	params.Region = &endpoint.SigningRegion
+	return autoscaling.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+}
+
+// IAMEndpointResolver implements EndpointResolverV2 interface for IAM.
+type IAMEndpointResolver struct {
+	*MultiServiceEndpointResolver
+}
+
+// ResolveEndpoint for IAM.
+func (s *IAMEndpointResolver) ResolveEndpoint(ctx context.Context, params iam.EndpointParameters) (smithyendpoints.Endpoint, error) {
+	// If custom endpoint not found, return default endpoint for the service
+	log := logger.FromContext(ctx)
+	endpoint, ok := s.endpoints[iam.ServiceID]
+
+	if !ok {
+		log.Debug("Custom endpoint not found, using default endpoint")
+		return iam.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+	}
+
+	log.Debug("Custom endpoint found, using custom endpoint", "endpoint", endpoint.URL)
+	params.Endpoint = &endpoint.URL
+	params.Region = &endpoint.SigningRegion
+	return iam.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+}
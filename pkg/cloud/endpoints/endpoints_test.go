@@ -17,9 +17,17 @@ limitations under the License.
 package endpoints
 
 import (
+	"context"
 	"errors"
+	"net/http"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -74,6 +82,14 @@ func TestParseFlags(t *testing.T) {
 				"Secrets Manager":             {ServiceID: "Secrets Manager", URL: "https://secretmanager.com", SigningRegion: "us-iso"},
 			},
 		},
+		{
+			name:        "single region, autoscaling and iam",
+			flagToParse: "us-iso:autoscaling=https://autoscaling.com,iam=https://iam.com",
+			expectedServiceEndpointsMap: map[string]serviceEndpoint{
+				"Auto Scaling": {ServiceID: "Auto Scaling", URL: "https://autoscaling.com", SigningRegion: "us-iso"},
+				"IAM":          {ServiceID: "IAM", URL: "https://iam.com", SigningRegion: "us-iso"},
+			},
+		},
 		{
 			name:          "single region, duplicate service",
 			flagToParse:   "us-iso:ec2=https://localhost:8080,ec2=https://elbhost:8080",
@@ -84,6 +100,16 @@ func TestParseFlags(t *testing.T) {
 			flagToParse:   "us-iso:ec2=fdsfs",
 			expectedError: errServiceEndpointURL,
 		},
+		{
+			name:          "single region, URL without scheme",
+			flagToParse:   "us-iso:ec2=localhost:4566",
+			expectedError: errServiceEndpointURL,
+		},
+		{
+			name:          "single region, URL with unsupported scheme",
+			flagToParse:   "us-iso:ec2=ftp://localhost:4566",
+			expectedError: errServiceEndpointURL,
+		},
 		{
 			name:          "multiples regions",
 			flagToParse:   "us-iso:ec2=https://localhost:8080,sts=https://elbhost:8080;gb-iso:ec2=https://localhost:8080,sts=https://elbhost:8080",
@@ -121,6 +147,92 @@ func TestParseFlags(t *testing.T) {
 	}
 }
 
+// hostRecorder is an HTTP client recording the host of the requests it gets.
+type hostRecorder struct {
+	hosts []string
+}
+
+func (r *hostRecorder) Do(req *http.Request) (*http.Response, error) {
+	r.hosts = append(r.hosts, req.URL.Host)
+	return nil, errors.New("not sent")
+}
+
+func TestCustomEndpointsAreUsedByClients(t *testing.T) {
+	t.Cleanup(func() {
+		serviceEndpointsMap = make(map[string]serviceEndpoint)
+	})
+	if err := ParseFlag("us-east-1:ec2=http://localhost:4566,eks=http://localhost:4567,autoscaling=http://localhost:4568,iam=http://localhost:4569"); err != nil {
+		t.Fatalf("failed to parse service endpoints: %v", err)
+	}
+
+	cfg := aws.Config{
+		Region:      "us-east-1",
+		Credentials: credentials.NewStaticCredentialsProvider("id", "secret", ""),
+		Retryer:     func() aws.Retryer { return aws.NopRetryer{} },
+	}
+
+	testCases := []struct {
+		name         string
+		call         func(ctx context.Context, httpClient *hostRecorder)
+		expectedHost string
+	}{
+		{
+			name: "EC2",
+			call: func(ctx context.Context, httpClient *hostRecorder) {
+				client := ec2.NewFromConfig(cfg, func(o *ec2.Options) {
+					o.HTTPClient = httpClient
+					o.EndpointResolverV2 = &EC2EndpointResolver{MultiServiceEndpointResolver: NewMultiServiceEndpointResolver()}
+				})
+				_, _ = client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{})
+			},
+			expectedHost: "localhost:4566",
+		},
+		{
+			name: "EKS",
+			call: func(ctx context.Context, httpClient *hostRecorder) {
+				client := eks.NewFromConfig(cfg, func(o *eks.Options) {
+					o.HTTPClient = httpClient
+					o.EndpointResolverV2 = &EKSEndpointResolver{MultiServiceEndpointResolver: NewMultiServiceEndpointResolver()}
+				})
+				_, _ = client.ListClusters(ctx, &eks.ListClustersInput{})
+			},
+			expectedHost: "localhost:4567",
+		},
+		{
+			name: "Auto Scaling",
+			call: func(ctx context.Context, httpClient *hostRecorder) {
+				client := autoscaling.NewFromConfig(cfg, func(o *autoscaling.Options) {
+					o.HTTPClient = httpClient
+					o.EndpointResolverV2 = &AutoscalingEndpointResolver{MultiServiceEndpointResolver: NewMultiServiceEndpointResolver()}
+				})
+				_, _ = client.DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{})
+			},
+			expectedHost: "localhost:4568",
+		},
+		{
+			name: "IAM",
+			call: func(ctx context.Context, httpClient *hostRecorder) {
+				client := iam.NewFromConfig(cfg, func(o *iam.Options) {
+					o.HTTPClient = httpClient
+					o.EndpointResolverV2 = &IAMEndpointResolver{MultiServiceEndpointResolver: NewMultiServiceEndpointResolver()}
+				})
+				_, _ = client.ListRoles(ctx, &iam.ListRolesInput{})
+			},
+			expectedHost: "localhost:4569",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			httpClient := &hostRecorder{}
+			tc.call(context.Background(), httpClient)
+			if !cmp.Equal(httpClient.hosts, []string{tc.expectedHost}) {
+				t.Fatalf("expected a request to %s, got requests to %v", tc.expectedHost, httpClient.hosts)
+			}
+		})
+	}
+}
+
 func TestGetPartitionFromRegion(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -169,3 +281,48 @@ func TestGetPartitionFromRegion(t *testing.T) {
 		})
 	}
 }
+
+func TestIsPartitionUpdateAllowed(t *testing.T) {
+	testCases := []struct {
+		name         string
+		oldPartition string
+		newPartition string
+		region       string
+		expected     bool
+	}{
+		{
+			name:         "unchanged partition",
+			oldPartition: "aws",
+			newPartition: "aws",
+			region:       "us-east-1",
+			expected:     true,
+		},
+		{
+			name:         "changed partition",
+			oldPartition: "aws",
+			newPartition: "aws-us-gov",
+			region:       "us-east-1",
+			expected:     false,
+		},
+		{
+			name:         "empty partition set to the region's partition",
+			newPartition: "aws-cn",
+			region:       "cn-north-1",
+			expected:     true,
+		},
+		{
+			name:         "empty partition set to another partition",
+			newPartition: "aws-us-gov",
+			region:       "us-east-1",
+			expected:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsPartitionUpdateAllowed(tc.oldPartition, tc.newPartition, tc.region); got != tc.expected {
+				t.Fatalf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -193,3 +193,11 @@ func (ec2Filters) SecurityGroupName(name string) types.Filter {
 		Values: []string{name},
 	}
 }
+
+// SecurityGroupIDs returns a filter based on the given security group IDs.
+func (ec2Filters) SecurityGroupIDs(ids ...string) types.Filter {
+	return types.Filter{
+		Name:   aws.String("group-id"),
+		Values: ids,
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package scope
 
 import (
+	"context"
+
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -40,6 +42,8 @@ type AWSNodeScope interface {
 	DisableVPCCNI() bool
 	// VpcCni specifies configuration related to the VPC CNI.
 	VpcCni() ekscontrolplanev1.VpcCni
+	// HasWindowsNodegroups returns whether the cluster has nodegroups running Windows.
+	HasWindowsNodegroups(ctx context.Context) (bool, error)
 	// VPC returns the given VPC configuration.
 	VPC() *infrav1.VPCSpec
 }
@@ -19,11 +19,13 @@ package scope
 import (
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
 	rgapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
@@ -44,15 +46,21 @@ import (
 // NewASGClient creates a new ASG API client for a given session.
 func NewASGClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) *autoscaling.Client {
 	cfg := session.Session()
+	multiSvcEndpointResolver := endpoints.NewMultiServiceEndpointResolver()
+	autoscalingEndpointResolver := &endpoints.AutoscalingEndpointResolver{
+		MultiServiceEndpointResolver: multiSvcEndpointResolver,
+	}
 
 	autoscalingOpts := []func(*autoscaling.Options){
 		func(o *autoscaling.Options) {
 			o.Logger = logger.GetAWSLogger()
 			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
+			o.EndpointResolverV2 = autoscalingEndpointResolver
 		},
 		autoscaling.WithAPIOptions(
 			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
 			awsmetrics.WithCAPAUserAgentMiddleware(),
+			throttle.WithDeleteLimiterMiddleware(throttle.DefaultDeleteLimiter),
 		),
 	}
 
@@ -76,6 +84,7 @@ func NewEC2Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logg
 		ec2.WithAPIOptions(
 			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
 			awsmetrics.WithCAPAUserAgentMiddleware(),
+			throttle.WithDeleteLimiterMiddleware(throttle.DefaultDeleteLimiter),
 			throttle.WithServiceLimiterMiddleware(session.ServiceLimiter(ec2.ServiceID)),
 		),
 	}
@@ -100,6 +109,7 @@ func NewELBClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logg
 		elb.WithAPIOptions(
 			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
 			awsmetrics.WithCAPAUserAgentMiddleware(),
+			throttle.WithDeleteLimiterMiddleware(throttle.DefaultDeleteLimiter),
 			throttle.WithServiceLimiterMiddleware(session.ServiceLimiter(elb.ServiceID)),
 		),
 	}
@@ -124,6 +134,7 @@ func NewELBv2Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger lo
 		elbv2.WithAPIOptions(
 			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
 			awsmetrics.WithCAPAUserAgentMiddleware(),
+			throttle.WithDeleteLimiterMiddleware(throttle.DefaultDeleteLimiter),
 			throttle.WithServiceLimiterMiddleware(session.ServiceLimiter(elbv2.ServiceID)),
 		),
 	}
@@ -146,6 +157,7 @@ func NewEventBridgeClient(scopeUser cloud.ScopeUsage, session cloud.Session, tar
 		eventbridge.WithAPIOptions(
 			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
 			awsmetrics.WithCAPAUserAgentMiddleware(),
+			throttle.WithDeleteLimiterMiddleware(throttle.DefaultDeleteLimiter),
 		),
 	}
 
@@ -167,6 +179,7 @@ func NewSQSClient(scopeUser cloud.ScopeUsage, session cloud.Session, target runt
 		sqs.WithAPIOptions(
 			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
 			awsmetrics.WithCAPAUserAgentMiddleware(),
+			throttle.WithDeleteLimiterMiddleware(throttle.DefaultDeleteLimiter),
 		),
 	}
 
@@ -188,6 +201,7 @@ func NewGlobalSQSClient(scopeUser cloud.ScopeUsage, session cloud.Session) *sqs.
 		sqs.WithAPIOptions(
 			awsmetrics.WithRequestMetricContextMiddleware(),
 			awsmetrics.WithCAPAUserAgentMiddleware(),
+			throttle.WithDeleteLimiterMiddleware(throttle.DefaultDeleteLimiter),
 		),
 	}
 
@@ -208,7 +222,11 @@ func NewResourgeTaggingClient(scopeUser cloud.ScopeUsage, session cloud.Session,
 			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
 			o.EndpointResolverV2 = endpointResolver
 		},
-		rgapi.WithAPIOptions(awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target), awsmetrics.WithCAPAUserAgentMiddleware()),
+		rgapi.WithAPIOptions(
+			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
+			awsmetrics.WithCAPAUserAgentMiddleware(),
+			throttle.WithDeleteLimiterMiddleware(throttle.DefaultDeleteLimiter),
+		),
 	}
 
 	return rgapi.NewFromConfig(cfg, opts...)
@@ -230,6 +248,7 @@ func NewSecretsManagerClient(scopeUser cloud.ScopeUsage, session cloud.Session,
 		secretsmanager.WithAPIOptions(
 			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
 			awsmetrics.WithCAPAUserAgentMiddleware(),
+			throttle.WithDeleteLimiterMiddleware(throttle.DefaultDeleteLimiter),
 		),
 	}
 
@@ -249,23 +268,52 @@ func NewEKSClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logg
 			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
 			o.EndpointResolverV2 = eksEndpointResolver
 		},
-		eks.WithAPIOptions(awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target), awsmetrics.WithCAPAUserAgentMiddleware()),
+		eks.WithAPIOptions(
+			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
+			awsmetrics.WithCAPAUserAgentMiddleware(),
+			throttle.WithDeleteLimiterMiddleware(throttle.DefaultDeleteLimiter),
+		),
 	}
 	return eks.NewFromConfig(cfg, s3Opts...)
 }
 
+// NewECRClient creates a new ECR API client for a given session.
+func NewECRClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) *ecr.Client {
+	cfg := session.Session()
+
+	ecrOpts := []func(*ecr.Options){
+		func(o *ecr.Options) {
+			o.Logger = logger.GetAWSLogger()
+			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
+		},
+		ecr.WithAPIOptions(
+			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
+			awsmetrics.WithCAPAUserAgentMiddleware(),
+			throttle.WithDeleteLimiterMiddleware(throttle.DefaultDeleteLimiter),
+		),
+	}
+
+	return ecr.NewFromConfig(cfg, ecrOpts...)
+}
+
 // NewIAMClient creates a new IAM API client for a given session.
 func NewIAMClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) *iam.Client {
 	cfg := session.Session()
+	multiSvcEndpointResolver := endpoints.NewMultiServiceEndpointResolver()
+	iamEndpointResolver := &endpoints.IAMEndpointResolver{
+		MultiServiceEndpointResolver: multiSvcEndpointResolver,
+	}
 
 	iamOpts := []func(*iam.Options){
 		func(o *iam.Options) {
 			o.Logger = logger.GetAWSLogger()
 			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
+			o.EndpointResolverV2 = iamEndpointResolver
 		},
 		iam.WithAPIOptions(
 			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
 			awsmetrics.WithCAPAUserAgentMiddleware(),
+			throttle.WithDeleteLimiterMiddleware(throttle.DefaultDeleteLimiter),
 		),
 	}
 
@@ -289,6 +337,7 @@ func NewSTSClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logg
 		stsv2.WithAPIOptions(
 			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
 			awsmetrics.WithCAPAUserAgentMiddleware(),
+			throttle.WithDeleteLimiterMiddleware(throttle.DefaultDeleteLimiter),
 		),
 	}
 
@@ -311,12 +360,35 @@ func NewSSMClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logg
 		ssm.WithAPIOptions(
 			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
 			awsmetrics.WithCAPAUserAgentMiddleware(),
+			throttle.WithDeleteLimiterMiddleware(throttle.DefaultDeleteLimiter),
 		),
 	}
 
 	return ssm.NewFromConfig(cfg, ssmOpts...)
 }
 
+// NewKMSClient creates a new KMS API client for a given session.
+func NewKMSClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) *kms.Client {
+	cfg := session.Session()
+	multiSvcEndpointResolver := endpoints.NewMultiServiceEndpointResolver()
+	kmsEndpointResolver := &endpoints.KMSEndpointResolver{
+		MultiServiceEndpointResolver: multiSvcEndpointResolver,
+	}
+	kmsOpts := []func(*kms.Options){
+		func(o *kms.Options) {
+			o.Logger = logger.GetAWSLogger()
+			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
+			o.EndpointResolverV2 = kmsEndpointResolver
+		},
+		kms.WithAPIOptions(
+			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
+			awsmetrics.WithCAPAUserAgentMiddleware(),
+		),
+	}
+
+	return kms.NewFromConfig(cfg, kmsOpts...)
+}
+
 // NewS3Client creates a new S3 API client for a given session.
 func NewS3Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) *s3.Client {
 	cfg := session.Session()
@@ -330,7 +402,11 @@ func NewS3Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logge
 			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
 			o.EndpointResolverV2 = s3EndpointResolver
 		},
-		s3.WithAPIOptions(awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target), awsmetrics.WithCAPAUserAgentMiddleware()),
+		s3.WithAPIOptions(
+			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
+			awsmetrics.WithCAPAUserAgentMiddleware(),
+			throttle.WithDeleteLimiterMiddleware(throttle.DefaultDeleteLimiter),
+		),
 	}
 	return s3.NewFromConfig(cfg, s3Opts...)
 }
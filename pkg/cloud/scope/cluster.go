@@ -417,6 +417,11 @@ func (s *ClusterScope) ImageLookupBaseOS() string {
 	return s.AWSCluster.Spec.ImageLookupBaseOS
 }
 
+// ControlPlaneVolumeEncryption returns the default encryption of the control plane machines' volumes.
+func (s *ClusterScope) ControlPlaneVolumeEncryption() *infrav1.VolumeEncryption {
+	return s.AWSCluster.Spec.ControlPlaneVolumeEncryption
+}
+
 // Partition returns the cluster partition.
 func (s *ClusterScope) Partition() string {
 	if s.AWSCluster.Spec.Partition == "" {
@@ -54,4 +54,7 @@ type EC2Scope interface {
 
 	// ImageLookupBaseOS returns the base operating system name to use when looking up AMIs
 	ImageLookupBaseOS() string
+
+	// ControlPlaneVolumeEncryption returns the default encryption of the control plane machines' volumes.
+	ControlPlaneVolumeEncryption() *infrav1.VolumeEncryption
 }
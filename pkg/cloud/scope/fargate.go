@@ -18,6 +18,7 @@ package scope
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/pkg/errors"
@@ -46,10 +47,29 @@ type FargateProfileScopeParams struct {
 	FargateProfile *expinfrav1.AWSFargateProfile
 	ControllerName string
 	Session        aws.Config
+	// RequeueInterval is how long to wait before checking again on a fargate profile being
+	// created or deleted, or on its IAM role being updated. Defaults to DefaultFargateRequeueInterval.
+	RequeueInterval time.Duration
+	// MaxRequeueInterval caps the interval, doubled each time it has elapsed, while a fargate
+	// profile stays in the creating or deleting status. Defaults to RequeueInterval, which
+	// doesn't back off.
+	MaxRequeueInterval time.Duration
+	// CreateTimeout is how long a fargate profile can stay in the creating status before
+	// its ready condition reports the timeout. Defaults to DefaultFargateCreateTimeout.
+	CreateTimeout time.Duration
 
 	EnableIAM bool
 }
 
+const (
+	// DefaultFargateRequeueInterval is the default interval to check again on fargate profiles
+	// being created or deleted.
+	DefaultFargateRequeueInterval = 10 * time.Second
+	// DefaultFargateCreateTimeout is the default time a fargate profile can stay in the
+	// creating status before its ready condition reports the timeout.
+	DefaultFargateCreateTimeout = 20 * time.Minute
+)
+
 // NewFargateProfileScope creates a new Scope from the supplied parameters.
 // This is meant to be called for each reconcile iteration.
 func NewFargateProfileScope(params FargateProfileScopeParams) (*FargateProfileScope, error) {
@@ -74,6 +94,16 @@ func NewFargateProfileScope(params FargateProfileScopeParams) (*FargateProfileSc
 		return nil, errors.Errorf("failed to create aws v2 session: %v", err)
 	}
 
+	if params.RequeueInterval <= 0 {
+		params.RequeueInterval = DefaultFargateRequeueInterval
+	}
+	if params.MaxRequeueInterval < params.RequeueInterval {
+		params.MaxRequeueInterval = params.RequeueInterval
+	}
+	if params.CreateTimeout <= 0 {
+		params.CreateTimeout = DefaultFargateCreateTimeout
+	}
+
 	helper, err := v1beta1patch.NewHelper(params.FargateProfile, params.Client)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to init patch helper")
@@ -90,6 +120,10 @@ func NewFargateProfileScope(params FargateProfileScopeParams) (*FargateProfileSc
 		serviceLimiters: serviceLimiters,
 		controllerName:  params.ControllerName,
 		enableIAM:       params.EnableIAM,
+
+		RequeueInterval:    params.RequeueInterval,
+		MaxRequeueInterval: params.MaxRequeueInterval,
+		CreateTimeout:      params.CreateTimeout,
 	}, nil
 }
 
@@ -103,6 +137,10 @@ type FargateProfileScope struct {
 	ControlPlane   *ekscontrolplanev1.AWSManagedControlPlane
 	FargateProfile *expinfrav1.AWSFargateProfile
 
+	RequeueInterval    time.Duration
+	MaxRequeueInterval time.Duration
+	CreateTimeout      time.Duration
+
 	session         aws.Config
 	serviceLimiters throttle.ServiceLimiters
 	controllerName  string
@@ -37,10 +37,12 @@ type LaunchTemplateScope interface {
 	SetLaunchTemplateIDStatus(id string)
 	GetLaunchTemplateLatestVersionStatus() string
 	SetLaunchTemplateLatestVersionStatus(version string)
+	GetLaunchTemplatePinnedVersion() *int64
 	GetRawBootstrapData() ([]byte, string, *types.NamespacedName, error)
 
 	IsEKSManaged() bool
 	AdditionalTags() infrav1.Tags
+	DefaultVolumeEncryption() *infrav1.VolumeEncryption
 
 	GetObjectMeta() *metav1.ObjectMeta
 	GetSetter() v1beta1conditions.Setter
@@ -126,6 +126,12 @@ func (m *MachinePoolScope) Ignition() *infrav1.Ignition {
 	return m.AWSMachinePool.Spec.Ignition
 }
 
+// InstanceRefreshForceUpdateEnabled returns true if launch template changes should cancel an
+// unfinished instance refresh rather than wait for it to finish.
+func (m *MachinePoolScope) InstanceRefreshForceUpdateEnabled() bool {
+	return m.AWSMachinePool.Spec.RefreshPreferences != nil && m.AWSMachinePool.Spec.RefreshPreferences.ForceUpdateEnabled
+}
+
 // Name returns the AWSMachinePool name.
 func (m *MachinePoolScope) Name() string {
 	return m.AWSMachinePool.Name
@@ -271,11 +277,22 @@ func (m *MachinePoolScope) SetLaunchTemplateLatestVersionStatus(version string)
 	m.AWSMachinePool.Status.LaunchTemplateVersion = &version
 }
 
+// GetLaunchTemplatePinnedVersion returns nil, machine pools always use the latest launch template version.
+func (m *MachinePoolScope) GetLaunchTemplatePinnedVersion() *int64 {
+	return nil
+}
+
 // IsEKSManaged checks if the AWSMachinePool is EKS managed.
 func (m *MachinePoolScope) IsEKSManaged() bool {
 	return m.InfraCluster.InfraCluster().GetObjectKind().GroupVersionKind().Kind == ekscontrolplanev1.AWSManagedControlPlaneKind
 }
 
+// DefaultVolumeEncryption returns nil, the volumes of self-managed machine pools
+// only use the encryption set in their launch template.
+func (m *MachinePoolScope) DefaultVolumeEncryption() *infrav1.VolumeEncryption {
+	return nil
+}
+
 // SubnetIDs returns the machine pool subnet IDs.
 func (m *MachinePoolScope) SubnetIDs(subnetIDs []string) ([]string, error) {
 	strategy, err := newDefaultSubnetPlacementStrategy(&m.Logger)
@@ -26,6 +26,7 @@ import (
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -35,6 +36,7 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/endpoints"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/throttle"
@@ -51,6 +53,7 @@ func init() {
 	_ = amazoncni.AddToScheme(scheme)
 	_ = appsv1.AddToScheme(scheme)
 	_ = corev1.AddToScheme(scheme)
+	_ = policyv1.AddToScheme(scheme)
 	_ = rbacv1.AddToScheme(scheme)
 }
 
@@ -281,6 +284,7 @@ func (s *ManagedControlPlaneScope) PatchObject() error {
 			ekscontrolplanev1.EKSControlPlaneCreatingCondition,
 			ekscontrolplanev1.EKSControlPlaneReadyCondition,
 			ekscontrolplanev1.EKSControlPlaneUpdatingCondition,
+			ekscontrolplanev1.EKSControlPlanePreUpgradeHooksSucceededCondition,
 			ekscontrolplanev1.IAMControlPlaneRolesReadyCondition,
 		}})
 }
@@ -402,6 +406,11 @@ func (s *ManagedControlPlaneScope) ImageLookupBaseOS() string {
 	return s.ControlPlane.Spec.ImageLookupBaseOS
 }
 
+// ControlPlaneVolumeEncryption returns nil, as EKS runs the control plane.
+func (s *ManagedControlPlaneScope) ControlPlaneVolumeEncryption() *infrav1.VolumeEncryption {
+	return nil
+}
+
 // IAMAuthConfig returns the IAM authenticator config. The returned value will never be nil.
 func (s *ManagedControlPlaneScope) IAMAuthConfig() *ekscontrolplanev1.IAMAuthenticatorConfig {
 	if s.ControlPlane.Spec.IAMAuthenticatorConfig == nil {
@@ -438,6 +447,20 @@ func (s *ManagedControlPlaneScope) VpcCni() ekscontrolplanev1.VpcCni {
 	return s.ControlPlane.Spec.VpcCni
 }
 
+// HasWindowsNodegroups returns whether any of the cluster's managed machine pools use a Windows AMI type.
+func (s *ManagedControlPlaneScope) HasWindowsNodegroups(ctx context.Context) (bool, error) {
+	pools := &expinfrav1.AWSManagedMachinePoolList{}
+	if err := s.Client.List(ctx, pools, client.InNamespace(s.Namespace()), client.MatchingLabels{clusterv1.ClusterNameLabel: s.Name()}); err != nil {
+		return false, fmt.Errorf("listing managed machine pools: %w", err)
+	}
+	for _, pool := range pools.Items {
+		if pool.Spec.AMIType != nil && pool.Spec.AMIType.IsWindows() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // RestrictPrivateSubnets returns whether Control Plane should be restricted to Private subnets.
 func (s *ManagedControlPlaneScope) RestrictPrivateSubnets() bool {
 	return s.ControlPlane.Spec.RestrictPrivateSubnets
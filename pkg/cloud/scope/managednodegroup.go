@@ -19,14 +19,17 @@ package scope
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -36,9 +39,12 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/endpoints"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/throttle"
+	ekspkg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/external"
+	"sigs.k8s.io/cluster-api/controllers/remote"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"
 	"sigs.k8s.io/cluster-api/util/patch"
@@ -55,9 +61,19 @@ type ManagedMachinePoolScopeParams struct {
 	ControllerName            string
 	Session                   awsv2.Config
 	MaxWaitActiveUpdateDelete time.Duration
+	// MaxWaitCreateUpdate is the maximum duration to wait for the nodegroup to be active
+	// after it was created or updated. Defaults to MaxWaitActiveUpdateDelete.
+	MaxWaitCreateUpdate time.Duration
+	// MaxWaitDelete is the maximum duration to wait for the nodegroup to be deleted.
+	// Defaults to MaxWaitActiveUpdateDelete.
+	MaxWaitDelete time.Duration
 
 	EnableIAM            bool
 	AllowAdditionalRoles bool
+	RequiredRolePolicies []string
+
+	WaitForNodegroupUpdates bool
+	PriceTable              *ekspkg.PriceTable
 
 	InfraCluster EC2Scope
 }
@@ -78,6 +94,12 @@ func NewManagedMachinePoolScope(params ManagedMachinePoolScopeParams) (*ManagedM
 		log := klog.Background()
 		params.Logger = logger.NewLogger(log)
 	}
+	if params.MaxWaitCreateUpdate == 0 {
+		params.MaxWaitCreateUpdate = params.MaxWaitActiveUpdateDelete
+	}
+	if params.MaxWaitDelete == 0 {
+		params.MaxWaitDelete = params.MaxWaitActiveUpdateDelete
+	}
 
 	managedScope := &ManagedControlPlaneScope{
 		Logger:                    *params.Logger,
@@ -108,17 +130,21 @@ func NewManagedMachinePoolScope(params ManagedMachinePoolScopeParams) (*ManagedM
 		patchHelper:                ammpHelper,
 		capiMachinePoolPatchHelper: mpHelper,
 
-		Cluster:                   params.Cluster,
-		ControlPlane:              params.ControlPlane,
-		ManagedMachinePool:        params.ManagedMachinePool,
-		MachinePool:               params.MachinePool,
-		MaxWaitActiveUpdateDelete: params.MaxWaitActiveUpdateDelete,
-		EC2Scope:                  params.InfraCluster,
-		session:                   *session,
-		serviceLimiters:           serviceLimiters,
-		controllerName:            params.ControllerName,
-		enableIAM:                 params.EnableIAM,
-		allowAdditionalRoles:      params.AllowAdditionalRoles,
+		Cluster:                 params.Cluster,
+		ControlPlane:            params.ControlPlane,
+		ManagedMachinePool:      params.ManagedMachinePool,
+		MachinePool:             params.MachinePool,
+		MaxWaitCreateUpdate:     params.MaxWaitCreateUpdate,
+		MaxWaitDelete:           params.MaxWaitDelete,
+		EC2Scope:                params.InfraCluster,
+		session:                 *session,
+		serviceLimiters:         serviceLimiters,
+		controllerName:          params.ControllerName,
+		enableIAM:               params.EnableIAM,
+		allowAdditionalRoles:    params.AllowAdditionalRoles,
+		requiredRolePolicies:    params.RequiredRolePolicies,
+		waitForNodegroupUpdates: params.WaitForNodegroupUpdates,
+		priceTable:              params.PriceTable,
 	}, nil
 }
 
@@ -129,12 +155,13 @@ type ManagedMachinePoolScope struct {
 	patchHelper                *v1beta1patch.Helper
 	capiMachinePoolPatchHelper *patch.Helper
 
-	Cluster                   *clusterv1.Cluster
-	ControlPlane              *ekscontrolplanev1.AWSManagedControlPlane
-	ManagedMachinePool        *expinfrav1.AWSManagedMachinePool
-	MachinePool               *clusterv1.MachinePool
-	EC2Scope                  EC2Scope
-	MaxWaitActiveUpdateDelete time.Duration
+	Cluster             *clusterv1.Cluster
+	ControlPlane        *ekscontrolplanev1.AWSManagedControlPlane
+	ManagedMachinePool  *expinfrav1.AWSManagedMachinePool
+	MachinePool         *clusterv1.MachinePool
+	EC2Scope            EC2Scope
+	MaxWaitCreateUpdate time.Duration
+	MaxWaitDelete       time.Duration
 
 	session         awsv2.Config
 	serviceLimiters throttle.ServiceLimiters
@@ -142,6 +169,10 @@ type ManagedMachinePoolScope struct {
 
 	enableIAM            bool
 	allowAdditionalRoles bool
+	requiredRolePolicies []string
+
+	waitForNodegroupUpdates bool
+	priceTable              *ekspkg.PriceTable
 }
 
 // ManagedPoolName returns the managed machine pool name.
@@ -172,6 +203,25 @@ func (s *ManagedMachinePoolScope) AllowAdditionalRoles() bool {
 	return s.allowAdditionalRoles
 }
 
+// RequiredRolePolicies returns the policies the node group role is required to have
+// on top of the default node policies. Each policy is either an ARN or the name of
+// an AWS managed policy.
+func (s *ManagedMachinePoolScope) RequiredRolePolicies() []string {
+	return s.requiredRolePolicies
+}
+
+// WaitForNodegroupUpdates indicates that reconciliation should block until an update
+// of the nodegroup has completed, instead of requeueing until the nodegroup is active.
+func (s *ManagedMachinePoolScope) WaitForNodegroupUpdates() bool {
+	return s.waitForNodegroupUpdates
+}
+
+// PriceTable returns the prices used to estimate the hourly cost of the nodegroup, or nil
+// when cost estimates are disabled.
+func (s *ManagedMachinePoolScope) PriceTable() *ekspkg.PriceTable {
+	return s.priceTable
+}
+
 // Partition returns the machine pool subnet IDs.
 func (s *ManagedMachinePoolScope) Partition() string {
 	return endpoints.GetPartitionFromRegion(s.ControlPlane.Spec.Region)
@@ -200,6 +250,54 @@ func (s *ManagedMachinePoolScope) RoleName() string {
 	return s.ManagedMachinePool.Spec.RoleName
 }
 
+// NodeLabels returns the labels for the nodes of the node group. The control
+// plane's default node labels are merged with the pool's labels, with the pool's
+// labels taking precedence.
+func (s *ManagedMachinePoolScope) NodeLabels() map[string]string {
+	defaults := s.ControlPlane.Spec.DefaultNodeLabels
+	poolLabels := s.ManagedMachinePool.Spec.Labels
+	if len(defaults) == 0 && len(poolLabels) == 0 {
+		return nil
+	}
+
+	labels := make(map[string]string, len(defaults)+len(poolLabels))
+	for k, v := range defaults {
+		labels[k] = v
+	}
+	for k, v := range poolLabels {
+		labels[k] = v
+	}
+
+	return labels
+}
+
+// NodeTaints returns the taints for the nodes of the node group. The control
+// plane's default node taints are merged with the pool's taints, with a pool
+// taint replacing any default taint that has the same key and effect.
+func (s *ManagedMachinePoolScope) NodeTaints() expinfrav1.Taints {
+	defaults := s.ControlPlane.Spec.DefaultNodeTaints
+	poolTaints := s.ManagedMachinePool.Spec.Taints
+	if len(defaults) == 0 && len(poolTaints) == 0 {
+		return nil
+	}
+
+	taints := make(expinfrav1.Taints, 0, len(defaults)+len(poolTaints))
+	for _, defaultTaint := range defaults {
+		overridden := false
+		for _, poolTaint := range poolTaints {
+			if poolTaint.Key == defaultTaint.Key && poolTaint.Effect == defaultTaint.Effect {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			taints = append(taints, defaultTaint)
+		}
+	}
+
+	return append(taints, poolTaints...)
+}
+
 // Version returns the nodegroup Kubernetes version.
 func (s *ManagedMachinePoolScope) Version() *string {
 	if s.MachinePool.Spec.Template.Spec.Version == "" {
@@ -213,20 +311,116 @@ func (s *ManagedMachinePoolScope) ControlPlaneSubnets() infrav1.Subnets {
 	return s.ControlPlane.Spec.NetworkSpec.Subnets
 }
 
-// SubnetIDs returns the machine pool subnet IDs.
+// SubnetIDs returns the machine pool subnet IDs. Without subnets or availability zones in the
+// spec, they are resolved from the failure domains of the MachinePool.
 func (s *ManagedMachinePoolScope) SubnetIDs() ([]string, error) {
 	strategy, err := newDefaultSubnetPlacementStrategy(&s.Logger)
 	if err != nil {
 		return []string{}, fmt.Errorf("getting subnet placement strategy: %w", err)
 	}
 
-	return strategy.Place(&placementInput{
+	subnetIDs, err := strategy.Place(&placementInput{
 		SpecSubnetIDs:           s.ManagedMachinePool.Spec.SubnetIDs,
 		SpecAvailabilityZones:   s.ManagedMachinePool.Spec.AvailabilityZones,
 		ParentAvailabilityZones: s.MachinePool.Spec.FailureDomains,
 		ControlplaneSubnets:     s.ControlPlaneSubnets(),
 		SubnetPlacementType:     s.ManagedMachinePool.Spec.AvailabilityZoneSubnetType,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(s.ManagedMachinePool.Spec.SubnetIDs) > 0 && len(s.ManagedMachinePool.Spec.AvailabilityZones) > 0 {
+		return s.subnetIDsInAvailabilityZones(subnetIDs)
+	}
+	return subnetIDs, nil
+}
+
+// subnetIDsInAvailabilityZones narrows the subnets listed in the spec to the ones the cluster
+// network has in the availability zones of the spec. Subnets that aren't part of the cluster
+// network are left out, as their availability zone isn't known.
+func (s *ManagedMachinePoolScope) subnetIDsInAvailabilityZones(subnetIDs []string) ([]string, error) {
+	zones := s.ManagedMachinePool.Spec.AvailabilityZones
+	clusterSubnets := s.ControlPlaneSubnets()
+
+	placed := []string{}
+	for _, id := range subnetIDs {
+		subnet := clusterSubnets.FindByID(id)
+		if subnet == nil || !slices.Contains(zones, subnet.AvailabilityZone) {
+			s.Debug("Leaving out subnet outside of the availability zones of the machine pool", "subnet", id, "availability-zones", zones)
+			continue
+		}
+		placed = append(placed, id)
+	}
+	if len(placed) == 0 {
+		return nil, fmt.Errorf("none of the subnets %v is in the availability zones %v of the cluster network: %w", subnetIDs, zones, ErrAZSubnetsNotFound)
+	}
+	return placed, nil
+}
+
+// ClusterSecurityGroupOverride returns the security group to use instead of the EKS cluster security group, if any.
+func (s *ManagedMachinePoolScope) ClusterSecurityGroupOverride() *infrav1.AWSResourceReference {
+	return s.ManagedMachinePool.Spec.ClusterSecurityGroupOverride
+}
+
+// ReportsBootstrapReadiness returns whether the bootstrap config of the MachinePool, an EKSConfig
+// or a NodeadmConfig, makes the instances tag themselves once their kubelet is healthy.
+func (s *ManagedMachinePoolScope) ReportsBootstrapReadiness(ctx context.Context) (bool, error) {
+	ref := s.MachinePool.Spec.Template.Spec.Bootstrap.ConfigRef
+	if !ref.IsDefined() {
+		return false, nil
+	}
+
+	config, err := external.GetObjectFromContractVersionedRef(ctx, s.Client, ref, s.MachinePool.Namespace)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get bootstrap config %s/%s", s.MachinePool.Namespace, ref.Name)
+	}
+	enabled, _, err := unstructured.NestedBool(config.Object, "spec", "reportBootstrapReadiness")
+	return enabled, err
+}
+
+// RemovedSubnetIDs returns the given nodegroup subnet IDs that are no longer part of the cluster
+// network. Subnets explicitly listed in the machine pool spec are never reported, as they are not
+// resolved from the cluster network.
+func (s *ManagedMachinePoolScope) RemovedSubnetIDs(subnetIDs []string) []string {
+	clusterSubnets := s.ControlPlaneSubnets()
+	if len(clusterSubnets) == 0 {
+		return nil
+	}
+
+	removed := []string{}
+	for _, id := range subnetIDs {
+		if slices.Contains(s.ManagedMachinePool.Spec.SubnetIDs, id) {
+			continue
+		}
+		if clusterSubnets.FindByID(id) == nil {
+			removed = append(removed, id)
+		}
+	}
+	return removed
+}
+
+// FailureDomainsChanged reports whether the given nodegroup subnets are in other availability zones
+// than the failure domains of the MachinePool, and returns these zones. Nothing is reported when the
+// subnets aren't resolved from the failure domains, or for subnets that aren't part of the cluster
+// network, as their availability zone isn't known.
+func (s *ManagedMachinePoolScope) FailureDomainsChanged(subnetIDs []string) ([]string, bool) {
+	failureDomains := s.MachinePool.Spec.FailureDomains
+	if len(failureDomains) == 0 || len(s.ManagedMachinePool.Spec.SubnetIDs) > 0 || len(s.ManagedMachinePool.Spec.AvailabilityZones) > 0 {
+		return nil, false
+	}
+
+	clusterSubnets := s.ControlPlaneSubnets()
+	zones := sets.New[string]()
+	for _, id := range subnetIDs {
+		if subnet := clusterSubnets.FindByID(id); subnet != nil {
+			zones.Insert(subnet.AvailabilityZone)
+		}
+	}
+	if zones.Len() == 0 {
+		return nil, false
+	}
+	return sets.List(zones), !zones.Equal(sets.New(failureDomains...))
 }
 
 // NodegroupReadyFalse marks the ready condition false using warning if error isn't
@@ -279,6 +473,12 @@ func (s *ManagedMachinePoolScope) PatchObject() error {
 		v1beta1patch.WithOwnedConditions{Conditions: []clusterv1beta1.ConditionType{
 			expinfrav1.EKSNodegroupReadyCondition,
 			expinfrav1.IAMNodegroupRolesReadyCondition,
+			expinfrav1.EKSNodegroupReplicasAvailableCondition,
+			expinfrav1.EKSNodegroupReplicasSourceCondition,
+			expinfrav1.EKSNodegroupHealthyCondition,
+			expinfrav1.EKSNodegroupNodesJoinedCondition,
+			expinfrav1.EKSNodegroupConfigUpToDateCondition,
+			expinfrav1.EKSNodegroupScaleDownAllowedCondition,
 		}})
 }
 
@@ -295,6 +495,22 @@ func (s *ManagedMachinePoolScope) Close() error {
 	return s.PatchObject()
 }
 
+// RemoteClient returns the Kubernetes client for connecting to the workload cluster.
+func (s *ManagedMachinePoolScope) RemoteClient() (client.Client, error) {
+	clusterKey := client.ObjectKey{
+		Name:      s.Cluster.Name,
+		Namespace: s.Cluster.Namespace,
+	}
+
+	restConfig, err := remote.RESTConfig(context.Background(), s.ControllerName(), s.Client, clusterKey)
+	if err != nil {
+		return nil, fmt.Errorf("getting remote rest config for %s/%s: %w", clusterKey.Namespace, clusterKey.Name, err)
+	}
+	restConfig.Timeout = 1 * time.Minute
+
+	return client.New(restConfig, client.Options{Scheme: scheme})
+}
+
 // InfraCluster returns the AWS infrastructure cluster or control plane object.
 func (s *ManagedMachinePoolScope) InfraCluster() cloud.ClusterObject {
 	return s.ControlPlane
@@ -382,6 +598,34 @@ func (s *ManagedMachinePoolScope) IsEKSManaged() bool {
 	return true
 }
 
+// InstanceTypes returns the instance types of the nodes of the node group, taking
+// the deprecated InstanceType into account. A node group using a launch template
+// gets its instance type from the launch template unless the pool sets instance
+// types, otherwise the control plane's default instance type is used when the pool
+// doesn't set one.
+func (s *ManagedMachinePoolScope) InstanceTypes() []string {
+	pool := s.ManagedMachinePool.Spec
+	if len(pool.InstanceTypes) > 0 {
+		return pool.InstanceTypes
+	}
+	if pool.InstanceType != nil {
+		return []string{*pool.InstanceType}
+	}
+	if pool.AWSLaunchTemplate != nil || pool.ExternalLaunchTemplate != nil {
+		return nil
+	}
+	if instanceType := s.ControlPlane.Spec.DefaultNodeInstanceType; instanceType != nil {
+		return []string{*instanceType}
+	}
+	return nil
+}
+
+// DefaultVolumeEncryption returns the control plane's default encryption for
+// the volumes of the node group's launch template.
+func (s *ManagedMachinePoolScope) DefaultVolumeEncryption() *infrav1.VolumeEncryption {
+	return s.ControlPlane.Spec.DefaultNodeVolumeEncryption
+}
+
 // GetLaunchTemplateIDStatus returns the launch template ID status.
 func (s *ManagedMachinePoolScope) GetLaunchTemplateIDStatus() string {
 	if s.ManagedMachinePool.Status.LaunchTemplateID != nil {
@@ -408,9 +652,21 @@ func (s *ManagedMachinePoolScope) SetLaunchTemplateLatestVersionStatus(version s
 	s.ManagedMachinePool.Status.LaunchTemplateVersion = &version
 }
 
-// GetLaunchTemplate returns the launch template.
+// GetLaunchTemplatePinnedVersion returns the launch template version the nodegroup is pinned to.
+func (s *ManagedMachinePoolScope) GetLaunchTemplatePinnedVersion() *int64 {
+	return s.ManagedMachinePool.Spec.LaunchTemplateVersion
+}
+
+// GetLaunchTemplate returns the launch template. While a fallback instance type is in use
+// because of insufficient capacity, it replaces the launch template's instance type.
 func (s *ManagedMachinePoolScope) GetLaunchTemplate() *expinfrav1.AWSLaunchTemplate {
-	return s.ManagedMachinePool.Spec.AWSLaunchTemplate
+	lt := s.ManagedMachinePool.Spec.AWSLaunchTemplate
+	if lt == nil || s.ManagedMachinePool.Status.InstanceTypeFallback == nil {
+		return lt
+	}
+	lt = lt.DeepCopy()
+	lt.InstanceType = s.ManagedMachinePool.Status.InstanceTypeFallback.InstanceType
+	return lt
 }
 
 // GetMachinePool returns the machine pool.
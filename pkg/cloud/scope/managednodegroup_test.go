@@ -0,0 +1,580 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestManagedMachinePoolScopeNodeLabels(t *testing.T) {
+	testCases := []struct {
+		name          string
+		defaultLabels map[string]string
+		poolLabels    map[string]string
+		expected      map[string]string
+	}{
+		{
+			name:     "no labels",
+			expected: nil,
+		},
+		{
+			name:          "only defaults",
+			defaultLabels: map[string]string{"team": "platform"},
+			expected:      map[string]string{"team": "platform"},
+		},
+		{
+			name:       "only pool labels",
+			poolLabels: map[string]string{"workload": "batch"},
+			expected:   map[string]string{"workload": "batch"},
+		},
+		{
+			name:          "pool labels override defaults",
+			defaultLabels: map[string]string{"team": "platform", "env": "dev"},
+			poolLabels:    map[string]string{"env": "prod", "workload": "batch"},
+			expected:      map[string]string{"team": "platform", "env": "prod", "workload": "batch"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &ManagedMachinePoolScope{
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						DefaultNodeLabels: tc.defaultLabels,
+					},
+				},
+				ManagedMachinePool: &expinfrav1.AWSManagedMachinePool{
+					Spec: expinfrav1.AWSManagedMachinePoolSpec{
+						Labels: tc.poolLabels,
+					},
+				},
+			}
+			g.Expect(s.NodeLabels()).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestManagedMachinePoolScopeNodeTaints(t *testing.T) {
+	testCases := []struct {
+		name          string
+		defaultTaints expinfrav1.Taints
+		poolTaints    expinfrav1.Taints
+		expected      expinfrav1.Taints
+	}{
+		{
+			name:     "no taints",
+			expected: nil,
+		},
+		{
+			name: "only defaults",
+			defaultTaints: expinfrav1.Taints{
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoSchedule},
+			},
+			expected: expinfrav1.Taints{
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoSchedule},
+			},
+		},
+		{
+			name: "pool taint overrides default with same key and effect",
+			defaultTaints: expinfrav1.Taints{
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoSchedule},
+				{Key: "spot", Value: "true", Effect: expinfrav1.TaintEffectPreferNoSchedule},
+			},
+			poolTaints: expinfrav1.Taints{
+				{Key: "dedicated", Value: "gpu", Effect: expinfrav1.TaintEffectNoSchedule},
+			},
+			expected: expinfrav1.Taints{
+				{Key: "spot", Value: "true", Effect: expinfrav1.TaintEffectPreferNoSchedule},
+				{Key: "dedicated", Value: "gpu", Effect: expinfrav1.TaintEffectNoSchedule},
+			},
+		},
+		{
+			name: "pool taint with same key but different effect does not override default",
+			defaultTaints: expinfrav1.Taints{
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoSchedule},
+			},
+			poolTaints: expinfrav1.Taints{
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoExecute},
+			},
+			expected: expinfrav1.Taints{
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoSchedule},
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoExecute},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &ManagedMachinePoolScope{
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						DefaultNodeTaints: tc.defaultTaints,
+					},
+				},
+				ManagedMachinePool: &expinfrav1.AWSManagedMachinePool{
+					Spec: expinfrav1.AWSManagedMachinePoolSpec{
+						Taints: tc.poolTaints,
+					},
+				},
+			}
+			g.Expect(s.NodeTaints()).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestManagedMachinePoolScopeRemovedSubnetIDs(t *testing.T) {
+	clusterSubnets := infrav1.Subnets{
+		{ID: "subnet-1", AvailabilityZone: "us-east-1a"},
+		{ID: "subnet-2", AvailabilityZone: "us-east-1b"},
+	}
+
+	testCases := []struct {
+		name           string
+		clusterSubnets infrav1.Subnets
+		specSubnetIDs  []string
+		nodegroupIDs   []string
+		expected       []string
+	}{
+		{
+			name:           "all subnets still in the cluster network",
+			clusterSubnets: clusterSubnets,
+			nodegroupIDs:   []string{"subnet-1", "subnet-2"},
+			expected:       []string{},
+		},
+		{
+			name:           "subnet removed from the cluster network",
+			clusterSubnets: clusterSubnets,
+			nodegroupIDs:   []string{"subnet-1", "subnet-3"},
+			expected:       []string{"subnet-3"},
+		},
+		{
+			name:           "explicit spec subnets are not reported",
+			clusterSubnets: clusterSubnets,
+			specSubnetIDs:  []string{"subnet-3"},
+			nodegroupIDs:   []string{"subnet-3"},
+			expected:       []string{},
+		},
+		{
+			name:         "cluster network has no subnets",
+			nodegroupIDs: []string{"subnet-3"},
+			expected:     nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &ManagedMachinePoolScope{
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						NetworkSpec: infrav1.NetworkSpec{Subnets: tc.clusterSubnets},
+					},
+				},
+				ManagedMachinePool: &expinfrav1.AWSManagedMachinePool{
+					Spec: expinfrav1.AWSManagedMachinePoolSpec{
+						SubnetIDs: tc.specSubnetIDs,
+					},
+				},
+			}
+			g.Expect(s.RemovedSubnetIDs(tc.nodegroupIDs)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestManagedMachinePoolScopeFailureDomainsChanged(t *testing.T) {
+	clusterSubnets := infrav1.Subnets{
+		{ID: "subnet-1a", AvailabilityZone: "us-east-1a"},
+		{ID: "subnet-1b", AvailabilityZone: "us-east-1b"},
+		{ID: "subnet-1c", AvailabilityZone: "us-east-1c"},
+	}
+
+	testCases := []struct {
+		name            string
+		pool            expinfrav1.AWSManagedMachinePoolSpec
+		failureDomains  []string
+		nodegroupIDs    []string
+		expectedZones   []string
+		expectedChanged bool
+	}{
+		{
+			name:           "unchanged failure domains",
+			failureDomains: []string{"us-east-1b", "us-east-1a"},
+			nodegroupIDs:   []string{"subnet-1a", "subnet-1b"},
+			expectedZones:  []string{"us-east-1a", "us-east-1b"},
+		},
+		{
+			name:            "failure domain added",
+			failureDomains:  []string{"us-east-1a", "us-east-1b", "us-east-1c"},
+			nodegroupIDs:    []string{"subnet-1a", "subnet-1b"},
+			expectedZones:   []string{"us-east-1a", "us-east-1b"},
+			expectedChanged: true,
+		},
+		{
+			name:            "failure domain replaced",
+			failureDomains:  []string{"us-east-1a", "us-east-1c"},
+			nodegroupIDs:    []string{"subnet-1a", "subnet-1b"},
+			expectedZones:   []string{"us-east-1a", "us-east-1b"},
+			expectedChanged: true,
+		},
+		{
+			name:           "subnets outside of the cluster network are ignored",
+			failureDomains: []string{"us-east-1a"},
+			nodegroupIDs:   []string{"subnet-1a", "subnet-other"},
+			expectedZones:  []string{"us-east-1a"},
+		},
+		{
+			name:         "no failure domains",
+			nodegroupIDs: []string{"subnet-1a", "subnet-1b"},
+		},
+		{
+			name:           "spec subnets take precedence over failure domains",
+			pool:           expinfrav1.AWSManagedMachinePoolSpec{SubnetIDs: []string{"subnet-1a"}},
+			failureDomains: []string{"us-east-1b"},
+			nodegroupIDs:   []string{"subnet-1a"},
+		},
+		{
+			name:           "spec availability zones take precedence over failure domains",
+			pool:           expinfrav1.AWSManagedMachinePoolSpec{AvailabilityZones: []string{"us-east-1a"}},
+			failureDomains: []string{"us-east-1b"},
+			nodegroupIDs:   []string{"subnet-1a"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &ManagedMachinePoolScope{
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						NetworkSpec: infrav1.NetworkSpec{Subnets: clusterSubnets},
+					},
+				},
+				ManagedMachinePool: &expinfrav1.AWSManagedMachinePool{Spec: tc.pool},
+				MachinePool: &clusterv1.MachinePool{
+					Spec: clusterv1.MachinePoolSpec{FailureDomains: tc.failureDomains},
+				},
+			}
+			zones, changed := s.FailureDomainsChanged(tc.nodegroupIDs)
+			g.Expect(changed).To(Equal(tc.expectedChanged))
+			g.Expect(zones).To(Equal(tc.expectedZones))
+		})
+	}
+}
+
+func TestManagedMachinePoolScopeSubnetIDs(t *testing.T) {
+	clusterSubnets := infrav1.Subnets{
+		{ID: "subnet-1a-private", AvailabilityZone: "us-east-1a"},
+		{ID: "subnet-1a-public", AvailabilityZone: "us-east-1a", IsPublic: true},
+		{ID: "subnet-1b-private", AvailabilityZone: "us-east-1b"},
+		{ID: "subnet-1c-private", AvailabilityZone: "us-east-1c"},
+	}
+
+	testCases := []struct {
+		name           string
+		pool           expinfrav1.AWSManagedMachinePoolSpec
+		failureDomains []string
+		expected       []string
+		expectedErr    error
+	}{
+		{
+			name:     "private subnets of the cluster network",
+			expected: []string{"subnet-1a-private", "subnet-1b-private", "subnet-1c-private"},
+		},
+		{
+			name: "subnets of the availability zone",
+			pool: expinfrav1.AWSManagedMachinePoolSpec{
+				AvailabilityZones:          []string{"us-east-1b"},
+				AvailabilityZoneSubnetType: expinfrav1.NewAZSubnetType(expinfrav1.AZSubnetTypePrivate),
+			},
+			expected: []string{"subnet-1b-private"},
+		},
+		{
+			name:           "subnets of the machine pool failure domains",
+			pool:           expinfrav1.AWSManagedMachinePoolSpec{AvailabilityZoneSubnetType: expinfrav1.NewAZSubnetType(expinfrav1.AZSubnetTypePrivate)},
+			failureDomains: []string{"us-east-1a", "us-east-1c"},
+			expected:       []string{"subnet-1a-private", "subnet-1c-private"},
+		},
+		{
+			name: "spec availability zones take precedence over failure domains",
+			pool: expinfrav1.AWSManagedMachinePoolSpec{
+				AvailabilityZones:          []string{"us-east-1b"},
+				AvailabilityZoneSubnetType: expinfrav1.NewAZSubnetType(expinfrav1.AZSubnetTypePrivate),
+			},
+			failureDomains: []string{"us-east-1a"},
+			expected:       []string{"subnet-1b-private"},
+		},
+		{
+			name: "spec subnets",
+			pool: expinfrav1.AWSManagedMachinePoolSpec{
+				SubnetIDs: []string{"subnet-1a-private", "subnet-1b-private"},
+			},
+			expected: []string{"subnet-1a-private", "subnet-1b-private"},
+		},
+		{
+			name: "spec subnets narrowed to the availability zones",
+			pool: expinfrav1.AWSManagedMachinePoolSpec{
+				SubnetIDs:         []string{"subnet-1a-private", "subnet-1a-public", "subnet-1b-private", "subnet-1c-private"},
+				AvailabilityZones: []string{"us-east-1a", "us-east-1c"},
+			},
+			expected: []string{"subnet-1a-private", "subnet-1a-public", "subnet-1c-private"},
+		},
+		{
+			name: "spec subnets outside of the cluster network are left out",
+			pool: expinfrav1.AWSManagedMachinePoolSpec{
+				SubnetIDs:         []string{"subnet-other", "subnet-1b-private"},
+				AvailabilityZones: []string{"us-east-1b"},
+			},
+			expected: []string{"subnet-1b-private"},
+		},
+		{
+			name: "no spec subnet in the availability zones",
+			pool: expinfrav1.AWSManagedMachinePoolSpec{
+				SubnetIDs:         []string{"subnet-1a-private", "subnet-other"},
+				AvailabilityZones: []string{"us-east-1b"},
+			},
+			expectedErr: ErrAZSubnetsNotFound,
+		},
+		{
+			name: "no subnet of the cluster network in the availability zone",
+			pool: expinfrav1.AWSManagedMachinePoolSpec{
+				AvailabilityZones: []string{"us-east-1d"},
+			},
+			expectedErr: ErrAZSubnetsNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &ManagedMachinePoolScope{
+				Logger: *logger.NewLogger(klog.Background()),
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						NetworkSpec: infrav1.NetworkSpec{Subnets: clusterSubnets},
+					},
+				},
+				ManagedMachinePool: &expinfrav1.AWSManagedMachinePool{Spec: tc.pool},
+				MachinePool: &clusterv1.MachinePool{
+					Spec: clusterv1.MachinePoolSpec{FailureDomains: tc.failureDomains},
+				},
+			}
+
+			subnetIDs, err := s.SubnetIDs()
+			if tc.expectedErr != nil {
+				g.Expect(err).To(MatchError(tc.expectedErr))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(subnetIDs).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestManagedMachinePoolScopeInstanceTypes(t *testing.T) {
+	testCases := []struct {
+		name                string
+		defaultInstanceType *string
+		pool                expinfrav1.AWSManagedMachinePoolSpec
+		expected            []string
+	}{
+		{
+			name:     "no instance type",
+			expected: nil,
+		},
+		{
+			name:                "pool inherits the default",
+			defaultInstanceType: ptr.To("m5.large"),
+			expected:            []string{"m5.large"},
+		},
+		{
+			name:                "pool instance type overrides the default",
+			defaultInstanceType: ptr.To("m5.large"),
+			pool:                expinfrav1.AWSManagedMachinePoolSpec{InstanceType: ptr.To("c5.xlarge")},
+			expected:            []string{"c5.xlarge"},
+		},
+		{
+			name:                "pool instance types override the default",
+			defaultInstanceType: ptr.To("m5.large"),
+			pool:                expinfrav1.AWSManagedMachinePoolSpec{InstanceTypes: []string{"c5.xlarge", "c6i.xlarge"}},
+			expected:            []string{"c5.xlarge", "c6i.xlarge"},
+		},
+		{
+			name: "instance types take precedence over the deprecated instance type",
+			pool: expinfrav1.AWSManagedMachinePoolSpec{
+				InstanceType:  ptr.To("m5.large"),
+				InstanceTypes: []string{"c5.xlarge", "c6i.xlarge"},
+			},
+			expected: []string{"c5.xlarge", "c6i.xlarge"},
+		},
+		{
+			name: "instance types are used with a launch template",
+			pool: expinfrav1.AWSManagedMachinePoolSpec{
+				InstanceTypes:     []string{"c5.xlarge", "c6i.xlarge"},
+				AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{},
+			},
+			expected: []string{"c5.xlarge", "c6i.xlarge"},
+		},
+		{
+			name:                "default isn't used with a launch template",
+			defaultInstanceType: ptr.To("m5.large"),
+			pool:                expinfrav1.AWSManagedMachinePoolSpec{AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{}},
+			expected:            nil,
+		},
+		{
+			name:                "default isn't used with an external launch template",
+			defaultInstanceType: ptr.To("m5.large"),
+			pool:                expinfrav1.AWSManagedMachinePoolSpec{ExternalLaunchTemplate: &expinfrav1.ExternalLaunchTemplate{}},
+			expected:            nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &ManagedMachinePoolScope{
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						DefaultNodeInstanceType: tc.defaultInstanceType,
+					},
+				},
+				ManagedMachinePool: &expinfrav1.AWSManagedMachinePool{
+					Spec: tc.pool,
+				},
+			}
+			g.Expect(s.InstanceTypes()).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestManagedMachinePoolScopeGetLaunchTemplate(t *testing.T) {
+	g := NewWithT(t)
+	s := &ManagedMachinePoolScope{
+		ManagedMachinePool: &expinfrav1.AWSManagedMachinePool{
+			Spec: expinfrav1.AWSManagedMachinePoolSpec{
+				AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{Name: "lt", InstanceType: "m5.large"},
+			},
+		},
+	}
+	g.Expect(s.GetLaunchTemplate().InstanceType).To(Equal("m5.large"))
+
+	s.ManagedMachinePool.Status.InstanceTypeFallback = &expinfrav1.InstanceTypeFallbackStatus{InstanceType: "m5a.large"}
+	lt := s.GetLaunchTemplate()
+	g.Expect(lt.Name).To(Equal("lt"))
+	g.Expect(lt.InstanceType).To(Equal("m5a.large"))
+	g.Expect(s.ManagedMachinePool.Spec.AWSLaunchTemplate.InstanceType).To(Equal("m5.large"))
+}
+
+func TestManagedMachinePoolScopeReportsBootstrapReadiness(t *testing.T) {
+	crd := func(plural string) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   plural + "." + eksbootstrapv1.GroupVersion.Group,
+				Labels: map[string]string{"cluster.x-k8s.io/v1beta2": eksbootstrapv1.GroupVersion.Version},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		configRef clusterv1.ContractVersionedObjectReference
+		expected  bool
+		expectErr bool
+	}{
+		{
+			name: "no bootstrap config",
+		},
+		{
+			name:      "EKSConfig reporting the readiness",
+			configRef: clusterv1.ContractVersionedObjectReference{APIGroup: eksbootstrapv1.GroupVersion.Group, Kind: "EKSConfig", Name: "reporting"},
+			expected:  true,
+		},
+		{
+			name:      "NodeadmConfig reporting the readiness",
+			configRef: clusterv1.ContractVersionedObjectReference{APIGroup: eksbootstrapv1.GroupVersion.Group, Kind: "NodeadmConfig", Name: "reporting"},
+			expected:  true,
+		},
+		{
+			name:      "EKSConfig not reporting the readiness",
+			configRef: clusterv1.ContractVersionedObjectReference{APIGroup: eksbootstrapv1.GroupVersion.Group, Kind: "EKSConfig", Name: "default"},
+		},
+		{
+			name:      "missing bootstrap config",
+			configRef: clusterv1.ContractVersionedObjectReference{APIGroup: eksbootstrapv1.GroupVersion.Group, Kind: "EKSConfig", Name: "missing"},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = apiextensionsv1.AddToScheme(scheme)
+			_ = eksbootstrapv1.AddToScheme(scheme)
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				crd("eksconfigs"),
+				crd("nodeadmconfigs"),
+				&eksbootstrapv1.EKSConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "reporting", Namespace: "default"},
+					Spec:       eksbootstrapv1.EKSConfigSpec{ReportBootstrapReadiness: ptr.To(true)},
+				},
+				&eksbootstrapv1.EKSConfig{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"}},
+				&eksbootstrapv1.NodeadmConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "reporting", Namespace: "default"},
+					Spec:       eksbootstrapv1.NodeadmConfigSpec{ReportBootstrapReadiness: ptr.To(true)},
+				},
+			).Build()
+
+			s := &ManagedMachinePoolScope{
+				Client: c,
+				MachinePool: &clusterv1.MachinePool{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+					Spec: clusterv1.MachinePoolSpec{
+						Template: clusterv1.MachineTemplateSpec{
+							Spec: clusterv1.MachineSpec{Bootstrap: clusterv1.Bootstrap{ConfigRef: tt.configRef}},
+						},
+					},
+				},
+			}
+
+			enabled, err := s.ReportsBootstrapReadiness(context.TODO())
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(enabled).To(Equal(tt.expected))
+		})
+	}
+}
@@ -35,6 +35,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/identity"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/throttle"
@@ -48,6 +49,9 @@ import (
 
 const (
 	notPermittedError = "Namespace is not permitted to use %s: %s"
+
+	// maxRoleSessionNameLength is the maximum length of the session name of an assumed role.
+	maxRoleSessionNameLength = 64
 )
 
 var (
@@ -383,9 +387,34 @@ func getProvidersForCluster(ctx context.Context, k8sClient client.Client, cluste
 		return nil, err
 	}
 
+	// The role assumed for an EKS control plane gets a session name identifying the cluster, so
+	// that its calls can be traced in CloudTrail. Roles the identity is chained from keep theirs.
+	if controlPlane, ok := clusterScoper.InfraCluster().(*ekscontrolplanev1.AWSManagedControlPlane); ok && len(providers) > 0 {
+		if roleProvider, ok := providers[len(providers)-1].(*identity.AWSRolePrincipalTypeProvider); ok {
+			roleProvider.Principal.Spec.SessionName = controlPlaneRoleSessionName(controlPlane, roleProvider.Principal.Spec.SessionName)
+		}
+	}
+
 	return providers, nil
 }
 
+// controlPlaneRoleSessionName returns the session name of the role assumed for an EKS control
+// plane: the one of the control plane, else the one of the identity, else one naming CAPA and
+// the control plane.
+func controlPlaneRoleSessionName(controlPlane *ekscontrolplanev1.AWSManagedControlPlane, identitySessionName string) string {
+	if controlPlane.Spec.RoleSessionName != "" {
+		return controlPlane.Spec.RoleSessionName
+	}
+	if identitySessionName != "" {
+		return identitySessionName
+	}
+	sessionName := fmt.Sprintf("capa-%s-%s", controlPlane.Namespace, controlPlane.Name)
+	if len(sessionName) > maxRoleSessionNameLength {
+		sessionName = sessionName[:maxRoleSessionNameLength]
+	}
+	return sessionName
+}
+
 func isClusterPermittedToUsePrincipal(k8sClient client.Client, allowedNs *infrav1.AllowedNamespaces, clusterNamespace string) (bool, error) {
 	// nil value does not match with any namespaces
 	if allowedNs == nil {
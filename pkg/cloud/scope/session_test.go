@@ -31,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/identity"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/util/system"
@@ -502,3 +503,85 @@ func TestPrincipalParsing(t *testing.T) {
 		})
 	}
 }
+
+func TestControlPlaneRoleSessionName(t *testing.T) {
+	testCases := []struct {
+		name                string
+		controlPlaneName    string
+		roleSessionName     string
+		identitySessionName string
+		expectedSessionName string
+	}{
+		{
+			name:                "defaults to a session name identifying the control plane",
+			controlPlaneName:    "my-cluster",
+			expectedSessionName: "capa-default-my-cluster",
+		},
+		{
+			name:                "session name of the identity",
+			controlPlaneName:    "my-cluster",
+			identitySessionName: "identity-session",
+			expectedSessionName: "identity-session",
+		},
+		{
+			name:                "session name of the control plane takes precedence",
+			controlPlaneName:    "my-cluster",
+			roleSessionName:     "my-cluster@audit",
+			identitySessionName: "identity-session",
+			expectedSessionName: "my-cluster@audit",
+		},
+		{
+			name:                "default session name is truncated",
+			controlPlaneName:    "a-very-long-control-plane-name-exceeding-the-session-name-limit",
+			expectedSessionName: "capa-default-a-very-long-control-plane-name-exceeding-the-sessio",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			roleIdentity := &infrav1.AWSClusterRoleIdentity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "role-identity",
+				},
+				Spec: infrav1.AWSClusterRoleIdentitySpec{
+					AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+						AllowedNamespaces: &infrav1.AllowedNamespaces{},
+					},
+					AWSRoleSpec: infrav1.AWSRoleSpec{
+						RoleArn:     "role-arn",
+						SessionName: tc.identitySessionName,
+					},
+				},
+			}
+			g.Expect(k8sClient.Create(context.Background(), roleIdentity)).To(Succeed())
+
+			managedScope := &ManagedControlPlaneScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: tc.controlPlaneName, Namespace: "default"},
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						IdentityRef: &infrav1.AWSIdentityReference{
+							Name: "role-identity",
+							Kind: infrav1.ClusterRoleIdentityKind,
+						},
+						RoleSessionName: tc.roleSessionName,
+					},
+				},
+			}
+
+			providers, err := getProvidersForCluster(context.Background(), k8sClient, managedScope, "us-west-2", logger.NewLogger(klog.Background()))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(providers).To(HaveLen(1))
+			roleProvider, ok := providers[0].(*identity.AWSRolePrincipalTypeProvider)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(roleProvider.Principal.Spec.SessionName).To(Equal(tc.expectedSessionName))
+		})
+	}
+}
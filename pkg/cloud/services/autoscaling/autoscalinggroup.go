@@ -37,6 +37,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/utils"
 	"sigs.k8s.io/cluster-api/util/annotations"
@@ -52,6 +53,9 @@ func (s *Service) SDKToAutoScalingGroup(v *autoscalingtypes.AutoScalingGroup) (*
 		MaxSize:           aws.ToInt32(v.MaxSize), //#nosec G115
 		MinSize:           aws.ToInt32(v.MinSize), //#nosec G115
 		CapacityRebalance: aws.ToBool(v.CapacityRebalance),
+		HealthCheckType:   expinfrav1.HealthCheckType(aws.ToString(v.HealthCheckType)),
+		LoadBalancerNames: v.LoadBalancerNames,
+		TargetGroupARNs:   v.TargetGroupARNs,
 		// TODO: determine what additional values go here and what else should be in the struct
 	}
 
@@ -205,6 +209,10 @@ func (s *Service) CreateASG(machinePoolScope *scope.MachinePoolScope) (*expinfra
 		input.DesiredCapacity = aws.Int32(*desiredCapacity)
 	}
 
+	if healthCheckType := machinePoolScope.AWSMachinePool.Spec.HealthCheckType; healthCheckType != nil {
+		input.HealthCheckType = aws.String(string(*healthCheckType))
+	}
+
 	if machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy != nil {
 		input.MixedInstancesPolicy = createSDKMixedInstancesPolicy(name, machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy)
 	} else {
@@ -289,6 +297,10 @@ func (s *Service) UpdateASG(machinePoolScope *scope.MachinePoolScope) error {
 		input.DesiredCapacity = aws.Int32(*machinePoolScope.MachinePool.Spec.Replicas)
 	}
 
+	if healthCheckType := machinePoolScope.AWSMachinePool.Spec.HealthCheckType; healthCheckType != nil {
+		input.HealthCheckType = aws.String(string(*healthCheckType))
+	}
+
 	if machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy != nil {
 		input.MixedInstancesPolicy = createSDKMixedInstancesPolicy(machinePoolScope.Name(), machinePoolScope.AWSMachinePool.Spec.MixedInstancesPolicy)
 	} else {
@@ -377,7 +389,21 @@ func (s *Service) StartASGInstanceRefresh(scope *scope.MachinePoolScope) error {
 		},
 	}
 
-	if _, err := s.ASGClient.StartInstanceRefresh(context.TODO(), input); err != nil {
+	if !scope.InstanceRefreshForceUpdateEnabled() {
+		if _, err := s.ASGClient.StartInstanceRefresh(context.TODO(), input); err != nil {
+			return errors.Wrapf(err, "failed to start ASG instance refresh %q", scope.Name())
+		}
+		return nil
+	}
+
+	// A forced update may have just cancelled the previous refresh, which takes a moment
+	// to go through. Keep retrying until the new refresh can be started.
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if _, err := s.ASGClient.StartInstanceRefresh(context.TODO(), input); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, (&autoscalingtypes.InstanceRefreshInProgressFault{}).ErrorCode()); err != nil {
 		return errors.Wrapf(err, "failed to start ASG instance refresh %q", scope.Name())
 	}
 
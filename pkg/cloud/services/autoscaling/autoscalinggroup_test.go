@@ -149,6 +149,8 @@ func TestServiceSDKToAutoScalingGroup(t *testing.T) {
 				MaxSize:              aws.Int32(1234),
 				MinSize:              aws.Int32(1234),
 				CapacityRebalance:    aws.Bool(true),
+				HealthCheckType:      aws.String("ELB"),
+				TargetGroupARNs:      []string{"test-target-group"},
 				MixedInstancesPolicy: &autoscalingtypes.MixedInstancesPolicy{
 					InstancesDistribution: &autoscalingtypes.InstancesDistribution{
 						OnDemandAllocationStrategy:          aws.String("prioritized"),
@@ -173,6 +175,8 @@ func TestServiceSDKToAutoScalingGroup(t *testing.T) {
 				MaxSize:           int32(1234),
 				MinSize:           int32(1234),
 				CapacityRebalance: true,
+				HealthCheckType:   expinfrav1.HealthCheckTypeELB,
+				TargetGroupARNs:   []string{"test-target-group"},
 				MixedInstancesPolicy: &expinfrav1.MixedInstancesPolicy{
 					InstancesDistribution: &expinfrav1.InstancesDistribution{
 						OnDemandAllocationStrategy:          expinfrav1.OnDemandAllocationStrategyPrioritized,
@@ -764,6 +768,32 @@ func TestServiceUpdateASG(t *testing.T) {
 				})
 			},
 		},
+		{
+			name:                  "health check type is left unchanged when not set",
+			machinePoolName:       "update-asg-health-check-type-unset",
+			wantErr:               false,
+			setupMachinePoolScope: func(mps *scope.MachinePoolScope) {},
+			expect: func(e *mocks.MockEC2APIMockRecorder, m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder, g *WithT) {
+				m.UpdateAutoScalingGroup(context.TODO(), gomock.AssignableToTypeOf(&autoscaling.UpdateAutoScalingGroupInput{})).DoAndReturn(func(ctx context.Context, input *autoscaling.UpdateAutoScalingGroupInput, options ...autoscaling.Options) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+					g.Expect(input.HealthCheckType).To(BeNil())
+					return &autoscaling.UpdateAutoScalingGroupOutput{}, nil
+				})
+			},
+		},
+		{
+			name:            "health check type is switched to ELB",
+			machinePoolName: "update-asg-health-check-type-elb",
+			wantErr:         false,
+			setupMachinePoolScope: func(mps *scope.MachinePoolScope) {
+				mps.AWSMachinePool.Spec.HealthCheckType = ptr.To(expinfrav1.HealthCheckTypeELB)
+			},
+			expect: func(e *mocks.MockEC2APIMockRecorder, m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder, g *WithT) {
+				m.UpdateAutoScalingGroup(context.TODO(), gomock.AssignableToTypeOf(&autoscaling.UpdateAutoScalingGroupInput{})).DoAndReturn(func(ctx context.Context, input *autoscaling.UpdateAutoScalingGroupInput, options ...autoscaling.Options) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+					g.Expect(input.HealthCheckType).To(BeComparableTo(aws.String("ELB")))
+					return &autoscaling.UpdateAutoScalingGroupOutput{}, nil
+				})
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1205,9 +1235,10 @@ func TestServiceStartASGInstanceRefresh(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	tests := []struct {
-		name    string
-		wantErr bool
-		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		name               string
+		forceUpdateEnabled bool
+		wantErr            bool
+		expect             func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
 	}{
 		{
 			name:    "should return error if start instance refresh failed",
@@ -1241,6 +1272,44 @@ func TestServiceStartASGInstanceRefresh(t *testing.T) {
 					Return(&autoscaling.StartInstanceRefreshOutput{}, nil)
 			},
 		},
+		{
+			name:    "should not retry if an instance refresh is in progress and the update isn't forced",
+			wantErr: true,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.StartInstanceRefresh(context.TODO(), gomock.Any()).
+					Return(nil, &autoscalingtypes.InstanceRefreshInProgressFault{}).Times(1)
+			},
+		},
+		{
+			name:               "should retry until the cancelled instance refresh is gone if the update is forced",
+			forceUpdateEnabled: true,
+			wantErr:            false,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				gomock.InOrder(
+					m.StartInstanceRefresh(context.TODO(), gomock.Any()).
+						Return(nil, &autoscalingtypes.InstanceRefreshInProgressFault{}),
+					m.StartInstanceRefresh(context.TODO(), gomock.Eq(&autoscaling.StartInstanceRefreshInput{
+						AutoScalingGroupName: aws.String("mpn"),
+						Strategy:             autoscalingtypes.RefreshStrategyRolling,
+						Preferences: &autoscalingtypes.RefreshPreferences{
+							InstanceWarmup:       aws.Int32(100),
+							MinHealthyPercentage: aws.Int32(80),
+							MaxHealthyPercentage: aws.Int32(100),
+						},
+					})).
+						Return(&autoscaling.StartInstanceRefreshOutput{}, nil),
+				)
+			},
+		},
+		{
+			name:               "should return other errors immediately if the update is forced",
+			forceUpdateEnabled: true,
+			wantErr:            true,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.StartInstanceRefresh(context.TODO(), gomock.Any()).
+					Return(nil, awserrors.NewNotFound("not found")).Times(1)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1258,6 +1327,7 @@ func TestServiceStartASGInstanceRefresh(t *testing.T) {
 			mps, err := getMachinePoolScope(fakeClient, clusterScope)
 			g.Expect(err).ToNot(HaveOccurred())
 			mps.AWSMachinePool.Name = "mpn"
+			mps.AWSMachinePool.Spec.RefreshPreferences.ForceUpdateEnabled = tt.forceUpdateEnabled
 
 			err = s.StartASGInstanceRefresh(mps)
 			checkErr(tt.wantErr, err, g)
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/util/cache"
+)
+
+// cachedClient serves DescribeAutoScalingGroups calls by group names from a describe
+// cache, and invalidates the cached outputs after the calls changing groups. The cached
+// outputs are shared, so callers must not modify them.
+type cachedClient struct {
+	AutoScalingAPI
+	cache     *cache.DescribeCache
+	keyPrefix string
+}
+
+// NewCachedClient returns an AutoScaling client using the default describe cache. The
+// keyPrefix must identify the account and region of the client, so that groups with
+// the same name in different accounts or regions don't share cached outputs.
+func NewCachedClient(client AutoScalingAPI, keyPrefix string) AutoScalingAPI {
+	return &cachedClient{
+		AutoScalingAPI: client,
+		cache:          cache.DefaultDescribeCache,
+		keyPrefix:      keyPrefix,
+	}
+}
+
+func (c *cachedClient) groupsKey() string {
+	return c.keyPrefix + "asg/"
+}
+
+func (c *cachedClient) DescribeAutoScalingGroups(ctx context.Context, params *autoscaling.DescribeAutoScalingGroupsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+	// Only the plain lookups by name are cached, filtered and paginated calls are
+	// rare enough to always go to the API.
+	if len(params.AutoScalingGroupNames) == 0 || len(params.Filters) > 0 || params.NextToken != nil || params.MaxRecords != nil {
+		return c.AutoScalingAPI.DescribeAutoScalingGroups(ctx, params, optFns...)
+	}
+
+	names := slices.Clone(params.AutoScalingGroupNames)
+	slices.Sort(names)
+	key := c.groupsKey() + strings.Join(names, ",")
+	if out, ok := c.cache.Get(key); ok {
+		return out.(*autoscaling.DescribeAutoScalingGroupsOutput), nil
+	}
+	out, err := c.AutoScalingAPI.DescribeAutoScalingGroups(ctx, params, optFns...)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Add(key, out)
+	return out, nil
+}
+
+// The calls changing groups drop all the groups cached for the prefix, as a group can
+// be cached under several keys.
+func (c *cachedClient) CreateAutoScalingGroup(ctx context.Context, params *autoscaling.CreateAutoScalingGroupInput, optFns ...func(*autoscaling.Options)) (*autoscaling.CreateAutoScalingGroupOutput, error) {
+	defer c.cache.Invalidate(c.groupsKey())
+	return c.AutoScalingAPI.CreateAutoScalingGroup(ctx, params, optFns...)
+}
+
+func (c *cachedClient) DeleteAutoScalingGroup(ctx context.Context, params *autoscaling.DeleteAutoScalingGroupInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DeleteAutoScalingGroupOutput, error) {
+	defer c.cache.Invalidate(c.groupsKey())
+	return c.AutoScalingAPI.DeleteAutoScalingGroup(ctx, params, optFns...)
+}
+
+func (c *cachedClient) UpdateAutoScalingGroup(ctx context.Context, params *autoscaling.UpdateAutoScalingGroupInput, optFns ...func(*autoscaling.Options)) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+	defer c.cache.Invalidate(c.groupsKey())
+	return c.AutoScalingAPI.UpdateAutoScalingGroup(ctx, params, optFns...)
+}
+
+func (c *cachedClient) StartInstanceRefresh(ctx context.Context, params *autoscaling.StartInstanceRefreshInput, optFns ...func(*autoscaling.Options)) (*autoscaling.StartInstanceRefreshOutput, error) {
+	defer c.cache.Invalidate(c.groupsKey())
+	return c.AutoScalingAPI.StartInstanceRefresh(ctx, params, optFns...)
+}
+
+func (c *cachedClient) CancelInstanceRefresh(ctx context.Context, params *autoscaling.CancelInstanceRefreshInput, optFns ...func(*autoscaling.Options)) (*autoscaling.CancelInstanceRefreshOutput, error) {
+	defer c.cache.Invalidate(c.groupsKey())
+	return c.AutoScalingAPI.CancelInstanceRefresh(ctx, params, optFns...)
+}
+
+func (c *cachedClient) CreateOrUpdateTags(ctx context.Context, params *autoscaling.CreateOrUpdateTagsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.CreateOrUpdateTagsOutput, error) {
+	defer c.cache.Invalidate(c.groupsKey())
+	return c.AutoScalingAPI.CreateOrUpdateTags(ctx, params, optFns...)
+}
+
+func (c *cachedClient) DeleteTags(ctx context.Context, params *autoscaling.DeleteTagsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DeleteTagsOutput, error) {
+	defer c.cache.Invalidate(c.groupsKey())
+	return c.AutoScalingAPI.DeleteTags(ctx, params, optFns...)
+}
+
+func (c *cachedClient) SuspendProcesses(ctx context.Context, params *autoscaling.SuspendProcessesInput, optFns ...func(*autoscaling.Options)) (*autoscaling.SuspendProcessesOutput, error) {
+	defer c.cache.Invalidate(c.groupsKey())
+	return c.AutoScalingAPI.SuspendProcesses(ctx, params, optFns...)
+}
+
+func (c *cachedClient) ResumeProcesses(ctx context.Context, params *autoscaling.ResumeProcessesInput, optFns ...func(*autoscaling.Options)) (*autoscaling.ResumeProcessesOutput, error) {
+	defer c.cache.Invalidate(c.groupsKey())
+	return c.AutoScalingAPI.ResumeProcesses(ctx, params, optFns...)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/util/cache"
+)
+
+func TestCachedClientDescribeAutoScalingGroups(t *testing.T) {
+	describeGroups := &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{"b", "a"},
+	}
+	groups := func(desired int32) *autoscaling.DescribeAutoScalingGroupsOutput {
+		return &autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []autoscalingtypes.AutoScalingGroup{{DesiredCapacity: aws.Int32(desired)}},
+		}
+	}
+
+	t.Run("groups are described once within the TTL", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+		asgMock.EXPECT().DescribeAutoScalingGroups(gomock.Any(), describeGroups).Return(groups(1), nil).Times(1)
+		client := &cachedClient{AutoScalingAPI: asgMock, cache: cache.NewDescribeCache(time.Minute), keyPrefix: "eu-west-1/ns/cp/"}
+
+		_, err := client.DescribeAutoScalingGroups(context.TODO(), describeGroups)
+		g.Expect(err).NotTo(HaveOccurred())
+		out, err := client.DescribeAutoScalingGroups(context.TODO(), &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []string{"a", "b"},
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(out.AutoScalingGroups[0].DesiredCapacity).To(Equal(aws.Int32(1)))
+	})
+
+	t.Run("groups are described again after an update", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+		gomock.InOrder(
+			asgMock.EXPECT().DescribeAutoScalingGroups(gomock.Any(), describeGroups).Return(groups(1), nil),
+			asgMock.EXPECT().UpdateAutoScalingGroup(gomock.Any(), gomock.Any()).Return(&autoscaling.UpdateAutoScalingGroupOutput{}, nil),
+			asgMock.EXPECT().DescribeAutoScalingGroups(gomock.Any(), describeGroups).Return(groups(2), nil),
+		)
+		client := &cachedClient{AutoScalingAPI: asgMock, cache: cache.NewDescribeCache(time.Minute), keyPrefix: "eu-west-1/ns/cp/"}
+
+		_, err := client.DescribeAutoScalingGroups(context.TODO(), describeGroups)
+		g.Expect(err).NotTo(HaveOccurred())
+		_, err = client.UpdateAutoScalingGroup(context.TODO(), &autoscaling.UpdateAutoScalingGroupInput{
+			AutoScalingGroupName: aws.String("a"),
+			DesiredCapacity:      aws.Int32(2),
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+		out, err := client.DescribeAutoScalingGroups(context.TODO(), describeGroups)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(out.AutoScalingGroups[0].DesiredCapacity).To(Equal(aws.Int32(2)))
+	})
+
+	t.Run("filtered calls aren't cached", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+		filtered := &autoscaling.DescribeAutoScalingGroupsInput{
+			Filters: []autoscalingtypes.Filter{{Name: aws.String("tag-key"), Values: []string{"owned"}}},
+		}
+		asgMock.EXPECT().DescribeAutoScalingGroups(gomock.Any(), filtered).Return(groups(1), nil).Times(2)
+		client := &cachedClient{AutoScalingAPI: asgMock, cache: cache.NewDescribeCache(time.Minute), keyPrefix: "eu-west-1/ns/cp/"}
+
+		for range 2 {
+			_, err := client.DescribeAutoScalingGroups(context.TODO(), filtered)
+			g.Expect(err).NotTo(HaveOccurred())
+		}
+	})
+}
@@ -40,6 +40,11 @@ import (
 const (
 	awsNodeName      = "aws-node"
 	awsNodeNamespace = "kube-system"
+
+	// VPCCNIConfigMapName is the VPC CNI config map in which Windows support is enabled.
+	VPCCNIConfigMapName = "amazon-vpc-cni"
+	// VPCCNIWindowsIPAMKey is the VPC CNI setting that enables Windows support.
+	VPCCNIWindowsIPAMKey = "enable-windows-ipam"
 )
 
 // ReconcileCNI will reconcile the CNI of a service.
@@ -59,6 +64,10 @@ func (s *Service) ReconcileCNI(ctx context.Context) error {
 		return nil
 	}
 
+	if err := s.reconcileWindowsIPAM(ctx, remoteClient); err != nil {
+		return fmt.Errorf("enabling windows support: %w", err)
+	}
+
 	var ds appsv1.DaemonSet
 	if err := remoteClient.Get(ctx, types.NamespacedName{Namespace: awsNodeNamespace, Name: awsNodeName}, &ds); err != nil {
 		if !apierrors.IsNotFound(err) {
@@ -169,6 +178,46 @@ func (s *Service) ReconcileCNI(ctx context.Context) error {
 	return remoteClient.Update(ctx, &ds, &client.UpdateOptions{})
 }
 
+// reconcileWindowsIPAM enables Windows support in the VPC CNI configuration once the cluster has
+// Windows nodegroups, so that the VPC resource controller assigns IP addresses to Windows pods.
+// It's never disabled again, as that would break the pods of any Windows nodes that remain.
+func (s *Service) reconcileWindowsIPAM(ctx context.Context, remoteClient client.Client) error {
+	hasWindowsNodegroups, err := s.scope.HasWindowsNodegroups(ctx)
+	if err != nil {
+		return err
+	}
+	if !hasWindowsNodegroups {
+		return nil
+	}
+
+	var cm corev1.ConfigMap
+	if err := remoteClient.Get(ctx, types.NamespacedName{Namespace: awsNodeNamespace, Name: VPCCNIConfigMapName}, &cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		s.scope.Info("Creating vpc-cni config map with windows support enabled", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
+		cm = corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: awsNodeNamespace,
+				Name:      VPCCNIConfigMapName,
+			},
+			Data: map[string]string{VPCCNIWindowsIPAMKey: "true"},
+		}
+		return remoteClient.Create(ctx, &cm)
+	}
+
+	if cm.Data[VPCCNIWindowsIPAMKey] == "true" {
+		return nil
+	}
+
+	s.scope.Info("Enabling windows support in vpc-cni config map", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[VPCCNIWindowsIPAMKey] = "true"
+	return remoteClient.Update(ctx, &cm)
+}
+
 func (s *Service) getSecurityGroups() ([]string, error) {
 	sgRoles := []infrav1.SecurityGroupRole{
 		infrav1.SecurityGroupNode,
@@ -26,8 +26,10 @@ import (
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
@@ -257,6 +259,79 @@ func TestReconcileCniVpcCniValues(t *testing.T) {
 	}
 }
 
+func TestReconcileCniWindowsIPAM(t *testing.T) {
+	daemonSet := &v1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      awsNodeName,
+			Namespace: awsNodeNamespace,
+		},
+	}
+	vpcCNIConfig := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      VPCCNIConfigMapName,
+				Namespace: awsNodeNamespace,
+			},
+			Data: data,
+		}
+	}
+
+	tests := []struct {
+		name              string
+		windowsNodegroups bool
+		configMap         *corev1.ConfigMap
+		expectedData      map[string]string
+	}{
+		{
+			name: "config map isn't created without windows nodegroups",
+		},
+		{
+			name:              "config map is created for windows nodegroups",
+			windowsNodegroups: true,
+			expectedData:      map[string]string{VPCCNIWindowsIPAMKey: "true"},
+		},
+		{
+			name:              "windows support is enabled in the existing config map",
+			windowsNodegroups: true,
+			configMap:         vpcCNIConfig(map[string]string{"warm-ip-target": "1"}),
+			expectedData:      map[string]string{"warm-ip-target": "1", VPCCNIWindowsIPAMKey: "true"},
+		},
+		{
+			name:         "windows support isn't disabled without windows nodegroups",
+			configMap:    vpcCNIConfig(map[string]string{VPCCNIWindowsIPAMKey: "true"}),
+			expectedData: map[string]string{VPCCNIWindowsIPAMKey: "true"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			objects := []client.Object{daemonSet.DeepCopy()}
+			if tc.configMap != nil {
+				objects = append(objects, tc.configMap)
+			}
+			remoteClient := fake.NewClientBuilder().WithObjects(objects...).Build()
+			m := &mockScope{
+				client:            remoteClient,
+				windowsNodegroups: tc.windowsNodegroups,
+			}
+			s := NewService(m)
+
+			g.Expect(s.ReconcileCNI(context.Background())).To(Succeed())
+
+			cm := &corev1.ConfigMap{}
+			err := remoteClient.Get(context.Background(), client.ObjectKey{Namespace: awsNodeNamespace, Name: VPCCNIConfigMapName}, cm)
+			if tc.expectedData == nil {
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(cm.Data).To(Equal(tc.expectedData))
+		})
+	}
+}
+
 type cachingClient struct {
 	client.Client
 	getValue    client.Object
@@ -287,6 +362,7 @@ type mockScope struct {
 	secondaryCidrBlock *string
 	securityGroups     map[infrav1.SecurityGroupRole]infrav1.SecurityGroup
 	subnets            infrav1.Subnets
+	windowsNodegroups  bool
 }
 
 func (s *mockScope) RemoteClient() (client.Client, error) {
@@ -297,6 +373,10 @@ func (s *mockScope) VpcCni() ekscontrolplanev1.VpcCni {
 	return s.cni
 }
 
+func (s *mockScope) HasWindowsNodegroups(_ context.Context) (bool, error) {
+	return s.windowsNodegroups, nil
+}
+
 func (s *mockScope) Info(_ string, _ ...interface{}) {
 
 }
@@ -49,14 +49,14 @@ var (
 func (s *Service) ReconcileBastion() error {
 	if !s.scope.Bastion().Enabled {
 		s.scope.Trace("Skipping bastion reconcile")
-		_, err := s.describeBastionInstance()
-		if err != nil {
-			if awserrors.IsNotFound(err) {
-				return nil
-			}
+		// Delete the bastion host left over from when it was enabled, if any, and
+		// drop its status as it doesn't apply to the cluster anymore.
+		if err := s.DeleteBastion(); err != nil {
 			return err
 		}
-		return s.DeleteBastion()
+		s.scope.SetBastionInstance(nil)
+		v1beta1conditions.Delete(s.scope.InfraCluster(), infrav1.BastionHostReadyCondition)
+		return nil
 	}
 
 	s.scope.Debug("Reconciling bastion host")
@@ -36,6 +36,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
 func TestServiceDeleteBastion(t *testing.T) {
@@ -502,6 +503,120 @@ func TestServiceReconcileBastion(t *testing.T) {
 	}
 }
 
+func TestServiceReconcileBastionDisabled(t *testing.T) {
+	g := NewWithT(t)
+	clusterName := "cluster"
+
+	describeInput := &ec2.DescribeInstancesInput{
+		Filters: []types.Filter{
+			filter.EC2.ProviderRole(infrav1.BastionRoleTagValue),
+			filter.EC2.Cluster(clusterName),
+			filter.EC2.InstanceStates(
+				types.InstanceStateNamePending,
+				types.InstanceStateNameRunning,
+				types.InstanceStateNameStopping,
+				types.InstanceStateNameStopped,
+			),
+		},
+	}
+	foundOutput := &ec2.DescribeInstancesOutput{
+		Reservations: []types.Reservation{
+			{
+				Instances: []types.Instance{
+					{
+						InstanceId: aws.String("id123"),
+						State: &types.InstanceState{
+							Name: types.InstanceStateNameRunning,
+						},
+						Placement: &types.Placement{
+							AvailabilityZone: aws.String("us-east-1"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	mockControl := gomock.NewController(t)
+	ec2Mock := mocks.NewMockEC2API(mockControl)
+	gomock.InOrder(
+		// Bastion enabled, the instance already exists.
+		ec2Mock.EXPECT().DescribeInstances(context.TODO(), gomock.Eq(describeInput)).Return(foundOutput, nil),
+		// Bastion disabled, the instance is terminated.
+		ec2Mock.EXPECT().DescribeInstances(context.TODO(), gomock.Eq(describeInput)).Return(foundOutput, nil),
+		ec2Mock.EXPECT().TerminateInstances(context.TODO(), gomock.Eq(&ec2.TerminateInstancesInput{
+			InstanceIds: []string{"id123"},
+		})).Return(&ec2.TerminateInstancesOutput{}, nil),
+		ec2Mock.EXPECT().DescribeInstances(gomock.Any(), gomock.Eq(&ec2.DescribeInstancesInput{
+			InstanceIds: []string{"id123"},
+		}), gomock.Any()).Return(&ec2.DescribeInstancesOutput{
+			Reservations: []types.Reservation{
+				{
+					Instances: []types.Instance{
+						{
+							InstanceId: aws.String("id123"),
+							State: &types.InstanceState{
+								Name: types.InstanceStateNameTerminated,
+							},
+						},
+					},
+				},
+			},
+		}, nil),
+		// Bastion still disabled, nothing is left to delete.
+		ec2Mock.EXPECT().DescribeInstances(context.TODO(), gomock.Eq(describeInput)).Return(&ec2.DescribeInstancesOutput{}, nil),
+	)
+
+	scheme, err := setupScheme()
+	g.Expect(err).To(BeNil())
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: infrav1.AWSClusterSpec{
+			NetworkSpec: infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					ID: "vpcID",
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID: "subnet-1",
+					},
+					infrav1.SubnetSpec{
+						ID:       "subnet-2",
+						IsPublic: true,
+					},
+				},
+			},
+			Bastion: infrav1.Bastion{Enabled: true},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).WithStatusSubresource(awsCluster).Build()
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      clusterName,
+			},
+		},
+		AWSCluster: awsCluster,
+		Client:     client,
+	})
+	g.Expect(err).To(BeNil())
+	s := NewService(scope)
+	s.EC2Client = ec2Mock
+
+	g.Expect(s.ReconcileBastion()).To(Succeed())
+	g.Expect(scope.AWSCluster.Status.Bastion).NotTo(BeNil())
+	g.Expect(v1beta1conditions.IsTrue(scope.AWSCluster, infrav1.BastionHostReadyCondition)).To(BeTrue())
+
+	scope.AWSCluster.Spec.Bastion.Enabled = false
+	g.Expect(s.ReconcileBastion()).To(Succeed())
+	g.Expect(scope.AWSCluster.Status.Bastion).To(BeNil())
+	g.Expect(v1beta1conditions.Has(scope.AWSCluster, infrav1.BastionHostReadyCondition)).To(BeFalse())
+
+	g.Expect(s.ReconcileBastion()).To(Succeed())
+	g.Expect(scope.AWSCluster.Status.Bastion).To(BeNil())
+}
+
 func TestServiceReconcileBastionUSGOV(t *testing.T) {
 	clusterName := "cluster-us-gov"
 
@@ -27,6 +27,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/pkg/errors"
 	"k8s.io/utils/ptr"
 
@@ -124,6 +126,27 @@ func (s *Service) CreateInstance(ctx context.Context, scope *scope.MachineScope,
 		NetworkInterfaceType: scope.AWSMachine.Spec.NetworkInterfaceType,
 	}
 
+	if encryption := s.scope.ControlPlaneVolumeEncryption(); encryption != nil && scope.IsControlPlane() {
+		if err := s.checkEncryptionKey(encryption); err != nil {
+			return nil, err
+		}
+
+		if input.RootVolume == nil {
+			// Without a root volume in the spec, the root volume of the AMI is only overridden
+			// to apply the encryption, keeping the size and type of its snapshot.
+			if rootVolume := volumeWithEncryption(&infrav1.Volume{}, encryption); volumeEncrypted(rootVolume) {
+				input.RootVolume = rootVolume
+			}
+		} else {
+			input.RootVolume = volumeWithEncryption(input.RootVolume, encryption)
+		}
+		nonRootVolumes := make([]infrav1.Volume, 0, len(input.NonRootVolumes))
+		for i := range input.NonRootVolumes {
+			nonRootVolumes = append(nonRootVolumes, *volumeWithEncryption(&input.NonRootVolumes[i], encryption))
+		}
+		input.NonRootVolumes = nonRootVolumes
+	}
+
 	// Make sure to use the MachineScope here to get the merger of AWSCluster and AWSMachine tags
 	additionalTags := scope.AdditionalTags()
 	input.Tags = infrav1.Build(infrav1.BuildParams{
@@ -529,6 +552,16 @@ func (s *Service) GetCoreNodeSecurityGroups(scope scope.LaunchTemplateScope) ([]
 
 	ids := make([]string, 0, len(sgRoles))
 	for _, sg := range sgRoles {
+		if sg == infrav1.SecurityGroupNode {
+			if o, ok := scope.(clusterSecurityGroupOverrider); ok && o.ClusterSecurityGroupOverride() != nil {
+				id, err := ResolveSecurityGroupInVPC(context.TODO(), s.EC2Client, o.ClusterSecurityGroupOverride(), s.scope.VPC().ID)
+				if err != nil {
+					return nil, err
+				}
+				ids = append(ids, id)
+				continue
+			}
+		}
 		if _, ok := s.scope.SecurityGroups()[sg]; !ok {
 			return nil, awserrors.NewFailedDependency(
 				fmt.Sprintf("%s security group not available", sg),
@@ -539,6 +572,12 @@ func (s *Service) GetCoreNodeSecurityGroups(scope scope.LaunchTemplateScope) ([]
 	return ids, nil
 }
 
+// clusterSecurityGroupOverrider is implemented by launch template scopes that can replace
+// the EKS cluster security group assigned to nodes.
+type clusterSecurityGroupOverrider interface {
+	ClusterSecurityGroupOverride() *infrav1.AWSResourceReference
+}
+
 // TerminateInstance terminates an EC2 instance.
 // Returns nil on success, error in all other cases.
 func (s *Service) TerminateInstance(instanceID string) error {
@@ -636,7 +675,14 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	blockdeviceMappings := []types.BlockDeviceMapping{}
 
 	if i.RootVolume != nil {
-		rootDeviceName, err := s.checkRootVolume(i.RootVolume, i.ImageID)
+		var rootDeviceName *string
+		var err error
+		if i.RootVolume.Size == 0 {
+			// The root volume only overrides the encryption of the root volume of the AMI.
+			rootDeviceName, err = s.getImageRootDevice(i.ImageID)
+		} else {
+			rootDeviceName, err = s.checkRootVolume(i.RootVolume, i.ImageID)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -758,13 +804,60 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	return s.SDKToInstance(out.Instances[0])
 }
 
+// volumeWithEncryption returns the volume with the given encryption applied, unless the
+// volume sets its own encryption.
+func volumeWithEncryption(v *infrav1.Volume, encryption *infrav1.VolumeEncryption) *infrav1.Volume {
+	if v == nil || encryption == nil || v.Encrypted != nil || v.EncryptionKey != "" {
+		return v
+	}
+
+	volume := v.DeepCopy()
+	volume.Encrypted = encryption.Encrypted
+	volume.EncryptionKey = encryption.EncryptionKey
+	return volume
+}
+
+// volumeEncrypted returns whether the volume is created encrypted.
+func volumeEncrypted(v *infrav1.Volume) bool {
+	return ptr.Deref(v.Encrypted, false) || v.EncryptionKey != ""
+}
+
+// checkEncryptionKey returns an error when the KMS key of the encryption can't encrypt EBS
+// volumes, as EC2 only reports it by terminating the instances it launches.
+func (s *Service) checkEncryptionKey(encryption *infrav1.VolumeEncryption) error {
+	if encryption == nil || encryption.EncryptionKey == "" {
+		return nil
+	}
+
+	out, err := s.KMSClient.DescribeKey(context.TODO(), &kms.DescribeKeyInput{
+		KeyId: aws.String(encryption.EncryptionKey),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe KMS key %q", encryption.EncryptionKey)
+	}
+
+	key := out.KeyMetadata
+	if key.KeyState != kmstypes.KeyStateEnabled {
+		return errors.Errorf("KMS key %q can't encrypt volumes in state %s", encryption.EncryptionKey, key.KeyState)
+	}
+	if key.KeySpec != kmstypes.KeySpecSymmetricDefault || key.KeyUsage != kmstypes.KeyUsageTypeEncryptDecrypt {
+		return errors.Errorf("KMS key %q can't encrypt volumes, it must be a symmetric encryption key", encryption.EncryptionKey)
+	}
+
+	return nil
+}
+
 func volumeToBlockDeviceMapping(v *infrav1.Volume) types.BlockDeviceMapping {
 	ebsDevice := &types.EbsBlockDevice{
 		DeleteOnTermination: aws.Bool(true),
-		VolumeSize:          utils.ToInt32Pointer(&v.Size),
 		Encrypted:           v.Encrypted,
 	}
 
+	// A volume without a size keeps the size of the snapshot of the AMI.
+	if v.Size != 0 {
+		ebsDevice.VolumeSize = utils.ToInt32Pointer(&v.Size)
+	}
+
 	if v.Throughput != nil {
 		ebsDevice.Throughput = utils.ToInt32Pointer(v.Throughput)
 	}
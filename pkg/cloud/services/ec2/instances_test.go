@@ -25,6 +25,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/aws/smithy-go"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
@@ -6106,6 +6108,219 @@ func TestCreateInstance(t *testing.T) {
 	}
 }
 
+func TestCreateInstanceControlPlaneVolumeEncryption(t *testing.T) {
+	encryption := &infrav1.VolumeEncryption{Encrypted: aws.Bool(true), EncryptionKey: "alias/control-plane"}
+
+	testCases := []struct {
+		name           string
+		labels         map[string]string
+		encryption     *infrav1.VolumeEncryption
+		keyState       kmstypes.KeyState
+		noRootVolume   bool
+		nonRootVolumes []infrav1.Volume
+		wantEbs        []types.EbsBlockDevice
+		wantErr        bool
+	}{
+		{
+			name:       "control plane volumes inherit the cluster encryption",
+			labels:     map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+			encryption: encryption,
+			keyState:   kmstypes.KeyStateEnabled,
+			nonRootVolumes: []infrav1.Volume{
+				{DeviceName: "/dev/sdb", Size: 20},
+				{DeviceName: "/dev/sdc", Size: 20, EncryptionKey: "alias/etcd"},
+			},
+			wantEbs: []types.EbsBlockDevice{
+				{Encrypted: aws.Bool(true), KmsKeyId: aws.String("alias/control-plane")},
+				{Encrypted: aws.Bool(true), KmsKeyId: aws.String("alias/control-plane")},
+				{Encrypted: aws.Bool(true), KmsKeyId: aws.String("alias/etcd")},
+			},
+		},
+		{
+			name:           "control plane volume disabling encryption keeps its setting",
+			labels:         map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+			encryption:     encryption,
+			keyState:       kmstypes.KeyStateEnabled,
+			nonRootVolumes: []infrav1.Volume{{DeviceName: "/dev/sdb", Size: 20, Encrypted: aws.Bool(false)}},
+			wantEbs: []types.EbsBlockDevice{
+				{Encrypted: aws.Bool(true), KmsKeyId: aws.String("alias/control-plane")},
+				{Encrypted: aws.Bool(false)},
+			},
+		},
+		{
+			name:       "worker volumes don't inherit the cluster encryption",
+			labels:     map[string]string{"set": "node"},
+			encryption: encryption,
+			wantEbs:    []types.EbsBlockDevice{{}},
+		},
+		{
+			name:    "control plane volumes without cluster encryption",
+			labels:  map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+			wantEbs: []types.EbsBlockDevice{{}},
+		},
+		{
+			name:         "root volume of the AMI of a control plane machine is encrypted",
+			labels:       map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+			encryption:   encryption,
+			keyState:     kmstypes.KeyStateEnabled,
+			noRootVolume: true,
+			wantEbs: []types.EbsBlockDevice{
+				{Encrypted: aws.Bool(true), KmsKeyId: aws.String("alias/control-plane")},
+			},
+		},
+		{
+			name:       "disabled key of the cluster encryption",
+			labels:     map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+			encryption: encryption,
+			keyState:   kmstypes.KeyStateDisabled,
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "bootstrap-data"},
+				Data:       map[string][]byte{"value": []byte("data")},
+			}
+			cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test1"}}
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Labels: tc.labels},
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{DataSecretName: ptr.To[string]("bootstrap-data")},
+				},
+			}
+			awsCluster := &infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSClusterSpec{
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: infrav1.Subnets{{ID: "subnet-1"}},
+						VPC:     infrav1.VPCSpec{ID: "vpc-test"},
+					},
+					ControlPlaneVolumeEncryption: tc.encryption,
+				},
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupControlPlane: {ID: "1"},
+							infrav1.SecurityGroupNode:         {ID: "2"},
+							infrav1.SecurityGroupLB:           {ID: "3"},
+						},
+						APIServerELB: infrav1.LoadBalancer{DNSName: "test-apiserver.us-east-1.aws"},
+					},
+				},
+			}
+
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret, cluster, machine).Build()
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    cluster,
+				AWSCluster: awsCluster,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       client,
+				Cluster:      cluster,
+				Machine:      machine,
+				AWSMachine:   &infrav1.AWSMachine{ObjectMeta: metav1.ObjectMeta{Name: "aws-test1"}},
+				InfraCluster: clusterScope,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			machineScope.AWSMachine.Spec = infrav1.AWSMachineSpec{
+				AMI:            infrav1.AMIReference{ID: aws.String("abc")},
+				InstanceType:   "m5.large",
+				RootVolume:     &infrav1.Volume{Size: 30},
+				NonRootVolumes: tc.nonRootVolumes,
+			}
+			if tc.noRootVolume {
+				machineScope.AWSMachine.Spec.RootVolume = nil
+			}
+			specBefore := machineScope.AWSMachine.Spec.DeepCopy()
+
+			kmsMock := mocks.NewMockKMSAPI(mockCtrl)
+			if tc.keyState != "" {
+				kmsMock.EXPECT().DescribeKey(context.TODO(), &kms.DescribeKeyInput{KeyId: aws.String("alias/control-plane")}).Return(&kms.DescribeKeyOutput{
+					KeyMetadata: &kmstypes.KeyMetadata{
+						KeyState: tc.keyState,
+						KeySpec:  kmstypes.KeySpecSymmetricDefault,
+						KeyUsage: kmstypes.KeyUsageTypeEncryptDecrypt,
+					},
+				}, nil)
+			}
+
+			s := NewService(clusterScope).WithInstanceTypeArchitectureCache(nil)
+			s.EC2Client = ec2Mock
+			s.KMSClient = kmsMock
+
+			if tc.wantErr {
+				_, err = s.CreateInstance(context.TODO(), machineScope, []byte("userData"), "")
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+
+			// Without a root volume, only the root device of the AMI is looked up.
+			describeImagesTimes := 2
+			if tc.noRootVolume {
+				describeImagesTimes = 1
+			}
+			ec2Mock.EXPECT().DescribeInstanceTypes(context.TODO(), gomock.Any()).Return(&ec2.DescribeInstanceTypesOutput{
+				InstanceTypes: []types.InstanceTypeInfo{
+					{ProcessorInfo: &types.ProcessorInfo{SupportedArchitectures: []types.ArchitectureType{types.ArchitectureTypeX8664}}},
+				},
+			}, nil)
+			ec2Mock.EXPECT().DescribeImages(context.TODO(), gomock.Any()).Return(&ec2.DescribeImagesOutput{
+				Images: []types.Image{
+					{
+						RootDeviceName: aws.String("/dev/sda1"),
+						BlockDeviceMappings: []types.BlockDeviceMapping{
+							{DeviceName: aws.String("/dev/sda1"), Ebs: &types.EbsBlockDevice{VolumeSize: aws.Int32(8)}},
+						},
+					},
+				},
+			}, nil).Times(describeImagesTimes)
+			ec2Mock.EXPECT().RunInstances(context.TODO(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, input *ec2.RunInstancesInput, _ ...func(*ec2.Options)) (*ec2.RunInstancesOutput, error) {
+					g.Expect(input.BlockDeviceMappings).To(HaveLen(len(tc.wantEbs)))
+					for i, want := range tc.wantEbs {
+						g.Expect(input.BlockDeviceMappings[i].Ebs.Encrypted).To(Equal(want.Encrypted))
+						g.Expect(input.BlockDeviceMappings[i].Ebs.KmsKeyId).To(Equal(want.KmsKeyId))
+					}
+					if tc.noRootVolume {
+						g.Expect(input.BlockDeviceMappings[0].DeviceName).To(Equal(aws.String("/dev/sda1")))
+						g.Expect(input.BlockDeviceMappings[0].Ebs.VolumeSize).To(BeNil())
+					}
+					return &ec2.RunInstancesOutput{
+						Instances: []types.Instance{
+							{
+								State:          &types.InstanceState{Name: types.InstanceStateNamePending},
+								InstanceId:     aws.String("two"),
+								InstanceType:   types.InstanceTypeM5Large,
+								SubnetId:       aws.String("subnet-1"),
+								ImageId:        aws.String("abc"),
+								RootDeviceName: aws.String("/dev/sda1"),
+								Placement:      &types.Placement{AvailabilityZone: aws.String("us-east-1a")},
+							},
+						},
+					}, nil
+				})
+			ec2Mock.EXPECT().DescribeNetworkInterfaces(context.TODO(), gomock.Any()).Return(&ec2.DescribeNetworkInterfacesOutput{}, nil)
+
+			_, err = s.CreateInstance(context.TODO(), machineScope, []byte("userData"), "")
+			g.Expect(err).NotTo(HaveOccurred())
+			// The encryption of the cluster must not be written to the volumes of the machine.
+			g.Expect(machineScope.AWSMachine.Spec.RootVolume).To(Equal(specBefore.RootVolume))
+			g.Expect(machineScope.AWSMachine.Spec.NonRootVolumes).To(Equal(specBefore.NonRootVolumes))
+		})
+	}
+}
+
 func TestGetInstanceMarketOptionsRequest(t *testing.T) {
 	mockCapacityReservationID := ptr.To[string]("cr-123")
 	testCases := []struct {
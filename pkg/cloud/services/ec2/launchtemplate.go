@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"maps"
 	"sort"
 	"strconv"
 	"strings"
@@ -45,7 +46,9 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/common"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/userdata"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/utils"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
@@ -284,9 +287,13 @@ func (s *Service) ReconcileLaunchTemplate(
 
 		// There is a limit to the number of Launch Template Versions.
 		// We ensure that the number of versions does not grow without bound by following a simple rule: Before we create a new version, we delete one old version, if there is at least one old version that is not in use.
-		deletedLaunchTemplateVersion, err := ec2svc.PruneLaunchTemplateVersions(scope.GetLaunchTemplateIDStatus())
-		if err != nil {
-			return nil, err
+		// The version a nodegroup is pinned to can be an old one, so nothing is pruned while it's pinned.
+		var deletedLaunchTemplateVersion *types.LaunchTemplateVersion
+		if scope.GetLaunchTemplatePinnedVersion() == nil {
+			deletedLaunchTemplateVersion, err = ec2svc.PruneLaunchTemplateVersions(scope.GetLaunchTemplateIDStatus())
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		// S3 objects should be deleted as soon as possible if they're not used
@@ -355,13 +362,34 @@ func (s *Service) ensureTags(scope scope.LaunchTemplateScope, resourceServicesTo
 	// It would be possible here to only send new/updated tags, but for the
 	// moment we send everything, even if only a single tag was created or
 	// upated.
-	changed, created, deleted, newAnnotation := tagsChanged(annotation, additionalTags)
+	changed, _, _, newAnnotation := tagsChanged(annotation, additionalTags)
 	if changed {
+		// The tags last applied are the current tags of every resource, they're
+		// all updated in a single sweep so that a broken resource doesn't leave
+		// the others with stale tags. The annotation is only updated once all of
+		// them have been tagged.
+		lastApplied := make(map[string]string, len(annotation))
+		for k, v := range annotation {
+			lastApplied[k] = v.(string)
+		}
+		resources := make([]tags.SweepResource, 0, len(resourceServicesToUpdate))
 		for _, resourceServiceToUpdate := range resourceServicesToUpdate {
-			err := resourceServiceToUpdate.ResourceService.UpdateResourceTags(resourceServiceToUpdate.ResourceID, created, deleted)
-			if err != nil {
-				return false, err
-			}
+			resources = append(resources, tags.SweepResource{
+				Kind:    "resource",
+				ID:      aws.ToString(resourceServiceToUpdate.ResourceID),
+				Current: lastApplied,
+				Desired: additionalTags,
+				Update: func(_ context.Context, create map[string]string, remove []string) error {
+					removed := make(map[string]string, len(remove))
+					for _, k := range remove {
+						removed[k] = lastApplied[k]
+					}
+					return resourceServiceToUpdate.ResourceService.UpdateResourceTags(resourceServiceToUpdate.ResourceID, create, removed)
+				},
+			})
+		}
+		if err := tags.Sweep(context.TODO(), resources...); err != nil {
+			return false, err
 		}
 
 		// We also need to update the annotation if anything changed.
@@ -675,6 +703,10 @@ func (s *Service) createLaunchTemplateData(scope scope.LaunchTemplateScope, imag
 	data.PrivateDnsNameOptions = getLaunchTemplatePrivateDNSNameOptionsRequest(scope.GetLaunchTemplate().PrivateDNSName)
 	data.CapacityReservationSpecification = getLaunchTemplateCapacityReservationSpecification(scope.GetLaunchTemplate())
 
+	if err := s.checkEncryptionKey(scope.DefaultVolumeEncryption()); err != nil {
+		return nil, err
+	}
+
 	blockDeviceMappings := []types.LaunchTemplateBlockDeviceMappingRequest{}
 
 	// Set up root volume
@@ -686,14 +718,25 @@ func (s *Service) createLaunchTemplateData(scope scope.LaunchTemplateScope, imag
 
 		lt.RootVolume.DeviceName = aws.ToString(rootDeviceName)
 
-		req := volumeToLaunchTemplateBlockDeviceMappingRequest(lt.RootVolume)
+		req := volumeToLaunchTemplateBlockDeviceMappingRequest(volumeWithEncryption(lt.RootVolume, scope.DefaultVolumeEncryption()))
+		blockDeviceMappings = append(blockDeviceMappings, *req)
+	} else if rootVolume := volumeWithEncryption(&infrav1.Volume{}, scope.DefaultVolumeEncryption()); volumeEncrypted(rootVolume) {
+		// Without a root volume in the spec, the root volume of the AMI is only overridden
+		// to apply the default encryption, keeping the size and type of its snapshot.
+		rootDeviceName, err := s.getImageRootDevice(*data.ImageId)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get root volume from image %q", *data.ImageId)
+		}
+
+		rootVolume.DeviceName = aws.ToString(rootDeviceName)
+		req := volumeToLaunchTemplateBlockDeviceMappingRequest(rootVolume)
 		blockDeviceMappings = append(blockDeviceMappings, *req)
 	}
 
 	for vi := range lt.NonRootVolumes {
 		nonRootVolume := lt.NonRootVolumes[vi]
 
-		blockDeviceMapping := volumeToLaunchTemplateBlockDeviceMappingRequest(&nonRootVolume)
+		blockDeviceMapping := volumeToLaunchTemplateBlockDeviceMappingRequest(volumeWithEncryption(&nonRootVolume, scope.DefaultVolumeEncryption()))
 		blockDeviceMappings = append(blockDeviceMappings, *blockDeviceMapping)
 	}
 
@@ -724,13 +767,58 @@ func getLaunchTemplateCapacityReservationSpecification(awsLaunchTemplate *expinf
 	return spec
 }
 
+// encryptedVolumes returns the KMS key of every encrypted volume keyed by its device name.
+// The key is empty for volumes encrypted with the default key of the account.
+func encryptedVolumes(volumes []infrav1.Volume) map[string]string {
+	encrypted := map[string]string{}
+	for i := range volumes {
+		if volumeEncrypted(&volumes[i]) {
+			encrypted[volumes[i].DeviceName] = volumes[i].EncryptionKey
+		}
+	}
+	return encrypted
+}
+
+// desiredVolumeEncryption returns the encrypted volumes of the launch template, as created
+// by createLaunchTemplateData, keyed by their device name.
+func (s *Service) desiredVolumeEncryption(scope scope.LaunchTemplateScope, lt *expinfrav1.AWSLaunchTemplate, imageID string) (map[string]string, error) {
+	volumes := make([]infrav1.Volume, 0, len(lt.NonRootVolumes)+1)
+	for i := range lt.NonRootVolumes {
+		volumes = append(volumes, *volumeWithEncryption(&lt.NonRootVolumes[i], scope.DefaultVolumeEncryption()))
+	}
+
+	rootVolume := lt.RootVolume
+	if rootVolume == nil {
+		rootVolume = &infrav1.Volume{}
+	}
+	rootVolume = volumeWithEncryption(rootVolume, scope.DefaultVolumeEncryption())
+
+	// The device name of the root volume is only looked up when it matters.
+	if volumeEncrypted(rootVolume) {
+		rootDeviceName, err := s.getImageRootDevice(imageID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get root volume from image %q", imageID)
+		}
+
+		rootVolume = rootVolume.DeepCopy()
+		rootVolume.DeviceName = aws.ToString(rootDeviceName)
+		volumes = append(volumes, *rootVolume)
+	}
+
+	return encryptedVolumes(volumes), nil
+}
+
 func volumeToLaunchTemplateBlockDeviceMappingRequest(v *infrav1.Volume) *types.LaunchTemplateBlockDeviceMappingRequest {
 	ltEbsDevice := &types.LaunchTemplateEbsBlockDeviceRequest{
 		DeleteOnTermination: aws.Bool(true),
-		VolumeSize:          utils.ToInt32Pointer(&v.Size),
 		Encrypted:           v.Encrypted,
 	}
 
+	// A volume without a size keeps the size of the snapshot of the AMI.
+	if v.Size != 0 {
+		ltEbsDevice.VolumeSize = utils.ToInt32Pointer(&v.Size)
+	}
+
 	if v.Throughput != nil {
 		ltEbsDevice.Throughput = utils.ToInt32Pointer(v.Throughput)
 	}
@@ -915,10 +1003,12 @@ func (s *Service) SDKToLaunchTemplate(d types.LaunchTemplateVersion) (*expinfrav
 		VersionNumber:     d.VersionNumber,
 	}
 
-	if v.CapacityReservationSpecification != nil &&
-		v.CapacityReservationSpecification.CapacityReservationTarget != nil &&
-		v.CapacityReservationSpecification.CapacityReservationTarget.CapacityReservationId != nil {
-		i.CapacityReservationID = v.CapacityReservationSpecification.CapacityReservationTarget.CapacityReservationId
+	if v.CapacityReservationSpecification != nil {
+		i.CapacityReservationPreference = SDKToCapacityReservationPreference(v.CapacityReservationSpecification.CapacityReservationPreference)
+		if v.CapacityReservationSpecification.CapacityReservationTarget != nil &&
+			v.CapacityReservationSpecification.CapacityReservationTarget.CapacityReservationId != nil {
+			i.CapacityReservationID = v.CapacityReservationSpecification.CapacityReservationTarget.CapacityReservationId
+		}
 	}
 
 	if v.MetadataOptions != nil {
@@ -960,6 +1050,24 @@ func (s *Service) SDKToLaunchTemplate(d types.LaunchTemplateVersion) (*expinfrav
 		}
 	}
 
+	for _, mapping := range v.BlockDeviceMappings {
+		if mapping.Ebs == nil {
+			continue
+		}
+		// NOTE: Like the security groups below, this includes the root volume, which can't be
+		// told apart from the other volumes without looking up the AMI. The volumes are only
+		// compared by their device name.
+		i.NonRootVolumes = append(i.NonRootVolumes, infrav1.Volume{
+			DeviceName:    aws.ToString(mapping.DeviceName),
+			Size:          int64(aws.ToInt32(mapping.Ebs.VolumeSize)),
+			Type:          infrav1.VolumeType(string(mapping.Ebs.VolumeType)),
+			IOPS:          int64(aws.ToInt32(mapping.Ebs.Iops)),
+			Throughput:    utils.ToInt64Pointer(mapping.Ebs.Throughput),
+			Encrypted:     mapping.Ebs.Encrypted,
+			EncryptionKey: aws.ToString(mapping.Ebs.KmsKeyId),
+		})
+	}
+
 	for _, id := range v.SecurityGroupIds {
 		// FIXME(dlipovetsky): This will include the core security groups as well, making the
 		// "Additional" a bit dishonest. However, including the core groups drastically simplifies
@@ -1024,6 +1132,12 @@ func (s *Service) LaunchTemplateNeedsUpdate(scope scope.LaunchTemplateScope, inc
 		return true, services.LaunchTemplateNeedsUpdateReasonCapacityReservationID, nil
 	}
 
+	// An empty preference leaves it to EC2, which may report its own default, so it's
+	// only compared when set.
+	if incoming.CapacityReservationPreference != "" && incoming.CapacityReservationPreference != existing.CapacityReservationPreference {
+		return true, services.LaunchTemplateNeedsUpdateReasonCapacityReservationPreference, nil
+	}
+
 	if !cmp.Equal(incoming.PrivateDNSName, existing.PrivateDNSName) {
 		return true, services.LaunchTemplateNeedsUpdateReasonPrivateDNSName, nil
 	}
@@ -1033,6 +1147,15 @@ func (s *Service) LaunchTemplateNeedsUpdate(scope scope.LaunchTemplateScope, inc
 		return true, services.LaunchTemplateNeedsUpdateReasonSSHKeyName, nil
 	}
 
+	incomingEncryption, err := s.desiredVolumeEncryption(scope, incoming, aws.ToString(existing.AMI.ID))
+	if err != nil {
+		return false, services.LaunchTemplateNeedsUpdateReasonNone, err
+	}
+
+	if !maps.Equal(incomingEncryption, encryptedVolumes(existing.NonRootVolumes)) {
+		return true, services.LaunchTemplateNeedsUpdateReasonVolumeEncryption, nil
+	}
+
 	incomingIDs, err := s.GetAdditionalSecurityGroupsIDs(incoming.AdditionalSecurityGroups)
 	if err != nil {
 		return false, services.LaunchTemplateNeedsUpdateReasonNone, err
@@ -1200,6 +1323,42 @@ func (s *Service) buildLaunchTemplateTagSpecificationRequest(scope scope.LaunchT
 	return tagSpecifications
 }
 
+// ResolveSecurityGroupInVPC returns the ID of the security group referenced by ID or filters. The reference
+// must match exactly one security group, and that security group must belong to the given VPC.
+func ResolveSecurityGroupInVPC(ctx context.Context, client common.EC2API, ref *infrav1.AWSResourceReference, vpcID string) (string, error) {
+	if ref == nil || (ref.ID == nil && len(ref.Filters) == 0) {
+		return "", errors.New("security group reference must specify an ID or filters")
+	}
+
+	input := &ec2.DescribeSecurityGroupsInput{}
+	if ref.ID != nil {
+		input.GroupIds = []string{*ref.ID}
+	}
+	for _, f := range ref.Filters {
+		input.Filters = append(input.Filters, types.Filter{Name: aws.String(f.Name), Values: f.Values})
+	}
+
+	out, err := client.DescribeSecurityGroups(ctx, input)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to describe security group override")
+	}
+
+	switch len(out.SecurityGroups) {
+	case 0:
+		return "", errors.New("no security group found matching the override")
+	case 1:
+	default:
+		return "", errors.Errorf("security group override matches %d security groups, expected exactly one", len(out.SecurityGroups))
+	}
+
+	sg := out.SecurityGroups[0]
+	if vpcID != "" && aws.ToString(sg.VpcId) != vpcID {
+		return "", errors.Errorf("security group %s belongs to vpc %s, not to the cluster vpc %s", aws.ToString(sg.GroupId), aws.ToString(sg.VpcId), vpcID)
+	}
+
+	return aws.ToString(sg.GroupId), nil
+}
+
 // getFilteredSecurityGroupIDs get security group IDs using filters.
 func (s *Service) getFilteredSecurityGroupIDs(securityGroup infrav1.AWSResourceReference) ([]string, error) {
 	if securityGroup.Filters == nil {
@@ -24,6 +24,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
 	"github.com/aws/smithy-go"
@@ -37,6 +39,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
@@ -224,6 +227,9 @@ func TestGetLaunchTemplate(t *testing.T) {
 					SSHKeyName:               aws.String("foo-keyname"),
 					VersionNumber:            aws.Int64(1),
 					AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-id")}},
+					NonRootVolumes: []infrav1.Volume{
+						{DeviceName: "foo-device", Size: 16, Type: infrav1.VolumeTypeGP2, Encrypted: aws.Bool(true)},
+					},
 				}
 
 				g.Expect(err).NotTo(HaveOccurred())
@@ -282,6 +288,9 @@ func TestGetLaunchTemplate(t *testing.T) {
 					SSHKeyName:               aws.String("foo-keyname"),
 					VersionNumber:            aws.Int64(1),
 					AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-id")}},
+					NonRootVolumes: []infrav1.Volume{
+						{DeviceName: "foo-device", Size: 16, Type: infrav1.VolumeTypeGP2, Encrypted: aws.Bool(true)},
+					},
 				}
 
 				g.Expect(err).NotTo(HaveOccurred())
@@ -364,6 +373,9 @@ func TestServiceSDKToLaunchTemplate(t *testing.T) {
 				IamInstanceProfile: "foo-profile",
 				SSHKeyName:         aws.String("foo-keyname"),
 				VersionNumber:      aws.Int64(1),
+				NonRootVolumes: []infrav1.Volume{
+					{DeviceName: "foo-device", Size: 16, Type: infrav1.VolumeTypeGP2, Encrypted: aws.Bool(true)},
+				},
 			},
 			wantUserDataHash:      testUserDataHash,
 			wantDataSecretKey:     nil, // respective tag is not given
@@ -405,6 +417,41 @@ func TestServiceSDKToLaunchTemplate(t *testing.T) {
 			wantUserDataHash:  testUserDataHash,
 			wantDataSecretKey: nil,
 		},
+		{
+			name: "targeted capacity reservation",
+			input: ec2types.LaunchTemplateVersion{
+				LaunchTemplateId:   aws.String("lt-12345"),
+				LaunchTemplateName: aws.String("foo"),
+				LaunchTemplateData: &ec2types.ResponseLaunchTemplateData{
+					ImageId: aws.String("foo-image"),
+					IamInstanceProfile: &ec2types.LaunchTemplateIamInstanceProfileSpecification{
+						Arn: aws.String("instance-profile/foo-profile"),
+					},
+					KeyName: aws.String("foo-keyname"),
+					CapacityReservationSpecification: &ec2types.LaunchTemplateCapacityReservationSpecificationResponse{
+						CapacityReservationPreference: ec2types.CapacityReservationPreferenceCapacityReservationsOnly,
+						CapacityReservationTarget: &ec2types.CapacityReservationTargetResponse{
+							CapacityReservationId: aws.String("cr-12345678901234567"),
+						},
+					},
+					UserData: aws.String(base64.StdEncoding.EncodeToString([]byte(testUserData))),
+				},
+				VersionNumber: aws.Int64(1),
+			},
+			wantLT: &expinfrav1.AWSLaunchTemplate{
+				Name: "foo",
+				AMI: infrav1.AMIReference{
+					ID: aws.String("foo-image"),
+				},
+				IamInstanceProfile:            "foo-profile",
+				SSHKeyName:                    aws.String("foo-keyname"),
+				VersionNumber:                 aws.Int64(1),
+				CapacityReservationID:         aws.String("cr-12345678901234567"),
+				CapacityReservationPreference: infrav1.CapacityReservationPreferenceOnly,
+			},
+			wantUserDataHash:  testUserDataHash,
+			wantDataSecretKey: nil,
+		},
 		{
 			name: "spot market options with no max price",
 			input: ec2types.LaunchTemplateVersion{
@@ -556,6 +603,9 @@ func TestServiceSDKToLaunchTemplate(t *testing.T) {
 				IamInstanceProfile: "foo-profile",
 				SSHKeyName:         aws.String("foo-keyname"),
 				VersionNumber:      aws.Int64(1),
+				NonRootVolumes: []infrav1.Volume{
+					{DeviceName: "foo-device", Size: 16, Type: infrav1.VolumeTypeGP2, Encrypted: aws.Bool(true)},
+				},
 			},
 			wantUserDataHash:      testUserDataHash,
 			wantDataSecretKey:     &types.NamespacedName{Namespace: "bootstrap-secret-ns", Name: "bootstrap-secret"},
@@ -947,6 +997,38 @@ func TestServiceLaunchTemplateNeedsUpdate(t *testing.T) {
 			wantNeedsUpdateReason: services.LaunchTemplateNeedsUpdateReasonCapacityReservationID,
 			wantErr:               false,
 		},
+		{
+			name: "Should return true if capacity reservation preferences are different",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				CapacityReservationPreference: infrav1.CapacityReservationPreferenceNone,
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+				CapacityReservationPreference: infrav1.CapacityReservationPreferenceOpen,
+			},
+			want:                  true,
+			wantNeedsUpdateReason: services.LaunchTemplateNeedsUpdateReasonCapacityReservationPreference,
+			wantErr:               false,
+		},
+		{
+			name: "Should return false if incoming doesn't set a capacity reservation preference",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				CapacityReservationID: aws.String("cr-12345678901234567"),
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+				CapacityReservationID:         aws.String("cr-12345678901234567"),
+				CapacityReservationPreference: infrav1.CapacityReservationPreferenceOpen,
+			},
+			want:    false,
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -995,6 +1077,76 @@ func TestServiceLaunchTemplateNeedsUpdate(t *testing.T) {
 	}
 }
 
+func TestGetLaunchTemplateCapacityReservationSpecification(t *testing.T) {
+	tests := []struct {
+		name           string
+		launchTemplate *expinfrav1.AWSLaunchTemplate
+		want           *ec2types.LaunchTemplateCapacityReservationSpecificationRequest
+	}{
+		{
+			name:           "no launch template",
+			launchTemplate: nil,
+			want:           nil,
+		},
+		{
+			name:           "no capacity reservation",
+			launchTemplate: &expinfrav1.AWSLaunchTemplate{},
+			want:           nil,
+		},
+		{
+			name: "open reservations",
+			launchTemplate: &expinfrav1.AWSLaunchTemplate{
+				CapacityReservationPreference: infrav1.CapacityReservationPreferenceOpen,
+			},
+			want: &ec2types.LaunchTemplateCapacityReservationSpecificationRequest{
+				CapacityReservationPreference: ec2types.CapacityReservationPreferenceOpen,
+			},
+		},
+		{
+			name: "no reservations",
+			launchTemplate: &expinfrav1.AWSLaunchTemplate{
+				CapacityReservationPreference: infrav1.CapacityReservationPreferenceNone,
+			},
+			want: &ec2types.LaunchTemplateCapacityReservationSpecificationRequest{
+				CapacityReservationPreference: ec2types.CapacityReservationPreferenceNone,
+			},
+		},
+		{
+			name: "targeted reservation",
+			launchTemplate: &expinfrav1.AWSLaunchTemplate{
+				CapacityReservationID: aws.String("cr-12345678901234567"),
+			},
+			want: &ec2types.LaunchTemplateCapacityReservationSpecificationRequest{
+				CapacityReservationTarget: &ec2types.CapacityReservationTarget{
+					CapacityReservationId: aws.String("cr-12345678901234567"),
+				},
+			},
+		},
+		{
+			name: "targeted reservation only",
+			launchTemplate: &expinfrav1.AWSLaunchTemplate{
+				CapacityReservationID:         aws.String("cr-12345678901234567"),
+				CapacityReservationPreference: infrav1.CapacityReservationPreferenceOnly,
+			},
+			want: &ec2types.LaunchTemplateCapacityReservationSpecificationRequest{
+				CapacityReservationPreference: ec2types.CapacityReservationPreferenceCapacityReservationsOnly,
+				CapacityReservationTarget: &ec2types.CapacityReservationTarget{
+					CapacityReservationId: aws.String("cr-12345678901234567"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(getLaunchTemplateCapacityReservationSpecification(tt.launchTemplate)).To(BeComparableTo(tt.want, cmpopts.IgnoreUnexported(
+				ec2types.LaunchTemplateCapacityReservationSpecificationRequest{},
+				ec2types.CapacityReservationTarget{},
+			)))
+		})
+	}
+}
+
 func TestGetLaunchTemplateID(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -1474,6 +1626,297 @@ func TestLaunchTemplateDataCreation(t *testing.T) {
 	})
 }
 
+func TestVolumeToLaunchTemplateBlockDeviceMappingRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume infrav1.Volume
+		want   *ec2types.LaunchTemplateBlockDeviceMappingRequest
+	}{
+		{
+			name:   "volume without a type",
+			volume: infrav1.Volume{DeviceName: "/dev/xvda", Size: 20},
+			want: &ec2types.LaunchTemplateBlockDeviceMappingRequest{
+				DeviceName: aws.String("/dev/xvda"),
+				Ebs: &ec2types.LaunchTemplateEbsBlockDeviceRequest{
+					DeleteOnTermination: aws.Bool(true),
+					VolumeSize:          aws.Int32(20),
+				},
+			},
+		},
+		{
+			name: "gp3 volume with iops, throughput and a KMS key",
+			volume: infrav1.Volume{
+				DeviceName:    "/dev/xvda",
+				Size:          50,
+				Type:          infrav1.VolumeTypeGP3,
+				IOPS:          4000,
+				Throughput:    aws.Int64(250),
+				EncryptionKey: "alias/nodes",
+			},
+			want: &ec2types.LaunchTemplateBlockDeviceMappingRequest{
+				DeviceName: aws.String("/dev/xvda"),
+				Ebs: &ec2types.LaunchTemplateEbsBlockDeviceRequest{
+					DeleteOnTermination: aws.Bool(true),
+					VolumeSize:          aws.Int32(50),
+					VolumeType:          ec2types.VolumeTypeGp3,
+					Iops:                aws.Int32(4000),
+					Throughput:          aws.Int32(250),
+					Encrypted:           aws.Bool(true),
+					KmsKeyId:            aws.String("alias/nodes"),
+				},
+			},
+		},
+		{
+			name: "encrypted io2 volume",
+			volume: infrav1.Volume{
+				DeviceName: "/dev/sdb",
+				Size:       100,
+				Type:       infrav1.VolumeTypeIO2,
+				IOPS:       10000,
+				Encrypted:  aws.Bool(true),
+			},
+			want: &ec2types.LaunchTemplateBlockDeviceMappingRequest{
+				DeviceName: aws.String("/dev/sdb"),
+				Ebs: &ec2types.LaunchTemplateEbsBlockDeviceRequest{
+					DeleteOnTermination: aws.Bool(true),
+					VolumeSize:          aws.Int32(100),
+					VolumeType:          ec2types.VolumeTypeIo2,
+					Iops:                aws.Int32(10000),
+					Encrypted:           aws.Bool(true),
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(volumeToLaunchTemplateBlockDeviceMappingRequest(&tc.volume)).To(Equal(tc.want))
+		})
+	}
+}
+
+func TestVolumeWithEncryption(t *testing.T) {
+	defaultEncryption := &infrav1.VolumeEncryption{
+		Encrypted:     aws.Bool(true),
+		EncryptionKey: "alias/cluster-default",
+	}
+
+	tests := []struct {
+		name              string
+		volume            infrav1.Volume
+		defaultEncryption *infrav1.VolumeEncryption
+		wantEncrypted     *bool
+		wantKmsKeyID      *string
+	}{
+		{
+			name:   "no default leaves the volume unchanged",
+			volume: infrav1.Volume{DeviceName: "/dev/xvda", Size: 20},
+		},
+		{
+			name:              "volume without encryption inherits the default",
+			volume:            infrav1.Volume{DeviceName: "/dev/xvda", Size: 20},
+			defaultEncryption: defaultEncryption,
+			wantEncrypted:     aws.Bool(true),
+			wantKmsKeyID:      aws.String("alias/cluster-default"),
+		},
+		{
+			name:              "volume inherits a default without a key",
+			volume:            infrav1.Volume{DeviceName: "/dev/xvda", Size: 20},
+			defaultEncryption: &infrav1.VolumeEncryption{Encrypted: aws.Bool(true)},
+			wantEncrypted:     aws.Bool(true),
+		},
+		{
+			name:              "volume disabling encryption overrides the default",
+			volume:            infrav1.Volume{DeviceName: "/dev/xvda", Size: 20, Encrypted: aws.Bool(false)},
+			defaultEncryption: defaultEncryption,
+			wantEncrypted:     aws.Bool(false),
+		},
+		{
+			name:              "volume with its own key overrides the default",
+			volume:            infrav1.Volume{DeviceName: "/dev/xvda", Size: 20, EncryptionKey: "alias/pool"},
+			defaultEncryption: defaultEncryption,
+			wantEncrypted:     aws.Bool(true),
+			wantKmsKeyID:      aws.String("alias/pool"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			volume := tc.volume.DeepCopy()
+
+			req := volumeToLaunchTemplateBlockDeviceMappingRequest(volumeWithEncryption(volume, tc.defaultEncryption))
+			g.Expect(req.Ebs.Encrypted).To(Equal(tc.wantEncrypted))
+			g.Expect(req.Ebs.KmsKeyId).To(Equal(tc.wantKmsKeyID))
+			// The spec of the pool must not be changed by the default.
+			g.Expect(*volume).To(Equal(tc.volume))
+		})
+	}
+}
+
+func TestDefaultVolumeEncryptionOfLaunchTemplate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	defaultEncryption := &infrav1.VolumeEncryption{EncryptionKey: "alias/cluster-default"}
+	describeImage := func(m *mocks.MockEC2APIMockRecorder) {
+		m.DescribeImages(context.TODO(), gomock.Eq(&ec2.DescribeImagesInput{
+			ImageIds: []string{"ami-1"},
+		})).Return(&ec2.DescribeImagesOutput{
+			Images: []ec2types.Image{{ImageId: aws.String("ami-1"), RootDeviceName: aws.String("/dev/xvda")}},
+		}, nil)
+	}
+
+	newScopes := func(encryption *infrav1.VolumeEncryption, lt *expinfrav1.AWSLaunchTemplate) (*Service, *mocks.MockEC2API, *scope.ManagedMachinePoolScope) {
+		cs := &scope.ClusterScope{
+			Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+			AWSCluster: &infrav1.AWSCluster{
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+							infrav1.SecurityGroupNode:              {ID: "sg-111"},
+							infrav1.SecurityGroupEKSNodeAdditional: {ID: "sg-222"},
+						},
+					},
+				},
+			},
+		}
+		mockEC2Client := mocks.NewMockEC2API(mockCtrl)
+		mockKMSClient := mocks.NewMockKMSAPI(mockCtrl)
+		mockKMSClient.EXPECT().DescribeKey(context.TODO(), gomock.Any()).Return(&kms.DescribeKeyOutput{
+			KeyMetadata: &kmstypes.KeyMetadata{
+				KeyState: kmstypes.KeyStateEnabled,
+				KeySpec:  kmstypes.KeySpecSymmetricDefault,
+				KeyUsage: kmstypes.KeyUsageTypeEncryptDecrypt,
+			},
+		}, nil).AnyTimes()
+		s := NewService(cs)
+		s.EC2Client = mockEC2Client
+		s.KMSClient = mockKMSClient
+
+		mmps := &scope.ManagedMachinePoolScope{
+			EC2Scope: cs,
+			ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       ekscontrolplanev1.AWSManagedControlPlaneSpec{DefaultNodeVolumeEncryption: encryption},
+			},
+			ManagedMachinePool: &expinfrav1.AWSManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+				Spec:       expinfrav1.AWSManagedMachinePoolSpec{AWSLaunchTemplate: lt},
+			},
+		}
+		return s, mockEC2Client, mmps
+	}
+
+	t.Run("encrypts the root volume of the AMI when the pool doesn't declare one", func(t *testing.T) {
+		g := NewWithT(t)
+		s, m, mmps := newScopes(defaultEncryption, &expinfrav1.AWSLaunchTemplate{})
+		describeImage(m.EXPECT())
+
+		data, err := s.createLaunchTemplateData(mmps, aws.String("ami-1"), types.NamespacedName{}, nil, "")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(data.BlockDeviceMappings).To(HaveLen(1))
+		g.Expect(data.BlockDeviceMappings[0].DeviceName).To(Equal(aws.String("/dev/xvda")))
+		// The size and type of the snapshot of the AMI are kept.
+		g.Expect(data.BlockDeviceMappings[0].Ebs.VolumeSize).To(BeNil())
+		g.Expect(data.BlockDeviceMappings[0].Ebs.VolumeType).To(BeEmpty())
+		g.Expect(data.BlockDeviceMappings[0].Ebs.Encrypted).To(Equal(aws.Bool(true)))
+		g.Expect(data.BlockDeviceMappings[0].Ebs.KmsKeyId).To(Equal(aws.String("alias/cluster-default")))
+	})
+
+	t.Run("doesn't override the root volume of the AMI without a default", func(t *testing.T) {
+		g := NewWithT(t)
+		s, _, mmps := newScopes(nil, &expinfrav1.AWSLaunchTemplate{})
+
+		data, err := s.createLaunchTemplateData(mmps, aws.String("ami-1"), types.NamespacedName{}, nil, "")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(data.BlockDeviceMappings).To(BeEmpty())
+	})
+
+	needsUpdateTests := []struct {
+		name                  string
+		encryption            *infrav1.VolumeEncryption
+		incoming              *expinfrav1.AWSLaunchTemplate
+		existingVolumes       []infrav1.Volume
+		expect                func(m *mocks.MockEC2APIMockRecorder)
+		want                  bool
+		wantNeedsUpdateReason services.LaunchTemplateNeedsUpdateReason
+	}{
+		{
+			name:                  "new default for a launch template without volumes",
+			encryption:            defaultEncryption,
+			incoming:              &expinfrav1.AWSLaunchTemplate{},
+			expect:                describeImage,
+			want:                  true,
+			wantNeedsUpdateReason: services.LaunchTemplateNeedsUpdateReasonVolumeEncryption,
+		},
+		{
+			name:       "unchanged default",
+			encryption: defaultEncryption,
+			incoming:   &expinfrav1.AWSLaunchTemplate{},
+			existingVolumes: []infrav1.Volume{
+				{DeviceName: "/dev/xvda", Encrypted: aws.Bool(true), EncryptionKey: "alias/cluster-default"},
+			},
+			expect: describeImage,
+			want:   false,
+		},
+		{
+			name:       "changed default key",
+			encryption: &infrav1.VolumeEncryption{EncryptionKey: "alias/rotated"},
+			incoming:   &expinfrav1.AWSLaunchTemplate{},
+			existingVolumes: []infrav1.Volume{
+				{DeviceName: "/dev/xvda", Encrypted: aws.Bool(true), EncryptionKey: "alias/cluster-default"},
+			},
+			expect:                describeImage,
+			want:                  true,
+			wantNeedsUpdateReason: services.LaunchTemplateNeedsUpdateReasonVolumeEncryption,
+		},
+		{
+			name:     "removed default",
+			incoming: &expinfrav1.AWSLaunchTemplate{},
+			existingVolumes: []infrav1.Volume{
+				{DeviceName: "/dev/xvda", Encrypted: aws.Bool(true), EncryptionKey: "alias/cluster-default"},
+			},
+			want:                  true,
+			wantNeedsUpdateReason: services.LaunchTemplateNeedsUpdateReasonVolumeEncryption,
+		},
+		{
+			name:       "default applied to a declared volume",
+			encryption: defaultEncryption,
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				RootVolume:     &infrav1.Volume{Size: 20, Encrypted: aws.Bool(false)},
+				NonRootVolumes: []infrav1.Volume{{DeviceName: "/dev/sdb", Size: 50}},
+			},
+			existingVolumes: []infrav1.Volume{
+				{DeviceName: "/dev/xvda", Size: 20, Encrypted: aws.Bool(false)},
+				{DeviceName: "/dev/sdb", Size: 50},
+			},
+			want:                  true,
+			wantNeedsUpdateReason: services.LaunchTemplateNeedsUpdateReasonVolumeEncryption,
+		},
+	}
+	for _, tt := range needsUpdateTests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s, m, mmps := newScopes(tt.encryption, tt.incoming)
+			if tt.expect != nil {
+				tt.expect(m.EXPECT())
+			}
+
+			existing := &expinfrav1.AWSLaunchTemplate{
+				AMI:                      infrav1.AMIReference{ID: aws.String("ami-1")},
+				NonRootVolumes:           tt.existingVolumes,
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-111")}, {ID: aws.String("sg-222")}},
+			}
+			got, gotNeedsUpdateReason, err := s.LaunchTemplateNeedsUpdate(mmps, tt.incoming, existing)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(gotNeedsUpdateReason).To(Equal(tt.wantNeedsUpdateReason))
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
 var LaunchTemplateVersionIgnoreUnexported = cmpopts.IgnoreUnexported(
 	ec2types.CapacityReservationTarget{},
 	ec2types.LaunchTemplateCapacityReservationSpecificationRequest{},
@@ -36,6 +36,9 @@ type Service struct {
 	// SSMClient is used to look up the official EKS AMI ID
 	SSMClient ssm.SSMAPI
 
+	// KMSClient is used to check the KMS keys encrypting volumes
+	KMSClient common.KMSAPI
+
 	// RetryEC2Client is used for dedicated host operations with enhanced retry configuration
 	// If nil, a new retry client will be created as needed
 	RetryEC2Client common.EC2API
@@ -49,6 +52,7 @@ func NewService(clusterScope scope.EC2Scope) *Service {
 		scope:                         clusterScope,
 		EC2Client:                     scope.NewEC2Client(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
 		SSMClient:                     scope.NewSSMClient(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
+		KMSClient:                     scope.NewKMSClient(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
 		netService:                    network.NewService(clusterScope.(scope.NetworkScope)),
 		InstanceTypeArchitectureCache: cache.InstanceTypeArchitectureCacheSingleton,
 	}
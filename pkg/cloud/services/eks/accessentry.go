@@ -76,7 +76,10 @@ func (s *Service) getManagedAccessEntries(ctx context.Context) (map[string]bool,
 	var nextToken *string
 
 	clusterName := s.scope.KubernetesClusterName()
-	managedTag := infrav1.ClusterAWSCloudProviderTagKey(s.scope.Name())
+	managedTag := infrav1.ClusterAWSCloudProviderTagKey(clusterName)
+	// Access entries were previously tagged using s.scope.Name(), check for
+	// both tags so existing entries are still treated as managed.
+	oldManagedTag := infrav1.ClusterAWSCloudProviderTagKey(s.scope.Name())
 
 	for {
 		input := &eks.ListAccessEntriesInput{
@@ -100,7 +103,9 @@ func (s *Service) getManagedAccessEntries(ctx context.Context) (map[string]bool,
 			}
 
 			if describeOutput.AccessEntry.Tags != nil {
-				if _, managed := describeOutput.AccessEntry.Tags[managedTag]; managed {
+				_, managed := describeOutput.AccessEntry.Tags[managedTag]
+				_, oldManaged := describeOutput.AccessEntry.Tags[oldManagedTag]
+				if managed || oldManaged {
 					existingAccessEntries[principalArn] = true
 				}
 			}
@@ -120,7 +125,7 @@ func (s *Service) createAccessEntry(ctx context.Context, accessEntry ekscontrolp
 	clusterName := s.scope.KubernetesClusterName()
 
 	additionalTags := s.scope.AdditionalTags()
-	additionalTags[infrav1.ClusterAWSCloudProviderTagKey(s.scope.Name())] = string(infrav1.ResourceLifecycleOwned)
+	additionalTags[infrav1.ClusterAWSCloudProviderTagKey(clusterName)] = string(infrav1.ResourceLifecycleOwned)
 	tags := make(map[string]string)
 	for k, v := range additionalTags {
 		tags[k] = v
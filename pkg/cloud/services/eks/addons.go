@@ -204,7 +204,7 @@ func (s *Service) translateAPIToAddon(addons []ekscontrolplanev1.Addon) []*eksad
 			Name:                  &addon.Name,
 			Version:               &addon.Version,
 			Configuration:         convertConfiguration(addon.Configuration),
-			Tags:                  ngTags(s.scope.Cluster.Name, s.scope.AdditionalTags()),
+			Tags:                  ngTags(s.scope.KubernetesClusterName(), s.scope.AdditionalTags()),
 			ResolveConflict:       conflict,
 			ServiceAccountRoleARN: addon.ServiceAccountRoleArn,
 			Preserve:              addon.PreserveOnDelete,
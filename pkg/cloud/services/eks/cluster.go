@@ -19,11 +19,16 @@ package eks
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/blang/semver"
@@ -35,8 +40,10 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/internal/cidr"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/internal/cmp"
@@ -47,6 +54,46 @@ import (
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
+// reconcileClusterOwnership checks that an existing EKS cluster is owned by the control plane,
+// tagging it as owned when the control plane has the adopt annotation.
+func (s *Service) reconcileClusterOwnership(ctx context.Context, cluster *ekstypes.Cluster) error {
+	eksClusterName := s.scope.KubernetesClusterName()
+	tagKey := infrav1.ClusterAWSCloudProviderTagKey(eksClusterName)
+	_, ownedTag := cluster.Tags[tagKey]
+	// Prior to https://github.com/kubernetes-sigs/cluster-api-provider-aws/pull/3573,
+	// Clusters were tagged using s.scope.Name()
+	// To support upgrading older clusters, check for both tags
+	oldTagKey := infrav1.ClusterAWSCloudProviderTagKey(s.scope.Name())
+	_, oldOwnedTag := cluster.Tags[oldTagKey]
+
+	if ownedTag || oldOwnedTag {
+		s.scope.Debug("Found owned EKS cluster in AWS", "cluster", klog.KRef("", eksClusterName))
+		return nil
+	}
+
+	if !annotations.IsTrue(s.scope.ControlPlane, infrav1.AdoptAnnotation) {
+		return fmt.Errorf("EKS cluster resource %q must have a tag with key %q or %q, set the %s annotation to adopt it",
+			eksClusterName, oldTagKey, tagKey, infrav1.AdoptAnnotation)
+	}
+
+	s.scope.Info("Adopting existing EKS cluster", "cluster", klog.KRef("", eksClusterName))
+	owned := map[string]string{tagKey: string(infrav1.ResourceLifecycleOwned)}
+	if err := tagEKSResource(ctx, s.EKSClient, cluster.Arn, owned, nil); err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedAdoptEKSCluster", "Failed to tag EKS cluster %s as owned: %v", eksClusterName, err)
+		return errors.Wrapf(err, "failed to tag EKS cluster %s as owned", eksClusterName)
+	}
+	// The described tags may be shared with the describe cache, so the tag is added to a copy.
+	tags := maps.Clone(cluster.Tags)
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	tags[tagKey] = string(infrav1.ResourceLifecycleOwned)
+	cluster.Tags = tags
+	record.Eventf(s.scope.ControlPlane, "SuccessfulAdoptEKSCluster", "Adopted existing EKS cluster %s", eksClusterName)
+
+	return nil
+}
+
 func (s *Service) reconcileCluster(ctx context.Context) error {
 	s.scope.Debug("Reconciling EKS cluster")
 
@@ -62,29 +109,33 @@ func (s *Service) reconcileCluster(ctx context.Context) error {
 		if err != nil {
 			return errors.Wrap(err, "failed to create cluster")
 		}
-	} else {
-		tagKey := infrav1.ClusterAWSCloudProviderTagKey(eksClusterName)
-		ownedTag := aws.String(cluster.Tags[tagKey])
-		// Prior to https://github.com/kubernetes-sigs/cluster-api-provider-aws/pull/3573,
-		// Clusters were tagged using s.scope.Name()
-		// To support upgrading older clusters, check for both tags
-		oldTagKey := infrav1.ClusterAWSCloudProviderTagKey(s.scope.Name())
-		oldOwnedTag := aws.String(cluster.Tags[oldTagKey])
-
-		if ownedTag == nil && oldOwnedTag == nil {
-			return fmt.Errorf("EKS cluster resource %q must have a tag with key %q or %q", eksClusterName, oldTagKey, tagKey)
-		}
-
-		s.scope.Debug("Found owned EKS cluster in AWS", "cluster", klog.KRef("", eksClusterName))
+	} else if err := s.reconcileClusterOwnership(ctx, cluster); err != nil {
+		return err
 	}
 
 	if err := s.setStatus(cluster); err != nil {
 		return errors.Wrap(err, "failed to set status")
 	}
 
-	// Wait for our cluster to be ready if necessary
+	// Associating an encryption config can take a long time, so check on its
+	// progress instead of waiting for the cluster to become active.
+	var encryptionErr error
+	if s.scope.ControlPlane.Status.EncryptionConfigUpdateID != nil {
+		encryptionErr = s.reconcileEncryptionConfigUpdate(ctx)
+		if encryptionErr != nil && !errors.Is(encryptionErr, ErrEncryptionConfigUpdateInProgress) {
+			return encryptionErr
+		}
+	}
+
+	// Wait for our cluster to be ready if necessary. Creating a cluster takes
+	// a long time, so its progress is reported instead of waiting for it.
 	switch cluster.Status {
-	case ekstypes.ClusterStatusUpdating, ekstypes.ClusterStatusCreating:
+	case ekstypes.ClusterStatusCreating:
+		return s.reconcileClusterCreating(cluster)
+	case ekstypes.ClusterStatusUpdating:
+		if encryptionErr != nil {
+			break
+		}
 		cluster, err = s.waitForClusterActive(ctx)
 	default:
 		break
@@ -116,28 +167,41 @@ func (s *Service) reconcileCluster(ctx context.Context) error {
 		return errors.Wrap(err, "failed reconciling additional kubeconfigs")
 	}
 
-	if err := s.reconcileClusterVersion(ctx, cluster); err != nil {
-		return errors.Wrap(err, "failed reconciling cluster version")
+	// EKS rejects other updates of the cluster until the encryption config is associated,
+	// they're applied once the association has completed.
+	if encryptionErr != nil {
+		return encryptionErr
 	}
 
-	if err := s.reconcileClusterConfig(ctx, cluster); err != nil {
-		return errors.Wrap(err, "failed reconciling cluster config")
+	// The other changes are still applied while the upgrade waits for its pre-upgrade hooks.
+	upgradeErr := s.reconcileVersionAndUpgradePolicy(ctx, cluster)
+	if upgradeErr != nil && !errors.Is(upgradeErr, ErrClusterUpgradeHooksPending) {
+		return upgradeErr
 	}
 
-	if err := s.reconcileAccessConfig(ctx, cluster.AccessConfig); err != nil {
-		return errors.Wrap(err, "failed reconciling access config")
+	configErr := s.reconcileClusterConfig(ctx, cluster)
+	if configErr != nil && !errors.Is(configErr, ErrClusterUpdating) {
+		return errors.Wrap(configErr, "failed reconciling cluster config")
 	}
 
-	if err := s.reconcileAccessEntries(ctx); err != nil {
-		return errors.Wrap(err, "failed reconciling access entries")
-	}
+	// EKS rejects other configuration updates while the security groups are being attached,
+	// they're applied once the update has completed.
+	if configErr == nil {
+		if err := s.reconcileAccessConfig(ctx, cluster.AccessConfig); err != nil {
+			return errors.Wrap(err, "failed reconciling access config")
+		}
 
-	if err := s.reconcileLogging(ctx, cluster.Logging); err != nil {
-		return errors.Wrap(err, "failed reconciling logging")
-	}
+		if err := s.reconcileAccessEntries(ctx); err != nil {
+			return errors.Wrap(err, "failed reconciling access entries")
+		}
+
+		if err := s.reconcileLogging(ctx, cluster.Logging); err != nil {
+			return errors.Wrap(err, "failed reconciling logging")
+		}
 
-	if err := s.reconcileEKSEncryptionConfig(ctx, cluster.EncryptionConfig); err != nil {
-		return errors.Wrap(err, "failed reconciling eks encryption config")
+		if err := s.reconcileEKSEncryptionConfig(ctx, cluster.EncryptionConfig); err != nil {
+			return errors.Wrap(err, "failed reconciling eks encryption config")
+		}
 	}
 
 	if err := s.reconcileTags(ctx, cluster); err != nil {
@@ -148,7 +212,10 @@ func (s *Service) reconcileCluster(ctx context.Context) error {
 		return errors.Wrap(err, "failed reconciling OIDC provider for cluster")
 	}
 
-	return nil
+	if configErr != nil {
+		return configErr
+	}
+	return upgradeErr
 }
 
 // computeCurrentStatusVersion returns the computed current EKS cluster kubernetes version.
@@ -342,7 +409,7 @@ func makeKubernetesNetworkConfig(serviceCidrs *clusterv1.NetworkRanges) (*ekstyp
 	}, nil
 }
 
-func makeVpcConfig(subnets infrav1.Subnets, endpointAccess ekscontrolplanev1.EndpointAccess, securityGroups map[infrav1.SecurityGroupRole]infrav1.SecurityGroup) (*ekstypes.VpcConfigRequest, error) {
+func makeVpcConfig(subnets infrav1.Subnets, endpointAccess ekscontrolplanev1.EndpointAccess, securityGroups map[infrav1.SecurityGroupRole]infrav1.SecurityGroup, additionalSecurityGroupIDs []string) (*ekstypes.VpcConfigRequest, error) {
 	// TODO: Do we need to just add the private subnets?
 	if len(subnets) < 2 {
 		return nil, awserrors.NewFailedDependency("at least 2 subnets is required")
@@ -382,6 +449,11 @@ func makeVpcConfig(subnets infrav1.Subnets, endpointAccess ekscontrolplanev1.End
 	if ok {
 		vpcConfig.SecurityGroupIds = append(vpcConfig.SecurityGroupIds, sg.ID)
 	}
+	for _, id := range additionalSecurityGroupIDs {
+		if !slices.Contains(vpcConfig.SecurityGroupIds, id) {
+			vpcConfig.SecurityGroupIds = append(vpcConfig.SecurityGroupIds, id)
+		}
+	}
 	return vpcConfig, nil
 }
 
@@ -440,9 +512,9 @@ func (s *Service) createCluster(ctx context.Context, eksClusterName string) (*ek
 	encryptionConfigs := makeEksEncryptionConfigs(s.scope.ControlPlane.Spec.EncryptionConfig)
 	if s.scope.ControlPlane.Spec.RestrictPrivateSubnets {
 		s.scope.Info("Filtering private subnets")
-		vpcConfig, err = makeVpcConfig(s.scope.Subnets().FilterPrivate(), s.scope.ControlPlane.Spec.EndpointAccess, s.scope.SecurityGroups())
+		vpcConfig, err = makeVpcConfig(s.scope.Subnets().FilterPrivate(), s.scope.ControlPlane.Spec.EndpointAccess, s.scope.SecurityGroups(), s.scope.ControlPlane.Spec.AdditionalSecurityGroupIDs)
 	} else {
-		vpcConfig, err = makeVpcConfig(s.scope.Subnets(), s.scope.ControlPlane.Spec.EndpointAccess, s.scope.SecurityGroups())
+		vpcConfig, err = makeVpcConfig(s.scope.Subnets(), s.scope.ControlPlane.Spec.EndpointAccess, s.scope.SecurityGroups(), s.scope.ControlPlane.Spec.AdditionalSecurityGroupIDs)
 	}
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't create vpc config for cluster")
@@ -521,6 +593,7 @@ func (s *Service) createCluster(ctx context.Context, eksClusterName string) (*ek
 		KubernetesNetworkConfig:    netConfig,
 		BootstrapSelfManagedAddons: bootstrapAddon,
 		UpgradePolicy:              upgradePolicy,
+		ClientRequestToken:         clientRequestToken(s.scope.ControlPlane, eksClusterName),
 	}
 
 	var out *eks.CreateClusterOutput
@@ -540,6 +613,32 @@ func (s *Service) createCluster(ctx context.Context, eksClusterName string) (*ek
 	return out.Cluster, nil
 }
 
+// reconcileClusterCreating reports the progress of a cluster that is being created.
+// ErrClusterCreating is returned until the cluster is active, or ErrClusterCreationTimedOut
+// once the creation has taken longer than MaxWaitActiveUpdateDelete.
+func (s *Service) reconcileClusterCreating(cluster *ekstypes.Cluster) error {
+	eksClusterName := s.scope.KubernetesClusterName()
+
+	var elapsed time.Duration
+	if cluster.CreatedAt != nil {
+		elapsed = time.Since(*cluster.CreatedAt).Round(time.Second)
+	}
+
+	v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneCreatingCondition)
+
+	if timeout := s.scope.MaxWaitActiveUpdateDelete; timeout > 0 && elapsed > timeout {
+		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneReadyCondition, ekscontrolplanev1.EKSControlPlaneCreationTimedOutReason, clusterv1beta1.ConditionSeverityError,
+			"EKS cluster %s is still being created after %s, exceeding the timeout of %s", eksClusterName, elapsed, timeout)
+		record.Warnf(s.scope.ControlPlane, "EKSControlPlaneCreationTimedOut", "EKS control plane %s is still being created after %s", eksClusterName, elapsed)
+		return errors.Wrapf(ErrClusterCreationTimedOut, "EKS cluster %s is still being created after %s", eksClusterName, elapsed)
+	}
+
+	s.scope.Info("Waiting for EKS cluster to be created", "cluster", klog.KRef("", eksClusterName), "elapsed", elapsed)
+	v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneReadyCondition, ekscontrolplanev1.EKSControlPlaneCreatingReason, clusterv1beta1.ConditionSeverityInfo,
+		"EKS cluster %s is being created (%s elapsed)", eksClusterName, elapsed)
+	return ErrClusterCreating
+}
+
 func (s *Service) waitForClusterActive(ctx context.Context) (*ekstypes.Cluster, error) {
 	eksClusterName := s.scope.KubernetesClusterName()
 	req := eks.DescribeClusterInput{
@@ -576,9 +675,12 @@ func (s *Service) reconcileClusterConfig(ctx context.Context, cluster *ekstypes.
 		input.ResourcesVpcConfig = updateVpcConfig
 	}
 
-	if updateUpgradePolicy := s.reconcileUpgradePolicy(cluster.UpgradePolicy); updateUpgradePolicy != nil {
-		needsUpdate = true
-		input.UpgradePolicy = updateUpgradePolicy
+	updatingSecurityGroups := updateVpcConfig != nil && updateVpcConfig.SecurityGroupIds != nil
+	if updatingSecurityGroups {
+		if err := s.validateControlPlaneSecurityGroups(ctx, updateVpcConfig.SecurityGroupIds); err != nil {
+			record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "Failed to update the EKS control plane security groups: %v", err)
+			return err
+		}
 	}
 
 	if needsUpdate {
@@ -594,6 +696,39 @@ func (s *Service) reconcileClusterConfig(ctx context.Context, cluster *ekstypes.
 			return errors.Wrapf(err, "failed to update EKS cluster")
 		}
 	}
+
+	// Any other configuration update would be rejected until the new security groups
+	// are attached, the caller applies them once the cluster is active again.
+	if updatingSecurityGroups {
+		return ErrClusterUpdating
+	}
+	return nil
+}
+
+// validateControlPlaneSecurityGroups checks the security groups to attach to the control plane
+// exist in the cluster's VPC.
+func (s *Service) validateControlPlaneSecurityGroups(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	out, err := s.EC2Client.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		Filters: []ec2types.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.SecurityGroupIDs(ids...),
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to describe control plane security groups")
+	}
+
+	found := sets.New[string]()
+	for _, sg := range out.SecurityGroups {
+		found.Insert(aws.ToString(sg.GroupId))
+	}
+	if missing := sets.List(sets.New(ids...).Difference(found)); len(missing) > 0 {
+		return errors.Errorf("security groups %v don't exist in VPC %s", missing, s.scope.VPC().ID)
+	}
 	return nil
 }
 
@@ -708,9 +843,9 @@ func (s *Service) reconcileVpcConfig(vpcConfig *ekstypes.VpcConfigResponse) (*ek
 	)
 	endpointAccess := s.scope.ControlPlane.Spec.EndpointAccess
 	if s.scope.ControlPlane.Spec.RestrictPrivateSubnets {
-		updatedVpcConfig, err = makeVpcConfig(s.scope.Subnets().FilterPrivate(), endpointAccess, s.scope.SecurityGroups())
+		updatedVpcConfig, err = makeVpcConfig(s.scope.Subnets().FilterPrivate(), endpointAccess, s.scope.SecurityGroups(), s.scope.ControlPlane.Spec.AdditionalSecurityGroupIDs)
 	} else {
-		updatedVpcConfig, err = makeVpcConfig(s.scope.Subnets(), endpointAccess, s.scope.SecurityGroups())
+		updatedVpcConfig, err = makeVpcConfig(s.scope.Subnets(), endpointAccess, s.scope.SecurityGroups(), s.scope.ControlPlane.Spec.AdditionalSecurityGroupIDs)
 	}
 	if err != nil {
 		return nil, err
@@ -725,6 +860,36 @@ func (s *Service) reconcileVpcConfig(vpcConfig *ekstypes.VpcConfigResponse) (*ek
 			PublicAccessCidrs:     updatedVpcConfig.PublicAccessCidrs,
 		}, nil
 	}
+
+	// EKS doesn't allow updating the endpoint access and the security groups in the same
+	// request, so the security groups are only updated once the endpoint access is in sync.
+	// The update replaces the whole set, so unless the user asked for the set to be managed
+	// exclusively, the security groups attached out of band are kept.
+	current := sets.New(vpcConfig.SecurityGroupIds...)
+	desired := sets.New(updatedVpcConfig.SecurityGroupIds...)
+	if !s.scope.ControlPlane.Spec.ExclusiveAdditionalSecurityGroups {
+		if current.IsSuperset(desired) {
+			return nil, nil
+		}
+		securityGroupIDs := slices.Clone(vpcConfig.SecurityGroupIds)
+		for _, id := range updatedVpcConfig.SecurityGroupIds {
+			if !current.Has(id) {
+				securityGroupIDs = append(securityGroupIDs, id)
+			}
+		}
+		return &ekstypes.VpcConfigRequest{
+			SecurityGroupIds: securityGroupIDs,
+		}, nil
+	}
+	if !current.Equal(desired) {
+		securityGroupIDs := updatedVpcConfig.SecurityGroupIds
+		if securityGroupIDs == nil {
+			securityGroupIDs = []string{}
+		}
+		return &ekstypes.VpcConfigRequest{
+			SecurityGroupIds: securityGroupIDs,
+		}, nil
+	}
 	return nil, nil
 }
 
@@ -739,6 +904,9 @@ func (s *Service) reconcileEKSEncryptionConfig(ctx context.Context, currentClust
 
 	if compareEncryptionConfig(currentClusterConfig, updatedEncryptionConfigs) {
 		s.Debug("encryption configuration unchanged, no action")
+		if len(updatedEncryptionConfigs) > 0 {
+			v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSEncryptionConfigAssociatedCondition)
+		}
 		return nil
 	}
 
@@ -768,13 +936,69 @@ func versionToEKS(v *version.Version) string {
 	return fmt.Sprintf("%d.%d", v.Major(), v.Minor())
 }
 
-func (s *Service) reconcileClusterVersion(ctx context.Context, cluster *ekstypes.Cluster) error {
+// reconcileVersionAndUpgradePolicy starts at most one of the version and upgrade policy
+// updates, as EKS only allows one update to be in progress at a time. Extended support
+// is enabled before upgrading, so the cluster isn't upgraded automatically while the
+// version update waits. Moving back to standard support waits until the upgrade is
+// done, as EKS rejects it while the cluster runs a version in extended support.
+func (s *Service) reconcileVersionAndUpgradePolicy(ctx context.Context, cluster *ekstypes.Cluster) error {
+	upgradePolicy := s.reconcileUpgradePolicy(cluster.UpgradePolicy)
+	if upgradePolicy != nil && upgradePolicy.SupportType == ekstypes.SupportTypeExtended {
+		return s.updateUpgradePolicy(ctx, upgradePolicy)
+	}
+
+	updating, err := s.reconcileClusterVersion(ctx, cluster)
+	if err != nil {
+		return errors.Wrap(err, "failed reconciling cluster version")
+	}
+
+	if upgradePolicy != nil && !updating {
+		return s.updateUpgradePolicy(ctx, upgradePolicy)
+	}
+	return nil
+}
+
+func (s *Service) updateUpgradePolicy(ctx context.Context, upgradePolicy *ekstypes.UpgradePolicyRequest) error {
+	input := &eks.UpdateClusterConfigInput{
+		Name:          aws.String(s.scope.KubernetesClusterName()),
+		UpgradePolicy: upgradePolicy,
+	}
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if _, err := s.EKSClient.UpdateClusterConfig(ctx, input); err != nil {
+			return false, err
+		}
+
+		// Wait until status transitions to UPDATING because there's a short
+		// window after UpdateClusterConfig returns where the cluster
+		// status is ACTIVE and the update would be tried again
+		if err := s.EKSClient.WaitUntilClusterUpdating(
+			ctx,
+			&eks.DescribeClusterInput{Name: aws.String(s.scope.KubernetesClusterName())},
+			s.scope.MaxWaitActiveUpdateDelete,
+		); err != nil {
+			return false, err
+		}
+
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition)
+		record.Eventf(s.scope.ControlPlane, "InitiatedUpdateEKSControlPlane", "Initiated upgrade policy update of EKS control plane %s to %s support", s.scope.KubernetesClusterName(), upgradePolicy.SupportType)
+		return true, nil
+	}); err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "Failed to update EKS control plane upgrade policy: %v", err)
+		return errors.Wrapf(err, "failed to update EKS cluster upgrade policy")
+	}
+	return nil
+}
+
+// reconcileClusterVersion starts an upgrade to the next minor version if the cluster is
+// behind the spec, and returns true if an upgrade was started.
+func (s *Service) reconcileClusterVersion(ctx context.Context, cluster *ekstypes.Cluster) (bool, error) {
 	var specVersion *version.Version
 	if s.scope.ControlPlane.Spec.Version != nil {
 		var err error
 		specVersion, err = parseEKSVersion(*s.scope.ControlPlane.Spec.Version)
 		if err != nil {
-			return fmt.Errorf("parsing EKS version from spec: %w", err)
+			return false, fmt.Errorf("parsing EKS version from spec: %w", err)
 		}
 	}
 
@@ -785,6 +1009,10 @@ func (s *Service) reconcileClusterVersion(ctx context.Context, cluster *ekstypes
 		// need to go 1.14-> 1.15 and then 1.15 -> 1.16.
 		nextVersionString := versionToEKS(clusterVersion.WithMinor(clusterVersion.Minor() + 1))
 
+		if err := s.reconcilePreUpgradeHooks(nextVersionString); err != nil {
+			return false, err
+		}
+
 		input := &eks.UpdateClusterVersionInput{
 			Name:    aws.String(s.scope.KubernetesClusterName()),
 			Version: &nextVersionString,
@@ -812,10 +1040,43 @@ func (s *Service) reconcileClusterVersion(ctx context.Context, cluster *ekstypes
 			return true, nil
 		}); err != nil {
 			record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "failed to update the EKS control plane: %v", err)
-			return errors.Wrapf(err, "failed to update EKS cluster")
+			return false, errors.Wrapf(err, "failed to update EKS cluster")
 		}
+		return true, nil
 	}
-	return nil
+	return false, s.reconcilePreUpgradeHooks("")
+}
+
+// reconcilePreUpgradeHooks reports on the EKSControlPlanePreUpgradeHooksSucceeded condition
+// whether the hooks registered with PreUpgradeHookAnnotationPrefix annotations succeeded for
+// the upgrade to the given version, empty when no upgrade is pending. It returns
+// ErrClusterUpgradeHooksPending while some of them haven't.
+func (s *Service) reconcilePreUpgradeHooks(nextVersion string) error {
+	var hooks, pending []string
+	for key, value := range s.scope.ControlPlane.GetAnnotations() {
+		name, ok := strings.CutPrefix(key, ekscontrolplanev1.PreUpgradeHookAnnotationPrefix)
+		if !ok {
+			continue
+		}
+		hooks = append(hooks, name)
+		if nextVersion != "" && value != nextVersion {
+			pending = append(pending, name)
+		}
+	}
+	if len(hooks) == 0 {
+		v1beta1conditions.Delete(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlanePreUpgradeHooksSucceededCondition)
+		return nil
+	}
+	if len(pending) == 0 {
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlanePreUpgradeHooksSucceededCondition)
+		return nil
+	}
+
+	sort.Strings(pending)
+	s.scope.Info("Waiting for pre-upgrade hooks before upgrading the EKS control plane", "version", nextVersion, "hooks", pending)
+	v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlanePreUpgradeHooksSucceededCondition, ekscontrolplanev1.EKSControlPlanePreUpgradeHooksPendingReason, clusterv1beta1.ConditionSeverityInfo,
+		"Waiting for pre-upgrade hooks %s to succeed for version %s", strings.Join(pending, ", "), nextVersion)
+	return errors.Wrapf(ErrClusterUpgradeHooksPending, "upgrade to version %s waits for pre-upgrade hooks %s", nextVersion, strings.Join(pending, ", "))
 }
 
 func (s *Service) reconcileUpgradePolicy(upgradePolicy *ekstypes.UpgradePolicyResponse) *ekstypes.UpgradePolicyRequest {
@@ -862,9 +1123,13 @@ func (s *Service) updateEncryptionConfig(ctx context.Context, updatedEncryptionC
 		EncryptionConfig: updatedEncryptionConfigs,
 	}
 	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-		if _, err := s.EKSClient.AssociateEncryptionConfig(ctx, input); err != nil {
+		out, err := s.EKSClient.AssociateEncryptionConfig(ctx, input)
+		if err != nil {
 			return false, err
 		}
+		if out.Update != nil {
+			s.scope.ControlPlane.Status.EncryptionConfigUpdateID = out.Update.Id
+		}
 
 		// Wait until status transitions to UPDATING because there's a short
 		// window after UpdateClusterVersion returns where the cluster
@@ -878,6 +1143,7 @@ func (s *Service) updateEncryptionConfig(ctx context.Context, updatedEncryptionC
 		}
 
 		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition)
+		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSEncryptionConfigAssociatedCondition, ekscontrolplanev1.EKSEncryptionConfigAssociatingReason, clusterv1beta1.ConditionSeverityInfo, "")
 		record.Eventf(s.scope.ControlPlane, "InitiatedUpdateEncryptionConfig", "Initiated update of encryption config in EKS control plane %s", s.scope.KubernetesClusterName())
 
 		return true, nil
@@ -887,6 +1153,53 @@ func (s *Service) updateEncryptionConfig(ctx context.Context, updatedEncryptionC
 	return nil
 }
 
+// reconcileEncryptionConfigUpdate checks on the progress of the encryption config
+// association recorded in the status. ErrEncryptionConfigUpdateInProgress is
+// returned while the update hasn't completed.
+func (s *Service) reconcileEncryptionConfigUpdate(ctx context.Context) error {
+	updateID := s.scope.ControlPlane.Status.EncryptionConfigUpdateID
+
+	out, err := s.EKSClient.DescribeUpdate(ctx, &eks.DescribeUpdateInput{
+		Name:     aws.String(s.scope.KubernetesClusterName()),
+		UpdateId: updateID,
+	})
+	if err != nil {
+		smithyErr := awserrors.ParseSmithyError(err)
+		notFoundErr := &ekstypes.ResourceNotFoundException{}
+		if smithyErr.ErrorCode() == notFoundErr.ErrorCode() {
+			// The update has expired, the encryption config is reconciled again from the cluster.
+			s.scope.ControlPlane.Status.EncryptionConfigUpdateID = nil
+			return nil
+		}
+		return errors.Wrapf(err, "failed to describe encryption config update %s", *updateID)
+	}
+
+	switch out.Update.Status {
+	case ekstypes.UpdateStatusInProgress:
+		s.scope.Debug("encryption config association in progress", "update", *updateID)
+		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSEncryptionConfigAssociatedCondition, ekscontrolplanev1.EKSEncryptionConfigAssociatingReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return ErrEncryptionConfigUpdateInProgress
+	case ekstypes.UpdateStatusSuccessful:
+		s.scope.ControlPlane.Status.EncryptionConfigUpdateID = nil
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSEncryptionConfigAssociatedCondition)
+		record.Eventf(s.scope.ControlPlane, "SuccessfulUpdateEncryptionConfig", "Associated encryption config with EKS control plane %s", s.scope.KubernetesClusterName())
+		return nil
+	default:
+		s.scope.ControlPlane.Status.EncryptionConfigUpdateID = nil
+		msgs := make([]string, 0, len(out.Update.Errors))
+		for _, updateErr := range out.Update.Errors {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", updateErr.ErrorCode, aws.ToString(updateErr.ErrorMessage)))
+		}
+		msg := fmt.Sprintf("encryption config update %s finished with status %s", *updateID, out.Update.Status)
+		if len(msgs) > 0 {
+			msg = fmt.Sprintf("%s: %s", msg, strings.Join(msgs, "; "))
+		}
+		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSEncryptionConfigAssociatedCondition, ekscontrolplanev1.EKSEncryptionConfigAssociationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", msg)
+		record.Warnf(s.scope.ControlPlane, "FailedUpdateEncryptionConfig", "Failed to associate encryption config with EKS control plane %s: %s", s.scope.KubernetesClusterName(), msg)
+		return errors.New(msg)
+	}
+}
+
 func compareEncryptionConfig(updatedEncryptionConfig, existingEncryptionConfig []ekstypes.EncryptionConfig) bool {
 	if len(updatedEncryptionConfig) != len(existingEncryptionConfig) {
 		return false
@@ -18,16 +18,23 @@ package eks
 
 import (
 	"context"
+	"maps"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	signerv4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/version"
@@ -39,7 +46,11 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/sts/mock_stsiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
 func TestMakeEKSEncryptionConfigs(t *testing.T) {
@@ -271,7 +282,7 @@ func TestMakeVPCConfig(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			g := NewWithT(t)
-			config, err := makeVpcConfig(tc.input.subnets, tc.input.endpointAccess, tc.input.securityGroups)
+			config, err := makeVpcConfig(tc.input.subnets, tc.input.endpointAccess, tc.input.securityGroups, nil)
 			if tc.err {
 				g.Expect(err).To(HaveOccurred())
 			} else {
@@ -464,7 +475,7 @@ func TestReconcileClusterVersion(t *testing.T) {
 			cluster, err := s.describeEKSCluster(context.TODO(), clusterName)
 			g.Expect(err).To(BeNil())
 
-			err = s.reconcileClusterVersion(context.TODO(), cluster)
+			_, err = s.reconcileClusterVersion(context.TODO(), cluster)
 			if tc.expectError {
 				g.Expect(err).To(HaveOccurred())
 				return
@@ -474,6 +485,106 @@ func TestReconcileClusterVersion(t *testing.T) {
 	}
 }
 
+func TestReconcileClusterVersionPreUpgradeHooks(t *testing.T) {
+	tests := []struct {
+		name            string
+		clusterVersion  string
+		hooks           map[string]string
+		expectUpgrade   bool
+		expectPending   bool
+		expectCondition *clusterv1beta1.Condition
+	}{
+		{
+			name:           "upgrade without hooks",
+			clusterVersion: "1.30",
+			expectUpgrade:  true,
+		},
+		{
+			name:           "upgrade waits for the hooks to succeed for the next version",
+			clusterVersion: "1.30",
+			hooks:          map[string]string{"snapshot": "1.31", "notify": "1.30", "audit": ""},
+			expectPending:  true,
+			expectCondition: v1beta1conditions.FalseCondition(ekscontrolplanev1.EKSControlPlanePreUpgradeHooksSucceededCondition, ekscontrolplanev1.EKSControlPlanePreUpgradeHooksPendingReason, clusterv1beta1.ConditionSeverityInfo,
+				"Waiting for pre-upgrade hooks audit, notify to succeed for version 1.31"),
+		},
+		{
+			name:            "upgrade starts once the hooks succeeded",
+			clusterVersion:  "1.30",
+			hooks:           map[string]string{"snapshot": "1.31", "notify": "1.31"},
+			expectUpgrade:   true,
+			expectCondition: v1beta1conditions.TrueCondition(ekscontrolplanev1.EKSControlPlanePreUpgradeHooksSucceededCondition),
+		},
+		{
+			name:           "each step of a multi-version upgrade waits for the hooks",
+			clusterVersion: "1.29",
+			hooks:          map[string]string{"snapshot": "1.31"},
+			expectPending:  true,
+			expectCondition: v1beta1conditions.FalseCondition(ekscontrolplanev1.EKSControlPlanePreUpgradeHooksSucceededCondition, ekscontrolplanev1.EKSControlPlanePreUpgradeHooksPendingReason, clusterv1beta1.ConditionSeverityInfo,
+				"Waiting for pre-upgrade hooks snapshot to succeed for version 1.30"),
+		},
+		{
+			name:            "hooks don't gate a cluster already at the version",
+			clusterVersion:  "1.31",
+			hooks:           map[string]string{"snapshot": "1.30"},
+			expectCondition: v1beta1conditions.TrueCondition(ekscontrolplanev1.EKSControlPlanePreUpgradeHooksSucceededCondition),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			annotations := map[string]string{"unrelated": "1.30"}
+			for name, value := range tc.hooks {
+				annotations[ekscontrolplanev1.PreUpgradeHookAnnotationPrefix+name] = value
+			}
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cluster"},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+					Spec:       ekscontrolplanev1.AWSManagedControlPlaneSpec{Version: aws.String("1.31")},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			// The mock fails the test on an upgrade that isn't expected.
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			if tc.expectUpgrade {
+				eksMock.EXPECT().UpdateClusterVersion(gomock.Any(), gomock.Any()).Return(&eks.UpdateClusterVersionOutput{}, nil)
+				eksMock.EXPECT().WaitUntilClusterUpdating(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			}
+			s := NewService(scope)
+			s.EKSClient = eksMock
+
+			updating, err := s.reconcileClusterVersion(context.TODO(), &ekstypes.Cluster{Version: aws.String(tc.clusterVersion)})
+			g.Expect(updating).To(Equal(tc.expectUpgrade))
+			if tc.expectPending {
+				g.Expect(err).To(MatchError(ErrClusterUpgradeHooksPending))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+
+			condition := v1beta1conditions.Get(scope.ControlPlane, ekscontrolplanev1.EKSControlPlanePreUpgradeHooksSucceededCondition)
+			if tc.expectCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tc.expectCondition.Status))
+			g.Expect(condition.Reason).To(Equal(tc.expectCondition.Reason))
+			g.Expect(condition.Message).To(Equal(tc.expectCondition.Message))
+		})
+	}
+}
+
 func TestReconcileAccessConfig(t *testing.T) {
 	clusterName := "default.cluster"
 	tests := []struct {
@@ -809,6 +920,362 @@ func TestReconcileEKSEncryptionConfig(t *testing.T) {
 	}
 }
 
+func TestReconcileEncryptionConfigAssociation(t *testing.T) {
+	clusterName := "default.cluster"
+	updateID := "update-1"
+
+	newService := func(g *WithT, eksMock *mock_eksiface.MockEKSAPI) *Service {
+		scheme := runtime.NewScheme()
+		_ = infrav1.AddToScheme(scheme)
+		_ = ekscontrolplanev1.AddToScheme(scheme)
+		client := fake.NewClientBuilder().WithScheme(scheme).Build()
+		scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+			Client: client,
+			Cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      clusterName,
+				},
+			},
+			ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					Version: aws.String("1.16"),
+					EncryptionConfig: &ekscontrolplanev1.EncryptionConfig{
+						Provider:  ptr.To[string]("provider"),
+						Resources: []*string{ptr.To[string]("secrets")},
+					},
+				},
+			},
+		})
+		g.Expect(err).To(BeNil())
+
+		s := NewService(scope)
+		s.EKSClient = eksMock
+		return s
+	}
+
+	associate := func(g *WithT, s *Service, m *mock_eksiface.MockEKSAPIMockRecorder) {
+		m.AssociateEncryptionConfig(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.AssociateEncryptionConfigInput{})).Return(&eks.AssociateEncryptionConfigOutput{
+			Update: &ekstypes.Update{
+				Id:     aws.String(updateID),
+				Status: ekstypes.UpdateStatusInProgress,
+			},
+		}, nil)
+		m.WaitUntilClusterUpdating(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}), gomock.Any()).Return(nil)
+
+		g.Expect(s.reconcileEKSEncryptionConfig(context.TODO(), nil)).To(Succeed())
+		g.Expect(s.scope.ControlPlane.Status.EncryptionConfigUpdateID).To(Equal(aws.String(updateID)))
+		g.Expect(v1beta1conditions.GetReason(s.scope.ControlPlane, ekscontrolplanev1.EKSEncryptionConfigAssociatedCondition)).To(Equal(ekscontrolplanev1.EKSEncryptionConfigAssociatingReason))
+	}
+
+	describeUpdate := func(s *Service, m *mock_eksiface.MockEKSAPIMockRecorder, update *ekstypes.Update) {
+		m.DescribeUpdate(gomock.Eq(context.TODO()), gomock.Eq(&eks.DescribeUpdateInput{
+			Name:     aws.String(s.scope.KubernetesClusterName()),
+			UpdateId: aws.String(updateID),
+		})).Return(&eks.DescribeUpdateOutput{Update: update}, nil)
+	}
+
+	t.Run("association completes over several reconciles", func(t *testing.T) {
+		g := NewWithT(t)
+		mockControl := gomock.NewController(t)
+		defer mockControl.Finish()
+		eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+		s := newService(g, eksMock)
+
+		associate(g, s, eksMock.EXPECT())
+
+		describeUpdate(s, eksMock.EXPECT(), &ekstypes.Update{Id: aws.String(updateID), Status: ekstypes.UpdateStatusInProgress})
+		err := s.reconcileEncryptionConfigUpdate(context.TODO())
+		g.Expect(errors.Is(err, ErrEncryptionConfigUpdateInProgress)).To(BeTrue())
+		g.Expect(s.scope.ControlPlane.Status.EncryptionConfigUpdateID).To(Equal(aws.String(updateID)))
+
+		describeUpdate(s, eksMock.EXPECT(), &ekstypes.Update{Id: aws.String(updateID), Status: ekstypes.UpdateStatusSuccessful})
+		g.Expect(s.reconcileEncryptionConfigUpdate(context.TODO())).To(Succeed())
+		g.Expect(s.scope.ControlPlane.Status.EncryptionConfigUpdateID).To(BeNil())
+		g.Expect(v1beta1conditions.IsTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSEncryptionConfigAssociatedCondition)).To(BeTrue())
+	})
+
+	t.Run("failed association is reported on the condition", func(t *testing.T) {
+		g := NewWithT(t)
+		mockControl := gomock.NewController(t)
+		defer mockControl.Finish()
+		eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+		s := newService(g, eksMock)
+
+		associate(g, s, eksMock.EXPECT())
+
+		describeUpdate(s, eksMock.EXPECT(), &ekstypes.Update{
+			Id:     aws.String(updateID),
+			Status: ekstypes.UpdateStatusFailed,
+			Errors: []ekstypes.ErrorDetail{
+				{
+					ErrorCode:    ekstypes.ErrorCodeAccessDenied,
+					ErrorMessage: aws.String("key policy doesn't allow the cluster role"),
+				},
+			},
+		})
+		err := s.reconcileEncryptionConfigUpdate(context.TODO())
+		g.Expect(err).To(MatchError(ContainSubstring("key policy doesn't allow the cluster role")))
+		g.Expect(s.scope.ControlPlane.Status.EncryptionConfigUpdateID).To(BeNil())
+
+		condition := v1beta1conditions.Get(s.scope.ControlPlane, ekscontrolplanev1.EKSEncryptionConfigAssociatedCondition)
+		g.Expect(condition).NotTo(BeNil())
+		g.Expect(condition.Reason).To(Equal(ekscontrolplanev1.EKSEncryptionConfigAssociationFailedReason))
+		g.Expect(condition.Severity).To(Equal(clusterv1beta1.ConditionSeverityError))
+		g.Expect(condition.Message).To(ContainSubstring(string(ekstypes.ErrorCodeAccessDenied)))
+	})
+}
+
+func TestReconcileClusterEncryptionConfigAssociating(t *testing.T) {
+	const updateID = "update-1"
+
+	g := NewWithT(t)
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+	eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+	ec2Mock := mocks.NewMockEC2API(mockControl)
+	stsMock := mock_stsiface.NewMockSTSClient(mockControl)
+
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cp"},
+		Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+			EKSClusterName: "eks-cluster",
+			Version:        aws.String("1.31"),
+		},
+		Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+			Ready:                    true,
+			EncryptionConfigUpdateID: aws.String(updateID),
+		},
+	}
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(controlPlane).WithStatusSubresource(controlPlane).Build()
+	scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+		Client:       client,
+		Cluster:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cluster"}},
+		ControlPlane: controlPlane,
+	})
+	g.Expect(err).To(BeNil())
+
+	s := NewService(scope)
+	s.EKSClient = eksMock
+	s.EC2Client = ec2Mock
+	s.STSClient = stsMock
+
+	eksMock.EXPECT().DescribeCluster(gomock.Any(), &eks.DescribeClusterInput{Name: aws.String("eks-cluster")}).Return(&eks.DescribeClusterOutput{
+		Cluster: &ekstypes.Cluster{
+			Name:                 aws.String("eks-cluster"),
+			Status:               ekstypes.ClusterStatusUpdating,
+			Version:              aws.String("1.31"),
+			Endpoint:             aws.String("https://eks-cluster.us-east-1.eks.amazonaws.com"),
+			CertificateAuthority: &ekstypes.Certificate{Data: aws.String("LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0t")},
+			ResourcesVpcConfig:   &ekstypes.VpcConfigResponse{ClusterSecurityGroupId: aws.String("sg-cluster")},
+			Tags:                 map[string]string{infrav1.ClusterAWSCloudProviderTagKey("eks-cluster"): string(infrav1.ResourceLifecycleOwned)},
+		},
+	}, nil)
+	eksMock.EXPECT().DescribeUpdate(gomock.Any(), &eks.DescribeUpdateInput{
+		Name:     aws.String("eks-cluster"),
+		UpdateId: aws.String(updateID),
+	}).Return(&eks.DescribeUpdateOutput{Update: &ekstypes.Update{Id: aws.String(updateID), Status: ekstypes.UpdateStatusInProgress}}, nil)
+	// The security groups and the kubeconfig are still reconciled, without waiting for the
+	// cluster to be active or sending other updates EKS would reject.
+	ec2Mock.EXPECT().DescribeSecurityGroups(gomock.Any(), gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{
+		SecurityGroups: []ec2types.SecurityGroup{{GroupId: aws.String("sg-cluster"), GroupName: aws.String("eks-cluster-sg")}},
+	}, nil).Times(2)
+	stsMock.EXPECT().PresignGetCallerIdentity(gomock.Any(), gomock.Any(), gomock.Any()).Return(&signerv4.PresignedHTTPRequest{URL: "https://example.com"}, nil)
+	stsMock.EXPECT().GetCallerIdentity(gomock.Any(), gomock.Any()).Return(&sts.GetCallerIdentityOutput{
+		Arn: aws.String("arn:aws:sts::123456789012:user/capa"),
+	}, nil).AnyTimes()
+
+	err = s.reconcileCluster(context.TODO())
+	g.Expect(errors.Is(err, ErrEncryptionConfigUpdateInProgress)).To(BeTrue())
+	g.Expect(s.scope.ControlPlane.Status.Initialized).To(BeTrue())
+	g.Expect(s.scope.ControlPlane.Status.Network.SecurityGroups).To(HaveKey(ekscontrolplanev1.SecurityGroupCluster))
+	g.Expect(v1beta1conditions.GetReason(s.scope.ControlPlane, ekscontrolplanev1.EKSEncryptionConfigAssociatedCondition)).To(Equal(ekscontrolplanev1.EKSEncryptionConfigAssociatingReason))
+}
+
+func TestReconcileClusterCreationProgress(t *testing.T) {
+	g := NewWithT(t)
+
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+	eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "cp",
+		},
+		Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+			EKSClusterName: "eks-cluster",
+			Version:        aws.String("1.31"),
+		},
+	}
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(controlPlane).WithStatusSubresource(controlPlane).Build()
+	scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "cluster",
+			},
+		},
+		ControlPlane:              controlPlane,
+		MaxWaitActiveUpdateDelete: 30 * time.Minute,
+	})
+	g.Expect(err).To(BeNil())
+
+	s := NewService(scope)
+	s.EKSClient = eksMock
+
+	describeCluster := func(status ekstypes.ClusterStatus, age time.Duration) {
+		eksMock.EXPECT().DescribeCluster(gomock.Any(), &eks.DescribeClusterInput{Name: aws.String("eks-cluster")}).Return(&eks.DescribeClusterOutput{
+			Cluster: &ekstypes.Cluster{
+				Name:      aws.String("eks-cluster"),
+				Status:    status,
+				Version:   aws.String("1.31"),
+				CreatedAt: aws.Time(time.Now().Add(-age)),
+				Tags:      map[string]string{infrav1.ClusterAWSCloudProviderTagKey("eks-cluster"): string(infrav1.ResourceLifecycleOwned)},
+			},
+		}, nil)
+	}
+
+	// The cluster is being created, its progress is reported and the reconcile requeued.
+	describeCluster(ekstypes.ClusterStatusCreating, 2*time.Minute)
+	err = s.reconcileCluster(context.TODO())
+	g.Expect(errors.Is(err, ErrClusterCreating)).To(BeTrue())
+	g.Expect(v1beta1conditions.IsTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneCreatingCondition)).To(BeTrue())
+	g.Expect(v1beta1conditions.GetReason(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneReadyCondition)).To(Equal(ekscontrolplanev1.EKSControlPlaneCreatingReason))
+	g.Expect(v1beta1conditions.GetMessage(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneReadyCondition)).To(ContainSubstring("2m0s elapsed"))
+
+	// Progress keeps being reported on later reconciles.
+	describeCluster(ekstypes.ClusterStatusCreating, 12*time.Minute)
+	err = s.reconcileCluster(context.TODO())
+	g.Expect(errors.Is(err, ErrClusterCreating)).To(BeTrue())
+	g.Expect(v1beta1conditions.GetMessage(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneReadyCondition)).To(ContainSubstring("12m0s elapsed"))
+
+	// The creation is taking longer than the timeout.
+	describeCluster(ekstypes.ClusterStatusCreating, 31*time.Minute)
+	err = s.reconcileCluster(context.TODO())
+	g.Expect(errors.Is(err, ErrClusterCreationTimedOut)).To(BeTrue())
+	condition := v1beta1conditions.Get(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneReadyCondition)
+	g.Expect(condition.Reason).To(Equal(ekscontrolplanev1.EKSControlPlaneCreationTimedOutReason))
+	g.Expect(condition.Severity).To(Equal(clusterv1beta1.ConditionSeverityError))
+
+	// Once active the cluster is no longer creating.
+	g.Expect(s.setStatus(&ekstypes.Cluster{Status: ekstypes.ClusterStatusActive, Version: aws.String("1.31")})).To(Succeed())
+	g.Expect(s.scope.ControlPlane.Status.Ready).To(BeTrue())
+	g.Expect(v1beta1conditions.IsFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneCreatingCondition)).To(BeTrue())
+}
+
+func TestReconcileClusterOwnership(t *testing.T) {
+	clusterARN := "arn:aws:eks:us-east-1:123456789012:cluster/eks-cluster"
+	ownedTagKey := infrav1.ClusterAWSCloudProviderTagKey("eks-cluster")
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		tags        map[string]string
+		expect      func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectErr   bool
+	}{
+		{
+			name: "owned cluster",
+			tags: map[string]string{ownedTagKey: string(infrav1.ResourceLifecycleOwned)},
+		},
+		{
+			name:      "unowned cluster is refused",
+			tags:      map[string]string{"team": "platform"},
+			expectErr: true,
+		},
+		{
+			name:        "unowned cluster isn't adopted when the annotation isn't true",
+			annotations: map[string]string{infrav1.AdoptAnnotation: "false"},
+			expectErr:   true,
+		},
+		{
+			name:        "unowned cluster is adopted",
+			annotations: map[string]string{infrav1.AdoptAnnotation: "true"},
+			tags:        map[string]string{"team": "platform"},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.TagResource(gomock.Any(), &eks.TagResourceInput{
+					ResourceArn: aws.String(clusterARN),
+					Tags:        map[string]string{ownedTagKey: string(infrav1.ResourceLifecycleOwned)},
+				}).Return(&eks.TagResourceOutput{}, nil)
+			},
+		},
+		{
+			name:        "failure to tag the adopted cluster is returned",
+			annotations: map[string]string{infrav1.AdoptAnnotation: "true"},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.TagResource(gomock.Any(), gomock.Any()).Return(nil, errors.New("access denied"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "ns",
+					Name:        "cp",
+					Annotations: tt.annotations,
+				},
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName: "eks-cluster",
+				},
+			}
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(controlPlane).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "cluster",
+					},
+				},
+				ControlPlane: controlPlane,
+			})
+			g.Expect(err).To(BeNil())
+
+			s := NewService(scope)
+			s.EKSClient = eksMock
+			if tt.expect != nil {
+				tt.expect(eksMock.EXPECT())
+			}
+
+			describedTags := maps.Clone(tt.tags)
+			cluster := &ekstypes.Cluster{
+				Name: aws.String("eks-cluster"),
+				Arn:  aws.String(clusterARN),
+				Tags: tt.tags,
+			}
+			err = s.reconcileClusterOwnership(context.TODO(), cluster)
+			// The described tags may be cached, they're never modified.
+			g.Expect(tt.tags).To(Equal(describedTags))
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(cluster.Tags).To(HaveKeyWithValue(ownedTagKey, string(infrav1.ResourceLifecycleOwned)))
+		})
+	}
+}
+
 func TestReconcileUpgradePolicy(t *testing.T) {
 	clusterName := "default.cluster"
 	tests := []struct {
@@ -894,6 +1361,122 @@ func TestReconcileUpgradePolicy(t *testing.T) {
 	}
 }
 
+func TestReconcileVersionAndUpgradePolicy(t *testing.T) {
+	clusterName := "default.cluster"
+	expectUpgradePolicyUpdate := func(m *mock_eksiface.MockEKSAPIMockRecorder, supportType ekstypes.SupportType) {
+		m.UpdateClusterConfig(gomock.Eq(context.TODO()), gomock.Eq(&eks.UpdateClusterConfigInput{
+			Name:          aws.String(clusterName),
+			UpgradePolicy: &ekstypes.UpgradePolicyRequest{SupportType: supportType},
+		})).Return(&eks.UpdateClusterConfigOutput{}, nil)
+		m.WaitUntilClusterUpdating(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}), gomock.Any()).Return(nil)
+	}
+	expectVersionUpdate := func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+		m.UpdateClusterVersion(gomock.Eq(context.TODO()), gomock.Eq(&eks.UpdateClusterVersionInput{
+			Name:    aws.String(clusterName),
+			Version: aws.String("1.16"),
+		})).Return(&eks.UpdateClusterVersionOutput{}, nil)
+		m.WaitUntilClusterUpdating(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}), gomock.Any()).Return(nil)
+	}
+
+	tests := []struct {
+		name              string
+		clusterVersion    string
+		clusterPolicy     ekstypes.SupportType
+		specUpgradePolicy ekscontrolplanev1.UpgradePolicy
+		expect            func(m *mock_eksiface.MockEKSAPIMockRecorder)
+	}{
+		{
+			name:              "no update necessary",
+			clusterVersion:    "1.16",
+			clusterPolicy:     ekstypes.SupportTypeStandard,
+			specUpgradePolicy: ekscontrolplanev1.UpgradePolicyStandard,
+			expect:            func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+		{
+			name:              "policy only change to extended",
+			clusterVersion:    "1.16",
+			clusterPolicy:     ekstypes.SupportTypeStandard,
+			specUpgradePolicy: ekscontrolplanev1.UpgradePolicyExtended,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				expectUpgradePolicyUpdate(m, ekstypes.SupportTypeExtended)
+			},
+		},
+		{
+			name:              "policy only change to standard",
+			clusterVersion:    "1.16",
+			clusterPolicy:     ekstypes.SupportTypeExtended,
+			specUpgradePolicy: ekscontrolplanev1.UpgradePolicyStandard,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				expectUpgradePolicyUpdate(m, ekstypes.SupportTypeStandard)
+			},
+		},
+		{
+			name:              "version and policy change to extended updates the policy first",
+			clusterVersion:    "1.15",
+			clusterPolicy:     ekstypes.SupportTypeStandard,
+			specUpgradePolicy: ekscontrolplanev1.UpgradePolicyExtended,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				expectUpgradePolicyUpdate(m, ekstypes.SupportTypeExtended)
+				m.UpdateClusterVersion(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:              "version and policy change to standard updates the version first",
+			clusterVersion:    "1.15",
+			clusterPolicy:     ekstypes.SupportTypeExtended,
+			specUpgradePolicy: ekscontrolplanev1.UpgradePolicyStandard,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				expectVersionUpdate(m)
+				m.UpdateClusterConfig(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						EKSClusterName: clusterName,
+						Version:        aws.String("1.16"),
+						UpgradePolicy:  tc.specUpgradePolicy,
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(eksMock.EXPECT())
+			s := NewService(scope)
+			s.EKSClient = eksMock
+
+			cluster := &ekstypes.Cluster{
+				Name:          aws.String(clusterName),
+				Version:       aws.String(tc.clusterVersion),
+				UpgradePolicy: &ekstypes.UpgradePolicyResponse{SupportType: tc.clusterPolicy},
+			}
+			g.Expect(s.reconcileVersionAndUpgradePolicy(context.TODO(), cluster)).To(Succeed())
+		})
+	}
+}
+
 func TestCreateIPv6Cluster(t *testing.T) {
 	g := NewWithT(t)
 
@@ -1064,3 +1647,170 @@ func TestCreateClusterWithBootstrapClusterCreatorAdminPermissions(t *testing.T)
 	_, err = s.createCluster(context.TODO(), clusterName)
 	g.Expect(err).To(BeNil())
 }
+
+func TestReconcileClusterConfigSecurityGroups(t *testing.T) {
+	clusterName := "default.cluster"
+	vpcID := "vpc-123"
+	nodeSG := "sg-node"
+
+	describeSecurityGroups := func(m *mocks.MockEC2APIMockRecorder, ids []string, existing ...string) {
+		out := &ec2.DescribeSecurityGroupsOutput{}
+		for _, id := range existing {
+			out.SecurityGroups = append(out.SecurityGroups, ec2types.SecurityGroup{GroupId: aws.String(id), VpcId: aws.String(vpcID)})
+		}
+		m.DescribeSecurityGroups(gomock.Any(), &ec2.DescribeSecurityGroupsInput{
+			Filters: []ec2types.Filter{
+				{Name: aws.String("vpc-id"), Values: []string{vpcID}},
+				{Name: aws.String("group-id"), Values: ids},
+			},
+		}).Return(out, nil)
+	}
+	updateSecurityGroups := func(m *mock_eksiface.MockEKSAPIMockRecorder, ids []string) {
+		m.UpdateClusterConfig(gomock.Any(), &eks.UpdateClusterConfigInput{
+			Name: aws.String(clusterName),
+			ResourcesVpcConfig: &ekstypes.VpcConfigRequest{
+				SecurityGroupIds: ids,
+			},
+		}).Return(&eks.UpdateClusterConfigOutput{}, nil)
+	}
+
+	tests := []struct {
+		name                       string
+		currentSecurityGroupIDs    []string
+		additionalSecurityGroupIDs []string
+		exclusive                  bool
+		expectEC2                  func(m *mocks.MockEC2APIMockRecorder)
+		expectEKS                  func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectErr                  error
+		expectErrMsg               string
+	}{
+		{
+			name:                       "no update when the security groups are in sync",
+			currentSecurityGroupIDs:    []string{"sg-extra", nodeSG},
+			additionalSecurityGroupIDs: []string{"sg-extra"},
+		},
+		{
+			name:                       "adds an additional security group",
+			currentSecurityGroupIDs:    []string{nodeSG},
+			additionalSecurityGroupIDs: []string{"sg-extra"},
+			expectEC2: func(m *mocks.MockEC2APIMockRecorder) {
+				describeSecurityGroups(m, []string{nodeSG, "sg-extra"}, nodeSG, "sg-extra")
+			},
+			expectEKS: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				updateSecurityGroups(m, []string{nodeSG, "sg-extra"})
+			},
+			expectErr: ErrClusterUpdating,
+		},
+		{
+			name:                       "keeps the security groups attached out of band",
+			currentSecurityGroupIDs:    []string{nodeSG, "sg-adopted"},
+			additionalSecurityGroupIDs: []string{"sg-extra"},
+			expectEC2: func(m *mocks.MockEC2APIMockRecorder) {
+				describeSecurityGroups(m, []string{nodeSG, "sg-adopted", "sg-extra"}, nodeSG, "sg-adopted", "sg-extra")
+			},
+			expectEKS: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				updateSecurityGroups(m, []string{nodeSG, "sg-adopted", "sg-extra"})
+			},
+			expectErr: ErrClusterUpdating,
+		},
+		{
+			name:                    "no update when only security groups attached out of band differ",
+			currentSecurityGroupIDs: []string{nodeSG, "sg-adopted"},
+		},
+		{
+			name:                    "removes an additional security group when managed exclusively",
+			currentSecurityGroupIDs: []string{nodeSG, "sg-old"},
+			exclusive:               true,
+			expectEC2: func(m *mocks.MockEC2APIMockRecorder) {
+				describeSecurityGroups(m, []string{nodeSG}, nodeSG)
+			},
+			expectEKS: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				updateSecurityGroups(m, []string{nodeSG})
+			},
+			expectErr: ErrClusterUpdating,
+		},
+		{
+			name:                       "security group outside of the VPC isn't attached",
+			currentSecurityGroupIDs:    []string{nodeSG},
+			additionalSecurityGroupIDs: []string{"sg-other-vpc"},
+			expectEC2: func(m *mocks.MockEC2APIMockRecorder) {
+				describeSecurityGroups(m, []string{nodeSG, "sg-other-vpc"}, nodeSG)
+			},
+			expectErrMsg: "security groups [sg-other-vpc] don't exist in VPC vpc-123",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			ec2Mock := mocks.NewMockEC2API(mockControl)
+			if tc.expectEKS != nil {
+				tc.expectEKS(eksMock.EXPECT())
+			}
+			if tc.expectEC2 != nil {
+				tc.expectEC2(ec2Mock.EXPECT())
+			}
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						EKSClusterName:                    clusterName,
+						AdditionalSecurityGroupIDs:        tc.additionalSecurityGroupIDs,
+						ExclusiveAdditionalSecurityGroups: tc.exclusive,
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC: infrav1.VPCSpec{ID: vpcID},
+							Subnets: infrav1.Subnets{
+								{ID: "subnet-1", AvailabilityZone: "us-east-1a"},
+								{ID: "subnet-2", AvailabilityZone: "us-east-1b"},
+							},
+						},
+					},
+					Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+						Network: infrav1.NetworkStatus{
+							SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+								infrav1.SecurityGroupEKSNodeAdditional: {ID: nodeSG},
+							},
+						},
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			s := NewService(scope)
+			s.EKSClient = eksMock
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileClusterConfig(context.TODO(), &ekstypes.Cluster{
+				Name: aws.String(clusterName),
+				ResourcesVpcConfig: &ekstypes.VpcConfigResponse{
+					EndpointPublicAccess: true,
+					SecurityGroupIds:     tc.currentSecurityGroupIDs,
+				},
+			})
+			switch {
+			case tc.expectErr != nil:
+				g.Expect(err).To(MatchError(tc.expectErr))
+			case tc.expectErrMsg != "":
+				g.Expect(err).To(MatchError(tc.expectErrMsg))
+			default:
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/util/cache"
+)
+
+// cachedEKSClient serves DescribeCluster and DescribeNodegroup from a describe cache,
+// and invalidates the cached outputs after the calls changing the cluster or the
+// nodegroup. The cached outputs are shared, so callers must not modify them.
+type cachedEKSClient struct {
+	EKSAPI
+	cache     *cache.DescribeCache
+	keyPrefix string
+}
+
+// newCachedEKSClient returns an EKS client using the default describe cache. The
+// keyPrefix scopes the cached outputs to a control plane, see describeCacheKeyPrefix.
+func newCachedEKSClient(client EKSAPI, keyPrefix string) EKSAPI {
+	return &cachedEKSClient{
+		EKSAPI:    client,
+		cache:     cache.DefaultDescribeCache,
+		keyPrefix: keyPrefix,
+	}
+}
+
+func (c *cachedEKSClient) clusterKey(clusterName *string) string {
+	return c.keyPrefix + "eks/" + aws.ToString(clusterName) + "/"
+}
+
+func (c *cachedEKSClient) nodegroupKey(clusterName, nodegroupName *string) string {
+	return c.clusterKey(clusterName) + "nodegroup/" + aws.ToString(nodegroupName)
+}
+
+func (c *cachedEKSClient) DescribeCluster(ctx context.Context, params *eks.DescribeClusterInput, optFns ...func(*eks.Options)) (*eks.DescribeClusterOutput, error) {
+	key := c.clusterKey(params.Name)
+	if out, ok := c.cache.Get(key); ok {
+		return out.(*eks.DescribeClusterOutput), nil
+	}
+	out, err := c.EKSAPI.DescribeCluster(ctx, params, optFns...)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Add(key, out)
+	return out, nil
+}
+
+func (c *cachedEKSClient) DescribeNodegroup(ctx context.Context, params *eks.DescribeNodegroupInput, optFns ...func(*eks.Options)) (*eks.DescribeNodegroupOutput, error) {
+	key := c.nodegroupKey(params.ClusterName, params.NodegroupName)
+	if out, ok := c.cache.Get(key); ok {
+		return out.(*eks.DescribeNodegroupOutput), nil
+	}
+	out, err := c.EKSAPI.DescribeNodegroup(ctx, params, optFns...)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Add(key, out)
+	return out, nil
+}
+
+func (c *cachedEKSClient) CreateCluster(ctx context.Context, params *eks.CreateClusterInput, optFns ...func(*eks.Options)) (*eks.CreateClusterOutput, error) {
+	defer c.cache.Invalidate(c.clusterKey(params.Name))
+	return c.EKSAPI.CreateCluster(ctx, params, optFns...)
+}
+
+func (c *cachedEKSClient) DeleteCluster(ctx context.Context, params *eks.DeleteClusterInput, optFns ...func(*eks.Options)) (*eks.DeleteClusterOutput, error) {
+	defer c.cache.Invalidate(c.clusterKey(params.Name))
+	return c.EKSAPI.DeleteCluster(ctx, params, optFns...)
+}
+
+func (c *cachedEKSClient) UpdateClusterConfig(ctx context.Context, params *eks.UpdateClusterConfigInput, optFns ...func(*eks.Options)) (*eks.UpdateClusterConfigOutput, error) {
+	defer c.cache.Invalidate(c.clusterKey(params.Name))
+	return c.EKSAPI.UpdateClusterConfig(ctx, params, optFns...)
+}
+
+func (c *cachedEKSClient) UpdateClusterVersion(ctx context.Context, params *eks.UpdateClusterVersionInput, optFns ...func(*eks.Options)) (*eks.UpdateClusterVersionOutput, error) {
+	defer c.cache.Invalidate(c.clusterKey(params.Name))
+	return c.EKSAPI.UpdateClusterVersion(ctx, params, optFns...)
+}
+
+func (c *cachedEKSClient) AssociateEncryptionConfig(ctx context.Context, params *eks.AssociateEncryptionConfigInput, optFns ...func(*eks.Options)) (*eks.AssociateEncryptionConfigOutput, error) {
+	defer c.cache.Invalidate(c.clusterKey(params.ClusterName))
+	return c.EKSAPI.AssociateEncryptionConfig(ctx, params, optFns...)
+}
+
+func (c *cachedEKSClient) AssociateIdentityProviderConfig(ctx context.Context, params *eks.AssociateIdentityProviderConfigInput, optFns ...func(*eks.Options)) (*eks.AssociateIdentityProviderConfigOutput, error) {
+	defer c.cache.Invalidate(c.clusterKey(params.ClusterName))
+	return c.EKSAPI.AssociateIdentityProviderConfig(ctx, params, optFns...)
+}
+
+func (c *cachedEKSClient) DisassociateIdentityProviderConfig(ctx context.Context, params *eks.DisassociateIdentityProviderConfigInput, optFns ...func(*eks.Options)) (*eks.DisassociateIdentityProviderConfigOutput, error) {
+	defer c.cache.Invalidate(c.clusterKey(params.ClusterName))
+	return c.EKSAPI.DisassociateIdentityProviderConfig(ctx, params, optFns...)
+}
+
+func (c *cachedEKSClient) CreateNodegroup(ctx context.Context, params *eks.CreateNodegroupInput, optFns ...func(*eks.Options)) (*eks.CreateNodegroupOutput, error) {
+	defer c.cache.Invalidate(c.nodegroupKey(params.ClusterName, params.NodegroupName))
+	return c.EKSAPI.CreateNodegroup(ctx, params, optFns...)
+}
+
+func (c *cachedEKSClient) DeleteNodegroup(ctx context.Context, params *eks.DeleteNodegroupInput, optFns ...func(*eks.Options)) (*eks.DeleteNodegroupOutput, error) {
+	defer c.cache.Invalidate(c.nodegroupKey(params.ClusterName, params.NodegroupName))
+	return c.EKSAPI.DeleteNodegroup(ctx, params, optFns...)
+}
+
+func (c *cachedEKSClient) UpdateNodegroupConfig(ctx context.Context, params *eks.UpdateNodegroupConfigInput, optFns ...func(*eks.Options)) (*eks.UpdateNodegroupConfigOutput, error) {
+	defer c.cache.Invalidate(c.nodegroupKey(params.ClusterName, params.NodegroupName))
+	return c.EKSAPI.UpdateNodegroupConfig(ctx, params, optFns...)
+}
+
+func (c *cachedEKSClient) UpdateNodegroupVersion(ctx context.Context, params *eks.UpdateNodegroupVersionInput, optFns ...func(*eks.Options)) (*eks.UpdateNodegroupVersionOutput, error) {
+	defer c.cache.Invalidate(c.nodegroupKey(params.ClusterName, params.NodegroupName))
+	return c.EKSAPI.UpdateNodegroupVersion(ctx, params, optFns...)
+}
+
+// TagResource and UntagResource take an ARN, which isn't worth parsing back to a
+// cluster or nodegroup name: they drop all the EKS outputs cached for the prefix.
+func (c *cachedEKSClient) TagResource(ctx context.Context, params *eks.TagResourceInput, optFns ...func(*eks.Options)) (*eks.TagResourceOutput, error) {
+	defer c.cache.Invalidate(c.keyPrefix + "eks/")
+	return c.EKSAPI.TagResource(ctx, params, optFns...)
+}
+
+func (c *cachedEKSClient) UntagResource(ctx context.Context, params *eks.UntagResourceInput, optFns ...func(*eks.Options)) (*eks.UntagResourceOutput, error) {
+	defer c.cache.Invalidate(c.keyPrefix + "eks/")
+	return c.EKSAPI.UntagResource(ctx, params, optFns...)
+}
+
+// The waiters poll the EKS API directly, so the state they wait for is newer than
+// anything cached.
+func (c *cachedEKSClient) WaitUntilClusterActive(ctx context.Context, params *eks.DescribeClusterInput, maxWait time.Duration) error {
+	defer c.cache.Invalidate(c.clusterKey(params.Name))
+	return c.EKSAPI.WaitUntilClusterActive(ctx, params, maxWait)
+}
+
+func (c *cachedEKSClient) WaitUntilClusterDeleted(ctx context.Context, params *eks.DescribeClusterInput, maxWait time.Duration) error {
+	defer c.cache.Invalidate(c.clusterKey(params.Name))
+	return c.EKSAPI.WaitUntilClusterDeleted(ctx, params, maxWait)
+}
+
+func (c *cachedEKSClient) WaitUntilClusterUpdating(ctx context.Context, params *eks.DescribeClusterInput, maxWait time.Duration) error {
+	defer c.cache.Invalidate(c.clusterKey(params.Name))
+	return c.EKSAPI.WaitUntilClusterUpdating(ctx, params, maxWait)
+}
+
+func (c *cachedEKSClient) WaitUntilNodegroupActive(ctx context.Context, params *eks.DescribeNodegroupInput, maxWait time.Duration) error {
+	defer c.cache.Invalidate(c.nodegroupKey(params.ClusterName, params.NodegroupName))
+	return c.EKSAPI.WaitUntilNodegroupActive(ctx, params, maxWait)
+}
+
+func (c *cachedEKSClient) WaitUntilNodegroupDeleted(ctx context.Context, params *eks.DescribeNodegroupInput, maxWait time.Duration) error {
+	defer c.cache.Invalidate(c.nodegroupKey(params.ClusterName, params.NodegroupName))
+	return c.EKSAPI.WaitUntilNodegroupDeleted(ctx, params, maxWait)
+}
+
+// describeCacheKeyPrefix returns the prefix of the describe cache keys for the services
+// of a control plane. The control plane namespace and name stand for the account, as
+// clusters with the same name in different accounts must not share cached outputs.
+func describeCacheKeyPrefix(region string, controlPlane *ekscontrolplanev1.AWSManagedControlPlane) string {
+	return region + "/" + controlPlane.Namespace + "/" + controlPlane.Name + "/"
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/util/cache"
+)
+
+func TestCachedEKSClient(t *testing.T) {
+	describeNodegroup := &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String("cluster"),
+		NodegroupName: aws.String("nodegroup"),
+	}
+	describeCluster := &eks.DescribeClusterInput{
+		Name: aws.String("cluster"),
+	}
+
+	t.Run("nodegroup is described once within the TTL", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+		eksMock.EXPECT().DescribeNodegroup(gomock.Any(), describeNodegroup).
+			Return(&eks.DescribeNodegroupOutput{Nodegroup: &ekstypes.Nodegroup{Status: ekstypes.NodegroupStatusActive}}, nil).
+			Times(1)
+		client := &cachedEKSClient{EKSAPI: eksMock, cache: cache.NewDescribeCache(time.Minute), keyPrefix: "eu-west-1/ns/cp/"}
+
+		for range 3 {
+			out, err := client.DescribeNodegroup(context.TODO(), describeNodegroup)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(out.Nodegroup.Status).To(Equal(ekstypes.NodegroupStatusActive))
+		}
+	})
+
+	t.Run("nodegroup is described again after an update", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+		gomock.InOrder(
+			eksMock.EXPECT().DescribeNodegroup(gomock.Any(), describeNodegroup).
+				Return(&eks.DescribeNodegroupOutput{Nodegroup: &ekstypes.Nodegroup{Status: ekstypes.NodegroupStatusActive}}, nil),
+			eksMock.EXPECT().UpdateNodegroupConfig(gomock.Any(), gomock.Any()).
+				Return(&eks.UpdateNodegroupConfigOutput{}, nil),
+			eksMock.EXPECT().DescribeNodegroup(gomock.Any(), describeNodegroup).
+				Return(&eks.DescribeNodegroupOutput{Nodegroup: &ekstypes.Nodegroup{Status: ekstypes.NodegroupStatusUpdating}}, nil),
+		)
+		client := &cachedEKSClient{EKSAPI: eksMock, cache: cache.NewDescribeCache(time.Minute), keyPrefix: "eu-west-1/ns/cp/"}
+
+		out, err := client.DescribeNodegroup(context.TODO(), describeNodegroup)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(out.Nodegroup.Status).To(Equal(ekstypes.NodegroupStatusActive))
+
+		_, err = client.UpdateNodegroupConfig(context.TODO(), &eks.UpdateNodegroupConfigInput{
+			ClusterName:   aws.String("cluster"),
+			NodegroupName: aws.String("nodegroup"),
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		out, err = client.DescribeNodegroup(context.TODO(), describeNodegroup)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(out.Nodegroup.Status).To(Equal(ekstypes.NodegroupStatusUpdating))
+	})
+
+	t.Run("cluster and nodegroups are described again after a cluster update", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+		eksMock.EXPECT().DescribeCluster(gomock.Any(), describeCluster).Return(&eks.DescribeClusterOutput{}, nil).Times(2)
+		eksMock.EXPECT().DescribeNodegroup(gomock.Any(), describeNodegroup).Return(&eks.DescribeNodegroupOutput{}, nil).Times(2)
+		eksMock.EXPECT().UpdateClusterVersion(gomock.Any(), gomock.Any()).Return(&eks.UpdateClusterVersionOutput{}, nil)
+		client := &cachedEKSClient{EKSAPI: eksMock, cache: cache.NewDescribeCache(time.Minute), keyPrefix: "eu-west-1/ns/cp/"}
+
+		for range 2 {
+			_, err := client.DescribeCluster(context.TODO(), describeCluster)
+			g.Expect(err).NotTo(HaveOccurred())
+			_, err = client.DescribeNodegroup(context.TODO(), describeNodegroup)
+			g.Expect(err).NotTo(HaveOccurred())
+		}
+		_, err := client.UpdateClusterVersion(context.TODO(), &eks.UpdateClusterVersionInput{Name: aws.String("cluster")})
+		g.Expect(err).NotTo(HaveOccurred())
+		_, err = client.DescribeCluster(context.TODO(), describeCluster)
+		g.Expect(err).NotTo(HaveOccurred())
+		_, err = client.DescribeNodegroup(context.TODO(), describeNodegroup)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
+	t.Run("errors aren't cached", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+		gomock.InOrder(
+			eksMock.EXPECT().DescribeCluster(gomock.Any(), describeCluster).Return(nil, &ekstypes.ResourceNotFoundException{}),
+			eksMock.EXPECT().DescribeCluster(gomock.Any(), describeCluster).Return(&eks.DescribeClusterOutput{}, nil),
+		)
+		client := &cachedEKSClient{EKSAPI: eksMock, cache: cache.NewDescribeCache(time.Minute), keyPrefix: "eu-west-1/ns/cp/"}
+
+		_, err := client.DescribeCluster(context.TODO(), describeCluster)
+		g.Expect(err).To(HaveOccurred())
+		_, err = client.DescribeCluster(context.TODO(), describeCluster)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+}
@@ -43,12 +43,37 @@ func (s *Service) ReconcileControlPlane(ctx context.Context) error {
 	v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.IAMControlPlaneRolesReadyCondition)
 
 	// EKS Cluster
-	if err := s.reconcileCluster(ctx); err != nil {
-		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneReadyCondition, ekscontrolplanev1.EKSControlPlaneReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+	clusterErr := s.reconcileCluster(ctx)
+	// The control plane is usable while its upgrade waits for the pre-upgrade hooks, which are
+	// reported by the EKSControlPlanePreUpgradeHooksSucceeded condition, while its encryption
+	// config is associated, which is reported by the EKSEncryptionConfigAssociated condition, or
+	// while its security groups are updated, which is reported by the EKSControlPlaneUpdating
+	// condition. It's reconciled as a whole before coming back for the pending change.
+	clusterDeferred := errors.Is(clusterErr, ErrClusterUpgradeHooksPending) ||
+		errors.Is(clusterErr, ErrEncryptionConfigUpdateInProgress) ||
+		errors.Is(clusterErr, ErrClusterUpdating)
+	if err := clusterErr; err != nil && !clusterDeferred {
+		switch {
+		case errors.Is(err, ErrClusterCreating), errors.Is(err, ErrClusterCreationTimedOut):
+			// The creation progress has already been reported on the EKSControlPlaneReady condition.
+		default:
+			v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneReadyCondition, ekscontrolplanev1.EKSControlPlaneReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		}
 		return err
 	}
 	v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneReadyCondition)
 
+	// ECR pull-through cache
+	if s.scope.ControlPlane.Spec.ECRPullThroughCache == nil {
+		v1beta1conditions.Delete(s.scope.ControlPlane, ekscontrolplanev1.EKSPullThroughCacheReadyCondition)
+	} else {
+		if err := s.reconcilePullThroughCache(ctx); err != nil {
+			v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSPullThroughCacheReadyCondition, ekscontrolplanev1.EKSPullThroughCacheReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+			return errors.Wrap(err, "failed reconciling ecr pull-through cache")
+		}
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSPullThroughCacheReadyCondition)
+	}
+
 	// EKS Addons
 	if err := s.reconcileAddons(ctx); err != nil {
 		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition, ekscontrolplanev1.EKSAddonsConfiguredFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
@@ -63,6 +88,9 @@ func (s *Service) ReconcileControlPlane(ctx context.Context) error {
 	}
 	v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSIdentityProviderConfiguredCondition)
 
+	if clusterDeferred {
+		return clusterErr
+	}
 	s.scope.Debug("Reconcile EKS control plane completed successfully")
 	return nil
 }
@@ -71,6 +99,11 @@ func (s *Service) ReconcileControlPlane(ctx context.Context) error {
 func (s *Service) DeleteControlPlane(ctx context.Context) (err error) {
 	s.scope.Debug("Deleting EKS control plane")
 
+	// ECR pull-through cache endpoints
+	if err := s.deletePullThroughCacheEndpoints(ctx); err != nil {
+		return err
+	}
+
 	// EKS Cluster
 	if err := s.deleteCluster(ctx); err != nil {
 		return err
@@ -94,29 +127,65 @@ func (s *Service) DeleteControlPlane(ctx context.Context) (err error) {
 func (s *NodegroupService) ReconcilePool(ctx context.Context) error {
 	s.scope.Debug("Reconciling EKS nodegroup")
 
-	if err := s.reconcileNodegroupIAMRole(ctx); err != nil {
+	err := s.reconcileNodegroupIAMRole(ctx)
+	switch {
+	case errors.Is(err, ErrNodegroupRolePoliciesMissing):
+		// Existing nodes keep working, but new nodes may not be able to join the cluster
+		// until the policies are attached to the role, so the nodegroup is still reconciled.
 		v1beta1conditions.MarkFalse(
 			s.scope.ManagedMachinePool,
 			expinfrav1.IAMNodegroupRolesReadyCondition,
-			expinfrav1.IAMNodegroupRolesReconciliationFailedReason,
+			expinfrav1.IAMNodegroupRolePoliciesMissingReason,
+			clusterv1beta1.ConditionSeverityWarning,
+			"%s",
+			err.Error(),
+		)
+		record.Warnf(s.scope.ManagedMachinePool, "NodegroupRolePoliciesMissing", "%s", err.Error())
+	case errors.Is(err, ErrNodegroupRoleCreationDenied):
+		v1beta1conditions.MarkFalse(
+			s.scope.ManagedMachinePool,
+			expinfrav1.IAMNodegroupRolesReadyCondition,
+			expinfrav1.IAMNodegroupRoleCreationDeniedReason,
 			clusterv1beta1.ConditionSeverityError,
 			"%s",
 			err.Error(),
 		)
 		return err
+	case err != nil:
+		v1beta1conditions.MarkFalse(
+			s.scope.ManagedMachinePool,
+			expinfrav1.IAMNodegroupRolesReadyCondition,
+			expinfrav1.IAMNodegroupRolesReconciliationFailedReason,
+			clusterv1beta1.ConditionSeverityError,
+			"%s",
+			awserrors.MessageWithoutRequestID(err),
+		)
+		return err
+	default:
+		v1beta1conditions.MarkTrue(s.scope.ManagedMachinePool, expinfrav1.IAMNodegroupRolesReadyCondition)
 	}
-	v1beta1conditions.MarkTrue(s.scope.ManagedMachinePool, expinfrav1.IAMNodegroupRolesReadyCondition)
 
 	if err := s.reconcileNodegroup(ctx); err != nil {
+		condition, retry := nodegroupReadyConditionForError(err)
+		v1beta1conditions.Set(s.scope.ManagedMachinePool, condition)
+		if retry {
+			return err
+		}
+		return nil
+	}
+	if s.scope.ManagedMachinePool.Spec.DegradedPolicy == expinfrav1.NodegroupDegradedPolicyNotReady &&
+		v1beta1conditions.GetReason(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupHealthyCondition) == expinfrav1.EKSNodegroupStatusDegradedReason {
+		// EKS recovers the nodegroup on its own once the health issues are fixed, which the
+		// periodic resync picks up.
 		v1beta1conditions.MarkFalse(
 			s.scope.ManagedMachinePool,
 			expinfrav1.EKSNodegroupReadyCondition,
-			expinfrav1.EKSNodegroupReconciliationFailedReason,
-			clusterv1beta1.ConditionSeverityError,
+			expinfrav1.EKSNodegroupStatusDegradedReason,
+			clusterv1beta1.ConditionSeverityWarning,
 			"%s",
-			err.Error(),
+			v1beta1conditions.GetMessage(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupHealthyCondition),
 		)
-		return err
+		return nil
 	}
 	v1beta1conditions.MarkTrue(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupReadyCondition)
 
@@ -150,7 +219,54 @@ func (s *NodegroupService) ReconcilePoolDelete(ctx context.Context) error {
 		return errors.Wrap(err, "failed to delete nodegroup IAM role")
 	}
 
-	record.Eventf(s.scope.ManagedMachinePool, "SuccessfulDeleteEKSNodegroup", "Deleted EKS nodegroup %s", eksNodegroupName)
+	record.Eventf(s.scope.ManagedMachinePool, "SuccessfulDeleteEKSNodegroup", "Deleted EKS nodegroup %s", eventResource(eksNodegroupName, ng.NodegroupArn))
 
 	return nil
 }
+
+// nodegroupErrorConditions maps the errors of the nodegroup reconciliation to the reason and
+// severity they're reported with on the EKSNodegroupReady condition. The first matching entry
+// applies. Errors that can't be fixed by retrying, until the spec or the external resources
+// are changed, aren't retried and their change triggers a new reconcile.
+var nodegroupErrorConditions = []struct {
+	err      error
+	reason   string
+	severity clusterv1beta1.ConditionSeverity
+	retry    bool
+}{
+	{ErrNodegroupUpdating, expinfrav1.EKSNodegroupUpdatingReason, clusterv1beta1.ConditionSeverityInfo, true},
+	{ErrNodegroupInstanceTypesChanged, expinfrav1.EKSNodegroupInstanceTypesChangedReason, clusterv1beta1.ConditionSeverityWarning, false},
+	{ErrNodegroupSubnetsRemoved, expinfrav1.EKSNodegroupSubnetsRemovedReason, clusterv1beta1.ConditionSeverityWarning, false},
+	{ErrNodegroupFailureDomainsChanged, expinfrav1.EKSNodegroupFailureDomainsChangedReason, clusterv1beta1.ConditionSeverityWarning, false},
+	{ErrNodegroupStatusUnknown, expinfrav1.EKSNodegroupUnknownStatusReason, clusterv1beta1.ConditionSeverityWarning, true},
+	{ErrNodegroupAMITypeChanged, expinfrav1.EKSNodegroupAMITypeChangedReason, clusterv1beta1.ConditionSeverityWarning, false},
+	{ErrNodegroupAMIVersionMismatch, expinfrav1.EKSNodegroupAMIVersionMismatchReason, clusterv1beta1.ConditionSeverityError, false},
+	{ErrNodegroupTaintLimitExceeded, expinfrav1.EKSNodegroupTaintLimitExceededReason, clusterv1beta1.ConditionSeverityError, false},
+	{ErrNodegroupInstanceProfileMismatch, expinfrav1.EKSNodegroupInstanceProfileMismatchReason, clusterv1beta1.ConditionSeverityError, false},
+	// Windows support is enabled in the workload cluster, which doesn't trigger a reconcile.
+	{ErrNodegroupWindowsSupportDisabled, expinfrav1.EKSNodegroupWindowsSupportDisabledReason, clusterv1beta1.ConditionSeverityWarning, true},
+	// The cluster may still be creating.
+	{ErrNodegroupClusterNotFound, expinfrav1.EKSNodegroupClusterNotFoundReason, clusterv1beta1.ConditionSeverityWarning, true},
+	{ErrNodegroupLaunchTemplateAMIMissing, expinfrav1.EKSNodegroupLaunchTemplateAMIMissingReason, clusterv1beta1.ConditionSeverityError, false},
+}
+
+// nodegroupReadyConditionForError returns the EKSNodegroupReady condition reporting an error of
+// the nodegroup reconciliation, and whether the error is returned so that it's retried.
+//
+// Changes deferred until the maintenance window opens or until PodDisruptionBudgets allow a
+// scale-down are reported on their own conditions while the rest of the nodegroup is
+// reconciled, so the nodegroup is ready but the error is retried. They're retried as well when
+// joined with an error that isn't.
+func nodegroupReadyConditionForError(err error) (*clusterv1beta1.Condition, bool) {
+	deferred := errors.Is(err, ErrNodegroupChangesDeferred) || errors.Is(err, ErrNodegroupScaleDownBlocked)
+	for _, c := range nodegroupErrorConditions {
+		if errors.Is(err, c.err) {
+			return v1beta1conditions.FalseCondition(expinfrav1.EKSNodegroupReadyCondition, c.reason, c.severity, "%s", err.Error()), c.retry || deferred
+		}
+	}
+	if deferred {
+		return v1beta1conditions.TrueCondition(expinfrav1.EKSNodegroupReadyCondition), true
+	}
+	return v1beta1conditions.FalseCondition(expinfrav1.EKSNodegroupReadyCondition, expinfrav1.EKSNodegroupReconciliationFailedReason, clusterv1beta1.ConditionSeverityError,
+		"%s", awserrors.MessageWithoutRequestID(err)), true
+}
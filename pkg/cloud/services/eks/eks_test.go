@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+)
+
+func TestNodegroupReadyConditionForError(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectStatus   corev1.ConditionStatus
+		expectReason   string
+		expectSeverity clusterv1beta1.ConditionSeverity
+		expectRetry    bool
+	}{
+		{
+			name:           "nodegroup updating",
+			err:            ErrNodegroupUpdating,
+			expectStatus:   corev1.ConditionFalse,
+			expectReason:   expinfrav1.EKSNodegroupUpdatingReason,
+			expectSeverity: clusterv1beta1.ConditionSeverityInfo,
+			expectRetry:    true,
+		},
+		{
+			name:           "instance types changed",
+			err:            errors.Wrap(ErrNodegroupInstanceTypesChanged, "instance types changed from [t3.large] to [m5.large]"),
+			expectStatus:   corev1.ConditionFalse,
+			expectReason:   expinfrav1.EKSNodegroupInstanceTypesChangedReason,
+			expectSeverity: clusterv1beta1.ConditionSeverityWarning,
+		},
+		{
+			name:           "instance types changed with deferred changes",
+			err:            kerrors.NewAggregate([]error{ErrNodegroupInstanceTypesChanged, errors.Wrap(ErrNodegroupChangesDeferred, "labels deferred")}),
+			expectStatus:   corev1.ConditionFalse,
+			expectReason:   expinfrav1.EKSNodegroupInstanceTypesChangedReason,
+			expectSeverity: clusterv1beta1.ConditionSeverityWarning,
+			expectRetry:    true,
+		},
+		{
+			name:           "subnets removed with a blocked scale-down",
+			err:            kerrors.NewAggregate([]error{ErrNodegroupSubnetsRemoved, ErrNodegroupScaleDownBlocked}),
+			expectStatus:   corev1.ConditionFalse,
+			expectReason:   expinfrav1.EKSNodegroupSubnetsRemovedReason,
+			expectSeverity: clusterv1beta1.ConditionSeverityWarning,
+			expectRetry:    true,
+		},
+		{
+			name:           "failure domains changed",
+			err:            ErrNodegroupFailureDomainsChanged,
+			expectStatus:   corev1.ConditionFalse,
+			expectReason:   expinfrav1.EKSNodegroupFailureDomainsChangedReason,
+			expectSeverity: clusterv1beta1.ConditionSeverityWarning,
+		},
+		{
+			name:         "changes deferred",
+			err:          errors.Wrap(ErrNodegroupChangesDeferred, "labels deferred"),
+			expectStatus: corev1.ConditionTrue,
+			expectRetry:  true,
+		},
+		{
+			name:         "scale-down blocked",
+			err:          ErrNodegroupScaleDownBlocked,
+			expectStatus: corev1.ConditionTrue,
+			expectRetry:  true,
+		},
+		{
+			name:           "unknown status",
+			err:            ErrNodegroupStatusUnknown,
+			expectStatus:   corev1.ConditionFalse,
+			expectReason:   expinfrav1.EKSNodegroupUnknownStatusReason,
+			expectSeverity: clusterv1beta1.ConditionSeverityWarning,
+			expectRetry:    true,
+		},
+		{
+			name:           "AMI type changed",
+			err:            ErrNodegroupAMITypeChanged,
+			expectStatus:   corev1.ConditionFalse,
+			expectReason:   expinfrav1.EKSNodegroupAMITypeChangedReason,
+			expectSeverity: clusterv1beta1.ConditionSeverityWarning,
+		},
+		{
+			name:           "AMI version mismatch",
+			err:            ErrNodegroupAMIVersionMismatch,
+			expectStatus:   corev1.ConditionFalse,
+			expectReason:   expinfrav1.EKSNodegroupAMIVersionMismatchReason,
+			expectSeverity: clusterv1beta1.ConditionSeverityError,
+		},
+		{
+			name:           "taint limit exceeded",
+			err:            ErrNodegroupTaintLimitExceeded,
+			expectStatus:   corev1.ConditionFalse,
+			expectReason:   expinfrav1.EKSNodegroupTaintLimitExceededReason,
+			expectSeverity: clusterv1beta1.ConditionSeverityError,
+		},
+		{
+			name:           "instance profile mismatch",
+			err:            ErrNodegroupInstanceProfileMismatch,
+			expectStatus:   corev1.ConditionFalse,
+			expectReason:   expinfrav1.EKSNodegroupInstanceProfileMismatchReason,
+			expectSeverity: clusterv1beta1.ConditionSeverityError,
+		},
+		{
+			name:           "windows support disabled",
+			err:            ErrNodegroupWindowsSupportDisabled,
+			expectStatus:   corev1.ConditionFalse,
+			expectReason:   expinfrav1.EKSNodegroupWindowsSupportDisabledReason,
+			expectSeverity: clusterv1beta1.ConditionSeverityWarning,
+			expectRetry:    true,
+		},
+		{
+			name:           "cluster not found",
+			err:            ErrNodegroupClusterNotFound,
+			expectStatus:   corev1.ConditionFalse,
+			expectReason:   expinfrav1.EKSNodegroupClusterNotFoundReason,
+			expectSeverity: clusterv1beta1.ConditionSeverityWarning,
+			expectRetry:    true,
+		},
+		{
+			name:           "launch template AMI missing",
+			err:            ErrNodegroupLaunchTemplateAMIMissing,
+			expectStatus:   corev1.ConditionFalse,
+			expectReason:   expinfrav1.EKSNodegroupLaunchTemplateAMIMissingReason,
+			expectSeverity: clusterv1beta1.ConditionSeverityError,
+		},
+		{
+			name:           "other errors",
+			err:            errors.New("failed to describe nodegroup"),
+			expectStatus:   corev1.ConditionFalse,
+			expectReason:   expinfrav1.EKSNodegroupReconciliationFailedReason,
+			expectSeverity: clusterv1beta1.ConditionSeverityError,
+			expectRetry:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			condition, retry := nodegroupReadyConditionForError(tt.err)
+			g.Expect(condition.Type).To(Equal(expinfrav1.EKSNodegroupReadyCondition))
+			g.Expect(condition.Status).To(Equal(tt.expectStatus))
+			g.Expect(condition.Reason).To(Equal(tt.expectReason))
+			g.Expect(condition.Severity).To(Equal(tt.expectSeverity))
+			g.Expect(retry).To(Equal(tt.expectRetry))
+			if tt.expectStatus == corev1.ConditionFalse {
+				g.Expect(condition.Message).To(Equal(tt.err.Error()))
+			}
+		})
+	}
+}
@@ -37,4 +37,65 @@ var (
 	ErrCannotUseAdditionalRoles = errors.New("additional rules cannot be added as this has been disabled")
 	// ErrNoSecurityGroup is an error when no security group is found for an EKS cluster.
 	ErrNoSecurityGroup = errors.New("no security group for EKS cluster")
+	// ErrNodegroupSubnetsRemoved is an error when subnets used by a nodegroup are no longer part of the
+	// cluster network. EKS doesn't allow changing the subnets of an existing nodegroup.
+	ErrNodegroupSubnetsRemoved = errors.New("nodegroup subnets were removed from the cluster network")
+	// ErrNodegroupFailureDomainsChanged is an error when the failure domains of the MachinePool differ
+	// from the availability zones of the nodegroup subnets. EKS doesn't allow changing the subnets of
+	// an existing nodegroup.
+	ErrNodegroupFailureDomainsChanged = errors.New("machine pool failure domains differ from the nodegroup availability zones")
+	// ErrNodegroupInstanceTypesChanged is an error when the instance types of a nodegroup differ from
+	// the spec. EKS doesn't allow changing the instance types of an existing nodegroup.
+	ErrNodegroupInstanceTypesChanged = errors.New("nodegroup instance types differ from the spec")
+	// ErrNodegroupAMITypeChanged is an error when the AMI type of a nodegroup differs from the spec.
+	// EKS doesn't allow changing the AMI type of an existing nodegroup.
+	ErrNodegroupAMITypeChanged = errors.New("nodegroup AMI type differs from the spec")
+	// ErrNodegroupAMIVersionMismatch is an error when the AMI release version of the spec is for
+	// another Kubernetes version than the nodegroup's, which EKS rejects.
+	ErrNodegroupAMIVersionMismatch = errors.New("AMI release version doesn't match the nodegroup Kubernetes version")
+	// ErrNodegroupTaintLimitExceeded is an error when a nodegroup has more taints than EKS allows.
+	ErrNodegroupTaintLimitExceeded = errors.New("nodegroup exceeds the EKS taint limit")
+	// ErrNodegroupInstanceProfileMismatch is an error when the instance profile set in the launch template
+	// of a nodegroup doesn't contain the nodegroup's node role.
+	ErrNodegroupInstanceProfileMismatch = errors.New("launch template instance profile doesn't match the nodegroup role")
+	// ErrNodegroupUpdating is an error when an update of the EKS nodegroup is in progress
+	// and other updates of the nodegroup have to wait for it to complete.
+	ErrNodegroupUpdating = errors.New("EKS nodegroup is being updated")
+	// ErrNodegroupStatusUnknown is an error when the EKS nodegroup is in a status that isn't
+	// known yet, such as a status added to EKS after this release.
+	ErrNodegroupStatusUnknown = errors.New("EKS nodegroup status is unknown")
+	// ErrNodegroupChangesDeferred is an error when disruptive changes of the nodegroup are
+	// deferred until the maintenance window of the pool opens.
+	ErrNodegroupChangesDeferred = errors.New("EKS nodegroup changes are deferred until the maintenance window")
+	// ErrNodegroupScaleDownBlocked is an error when a scale-down of the nodegroup is deferred
+	// because PodDisruptionBudgets of the workload cluster would block draining the nodes.
+	ErrNodegroupScaleDownBlocked = errors.New("EKS nodegroup scale-down is blocked by PodDisruptionBudgets")
+	// ErrNodegroupLaunchTemplateAMIMissing is an error when the launch template of a nodegroup
+	// using a custom AMI type doesn't specify an AMI.
+	ErrNodegroupLaunchTemplateAMIMissing = errors.New("launch template doesn't specify an AMI")
+	// ErrNodegroupWindowsSupportDisabled is an error when a Windows nodegroup is created in a
+	// cluster that doesn't have Windows support enabled.
+	ErrNodegroupWindowsSupportDisabled = errors.New("windows support isn't enabled in the cluster")
+	// ErrNodegroupClusterNotFound is an error when EKS can't find the cluster of a nodegroup
+	// being created, such as a cluster that is still being created or has been deleted.
+	ErrNodegroupClusterNotFound = errors.New("EKS cluster of the nodegroup wasn't found")
+	// ErrNodegroupRoleCreationDenied is an error when the controller isn't allowed to create the
+	// nodegroup role, as its IAM policy doesn't grant the IAM role creation actions.
+	ErrNodegroupRoleCreationDenied = errors.New("the controller isn't allowed to create the nodegroup role")
+	// ErrNodegroupRolePoliciesMissing is an error when the node role of a nodegroup doesn't have
+	// all the required policies attached.
+	ErrNodegroupRolePoliciesMissing = errors.New("nodegroup role is missing required policies")
+	// ErrEncryptionConfigUpdateInProgress is an error when the association of the encryption configuration
+	// with an EKS cluster hasn't completed yet.
+	ErrEncryptionConfigUpdateInProgress = errors.New("encryption config association is in progress")
+	// ErrClusterCreating is an error when the EKS cluster is still being created.
+	ErrClusterCreating = errors.New("EKS cluster is being created")
+	// ErrClusterUpdating is an error when an update of the EKS cluster configuration has been
+	// initiated and other updates have to wait for it to complete.
+	ErrClusterUpdating = errors.New("EKS cluster is being updated")
+	// ErrClusterUpgradeHooksPending is an error when the version upgrade of the EKS cluster waits
+	// for pre-upgrade hooks to succeed.
+	ErrClusterUpgradeHooksPending = errors.New("EKS cluster upgrade waits for pre-upgrade hooks")
+	// ErrClusterCreationTimedOut is an error when the EKS cluster creation is taking longer than allowed.
+	ErrClusterCreationTimedOut = errors.New("timed out waiting for the EKS cluster to be created")
 )
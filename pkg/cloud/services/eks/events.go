@@ -0,0 +1,30 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"fmt"
+)
+
+// eventResource formats an AWS resource for the message of an event: its name, followed by
+// its ARN when it's known so that the resource can be looked up from the event.
+func eventResource(name string, arn *string) string {
+	if arn == nil || *arn == "" {
+		return name
+	}
+	return fmt.Sprintf("%s (%s)", name, *arn)
+}
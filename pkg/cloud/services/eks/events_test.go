@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	eksiam "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// testRecorder is the global recorder of the record package during the tests. That
+// recorder can only be set once, so it hands the events over to the collector of the
+// running test.
+var testRecorder = &forwardingRecorder{}
+
+func TestMain(m *testing.M) {
+	record.InitFromRecorder(testRecorder)
+	os.Exit(m.Run())
+}
+
+// forwardingRecorder is an event recorder forwarding the events to the current collector.
+type forwardingRecorder struct {
+	mu        sync.Mutex
+	collector *eventCollector
+}
+
+func (r *forwardingRecorder) Event(_ runtime.Object, _, _, message string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.collector != nil {
+		r.collector.messages = append(r.collector.messages, message)
+	}
+}
+
+func (r *forwardingRecorder) Eventf(obj runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Event(obj, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *forwardingRecorder) AnnotatedEventf(obj runtime.Object, _ map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Eventf(obj, eventtype, reason, messageFmt, args...)
+}
+
+// eventCollector keeps the messages of the events recorded during a test.
+type eventCollector struct {
+	messages []string
+}
+
+// newEventCollector returns a collector of the events recorded until the end of the test.
+func newEventCollector(t *testing.T) *eventCollector {
+	t.Helper()
+	c := &eventCollector{}
+	testRecorder.mu.Lock()
+	testRecorder.collector = c
+	testRecorder.mu.Unlock()
+	t.Cleanup(func() {
+		testRecorder.mu.Lock()
+		defer testRecorder.mu.Unlock()
+		testRecorder.collector = nil
+	})
+	return c
+}
+
+// take returns the messages recorded since the last call.
+func (c *eventCollector) take() []string {
+	testRecorder.mu.Lock()
+	defer testRecorder.mu.Unlock()
+	messages := c.messages
+	c.messages = nil
+	return messages
+}
+
+func TestEventResource(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(eventResource("nodegroup", nil)).To(Equal("nodegroup"))
+	g.Expect(eventResource("nodegroup", aws.String(""))).To(Equal("nodegroup"))
+	g.Expect(eventResource("nodegroup", aws.String("arn:aws:eks:us-east-1:123456789012:nodegroup/eks-cluster/nodegroup/id"))).
+		To(Equal("nodegroup (arn:aws:eks:us-east-1:123456789012:nodegroup/eks-cluster/nodegroup/id)"))
+}
+
+func TestNodegroupUpdateEventIncludesARN(t *testing.T) {
+	const nodegroupARN = "arn:aws:eks:us-east-1:123456789012:nodegroup/eks-cluster/nodegroup/id"
+
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+	eksMock.EXPECT().UpdateNodegroupVersion(gomock.Any(), gomock.Any()).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+
+	s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{
+		EKSClusterName: "eks-cluster",
+	}, expinfrav1.AWSManagedMachinePoolSpec{
+		EKSNodegroupName:  "nodegroup",
+		AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{},
+	})
+	s.scope.ManagedMachinePool.Status.LaunchTemplateID = aws.String("lt-0123456789abcdef0")
+	s.scope.ManagedMachinePool.Status.LaunchTemplateVersion = aws.String("3")
+	s.scope.MachinePool = &clusterv1.MachinePool{}
+	s.EKSClient = eksMock
+
+	recorded := newEventCollector(t)
+	updated, err := s.reconcileNodegroupVersion(context.TODO(), &ekstypes.Nodegroup{
+		NodegroupName:  aws.String("nodegroup"),
+		NodegroupArn:   aws.String(nodegroupARN),
+		Version:        aws.String("1.30"),
+		ReleaseVersion: aws.String("1.30.0-20240101"),
+		LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+			Id:      aws.String("lt-0123456789abcdef0"),
+			Version: aws.String("2"),
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(updated).To(BeTrue())
+	g.Expect(recorded.take()).To(ContainElement("Updated EKS nodegroup nodegroup (" + nodegroupARN + ") to launch template version 3"))
+}
+
+func TestFargateProfileEventsIncludeARN(t *testing.T) {
+	const profileARN = "arn:aws:eks:us-east-1:123456789012:fargateprofile/eks-cluster/profile/id"
+
+	tests := []struct {
+		name          string
+		status        ekstypes.FargateProfileStatus
+		expectMessage string
+	}{
+		{
+			name:          "creation",
+			status:        ekstypes.FargateProfileStatusCreating,
+			expectMessage: "Started creating EKS fargate profile profile (" + profileARN + ")",
+		},
+		{
+			name:          "deletion",
+			status:        ekstypes.FargateProfileStatusDeleting,
+			expectMessage: "Started deleting EKS fargate profile profile (" + profileARN + ")",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := logger.NewLogger(klog.Background())
+			s := &FargateService{
+				scope: &scope.FargateProfileScope{
+					Logger: *log,
+					FargateProfile: &expinfrav1.AWSFargateProfile{
+						Spec: expinfrav1.FargateProfileSpec{ProfileName: "profile"},
+					},
+				},
+				IAMService: eksiam.IAMService{Wrapper: log},
+			}
+
+			recorded := newEventCollector(t)
+			s.handleStatus(&ekstypes.FargateProfile{
+				FargateProfileName: aws.String("profile"),
+				FargateProfileArn:  aws.String(profileARN),
+				Status:             tc.status,
+			})
+			g.Expect(recorded.take()).To(ContainElement(tc.expectMessage))
+		})
+	}
+}
@@ -19,29 +19,64 @@ package eks
 import (
 	"context"
 	"fmt"
+	"maps"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
-	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
-func requeueProfileUpdating() reconcile.Result {
-	return reconcile.Result{RequeueAfter: 10 * time.Second}
+// requeueInterval returns the interval to check again on the fargate profile.
+func (s *FargateService) requeueInterval() time.Duration {
+	if s.scope.RequeueInterval > 0 {
+		return s.scope.RequeueInterval
+	}
+	return scope.DefaultFargateRequeueInterval
 }
 
-func requeueRoleUpdating() reconcile.Result {
-	return reconcile.Result{RequeueAfter: 10 * time.Second}
+// requeueProfileUpdating returns the result to check again on a profile being created or
+// deleted. The interval doubles each time it has elapsed since the profile's ready condition
+// turned false, up to the maximum interval, so that slow operations don't churn the EKS API.
+func (s *FargateService) requeueProfileUpdating() reconcile.Result {
+	interval := s.requeueInterval()
+	maxInterval := max(s.scope.MaxRequeueInterval, interval)
+	if v1beta1conditions.IsFalse(s.scope.FargateProfile, expinfrav1.EKSFargateProfileReadyCondition) {
+		elapsed := time.Since(v1beta1conditions.GetLastTransitionTime(s.scope.FargateProfile, expinfrav1.EKSFargateProfileReadyCondition).Time)
+		for waited := interval; waited <= elapsed && interval < maxInterval; waited += interval {
+			interval *= 2
+		}
+	}
+	return reconcile.Result{RequeueAfter: min(interval, maxInterval)}
+}
+
+// createTimeout returns how long the fargate profile can stay in the creating status.
+func (s *FargateService) createTimeout() time.Duration {
+	if s.scope.CreateTimeout > 0 {
+		return s.scope.CreateTimeout
+	}
+	return scope.DefaultFargateCreateTimeout
+}
+
+func (s *FargateService) requeueRoleUpdating() reconcile.Result {
+	return reconcile.Result{RequeueAfter: s.requeueInterval()}
 }
 
 // Reconcile is the entrypoint for FargateProfile reconciliation.
@@ -56,14 +91,14 @@ func (s *FargateService) Reconcile(ctx context.Context) (reconcile.Result, error
 			expinfrav1.IAMFargateRolesReconciliationFailedReason,
 			clusterv1beta1.ConditionSeverityError,
 			"%s",
-			err.Error(),
+			awserrors.MessageWithoutRequestID(err),
 		)
 		return reconcile.Result{}, err
 	}
 	// When the role is updated, we requeue to let e.g. trust relationship
 	// propagate
 	if requeue {
-		return requeueRoleUpdating(), nil
+		return s.requeueRoleUpdating(), nil
 	}
 
 	v1beta1conditions.MarkTrue(s.scope.FargateProfile, expinfrav1.IAMFargateRolesReadyCondition)
@@ -76,12 +111,12 @@ func (s *FargateService) Reconcile(ctx context.Context) (reconcile.Result, error
 			expinfrav1.EKSFargateReconciliationFailedReason,
 			clusterv1beta1.ConditionSeverityError,
 			"%s",
-			err.Error(),
+			awserrors.MessageWithoutRequestID(err),
 		)
 		return reconcile.Result{}, err
 	}
 	if requeue {
-		return requeueProfileUpdating(), nil
+		return s.requeueProfileUpdating(), nil
 	}
 
 	return reconcile.Result{}, nil
@@ -104,12 +139,14 @@ func (s *FargateService) reconcileFargateProfile(ctx context.Context) (requeue b
 		profile.Status = ekstypes.FargateProfileStatusCreating
 		s.scope.Info("Created EKS fargate profile", "cluster-name", eksClusterName, "profile-name", profileName)
 	} else {
-		tagKey := infrav1.ClusterAWSCloudProviderTagKey(s.scope.ClusterName())
-		ownedTag := profile.Tags[tagKey]
-		if ownedTag == "" {
-			return false, errors.New("owned tag not found for this cluster")
+		if err := s.reconcileFargateProfileOwnership(ctx, profile); err != nil {
+			return false, err
 		}
 		s.scope.Debug("Found owned EKS fargate profile", "cluster-name", eksClusterName, "profile-name", profileName)
+
+		if s.subnetsChanged(profile) {
+			return s.recreateFargateProfile(ctx, profile)
+		}
 	}
 
 	if err := s.reconcileTags(ctx, profile); err != nil {
@@ -119,6 +156,36 @@ func (s *FargateService) reconcileFargateProfile(ctx context.Context) (requeue b
 	return s.handleStatus(profile), nil
 }
 
+// reconcileFargateProfileOwnership checks that an existing fargate profile is owned by the cluster,
+// tagging it as owned when the AWSFargateProfile has the adopt annotation, as the ones printed by
+// clusterawsadm eks fargate import do.
+func (s *FargateService) reconcileFargateProfileOwnership(ctx context.Context, profile *ekstypes.FargateProfile) error {
+	tagKey := infrav1.ClusterAWSCloudProviderTagKey(s.scope.ClusterName())
+	if profile.Tags[tagKey] != "" {
+		return nil
+	}
+
+	profileName := aws.ToString(profile.FargateProfileName)
+	if !annotations.IsTrue(s.scope.FargateProfile, infrav1.AdoptAnnotation) {
+		return errors.Errorf("owned tag not found for this cluster, set the %s annotation to adopt fargate profile %s", infrav1.AdoptAnnotation, profileName)
+	}
+
+	s.scope.Info("Adopting existing EKS fargate profile", "profile-name", profileName)
+	owned := map[string]string{tagKey: string(infrav1.ResourceLifecycleOwned)}
+	if err := tagEKSResource(ctx, s.EKSClient, profile.FargateProfileArn, owned, nil); err != nil {
+		record.Warnf(s.scope.FargateProfile, "FailedAdoptEKSFargateProfile", "Failed to tag EKS fargate profile %s as owned: %v", eventResource(profileName, profile.FargateProfileArn), err)
+		return errors.Wrapf(err, "failed to tag fargate profile %s as owned", profileName)
+	}
+	profile.Tags = maps.Clone(profile.Tags)
+	if profile.Tags == nil {
+		profile.Tags = map[string]string{}
+	}
+	profile.Tags[tagKey] = string(infrav1.ResourceLifecycleOwned)
+	record.Eventf(s.scope.FargateProfile, "SuccessfulAdoptEKSFargateProfile", "Adopted existing EKS fargate profile %s", eventResource(profileName, profile.FargateProfileArn))
+
+	return nil
+}
+
 func (s *FargateService) handleStatus(profile *ekstypes.FargateProfile) (requeue bool) {
 	s.Debug("fargate profile", "status", string(profile.Status))
 	switch profile.Status {
@@ -128,10 +195,10 @@ func (s *FargateService) handleStatus(profile *ekstypes.FargateProfile) (requeue
 			v1beta1conditions.MarkFalse(s.scope.FargateProfile, expinfrav1.EKSFargateDeletingCondition, expinfrav1.EKSFargateCreatingReason, clusterv1beta1.ConditionSeverityInfo, "")
 		}
 		if !v1beta1conditions.IsTrue(s.scope.FargateProfile, expinfrav1.EKSFargateCreatingCondition) {
-			record.Eventf(s.scope.FargateProfile, "InitiatedCreateEKSFargateProfile", "Started creating EKS fargate profile %s", s.scope.FargateProfile.Spec.ProfileName)
+			record.Eventf(s.scope.FargateProfile, "InitiatedCreateEKSFargateProfile", "Started creating EKS fargate profile %s", eventResource(s.scope.FargateProfile.Spec.ProfileName, profile.FargateProfileArn))
 			v1beta1conditions.MarkTrue(s.scope.FargateProfile, expinfrav1.EKSFargateCreatingCondition)
 		}
-		v1beta1conditions.MarkFalse(s.scope.FargateProfile, expinfrav1.EKSFargateProfileReadyCondition, expinfrav1.EKSFargateCreatingReason, clusterv1beta1.ConditionSeverityInfo, "")
+		s.markCreating()
 	case ekstypes.FargateProfileStatusCreateFailed, ekstypes.FargateProfileStatusDeleteFailed:
 		s.scope.FargateProfile.Status.Ready = false
 		s.scope.FargateProfile.Status.FailureMessage = aws.String(fmt.Sprintf("unexpected profile status: %s", string(profile.Status)))
@@ -141,18 +208,21 @@ func (s *FargateService) handleStatus(profile *ekstypes.FargateProfile) (requeue
 	case ekstypes.FargateProfileStatusActive:
 		s.scope.FargateProfile.Status.Ready = true
 		if v1beta1conditions.IsTrue(s.scope.FargateProfile, expinfrav1.EKSFargateCreatingCondition) {
-			record.Eventf(s.scope.FargateProfile, "SuccessfulCreateEKSFargateProfile", "Created new EKS fargate profile %s", s.scope.FargateProfile.Spec.ProfileName)
+			record.Eventf(s.scope.FargateProfile, "SuccessfulCreateEKSFargateProfile", "Created new EKS fargate profile %s", eventResource(s.scope.FargateProfile.Spec.ProfileName, profile.FargateProfileArn))
 			v1beta1conditions.MarkFalse(s.scope.FargateProfile, expinfrav1.EKSFargateCreatingCondition, expinfrav1.EKSFargateCreatedReason, clusterv1beta1.ConditionSeverityInfo, "")
 		}
 		v1beta1conditions.MarkTrue(s.scope.FargateProfile, expinfrav1.EKSFargateProfileReadyCondition)
 	case ekstypes.FargateProfileStatusDeleting:
 		s.scope.FargateProfile.Status.Ready = false
 		if !v1beta1conditions.IsTrue(s.scope.FargateProfile, expinfrav1.EKSFargateDeletingCondition) {
-			record.Eventf(s.scope.FargateProfile, "InitiatedDeleteEKSFargateProfile", "Started deleting EKS fargate profile %s", s.scope.FargateProfile.Spec.ProfileName)
+			record.Eventf(s.scope.FargateProfile, "InitiatedDeleteEKSFargateProfile", "Started deleting EKS fargate profile %s", eventResource(s.scope.FargateProfile.Spec.ProfileName, profile.FargateProfileArn))
 			v1beta1conditions.MarkTrue(s.scope.FargateProfile, expinfrav1.EKSFargateDeletingCondition)
 		}
 		v1beta1conditions.MarkFalse(s.scope.FargateProfile, expinfrav1.EKSFargateProfileReadyCondition, expinfrav1.EKSFargateDeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
 	}
+	if profile.Status != ekstypes.FargateProfileStatusCreating {
+		s.scope.FargateProfile.Status.CreatingSince = nil
+	}
 	switch profile.Status {
 	case ekstypes.FargateProfileStatusCreating, ekstypes.FargateProfileStatusDeleting:
 		return true
@@ -161,6 +231,35 @@ func (s *FargateService) handleStatus(profile *ekstypes.FargateProfile) (requeue
 	}
 }
 
+// markCreating reports the fargate profile as creating on its ready condition, unless it has
+// been creating for longer than the create timeout. EKS keeps a profile that can't be created,
+// for instance because of an unusable subnet or role, in the creating status, so the condition
+// then reports the timeout as an error. The profile is still checked on in case it completes.
+func (s *FargateService) markCreating() {
+	status := &s.scope.FargateProfile.Status
+	if status.CreatingSince == nil {
+		status.CreatingSince = ptr.To(metav1.Now())
+	}
+
+	timeout := s.createTimeout()
+	if time.Since(status.CreatingSince.Time) < timeout {
+		v1beta1conditions.MarkFalse(s.scope.FargateProfile, expinfrav1.EKSFargateProfileReadyCondition, expinfrav1.EKSFargateCreatingReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return
+	}
+
+	if v1beta1conditions.GetReason(s.scope.FargateProfile, expinfrav1.EKSFargateProfileReadyCondition) != expinfrav1.EKSFargateCreateTimeoutReason {
+		record.Warnf(s.scope.FargateProfile, "FailedCreateEKSFargateProfile", "EKS fargate profile %s has been creating for more than %s", s.scope.FargateProfile.Spec.ProfileName, timeout)
+	}
+	v1beta1conditions.MarkFalse(
+		s.scope.FargateProfile,
+		expinfrav1.EKSFargateProfileReadyCondition,
+		expinfrav1.EKSFargateCreateTimeoutReason,
+		clusterv1beta1.ConditionSeverityError,
+		"fargate profile has been creating since %s, check that its subnets and pod execution role are usable",
+		status.CreatingSince.UTC().Format(time.RFC3339),
+	)
+}
+
 // ReconcileDelete is the entrypoint for FargateProfile reconciliation.
 func (s *FargateService) ReconcileDelete(ctx context.Context) (reconcile.Result, error) {
 	s.scope.Debug("Reconciling EKS fargate profile deletion")
@@ -173,13 +272,13 @@ func (s *FargateService) ReconcileDelete(ctx context.Context) (reconcile.Result,
 			expinfrav1.EKSFargateReconciliationFailedReason,
 			clusterv1beta1.ConditionSeverityError,
 			"%s",
-			err.Error(),
+			awserrors.MessageWithoutRequestID(err),
 		)
 		return reconcile.Result{}, err
 	}
 
 	if requeue {
-		return requeueProfileUpdating(), nil
+		return s.requeueProfileUpdating(), nil
 	}
 
 	err = s.deleteFargateIAMRole(ctx)
@@ -190,7 +289,7 @@ func (s *FargateService) ReconcileDelete(ctx context.Context) (reconcile.Result,
 			expinfrav1.IAMFargateRolesReconciliationFailedReason,
 			clusterv1beta1.ConditionSeverityError,
 			"%s",
-			err.Error(),
+			awserrors.MessageWithoutRequestID(err),
 		)
 	}
 	return reconcile.Result{}, err
@@ -230,12 +329,9 @@ func (s *FargateService) createFargateProfile(ctx context.Context) (*ekstypes.Fa
 
 	tags := ngTags(s.scope.ClusterName(), additionalTags)
 
-	subnets := s.scope.FargateProfile.Spec.SubnetIDs
-	if len(subnets) == 0 {
-		subnets = []string{}
-		for _, s := range s.scope.ControlPlane.Spec.NetworkSpec.Subnets.FilterPrivate() {
-			subnets = append(subnets, s.ID)
-		}
+	subnets, err := s.desiredSubnets()
+	if err != nil {
+		return nil, err
 	}
 
 	selectors := []ekstypes.FargateProfileSelector{}
@@ -253,16 +349,101 @@ func (s *FargateService) createFargateProfile(ctx context.Context) (*ekstypes.Fa
 		Subnets:             subnets,
 		Tags:                tags,
 		Selectors:           selectors,
+		ClientRequestToken:  clientRequestToken(s.scope.FargateProfile, profileName, sets.List(sets.New(subnets...))...),
 	}
 
-	out, err := s.EKSClient.CreateFargateProfile(ctx, input)
-	if err != nil {
+	var out *eks.CreateFargateProfileOutput
+	if err := wait.WaitForWithClassifier(wait.NewBackoff(), withThrottlingEvent(s.scope.FargateProfile, "the fargate profile creation", func() (bool, error) {
+		var err error
+		if out, err = s.EKSClient.CreateFargateProfile(ctx, input); err != nil {
+			return false, err
+		}
+		return true, nil
+	}), awserrors.DefaultRetryClassifier); err != nil {
 		return nil, errors.Wrap(err, "failed to create fargate profile")
 	}
 
 	return out.FargateProfile, nil
 }
 
+// desiredSubnets returns the subnets of the profile. When none are set in the
+// spec, one private subnet is picked in each availability zone of the cluster.
+// Subnets in local zones, wavelength zones or without a known zone are
+// skipped as fargate doesn't run pods there.
+func (s *FargateService) desiredSubnets() ([]string, error) {
+	if len(s.scope.FargateProfile.Spec.SubnetIDs) > 0 {
+		return s.scope.FargateProfile.Spec.SubnetIDs, nil
+	}
+
+	subnets := []string{}
+	zones := sets.New[string]()
+	for _, subnet := range s.scope.ControlPlane.Spec.NetworkSpec.Subnets.FilterPrivate() {
+		if !fargateSupportsZone(subnet) || zones.Has(subnet.AvailabilityZone) {
+			continue
+		}
+		zones.Insert(subnet.AvailabilityZone)
+		subnets = append(subnets, subnet.GetResourceID())
+	}
+	if len(subnets) == 0 {
+		return nil, errors.New("no private subnet in an availability zone supported by fargate, set the subnets of the fargate profile")
+	}
+	return subnets, nil
+}
+
+// fargateSupportsZone reports whether fargate can run pods in the zone of the
+// subnet. Fargate only supports the regular availability zones of a region.
+func fargateSupportsZone(subnet infrav1.SubnetSpec) bool {
+	if subnet.AvailabilityZone == "" {
+		return false
+	}
+	return subnet.ZoneType == nil || subnet.ZoneType.Equal(infrav1.ZoneTypeAvailabilityZone)
+}
+
+// subnetsChanged reports whether the subnets in the spec differ from the ones
+// of the existing profile. Only explicitly configured subnets are compared, so
+// changes to the private subnets of the cluster don't recreate the profile.
+func (s *FargateService) subnetsChanged(profile *ekstypes.FargateProfile) bool {
+	desired := s.scope.FargateProfile.Spec.SubnetIDs
+	if len(desired) == 0 {
+		return false
+	}
+	return !sets.New(desired...).Equal(sets.New(profile.Subnets...))
+}
+
+// recreateFargateProfile deletes a profile whose subnets have drifted from the
+// spec. Subnets of a fargate profile are immutable, so the profile is created
+// again with the new subnets once the deletion has finished.
+func (s *FargateService) recreateFargateProfile(ctx context.Context, profile *ekstypes.FargateProfile) (requeue bool, err error) {
+	// Wait for any in-progress operation to finish before deleting the profile.
+	switch profile.Status {
+	case ekstypes.FargateProfileStatusCreating, ekstypes.FargateProfileStatusDeleting:
+		return s.handleStatus(profile), nil
+	case ekstypes.FargateProfileStatusDeleteFailed:
+		return s.handleStatus(profile), errors.New("cannot recreate fargate profile after failed deletion")
+	case ekstypes.FargateProfileStatusActive, ekstypes.FargateProfileStatusCreateFailed:
+	}
+
+	profileName := s.scope.FargateProfile.Spec.ProfileName
+	s.scope.Info("Recreating EKS fargate profile with new subnets", "profile-name", profileName, "current", profile.Subnets, "desired", s.scope.FargateProfile.Spec.SubnetIDs)
+	record.Eventf(s.scope.FargateProfile, "InitiatedRecreateEKSFargateProfile", "Recreating EKS fargate profile %s as its subnets changed", eventResource(profileName, profile.FargateProfileArn))
+
+	out, err := s.deleteFargateProfileWithRetry(ctx, &eks.DeleteFargateProfileInput{
+		ClusterName:        aws.String(s.scope.KubernetesClusterName()),
+		FargateProfileName: aws.String(profileName),
+	})
+	if err != nil {
+		record.Warnf(s.scope.FargateProfile, "FailedRecreateEKSFargateProfile", "Failed to delete EKS fargate profile %s for recreation: %v", eventResource(profileName, profile.FargateProfileArn), err)
+		return false, errors.Wrap(err, "failed to delete fargate profile for recreation")
+	}
+
+	profile = out.FargateProfile
+	profile.Status = ekstypes.FargateProfileStatusDeleting
+	requeue = s.handleStatus(profile)
+	v1beta1conditions.MarkFalse(s.scope.FargateProfile, expinfrav1.EKSFargateProfileReadyCondition, expinfrav1.EKSFargateRecreatingReason, clusterv1beta1.ConditionSeverityInfo, "subnets changed")
+
+	return requeue, nil
+}
+
 func (s *FargateService) deleteFargateProfile(ctx context.Context) (requeue bool, err error) {
 	eksClusterName := s.scope.KubernetesClusterName()
 	profileName := s.scope.FargateProfile.Spec.ProfileName
@@ -291,7 +472,7 @@ func (s *FargateService) deleteFargateProfile(ctx context.Context) (requeue bool
 		FargateProfileName: aws.String(profileName),
 	}
 
-	out, err := s.EKSClient.DeleteFargateProfile(ctx, input)
+	out, err := s.deleteFargateProfileWithRetry(ctx, input)
 	if err != nil {
 		return false, errors.Wrap(err, "failed to delete fargate profile")
 	}
@@ -303,13 +484,113 @@ func (s *FargateService) deleteFargateProfile(ctx context.Context) (requeue bool
 }
 
 func (s *FargateService) roleArn(ctx context.Context) (*string, error) {
-	var role *iamtypes.Role
-	if s.scope.RoleName() != "" {
+	if roleARN := s.scope.FargateProfile.Spec.PodExecutionRoleARN; roleARN != nil {
+		return roleARN, nil
+	}
+	if s.scope.RoleName() == "" {
+		return nil, errors.New("fargate profile IAM role name is empty")
+	}
+	role, err := s.GetIAMRole(ctx, s.scope.RoleName())
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting fargate profile IAM role: %s", s.scope.RoleName())
+	}
+	return role.Arn, nil
+}
+
+// deleteFargateProfileWithRetry deletes the fargate profile, retrying the errors
+// classified as retryable.
+func (s *FargateService) deleteFargateProfileWithRetry(ctx context.Context, input *eks.DeleteFargateProfileInput) (*eks.DeleteFargateProfileOutput, error) {
+	var out *eks.DeleteFargateProfileOutput
+	err := wait.WaitForWithClassifier(wait.NewBackoff(), withThrottlingEvent(s.scope.FargateProfile, "the fargate profile deletion", func() (bool, error) {
 		var err error
-		role, err = s.GetIAMRole(ctx, s.scope.RoleName())
+		if out, err = s.EKSClient.DeleteFargateProfile(ctx, input); err != nil {
+			return false, err
+		}
+		return true, nil
+	}), awserrors.DefaultRetryClassifier)
+	return out, err
+}
+
+// ImportFargateProfiles lists the fargate profiles of an EKS cluster and maps each of them to an
+// AWSFargateProfile for the given cluster, so that profiles created outside of CAPA can be adopted.
+func ImportFargateProfiles(ctx context.Context, client EKSAPI, eksClusterName, clusterName, namespace string) ([]expinfrav1.AWSFargateProfile, error) {
+	var profiles []expinfrav1.AWSFargateProfile
+
+	paginator := eks.NewListFargateProfilesPaginator(client, &eks.ListFargateProfilesInput{
+		ClusterName: aws.String(eksClusterName),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, errors.Wrapf(err, "error getting fargate profile IAM role: %s", s.scope.RoleName())
+			return nil, errors.Wrap(err, "failed to list fargate profiles")
+		}
+		for _, name := range page.FargateProfileNames {
+			out, err := client.DescribeFargateProfile(ctx, &eks.DescribeFargateProfileInput{
+				ClusterName:        aws.String(eksClusterName),
+				FargateProfileName: aws.String(name),
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to describe fargate profile %s", name)
+			}
+			if out.FargateProfile == nil {
+				continue
+			}
+			profiles = append(profiles, expinfrav1.AWSFargateProfile{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: expinfrav1.GroupVersion.String(),
+					Kind:       "AWSFargateProfile",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					// Profile names may contain characters that aren't allowed in object names.
+					Name: strings.ReplaceAll(strings.ToLower(name), "_", "-"),
+					// The profiles aren't tagged as owned by the cluster yet.
+					Annotations: map[string]string{infrav1.AdoptAnnotation: "true"},
+				},
+				Spec: FargateProfileToSpec(out.FargateProfile, clusterName),
+			})
 		}
 	}
-	return role.Arn, nil
+
+	return profiles, nil
+}
+
+// FargateProfileToSpec maps an EKS fargate profile back to the spec that reconciles to it.
+// Tags added by the provider itself aren't included in the additional tags.
+func FargateProfileToSpec(profile *ekstypes.FargateProfile, clusterName string) expinfrav1.FargateProfileSpec {
+	spec := expinfrav1.FargateProfileSpec{
+		ClusterName: clusterName,
+		ProfileName: aws.ToString(profile.FargateProfileName),
+		SubnetIDs:   profile.Subnets,
+	}
+
+	for _, selector := range profile.Selectors {
+		spec.Selectors = append(spec.Selectors, expinfrav1.FargateSelector{
+			Labels:    selector.Labels,
+			Namespace: aws.ToString(selector.Namespace),
+		})
+	}
+
+	for key, value := range profile.Tags {
+		if strings.HasPrefix(key, infrav1.NameKubernetesAWSCloudProviderPrefix) || strings.HasPrefix(key, infrav1.NameAWSProviderPrefix) {
+			continue
+		}
+		if spec.AdditionalTags == nil {
+			spec.AdditionalTags = infrav1.Tags{}
+		}
+		spec.AdditionalTags[key] = value
+	}
+
+	if roleArn, err := arn.Parse(aws.ToString(profile.PodExecutionRoleArn)); err == nil {
+		// The resource is "role/" followed by the role's path and name.
+		resource := strings.TrimPrefix(roleArn.Resource, "role")
+		if i := strings.LastIndex(resource, "/"); i >= 0 {
+			spec.RoleName = resource[i+1:]
+			if path := resource[:i+1]; path != "/" {
+				spec.RolePath = path
+			}
+		}
+	}
+
+	return spec
 }
@@ -0,0 +1,931 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	eksiam "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+func TestReconcileFargateProfileSubnetChange(t *testing.T) {
+	const (
+		clusterName    = "cluster"
+		eksClusterName = "eks-cluster"
+		profileName    = "profile"
+	)
+	ownedTags := map[string]string{infrav1.ClusterAWSCloudProviderTagKey(clusterName): string(infrav1.ResourceLifecycleOwned)}
+
+	tests := []struct {
+		name           string
+		expect         func(eksMock *mock_eksiface.MockEKSAPIMockRecorder, iamMock *mock_iamauth.MockIAMAPIMockRecorder)
+		expectRequeue  bool
+		expectedReason string
+	}{
+		{
+			name: "active profile with changed subnets is deleted",
+			expect: func(eksMock *mock_eksiface.MockEKSAPIMockRecorder, iamMock *mock_iamauth.MockIAMAPIMockRecorder) {
+				eksMock.DescribeFargateProfile(gomock.Any(), gomock.Any()).Return(&eks.DescribeFargateProfileOutput{
+					FargateProfile: &ekstypes.FargateProfile{
+						FargateProfileName: aws.String(profileName),
+						Status:             ekstypes.FargateProfileStatusActive,
+						Subnets:            []string{"subnet-1"},
+						Tags:               ownedTags,
+					},
+				}, nil)
+				eksMock.DeleteFargateProfile(gomock.Any(), &eks.DeleteFargateProfileInput{
+					ClusterName:        aws.String(eksClusterName),
+					FargateProfileName: aws.String(profileName),
+				}).Return(&eks.DeleteFargateProfileOutput{
+					FargateProfile: &ekstypes.FargateProfile{
+						FargateProfileName: aws.String(profileName),
+						Subnets:            []string{"subnet-1"},
+					},
+				}, nil)
+			},
+			expectRequeue:  true,
+			expectedReason: expinfrav1.EKSFargateRecreatingReason,
+		},
+		{
+			name: "recreate waits for the old profile to be deleted",
+			expect: func(eksMock *mock_eksiface.MockEKSAPIMockRecorder, iamMock *mock_iamauth.MockIAMAPIMockRecorder) {
+				eksMock.DescribeFargateProfile(gomock.Any(), gomock.Any()).Return(&eks.DescribeFargateProfileOutput{
+					FargateProfile: &ekstypes.FargateProfile{
+						FargateProfileName: aws.String(profileName),
+						Status:             ekstypes.FargateProfileStatusDeleting,
+						Subnets:            []string{"subnet-1"},
+						Tags:               ownedTags,
+					},
+				}, nil)
+			},
+			expectRequeue:  true,
+			expectedReason: expinfrav1.EKSFargateDeletingReason,
+		},
+		{
+			name: "profile is created with the new subnets once deleted",
+			expect: func(eksMock *mock_eksiface.MockEKSAPIMockRecorder, iamMock *mock_iamauth.MockIAMAPIMockRecorder) {
+				eksMock.DescribeFargateProfile(gomock.Any(), gomock.Any()).Return(nil, &ekstypes.ResourceNotFoundException{})
+				iamMock.GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{
+					Role: &iamtypes.Role{Arn: aws.String("arn:aws:iam::123456789012:role/fargate")},
+				}, nil)
+				eksMock.CreateFargateProfile(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, input *eks.CreateFargateProfileInput, _ ...func(*eks.Options)) (*eks.CreateFargateProfileOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.Subnets).To(Equal([]string{"subnet-2"}))
+						return &eks.CreateFargateProfileOutput{
+							FargateProfile: &ekstypes.FargateProfile{
+								FargateProfileName: aws.String(profileName),
+								Subnets:            input.Subnets,
+								Tags:               input.Tags,
+							},
+						}, nil
+					})
+			},
+			expectRequeue:  true,
+			expectedReason: expinfrav1.EKSFargateCreatingReason,
+		},
+		{
+			name: "unchanged subnets don't recreate the profile",
+			expect: func(eksMock *mock_eksiface.MockEKSAPIMockRecorder, iamMock *mock_iamauth.MockIAMAPIMockRecorder) {
+				eksMock.DescribeFargateProfile(gomock.Any(), gomock.Any()).Return(&eks.DescribeFargateProfileOutput{
+					FargateProfile: &ekstypes.FargateProfile{
+						FargateProfileName: aws.String(profileName),
+						Status:             ekstypes.FargateProfileStatusActive,
+						Subnets:            []string{"subnet-2"},
+						Tags:               ownedTags,
+					},
+				}, nil)
+			},
+			expectRequeue: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+			tc.expect(eksMock.EXPECT(), iamMock.EXPECT())
+
+			log := logger.NewLogger(klog.Background())
+			s := &FargateService{
+				scope: &scope.FargateProfileScope{
+					Logger: *log,
+					Cluster: &clusterv1.Cluster{
+						ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+					},
+					ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+						Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: eksClusterName},
+					},
+					FargateProfile: &expinfrav1.AWSFargateProfile{
+						Spec: expinfrav1.FargateProfileSpec{
+							ClusterName: clusterName,
+							ProfileName: profileName,
+							RoleName:    "fargate",
+							SubnetIDs:   []string{"subnet-2"},
+						},
+					},
+				},
+				EKSClient: eksMock,
+				IAMService: eksiam.IAMService{
+					Wrapper:   log,
+					IAMClient: iamMock,
+				},
+			}
+
+			requeue, err := s.reconcileFargateProfile(context.TODO())
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(requeue).To(Equal(tc.expectRequeue))
+			if tc.expectedReason != "" {
+				g.Expect(v1beta1conditions.GetReason(s.scope.FargateProfile, expinfrav1.EKSFargateProfileReadyCondition)).To(Equal(tc.expectedReason))
+			} else {
+				g.Expect(v1beta1conditions.IsTrue(s.scope.FargateProfile, expinfrav1.EKSFargateProfileReadyCondition)).To(BeTrue())
+			}
+		})
+	}
+}
+
+func TestRecreateFargateProfileUsesNewClientRequestToken(t *testing.T) {
+	const (
+		clusterName    = "cluster"
+		eksClusterName = "eks-cluster"
+		profileName    = "profile"
+	)
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+	iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+	ownedTags := map[string]string{infrav1.ClusterAWSCloudProviderTagKey(clusterName): string(infrav1.ResourceLifecycleOwned)}
+
+	var tokens []string
+	createProfile := func(_ context.Context, input *eks.CreateFargateProfileInput, _ ...func(*eks.Options)) (*eks.CreateFargateProfileOutput, error) {
+		tokens = append(tokens, aws.ToString(input.ClientRequestToken))
+		return &eks.CreateFargateProfileOutput{
+			FargateProfile: &ekstypes.FargateProfile{
+				FargateProfileName: aws.String(profileName),
+				Subnets:            input.Subnets,
+				Tags:               input.Tags,
+			},
+		}, nil
+	}
+	iamMock.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{
+		Role: &iamtypes.Role{Arn: aws.String("arn:aws:iam::123456789012:role/fargate")},
+	}, nil).AnyTimes()
+	gomock.InOrder(
+		eksMock.EXPECT().DescribeFargateProfile(gomock.Any(), gomock.Any()).Return(nil, &ekstypes.ResourceNotFoundException{}),
+		eksMock.EXPECT().CreateFargateProfile(gomock.Any(), gomock.Any()).DoAndReturn(createProfile),
+		eksMock.EXPECT().DescribeFargateProfile(gomock.Any(), gomock.Any()).Return(&eks.DescribeFargateProfileOutput{
+			FargateProfile: &ekstypes.FargateProfile{
+				FargateProfileName: aws.String(profileName),
+				Status:             ekstypes.FargateProfileStatusActive,
+				Subnets:            []string{"subnet-1"},
+				Tags:               ownedTags,
+			},
+		}, nil),
+		eksMock.EXPECT().DeleteFargateProfile(gomock.Any(), gomock.Any()).Return(&eks.DeleteFargateProfileOutput{
+			FargateProfile: &ekstypes.FargateProfile{FargateProfileName: aws.String(profileName)},
+		}, nil),
+		eksMock.EXPECT().DescribeFargateProfile(gomock.Any(), gomock.Any()).Return(nil, &ekstypes.ResourceNotFoundException{}),
+		eksMock.EXPECT().CreateFargateProfile(gomock.Any(), gomock.Any()).DoAndReturn(createProfile),
+	)
+
+	log := logger.NewLogger(klog.Background())
+	s := &FargateService{
+		scope: &scope.FargateProfileScope{
+			Logger: *log,
+			Cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+			},
+			ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: eksClusterName},
+			},
+			FargateProfile: &expinfrav1.AWSFargateProfile{
+				ObjectMeta: metav1.ObjectMeta{UID: "uid"},
+				Spec: expinfrav1.FargateProfileSpec{
+					ClusterName: clusterName,
+					ProfileName: profileName,
+					RoleName:    "fargate",
+					SubnetIDs:   []string{"subnet-1"},
+				},
+			},
+		},
+		EKSClient: eksMock,
+		IAMService: eksiam.IAMService{
+			Wrapper:   log,
+			IAMClient: iamMock,
+		},
+	}
+
+	_, err := s.reconcileFargateProfile(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s.scope.FargateProfile.Spec.SubnetIDs = []string{"subnet-2"}
+	_, err = s.reconcileFargateProfile(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(v1beta1conditions.GetReason(s.scope.FargateProfile, expinfrav1.EKSFargateProfileReadyCondition)).To(Equal(expinfrav1.EKSFargateRecreatingReason))
+
+	_, err = s.reconcileFargateProfile(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(tokens).To(HaveLen(2))
+	g.Expect(tokens[0]).NotTo(BeEmpty())
+	g.Expect(tokens[1]).NotTo(Equal(tokens[0]))
+}
+
+func TestFargateProfileConditionOmitsRequestID(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+
+	requestID := "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"
+	eksMock.EXPECT().DescribeFargateProfile(gomock.Any(), gomock.Any()).Return(nil, &smithy.OperationError{
+		ServiceID:     "EKS",
+		OperationName: "DescribeFargateProfile",
+		Err: &awshttp.ResponseError{
+			ResponseError: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusForbidden}},
+				Err:      &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "not authorized"},
+			},
+			RequestID: requestID,
+		},
+	})
+
+	s := &FargateService{
+		scope: &scope.FargateProfileScope{
+			Logger: *logger.NewLogger(klog.Background()),
+			ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "eks-cluster"},
+			},
+			FargateProfile: &expinfrav1.AWSFargateProfile{
+				Spec: expinfrav1.FargateProfileSpec{ProfileName: "profile"},
+			},
+		},
+		EKSClient: eksMock,
+	}
+
+	_, err := s.ReconcileDelete(context.TODO())
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring(requestID))
+	g.Expect(v1beta1conditions.GetMessage(s.scope.FargateProfile, clusterv1beta1.ReadyCondition)).To(SatisfyAll(
+		ContainSubstring("AccessDeniedException: not authorized"),
+		Not(ContainSubstring(requestID)),
+	))
+}
+
+func TestFargateProfileToSpec(t *testing.T) {
+	tests := []struct {
+		name     string
+		profile  *ekstypes.FargateProfile
+		expected expinfrav1.FargateProfileSpec
+	}{
+		{
+			name: "selectors, subnets and role are mapped",
+			profile: &ekstypes.FargateProfile{
+				FargateProfileName:  aws.String("profile_1"),
+				PodExecutionRoleArn: aws.String("arn:aws:iam::123456789012:role/fargate-pods"),
+				Subnets:             []string{"subnet-1", "subnet-2"},
+				Selectors: []ekstypes.FargateProfileSelector{
+					{Namespace: aws.String("kube-system")},
+					{Namespace: aws.String("apps"), Labels: map[string]string{"fargate": "true"}},
+				},
+			},
+			expected: expinfrav1.FargateProfileSpec{
+				ClusterName: "cluster",
+				ProfileName: "profile_1",
+				RoleName:    "fargate-pods",
+				SubnetIDs:   []string{"subnet-1", "subnet-2"},
+				Selectors: []expinfrav1.FargateSelector{
+					{Namespace: "kube-system"},
+					{Namespace: "apps", Labels: map[string]string{"fargate": "true"}},
+				},
+			},
+		},
+		{
+			name: "role path is mapped",
+			profile: &ekstypes.FargateProfile{
+				FargateProfileName:  aws.String("profile"),
+				PodExecutionRoleArn: aws.String("arn:aws-cn:iam::123456789012:role/eks/fargate/fargate-pods"),
+			},
+			expected: expinfrav1.FargateProfileSpec{
+				ClusterName: "cluster",
+				ProfileName: "profile",
+				RoleName:    "fargate-pods",
+				RolePath:    "/eks/fargate/",
+			},
+		},
+		{
+			name: "provider tags are not additional tags",
+			profile: &ekstypes.FargateProfile{
+				FargateProfileName: aws.String("profile"),
+				Tags: map[string]string{
+					infrav1.ClusterAWSCloudProviderTagKey("eks-cluster"): string(infrav1.ResourceLifecycleOwned),
+					infrav1.ClusterTagKey("cluster"):                     string(infrav1.ResourceLifecycleOwned),
+					"team":                                               "platform",
+				},
+			},
+			expected: expinfrav1.FargateProfileSpec{
+				ClusterName:    "cluster",
+				ProfileName:    "profile",
+				AdditionalTags: infrav1.Tags{"team": "platform"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(FargateProfileToSpec(tc.profile, "cluster")).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestImportFargateProfiles(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+
+	eksMock.EXPECT().ListFargateProfiles(gomock.Any(), &eks.ListFargateProfilesInput{ClusterName: aws.String("eks-cluster")}, gomock.Any()).
+		Return(&eks.ListFargateProfilesOutput{FargateProfileNames: []string{"Profile_1"}, NextToken: aws.String("next")}, nil)
+	eksMock.EXPECT().ListFargateProfiles(gomock.Any(), &eks.ListFargateProfilesInput{ClusterName: aws.String("eks-cluster"), NextToken: aws.String("next")}, gomock.Any()).
+		Return(&eks.ListFargateProfilesOutput{FargateProfileNames: []string{"profile-2"}}, nil)
+	for _, name := range []string{"Profile_1", "profile-2"} {
+		eksMock.EXPECT().DescribeFargateProfile(gomock.Any(), &eks.DescribeFargateProfileInput{
+			ClusterName:        aws.String("eks-cluster"),
+			FargateProfileName: aws.String(name),
+		}).Return(&eks.DescribeFargateProfileOutput{
+			FargateProfile: &ekstypes.FargateProfile{FargateProfileName: aws.String(name)},
+		}, nil)
+	}
+
+	profiles, err := ImportFargateProfiles(context.TODO(), eksMock, "eks-cluster", "cluster", "ns")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(profiles).To(HaveLen(2))
+	g.Expect(profiles[0].Namespace).To(Equal("ns"))
+	g.Expect(profiles[0].Name).To(Equal("profile-1"))
+	g.Expect(profiles[0].Spec.ProfileName).To(Equal("Profile_1"))
+	g.Expect(profiles[0].Spec.ClusterName).To(Equal("cluster"))
+	g.Expect(profiles[1].Name).To(Equal("profile-2"))
+	g.Expect(profiles[0].Annotations).To(HaveKeyWithValue(infrav1.AdoptAnnotation, "true"))
+}
+
+func TestReconcileImportedFargateProfile(t *testing.T) {
+	const profileARN = "arn:aws:eks:us-east-1:123456789012:fargateprofile/eks-cluster/profile/id"
+	ownedKey := infrav1.ClusterAWSCloudProviderTagKey("cluster")
+
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+
+	existing := &ekstypes.FargateProfile{
+		FargateProfileName: aws.String("profile"),
+		FargateProfileArn:  aws.String(profileARN),
+		Status:             ekstypes.FargateProfileStatusActive,
+		Subnets:            []string{"subnet-1"},
+		Selectors:          []ekstypes.FargateProfileSelector{{Namespace: aws.String("default")}},
+		Tags:               map[string]string{"team": "platform"},
+	}
+	describedTags := existing.Tags
+	eksMock.EXPECT().ListFargateProfiles(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&eks.ListFargateProfilesOutput{FargateProfileNames: []string{"profile"}}, nil)
+	eksMock.EXPECT().DescribeFargateProfile(gomock.Any(), gomock.Any()).
+		Return(&eks.DescribeFargateProfileOutput{FargateProfile: existing}, nil).Times(2)
+
+	profiles, err := ImportFargateProfiles(context.TODO(), eksMock, "eks-cluster", "cluster", "ns")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(profiles).To(HaveLen(1))
+
+	// The imported profile is tagged as owned by the cluster instead of failing the reconcile.
+	eksMock.EXPECT().TagResource(gomock.Any(), &eks.TagResourceInput{
+		ResourceArn: aws.String(profileARN),
+		Tags:        map[string]string{ownedKey: string(infrav1.ResourceLifecycleOwned)},
+	}).Return(&eks.TagResourceOutput{}, nil)
+
+	log := logger.NewLogger(klog.Background())
+	s := &FargateService{
+		scope: &scope.FargateProfileScope{
+			Logger: *log,
+			Cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			},
+			ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "eks-cluster"},
+			},
+			FargateProfile: &profiles[0],
+		},
+		EKSClient:  eksMock,
+		IAMService: eksiam.IAMService{Wrapper: log},
+	}
+
+	requeue, err := s.reconcileFargateProfile(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(requeue).To(BeFalse())
+	g.Expect(s.scope.FargateProfile.Status.Ready).To(BeTrue())
+	g.Expect(describedTags).NotTo(HaveKey(ownedKey), "the described tags aren't modified")
+}
+
+func TestReconcileFargateProfileNotOwned(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+
+	eksMock.EXPECT().DescribeFargateProfile(gomock.Any(), gomock.Any()).Return(&eks.DescribeFargateProfileOutput{
+		FargateProfile: &ekstypes.FargateProfile{FargateProfileName: aws.String("profile"), Status: ekstypes.FargateProfileStatusActive},
+	}, nil)
+
+	s := &FargateService{
+		scope: &scope.FargateProfileScope{
+			Logger:       *logger.NewLogger(klog.Background()),
+			Cluster:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}},
+			ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{},
+			FargateProfile: &expinfrav1.AWSFargateProfile{
+				Spec: expinfrav1.FargateProfileSpec{ClusterName: "cluster", ProfileName: "profile"},
+			},
+		},
+		EKSClient: eksMock,
+	}
+
+	_, err := s.reconcileFargateProfile(context.TODO())
+	g.Expect(err).To(MatchError(ContainSubstring(infrav1.AdoptAnnotation)))
+}
+
+func TestFargateProfileRoleArnEmptyRoleName(t *testing.T) {
+	g := NewWithT(t)
+	s := &FargateService{
+		scope: &scope.FargateProfileScope{
+			Logger:         *logger.NewLogger(klog.Background()),
+			FargateProfile: &expinfrav1.AWSFargateProfile{},
+		},
+	}
+
+	arn, err := s.roleArn(context.TODO())
+	g.Expect(err).To(MatchError("fargate profile IAM role name is empty"))
+	g.Expect(arn).To(BeNil())
+}
+
+func TestFargateProfilePodExecutionRoleARN(t *testing.T) {
+	const roleARN = "arn:aws:iam::123456789012:role/existing-fargate"
+
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+	// The IAM mock expects no calls: the role in the spec is used as is.
+	iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+
+	eksMock.EXPECT().CreateFargateProfile(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *eks.CreateFargateProfileInput, _ ...func(*eks.Options)) (*eks.CreateFargateProfileOutput, error) {
+			g.Expect(input.PodExecutionRoleArn).To(Equal(aws.String(roleARN)))
+			return &eks.CreateFargateProfileOutput{
+				FargateProfile: &ekstypes.FargateProfile{FargateProfileName: input.FargateProfileName},
+			}, nil
+		})
+
+	log := logger.NewLogger(klog.Background())
+	s := &FargateService{
+		scope: &scope.FargateProfileScope{
+			Logger: *log,
+			Cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			},
+			ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "eks-cluster"},
+			},
+			FargateProfile: &expinfrav1.AWSFargateProfile{
+				Spec: expinfrav1.FargateProfileSpec{
+					ClusterName:         "cluster",
+					ProfileName:         "profile",
+					SubnetIDs:           []string{"subnet-1"},
+					PodExecutionRoleARN: aws.String(roleARN),
+				},
+			},
+		},
+		EKSClient: eksMock,
+		IAMService: eksiam.IAMService{
+			Wrapper:   log,
+			IAMClient: iamMock,
+		},
+	}
+
+	requeue, err := s.reconcileFargateIAMRole(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(requeue).To(BeFalse())
+	g.Expect(s.scope.FargateProfile.Spec.RoleName).To(BeEmpty())
+
+	_, err = s.createFargateProfile(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestFargateProfileDesiredSubnets(t *testing.T) {
+	private := func(id, zone string, zoneType *infrav1.ZoneType) infrav1.SubnetSpec {
+		return infrav1.SubnetSpec{ResourceID: id, AvailabilityZone: zone, ZoneType: zoneType}
+	}
+	localZone := ptr.To(infrav1.ZoneTypeLocalZone)
+	wavelengthZone := ptr.To(infrav1.ZoneTypeWavelengthZone)
+	availabilityZone := ptr.To(infrav1.ZoneTypeAvailabilityZone)
+
+	tests := []struct {
+		name          string
+		specSubnets   []string
+		subnets       infrav1.Subnets
+		expected      []string
+		expectedError string
+	}{
+		{
+			name:        "subnets in the spec are used as is",
+			specSubnets: []string{"subnet-a", "subnet-b"},
+			subnets:     infrav1.Subnets{private("subnet-c", "us-east-1a", nil)},
+			expected:    []string{"subnet-a", "subnet-b"},
+		},
+		{
+			name: "one private subnet per availability zone",
+			subnets: infrav1.Subnets{
+				private("subnet-a1", "us-east-1a", nil),
+				private("subnet-a2", "us-east-1a", nil),
+				{ResourceID: "subnet-b-public", AvailabilityZone: "us-east-1b", IsPublic: true},
+				private("subnet-b1", "us-east-1b", availabilityZone),
+				private("subnet-c1", "us-east-1c", nil),
+				private("subnet-c2", "us-east-1c", availabilityZone),
+			},
+			expected: []string{"subnet-a1", "subnet-b1", "subnet-c1"},
+		},
+		{
+			name: "unsupported zones are skipped",
+			subnets: infrav1.Subnets{
+				private("subnet-lz", "us-east-1-nyc-1a", localZone),
+				private("subnet-a", "us-east-1a", nil),
+				private("subnet-wl", "us-east-1-wl1-bos-wlz-1", wavelengthZone),
+				private("subnet-nozone", "", nil),
+				private("subnet-b", "us-east-1b", nil),
+			},
+			expected: []string{"subnet-a", "subnet-b"},
+		},
+		{
+			name: "no usable subnet",
+			subnets: infrav1.Subnets{
+				{ResourceID: "subnet-public", AvailabilityZone: "us-east-1a", IsPublic: true},
+				private("subnet-lz", "us-east-1-nyc-1a", localZone),
+			},
+			expectedError: "no private subnet in an availability zone supported by fargate, set the subnets of the fargate profile",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &FargateService{
+				scope: &scope.FargateProfileScope{
+					ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+						Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+							NetworkSpec: infrav1.NetworkSpec{Subnets: tc.subnets},
+						},
+					},
+					FargateProfile: &expinfrav1.AWSFargateProfile{
+						Spec: expinfrav1.FargateProfileSpec{SubnetIDs: tc.specSubnets},
+					},
+				},
+			}
+
+			subnets, err := s.desiredSubnets()
+			if tc.expectedError != "" {
+				g.Expect(err).To(MatchError(tc.expectedError))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(subnets).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestFargateProfileReconcileTags(t *testing.T) {
+	const (
+		clusterName = "cluster"
+		profileARN  = "arn:aws:eks:us-east-1:123456789012:fargateprofile/eks-cluster/profile/id"
+	)
+	ownedKey := infrav1.ClusterAWSCloudProviderTagKey(clusterName)
+	owned := string(infrav1.ResourceLifecycleOwned)
+
+	tests := []struct {
+		name           string
+		additionalTags infrav1.Tags
+		current        map[string]string
+		expect         func(m *mock_eksiface.MockEKSAPIMockRecorder)
+	}{
+		{
+			name:           "tag removed from the spec is removed from the profile",
+			additionalTags: infrav1.Tags{"team": "a"},
+			current:        map[string]string{ownedKey: owned, "team": "a", "cost-center": "1234"},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UntagResource(gomock.Any(), &eks.UntagResourceInput{
+					ResourceArn: aws.String(profileARN),
+					TagKeys:     []string{"cost-center"},
+				}).Return(&eks.UntagResourceOutput{}, nil)
+			},
+		},
+		{
+			name:    "cluster owned tag and AWS tags are kept when all additional tags are removed",
+			current: map[string]string{ownedKey: owned, "aws:cloudformation:stack-name": "stack", "team": "a"},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UntagResource(gomock.Any(), &eks.UntagResourceInput{
+					ResourceArn: aws.String(profileARN),
+					TagKeys:     []string{"team"},
+				}).Return(&eks.UntagResourceOutput{}, nil)
+			},
+		},
+		{
+			name:           "changed tag is updated and stale tag removed",
+			additionalTags: infrav1.Tags{"team": "b"},
+			current:        map[string]string{ownedKey: owned, "team": "a", "cost-center": "1234"},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.TagResource(gomock.Any(), &eks.TagResourceInput{
+					ResourceArn: aws.String(profileARN),
+					Tags:        map[string]string{"team": "b"},
+				}).Return(&eks.TagResourceOutput{}, nil)
+				m.UntagResource(gomock.Any(), &eks.UntagResourceInput{
+					ResourceArn: aws.String(profileARN),
+					TagKeys:     []string{"cost-center"},
+				}).Return(&eks.UntagResourceOutput{}, nil)
+			},
+		},
+		{
+			name:           "tags in sync aren't updated",
+			additionalTags: infrav1.Tags{"team": "a"},
+			current:        map[string]string{ownedKey: owned, "team": "a"},
+			expect:         func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			tc.expect(eksMock.EXPECT())
+
+			s := &FargateService{
+				scope: &scope.FargateProfileScope{
+					Logger: *logger.NewLogger(klog.Background()),
+					Cluster: &clusterv1.Cluster{
+						ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+					},
+					FargateProfile: &expinfrav1.AWSFargateProfile{
+						Spec: expinfrav1.FargateProfileSpec{
+							ClusterName:    clusterName,
+							ProfileName:    "profile",
+							AdditionalTags: tc.additionalTags,
+						},
+					},
+				},
+				EKSClient: eksMock,
+			}
+
+			g.Expect(s.reconcileTags(context.TODO(), &ekstypes.FargateProfile{
+				FargateProfileName: aws.String("profile"),
+				FargateProfileArn:  aws.String(profileARN),
+				Tags:               tc.current,
+			})).To(Succeed())
+		})
+	}
+}
+
+func TestFargateProfileRequeueInterval(t *testing.T) {
+	tests := []struct {
+		name               string
+		requeueInterval    time.Duration
+		maxRequeueInterval time.Duration
+		notReadySince      time.Duration
+		expectedProfile    time.Duration
+		expectedRole       time.Duration
+	}{
+		{
+			name:            "defaults to 10 seconds",
+			notReadySince:   10 * time.Minute,
+			expectedProfile: 10 * time.Second,
+			expectedRole:    10 * time.Second,
+		},
+		{
+			name:            "configured interval",
+			requeueInterval: 30 * time.Second,
+			notReadySince:   10 * time.Minute,
+			expectedProfile: 30 * time.Second,
+			expectedRole:    30 * time.Second,
+		},
+		{
+			name:               "interval backs off while the profile isn't ready",
+			requeueInterval:    10 * time.Second,
+			maxRequeueInterval: time.Minute,
+			notReadySince:      35 * time.Second,
+			expectedProfile:    40 * time.Second,
+			expectedRole:       10 * time.Second,
+		},
+		{
+			name:               "backoff is capped",
+			requeueInterval:    10 * time.Second,
+			maxRequeueInterval: time.Minute,
+			notReadySince:      10 * time.Minute,
+			expectedProfile:    time.Minute,
+			expectedRole:       10 * time.Second,
+		},
+		{
+			name:               "backoff starts over once the profile changes status",
+			requeueInterval:    10 * time.Second,
+			maxRequeueInterval: time.Minute,
+			expectedProfile:    10 * time.Second,
+			expectedRole:       10 * time.Second,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			profile := &expinfrav1.AWSFargateProfile{}
+			v1beta1conditions.Set(profile, &clusterv1beta1.Condition{
+				Type:               expinfrav1.EKSFargateProfileReadyCondition,
+				Status:             corev1.ConditionFalse,
+				Reason:             expinfrav1.EKSFargateCreatingReason,
+				Severity:           clusterv1beta1.ConditionSeverityInfo,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-tc.notReadySince)),
+			})
+			s := &FargateService{
+				scope: &scope.FargateProfileScope{
+					FargateProfile:     profile,
+					RequeueInterval:    tc.requeueInterval,
+					MaxRequeueInterval: tc.maxRequeueInterval,
+				},
+			}
+
+			g.Expect(s.requeueProfileUpdating().RequeueAfter).To(Equal(tc.expectedProfile))
+			g.Expect(s.requeueRoleUpdating().RequeueAfter).To(Equal(tc.expectedRole))
+		})
+	}
+}
+
+func TestFargateProfileReconcileRequeuesWithConfiguredInterval(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+
+	eksMock.EXPECT().DescribeFargateProfile(gomock.Any(), gomock.Any()).Return(&eks.DescribeFargateProfileOutput{
+		FargateProfile: &ekstypes.FargateProfile{
+			FargateProfileName: aws.String("profile"),
+			Status:             ekstypes.FargateProfileStatusCreating,
+			Tags:               map[string]string{infrav1.ClusterAWSCloudProviderTagKey("cluster"): string(infrav1.ResourceLifecycleOwned)},
+		},
+	}, nil)
+
+	log := logger.NewLogger(klog.Background())
+	s := &FargateService{
+		scope: &scope.FargateProfileScope{
+			Logger: *log,
+			Cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			},
+			ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "eks-cluster"},
+			},
+			FargateProfile: &expinfrav1.AWSFargateProfile{
+				Spec: expinfrav1.FargateProfileSpec{
+					ClusterName:         "cluster",
+					ProfileName:         "profile",
+					PodExecutionRoleARN: aws.String("arn:aws:iam::123456789012:role/fargate"),
+				},
+			},
+			RequeueInterval: 45 * time.Second,
+		},
+		EKSClient:  eksMock,
+		IAMService: eksiam.IAMService{Wrapper: log},
+	}
+
+	result, err := s.Reconcile(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(45 * time.Second))
+}
+
+func TestFargateProfileCreateTimeout(t *testing.T) {
+	testCases := []struct {
+		name              string
+		status            ekstypes.FargateProfileStatus
+		creatingSince     *metav1.Time
+		wantCreatingSince bool
+		wantReason        string
+		wantSeverity      clusterv1beta1.ConditionSeverity
+	}{
+		{
+			name:              "profile starting to create records the time",
+			status:            ekstypes.FargateProfileStatusCreating,
+			wantCreatingSince: true,
+			wantReason:        expinfrav1.EKSFargateCreatingReason,
+			wantSeverity:      clusterv1beta1.ConditionSeverityInfo,
+		},
+		{
+			name:              "profile creating within the deadline",
+			status:            ekstypes.FargateProfileStatusCreating,
+			creatingSince:     &metav1.Time{Time: time.Now().Add(-10 * time.Minute)},
+			wantCreatingSince: true,
+			wantReason:        expinfrav1.EKSFargateCreatingReason,
+			wantSeverity:      clusterv1beta1.ConditionSeverityInfo,
+		},
+		{
+			name:              "profile stuck creating past the deadline",
+			status:            ekstypes.FargateProfileStatusCreating,
+			creatingSince:     &metav1.Time{Time: time.Now().Add(-time.Hour)},
+			wantCreatingSince: true,
+			wantReason:        expinfrav1.EKSFargateCreateTimeoutReason,
+			wantSeverity:      clusterv1beta1.ConditionSeverityError,
+		},
+		{
+			name:          "active profile clears the time",
+			status:        ekstypes.FargateProfileStatusActive,
+			creatingSince: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := logger.NewLogger(klog.Background())
+			s := &FargateService{
+				scope: &scope.FargateProfileScope{
+					Logger: *log,
+					FargateProfile: &expinfrav1.AWSFargateProfile{
+						Spec:   expinfrav1.FargateProfileSpec{ProfileName: "profile"},
+						Status: expinfrav1.FargateProfileStatus{CreatingSince: tc.creatingSince},
+					},
+					CreateTimeout: 30 * time.Minute,
+				},
+				IAMService: eksiam.IAMService{Wrapper: log},
+			}
+
+			requeue := s.handleStatus(&ekstypes.FargateProfile{
+				FargateProfileName: aws.String("profile"),
+				Status:             tc.status,
+			})
+			g.Expect(requeue).To(Equal(tc.status == ekstypes.FargateProfileStatusCreating))
+
+			fp := s.scope.FargateProfile
+			if !tc.wantCreatingSince {
+				g.Expect(fp.Status.CreatingSince).To(BeNil())
+				g.Expect(v1beta1conditions.IsTrue(fp, expinfrav1.EKSFargateProfileReadyCondition)).To(BeTrue())
+				return
+			}
+			g.Expect(fp.Status.CreatingSince).NotTo(BeNil())
+			if tc.creatingSince != nil {
+				g.Expect(fp.Status.CreatingSince.Time).To(Equal(tc.creatingSince.Time))
+			}
+			condition := v1beta1conditions.Get(fp, expinfrav1.EKSFargateProfileReadyCondition)
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			g.Expect(condition.Reason).To(Equal(tc.wantReason))
+			g.Expect(condition.Severity).To(Equal(tc.wantSeverity))
+		})
+	}
+}
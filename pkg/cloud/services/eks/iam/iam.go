@@ -67,6 +67,20 @@ func (s *IAMService) GetIAMRole(ctx context.Context, name string) (*iamtypes.Rol
 	return out.Role, nil
 }
 
+// GetIAMInstanceProfile returns the IAM instance profile with the given name.
+func (s *IAMService) GetIAMInstanceProfile(ctx context.Context, name string) (*iamtypes.InstanceProfile, error) {
+	input := &iam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+	}
+
+	out, err := s.IAMClient.GetInstanceProfile(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.InstanceProfile, nil
+}
+
 func (s *IAMService) getIAMPolicy(ctx context.Context, policyArn string) (*iamtypes.Policy, error) {
 	input := &iam.GetPolicyInput{
 		PolicyArn: &policyArn,
@@ -170,6 +184,23 @@ func (s *IAMService) EnsurePoliciesAttached(ctx context.Context, role *iamtypes.
 	return updatedPolicies, nil
 }
 
+// MissingPolicies returns the policies that aren't attached to the given role.
+func (s *IAMService) MissingPolicies(ctx context.Context, roleName string, policies []string) ([]string, error) {
+	existingPolicies, err := s.getIAMRolePolicies(ctx, roleName)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := []string{}
+	for _, policy := range policies {
+		if !findStringInSlice(existingPolicies, policy) {
+			missing = append(missing, policy)
+		}
+	}
+
+	return missing, nil
+}
+
 // RoleTags returns the tags for the given role.
 func RoleTags(key string, additionalTags infrav1.Tags) []iamtypes.Tag {
 	additionalTags[infrav1.ClusterAWSCloudProviderTagKey(key)] = string(infrav1.ResourceLifecycleOwned)
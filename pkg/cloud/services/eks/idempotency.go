@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// clientRequestToken returns the token making the creation of an EKS resource idempotent:
+// EKS answers a create retried with the same token, even by a restarted controller, with the
+// resource created by the first call rather than creating it again.
+//
+// The token is derived from the UID of the object owning the resource and from the resource
+// name, so that it's stable across retries even when the spec of the owner changes while
+// the creation is retried. Resources that are deleted and created again when an immutable
+// field changes pass that field as a discriminator, as EKS would otherwise answer the new
+// creation with the deleted resource. Objects without a UID get no token.
+func clientRequestToken(owner metav1.Object, name string, discriminators ...string) *string {
+	if owner.GetUID() == "" {
+		return nil
+	}
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s/%s/%s", owner.GetUID(), name, strings.Join(discriminators, ",")))
+	return aws.String(hex.EncodeToString(sum[:16]))
+}
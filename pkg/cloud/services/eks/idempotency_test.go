@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/smithy-go"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kwait "k8s.io/apimachinery/pkg/util/wait"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+)
+
+func TestClientRequestToken(t *testing.T) {
+	g := NewWithT(t)
+	owner := func(uid string, generation int64) metav1.Object {
+		return &expinfrav1.AWSManagedMachinePool{ObjectMeta: metav1.ObjectMeta{UID: types.UID(uid), Generation: generation}}
+	}
+
+	token := clientRequestToken(owner("1", 1), "nodegroup")
+	g.Expect(token).NotTo(BeNil())
+	g.Expect(clientRequestToken(owner("1", 1), "nodegroup")).To(Equal(token))
+	g.Expect(clientRequestToken(owner("2", 1), "nodegroup")).NotTo(Equal(token))
+	g.Expect(clientRequestToken(owner("1", 2), "nodegroup")).To(Equal(token))
+	g.Expect(clientRequestToken(owner("1", 1), "other")).NotTo(Equal(token))
+	g.Expect(clientRequestToken(&expinfrav1.AWSManagedMachinePool{}, "nodegroup")).To(BeNil())
+
+	token = clientRequestToken(owner("1", 1), "profile", "subnet-1", "subnet-2")
+	g.Expect(clientRequestToken(owner("1", 1), "profile", "subnet-1", "subnet-2")).To(Equal(token))
+	g.Expect(clientRequestToken(owner("1", 1), "profile", "subnet-1", "subnet-3")).NotTo(Equal(token))
+	g.Expect(clientRequestToken(owner("1", 1), "profile")).NotTo(Equal(token))
+}
+
+func TestCreateNodegroupClientRequestToken(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+	iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+	throttled := &smithy.GenericAPIError{Code: awserrors.ThrottlingException}
+
+	var tokens []string
+	recordToken := func(_ context.Context, input *eks.CreateNodegroupInput, _ ...func(*eks.Options)) (*eks.CreateNodegroupOutput, error) {
+		tokens = append(tokens, aws.ToString(input.ClientRequestToken))
+		if len(tokens) == 1 {
+			return nil, throttled
+		}
+		return &eks.CreateNodegroupOutput{Nodegroup: &ekstypes.Nodegroup{NodegroupName: input.NodegroupName}}, nil
+	}
+	iamMock.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(&awsiam.GetRoleOutput{
+		Role: &iamtypes.Role{Arn: aws.String("arn:aws:iam::123456789012:role/nodes")},
+	}, nil).Times(2)
+	eksMock.EXPECT().CreateNodegroup(gomock.Any(), gomock.Any()).DoAndReturn(recordToken).Times(3)
+
+	// The second service stands for a restarted controller creating the same nodegroup again.
+	for range 2 {
+		s := newTestNodegroupScopeService(g, iamMock, false, func(params *scope.ManagedMachinePoolScopeParams) {
+			params.ManagedMachinePool.UID = "3c1a9f8e-2d4b-4f6a-9c0e-7b5d8a1e2f3c"
+			params.ManagedMachinePool.Spec.SubnetIDs = []string{"subnet-1"}
+		})
+		s.EKSClient = eksMock
+		s.backoff = kwait.Backoff{Duration: time.Millisecond, Factor: 1, Steps: 3}
+
+		_, err := s.createNodegroup(context.TODO(), nil)
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+
+	g.Expect(tokens).To(HaveLen(3))
+	g.Expect(tokens[0]).NotTo(BeEmpty())
+	g.Expect(tokens).To(HaveEach(tokens[0]))
+}
@@ -17,5 +17,7 @@ limitations under the License.
 // Run go generate to regenerate this mock.
 //go:generate ../../../../hack/tools/bin/mockgen -destination mock_eksiface/eksapi_mock.go -package mock_eksiface . EKSAPI
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt mock_eksiface/eksapi_mock.go > mock_eksiface/_eksapi_mock.go && mv mock_eksiface/_eksapi_mock.go mock_eksiface/eksapi_mock.go"
+//go:generate ../../../../hack/tools/bin/mockgen -destination mock_eksiface/ecrapi_mock.go -package mock_eksiface . ECRAPI
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt mock_eksiface/ecrapi_mock.go > mock_eksiface/_ecrapi_mock.go && mv mock_eksiface/_ecrapi_mock.go mock_eksiface/ecrapi_mock.go"
 
 package eks
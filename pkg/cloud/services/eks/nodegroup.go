@@ -19,30 +19,70 @@ package eks
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
-	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/awsnode"
+	ec2svc "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	ekspkg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	"sigs.k8s.io/cluster-api/util/annotations"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
+// replicaShortfallGracePeriod is how long a nodegroup can have fewer InService
+// instances than the MachinePool's desired replicas before it's flagged.
+const replicaShortfallGracePeriod = 10 * time.Minute
+
+const (
+	// defaultInstanceTypeFallbackCooldown is how long a fallback instance type is used
+	// before the launch template's instance type is retried, unless set in the spec.
+	defaultInstanceTypeFallbackCooldown = 30 * time.Minute
+	// insufficientInstanceCapacity is the EC2 error reported in the nodegroup's launch
+	// failures when there isn't enough capacity for the instance type.
+	insufficientInstanceCapacity = "InsufficientInstanceCapacity"
+)
+
+// nodegroupNodeLabel is the label EKS sets on the nodes of a managed nodegroup.
+const nodegroupNodeLabel = "eks.amazonaws.com/nodegroup"
+
+// podNodeNameField is the field selector of the pods running on a node.
+const podNodeNameField = "spec.nodeName"
+
 func (s *NodegroupService) describeNodegroup(ctx context.Context) (*ekstypes.Nodegroup, error) {
 	eksClusterName := s.scope.KubernetesClusterName()
 	nodegroupName := s.scope.NodegroupName()
@@ -114,6 +154,31 @@ func (s *NodegroupService) scalingConfig() *ekstypes.NodegroupScalingConfig {
 	return &cfg
 }
 
+// desiredSizeWithoutReplicas returns the current desired size of the nodegroup, or 1 if it
+// has none, within the min and max sizes of the spec or else of the nodegroup.
+func (s *NodegroupService) desiredSizeWithoutReplicas(ng *ekstypes.Nodegroup) *int32 {
+	desiredSize := int32(1)
+	if ng.ScalingConfig.DesiredSize != nil {
+		desiredSize = *ng.ScalingConfig.DesiredSize
+	}
+	minSize, maxSize := ng.ScalingConfig.MinSize, ng.ScalingConfig.MaxSize
+	if scaling := s.scope.ManagedMachinePool.Spec.Scaling; scaling != nil {
+		if scaling.MinSize != nil {
+			minSize = scaling.MinSize
+		}
+		if scaling.MaxSize != nil {
+			maxSize = scaling.MaxSize
+		}
+	}
+	if minSize != nil {
+		desiredSize = max(desiredSize, *minSize)
+	}
+	if maxSize != nil {
+		desiredSize = min(desiredSize, *maxSize)
+	}
+	return aws.Int32(desiredSize)
+}
+
 func (s *NodegroupService) updateConfig() (*ekstypes.NodegroupUpdateConfig, error) {
 	updateConfig := s.scope.ManagedMachinePool.Spec.UpdateConfig
 
@@ -126,13 +191,12 @@ func (s *NodegroupService) nodeRepairConfig() *ekstypes.NodeRepairConfig {
 }
 
 func (s *NodegroupService) roleArn(ctx context.Context) (*string, error) {
-	var role *iamtypes.Role
-	if s.scope.RoleName() != "" {
-		var err error
-		role, err = s.GetIAMRole(ctx, s.scope.RoleName())
-		if err != nil {
-			return nil, errors.Wrapf(err, "error getting node group IAM role: %s", s.scope.RoleName())
-		}
+	if s.scope.RoleName() == "" {
+		return nil, errors.New("node group IAM role name is empty")
+	}
+	role, err := s.GetIAMRole(ctx, s.scope.RoleName())
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting node group IAM role: %s", s.scope.RoleName())
 	}
 	return role.Arn, nil
 }
@@ -143,7 +207,7 @@ func ngTags(key string, additionalTags infrav1.Tags) map[string]string {
 	return tags
 }
 
-func (s *NodegroupService) remoteAccess() (*ekstypes.RemoteAccessConfig, error) {
+func (s *NodegroupService) remoteAccess(ctx context.Context) (*ekstypes.RemoteAccessConfig, error) {
 	pool := s.scope.ManagedMachinePool.Spec
 	if pool.RemoteAccess == nil {
 		return nil, nil
@@ -158,14 +222,14 @@ func (s *NodegroupService) remoteAccess() (*ekstypes.RemoteAccessConfig, error)
 
 	if !pool.RemoteAccess.Public {
 		sSGs = pool.RemoteAccess.SourceSecurityGroups
-		// We add the EKS created cluster security group to the allowed security
-		// groups by default to prevent the API default of 0.0.0.0/0 from taking effect
-		// in case SourceSecurityGroups is empty
-		clusterSG, ok := controlPlane.Status.Network.SecurityGroups[ekscontrolplanev1.SecurityGroupCluster]
-		if !ok {
-			return nil, errors.Errorf("%s security group not found on control plane", ekscontrolplanev1.SecurityGroupCluster)
+		// We add the EKS created cluster security group, or the security group overriding
+		// it, to the allowed security groups by default to prevent the API default of
+		// 0.0.0.0/0 from taking effect in case SourceSecurityGroups is empty
+		clusterSGID, err := s.clusterSecurityGroupID(ctx)
+		if err != nil {
+			return nil, err
 		}
-		sSGs = append(sSGs, clusterSG.ID)
+		sSGs = append(sSGs, clusterSGID)
 
 		if controlPlane.Spec.Bastion.Enabled {
 			bastionSG, ok := controlPlane.Status.Network.SecurityGroups[infrav1.SecurityGroupBastion]
@@ -190,7 +254,133 @@ func (s *NodegroupService) remoteAccess() (*ekstypes.RemoteAccessConfig, error)
 	}, nil
 }
 
-func (s *NodegroupService) createNodegroup(ctx context.Context) (*ekstypes.Nodegroup, error) {
+// clusterSecurityGroupID returns the ID of the security group overriding the EKS cluster security
+// group for the pool, falling back to the EKS cluster security group if no override is set.
+func (s *NodegroupService) clusterSecurityGroupID(ctx context.Context) (string, error) {
+	if override := s.scope.ClusterSecurityGroupOverride(); override != nil {
+		id, err := ec2svc.ResolveSecurityGroupInVPC(ctx, s.EC2Client, override, s.scope.ControlPlane.Spec.NetworkSpec.VPC.ID)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to resolve cluster security group override")
+		}
+		return id, nil
+	}
+
+	clusterSG, ok := s.scope.ControlPlane.Status.Network.SecurityGroups[ekscontrolplanev1.SecurityGroupCluster]
+	if !ok {
+		return "", errors.Errorf("%s security group not found on control plane", ekscontrolplanev1.SecurityGroupCluster)
+	}
+	return clusterSG.ID, nil
+}
+
+// resolveExternalLaunchTemplate makes sure the externally managed launch template exists and
+// records the ID and version the nodegroup should use in the status. As `$Latest` and `$Default`
+// are resolved to a version number on each reconcile, the nodegroup follows them as they change.
+func (s *NodegroupService) resolveExternalLaunchTemplate(ctx context.Context, ref *expinfrav1.ExternalLaunchTemplate) (*ec2types.LaunchTemplateVersion, error) {
+	version := aws.ToString(ref.Version)
+	if version == "" {
+		version = expinfrav1.LaunchTemplateDefaultVersion
+	}
+
+	out, err := s.EC2Client.DescribeLaunchTemplateVersions(ctx, &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(ref.ID),
+		Versions:         []string{version},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe launch template %s version %s", ref.ID, version)
+	}
+	if len(out.LaunchTemplateVersions) == 0 || out.LaunchTemplateVersions[0].VersionNumber == nil {
+		return nil, errors.Errorf("launch template %s version %s not found", ref.ID, version)
+	}
+
+	ltVersion := out.LaunchTemplateVersions[0]
+	s.scope.ManagedMachinePool.Status.LaunchTemplateID = aws.String(ref.ID)
+	s.scope.ManagedMachinePool.Status.LaunchTemplateVersion = aws.String(strconv.FormatInt(*ltVersion.VersionNumber, 10))
+
+	return &ltVersion, nil
+}
+
+// validateLaunchTemplateInstanceProfile checks that an instance profile set in the launch template
+// contains the nodegroup's node role. EKS builds the instance profile of the nodes from the node role,
+// and rejects or fails to join nodes whose launch template uses a profile for another role.
+func (s *NodegroupService) validateLaunchTemplateInstanceProfile(ctx context.Context, lt *ec2types.LaunchTemplateVersion) error {
+	if lt == nil || lt.LaunchTemplateData == nil || lt.LaunchTemplateData.IamInstanceProfile == nil {
+		return nil
+	}
+
+	profileName := aws.ToString(lt.LaunchTemplateData.IamInstanceProfile.Name)
+	if profileARN := aws.ToString(lt.LaunchTemplateData.IamInstanceProfile.Arn); profileName == "" && profileARN != "" {
+		parsed, err := arn.Parse(profileARN)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse instance profile ARN %s", profileARN)
+		}
+		profileName = parsed.Resource[strings.LastIndex(parsed.Resource, "/")+1:]
+	}
+	if profileName == "" {
+		return nil
+	}
+
+	profile, err := s.GetIAMInstanceProfile(ctx, profileName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get instance profile %s", profileName)
+	}
+	roleArn, err := s.roleArn(ctx)
+	if err != nil {
+		return err
+	}
+
+	roles := make([]string, 0, len(profile.Roles))
+	for _, role := range profile.Roles {
+		if aws.ToString(role.Arn) == aws.ToString(roleArn) {
+			return nil
+		}
+		roles = append(roles, aws.ToString(role.RoleName))
+	}
+	return errors.Wrapf(ErrNodegroupInstanceProfileMismatch, "instance profile %s of launch template %s has roles %v, but the nodegroup role is %s",
+		profileName, aws.ToString(lt.LaunchTemplateId), roles, aws.ToString(roleArn))
+}
+
+// validateLaunchTemplateAMI checks that the external launch template provides the AMI of a
+// nodegroup using a custom AMI type, as EKS can't pick an AMI for it.
+func (s *NodegroupService) validateLaunchTemplateAMI(lt *ec2types.LaunchTemplateVersion) error {
+	amiType := s.scope.ManagedMachinePool.Spec.AMIType
+	if amiType == nil || *amiType != expinfrav1.Custom {
+		return nil
+	}
+	if lt.LaunchTemplateData == nil || aws.ToString(lt.LaunchTemplateData.ImageId) == "" {
+		return errors.Wrapf(ErrNodegroupLaunchTemplateAMIMissing, "launch template %s doesn't specify an AMI, which is required when amiType is %s",
+			aws.ToString(lt.LaunchTemplateId), *amiType)
+	}
+	return nil
+}
+
+// validateWindowsSupport checks that Windows support is enabled in the VPC CNI configuration of the
+// workload cluster before a Windows nodegroup is created, as pods on Windows nodes can't get IP
+// addresses without it.
+func (s *NodegroupService) validateWindowsSupport(ctx context.Context) error {
+	amiType := s.scope.ManagedMachinePool.Spec.AMIType
+	if amiType == nil || !amiType.IsWindows() {
+		return nil
+	}
+
+	remoteClient, err := s.remoteClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to get client for the workload cluster")
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := remoteClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceSystem, Name: awsnode.VPCCNIConfigMapName}, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to get %s config map", awsnode.VPCCNIConfigMapName)
+		}
+	}
+	if enabled, _ := strconv.ParseBool(cm.Data[awsnode.VPCCNIWindowsIPAMKey]); !enabled {
+		return errors.Wrapf(ErrNodegroupWindowsSupportDisabled, "set %s to true in the %s config map of the %s namespace to create Windows nodegroups",
+			awsnode.VPCCNIWindowsIPAMKey, awsnode.VPCCNIConfigMapName, metav1.NamespaceSystem)
+	}
+	return nil
+}
+
+func (s *NodegroupService) createNodegroup(ctx context.Context, externalLaunchTemplate *ec2types.LaunchTemplateVersion) (*ekstypes.Nodegroup, error) {
 	eksClusterName := s.scope.KubernetesClusterName()
 	nodegroupName := s.scope.NodegroupName()
 	additionalTags := s.scope.AdditionalTags()
@@ -201,7 +391,7 @@ func (s *NodegroupService) createNodegroup(ctx context.Context) (*ekstypes.Nodeg
 	managedPool := s.scope.ManagedMachinePool.Spec
 	tags := ngTags(s.scope.ClusterName(), additionalTags)
 
-	remoteAccess, err := s.remoteAccess()
+	remoteAccess, err := s.remoteAccess(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create remote access configuration")
 	}
@@ -215,28 +405,31 @@ func (s *NodegroupService) createNodegroup(ctx context.Context) (*ekstypes.Nodeg
 		return nil, fmt.Errorf("failed creating nodegroup, invalid update config: %w", err)
 	}
 	input := &eks.CreateNodegroupInput{
-		ScalingConfig: s.scalingConfig(),
-		ClusterName:   aws.String(eksClusterName),
-		NodegroupName: aws.String(nodegroupName),
-		Subnets:       subnets,
-		NodeRole:      roleArn,
-		Labels:        managedPool.Labels,
-		Tags:          tags,
-		RemoteAccess:  remoteAccess,
-		UpdateConfig:  updatedConfig,
-	}
-	if managedPool.AMIType != nil && (managedPool.AWSLaunchTemplate == nil || managedPool.AWSLaunchTemplate.AMI.ID == nil) {
+		ScalingConfig:      s.scalingConfig(),
+		ClusterName:        aws.String(eksClusterName),
+		NodegroupName:      aws.String(nodegroupName),
+		Subnets:            subnets,
+		NodeRole:           roleArn,
+		Labels:             s.scope.NodeLabels(),
+		Tags:               tags,
+		RemoteAccess:       remoteAccess,
+		UpdateConfig:       updatedConfig,
+		ClientRequestToken: clientRequestToken(s.scope.ManagedMachinePool, nodegroupName),
+	}
+	// A custom AMI is taken from the launch template, EKS rejects CUSTOM as the AMI type of a new nodegroup.
+	if managedPool.AMIType != nil && *managedPool.AMIType != expinfrav1.Custom && (managedPool.AWSLaunchTemplate == nil || managedPool.AWSLaunchTemplate.AMI.ID == nil) &&
+		(externalLaunchTemplate == nil || externalLaunchTemplate.LaunchTemplateData == nil || externalLaunchTemplate.LaunchTemplateData.ImageId == nil) {
 		input.AmiType = converters.AMITypeToSDK(*managedPool.AMIType)
 	}
 	if managedPool.DiskSize != nil {
 		input.DiskSize = managedPool.DiskSize
 	}
-	if managedPool.InstanceType != nil {
-		input.InstanceTypes = []string{aws.ToString(managedPool.InstanceType)}
+	if instanceTypes := s.scope.InstanceTypes(); len(instanceTypes) > 0 {
+		input.InstanceTypes = instanceTypes
 	}
-	if len(managedPool.Taints) > 0 {
+	if nodeTaints := s.scope.NodeTaints(); len(nodeTaints) > 0 {
 		s.Info("adding taints to nodegroup", "nodegroup", nodegroupName)
-		taints, err := converters.TaintsToSDK(managedPool.Taints)
+		taints, err := converters.TaintsToSDK(nodeTaints)
 		if err != nil {
 			return nil, fmt.Errorf("converting taints: %w", err)
 		}
@@ -249,18 +442,28 @@ func (s *NodegroupService) createNodegroup(ctx context.Context) (*ekstypes.Nodeg
 		}
 		input.CapacityType = capacityType
 	}
-	if managedPool.AWSLaunchTemplate != nil {
+	if managedPool.AWSLaunchTemplate != nil || managedPool.ExternalLaunchTemplate != nil {
+		launchTemplateVersion, err := s.launchTemplateVersion()
+		if err != nil {
+			return nil, err
+		}
 		input.LaunchTemplate = &ekstypes.LaunchTemplateSpecification{
 			Id:      s.scope.ManagedMachinePool.Status.LaunchTemplateID,
-			Version: s.scope.ManagedMachinePool.Status.LaunchTemplateVersion,
+			Version: launchTemplateVersion,
 		}
 	}
 	if managedPool.NodeRepairConfig != nil {
 		input.NodeRepairConfig = s.nodeRepairConfig()
 	}
 
-	out, err := s.EKSClient.CreateNodegroup(ctx, input)
-	if err != nil {
+	var out *eks.CreateNodegroupOutput
+	if err := wait.WaitForWithClassifier(s.backoff, withThrottlingEvent(s.scope.ManagedMachinePool, "the nodegroup creation", func() (bool, error) {
+		var err error
+		if out, err = s.EKSClient.CreateNodegroup(ctx, input); err != nil {
+			return false, err
+		}
+		return true, nil
+	}), awserrors.DefaultRetryClassifier); err != nil {
 		smithyErr := awserrors.ParseSmithyError(err)
 		notFoundErr := &ekstypes.ResourceNotFoundException{}
 		if smithyErr.ErrorCode() == notFoundErr.ErrorCode() {
@@ -268,6 +471,9 @@ func (s *NodegroupService) createNodegroup(ctx context.Context) (*ekstypes.Nodeg
 		}
 		return nil, errors.Wrap(err, "failed to create nodegroup")
 	}
+	if input.LaunchTemplate != nil {
+		s.scope.ManagedMachinePool.Status.AppliedLaunchTemplateVersion = input.LaunchTemplate.Version
+	}
 
 	return out.Nodegroup, nil
 }
@@ -283,7 +489,7 @@ func (s *NodegroupService) deleteNodegroupAndWait(ctx context.Context) (reterr e
 			record.Warnf(
 				s.scope.ManagedMachinePool, "FailedDeleteEKSNodegroup", "Failed to delete EKS nodegroup %s: %v", s.scope.NodegroupName(), reterr,
 			)
-			if err := s.scope.NodegroupReadyFalse("DeletingFailed", reterr.Error()); err != nil {
+			if err := s.scope.NodegroupReadyFalse("DeletingFailed", awserrors.MessageWithoutRequestID(reterr)); err != nil {
 				reterr = err
 			}
 		} else if err := s.scope.NodegroupReadyFalse(clusterv1beta1.DeletedReason, ""); err != nil {
@@ -295,7 +501,12 @@ func (s *NodegroupService) deleteNodegroupAndWait(ctx context.Context) (reterr e
 		NodegroupName: aws.String(nodegroupName),
 	}
 
-	_, err := s.EKSClient.DeleteNodegroup(ctx, input)
+	err := wait.WaitForWithClassifier(s.backoff, withThrottlingEvent(s.scope.ManagedMachinePool, "the nodegroup deletion", func() (bool, error) {
+		if _, err := s.EKSClient.DeleteNodegroup(ctx, input); err != nil {
+			return false, err
+		}
+		return true, nil
+	}), awserrors.DefaultRetryClassifier)
 	if err != nil {
 		smithyErr := awserrors.ParseSmithyError(err)
 		notFoundErr := &ekstypes.ResourceNotFoundException{}
@@ -309,7 +520,7 @@ func (s *NodegroupService) deleteNodegroupAndWait(ctx context.Context) (reterr e
 		ClusterName:   aws.String(eksClusterName),
 		NodegroupName: aws.String(nodegroupName),
 	}
-	err = s.EKSClient.WaitUntilNodegroupDeleted(ctx, waitInput, s.scope.MaxWaitActiveUpdateDelete)
+	err = s.EKSClient.WaitUntilNodegroupDeleted(ctx, waitInput, s.scope.MaxWaitDelete)
 	if err != nil {
 		return errors.Wrapf(err, "failed waiting for EKS nodegroup %s to delete", nodegroupName)
 	}
@@ -317,45 +528,118 @@ func (s *NodegroupService) deleteNodegroupAndWait(ctx context.Context) (reterr e
 	return nil
 }
 
-func (s *NodegroupService) reconcileNodegroupVersion(ctx context.Context, ng *ekstypes.Nodegroup) error {
+// reconcileVersionUpdateConfig updates the nodegroup's update config ahead of a version update if
+// it doesn't match the spec. EKS doesn't accept an update config when updating the version and rolls
+// the nodes with the update config the nodegroup has, so it has to be applied first for MaxUnavailable
+// and MaxUnavailablePercentage to be honored. It returns true if an update was started.
+func (s *NodegroupService) reconcileVersionUpdateConfig(ctx context.Context, ng *ekstypes.Nodegroup) (bool, error) {
+	specUpdateConfig := s.scope.ManagedMachinePool.Spec.UpdateConfig
+	if specUpdateConfig == nil || cmp.Equal(specUpdateConfig, converters.NodegroupUpdateconfigFromSDK(ng.UpdateConfig)) {
+		return false, nil
+	}
+
+	updateConfig, err := s.updateConfig()
+	if err != nil {
+		return false, errors.Wrap(err, "invalid update config")
+	}
+
+	eksClusterName := s.scope.KubernetesClusterName()
+	input := &eks.UpdateNodegroupConfigInput{
+		ClusterName:   aws.String(eksClusterName),
+		NodegroupName: aws.String(s.scope.NodegroupName()),
+		UpdateConfig:  updateConfig,
+	}
+	if _, err := s.EKSClient.UpdateNodegroupConfig(ctx, input); err != nil {
+		record.Warnf(s.scope.ManagedMachinePool, "FailedUpdateEKSNodegroup", "failed to update the update config of EKS nodegroup %s before the version update: %v", eventResource(s.scope.NodegroupName(), ng.NodegroupArn), err)
+		return false, errors.Wrap(err, "failed to update nodegroup update config")
+	}
+	record.Eventf(s.scope.ManagedMachinePool, "SuccessfulUpdateEKSNodegroup", "Updated the update config of EKS nodegroup %s before the version update", eventResource(s.scope.NodegroupName(), ng.NodegroupArn))
+	return true, nil
+}
+
+// reconcileNodegroupVersion starts an update of the nodegroup's Kubernetes version, AMI version
+// or launch template version if it doesn't match the spec. It returns true if an update was started,
+// which is an update of the nodegroup's update config when that has to be applied first.
+func (s *NodegroupService) reconcileNodegroupVersion(ctx context.Context, ng *ekstypes.Nodegroup) (bool, error) {
 	var specVersion *version.Version
 	if s.scope.Version() != nil {
 		var err error
 		specVersion, err = parseEKSVersion(*s.scope.Version())
 		if err != nil {
-			return fmt.Errorf("parsing EKS version from spec: %w", err)
+			return false, fmt.Errorf("parsing EKS version from spec: %w", err)
 		}
 	}
 
 	// Check for nil pointers before dereferencing
 	if ng.Version == nil {
-		return fmt.Errorf("nodegroup version is nil")
+		return false, fmt.Errorf("nodegroup version is nil")
 	}
 	ngVersion := version.MustParseGeneric(*ng.Version)
 	specAMI := s.scope.ManagedMachinePool.Spec.AMIVersion
 	ngAMI := *ng.ReleaseVersion
-	statusLaunchTemplateVersion := s.scope.ManagedMachinePool.Status.LaunchTemplateVersion
+	if specAMI != nil && *specAMI != ngAMI {
+		// The nodegroup is updated to the Kubernetes version of the spec before its AMI.
+		targetVersion := ngVersion
+		if specVersion != nil && ngVersion.LessThan(specVersion) {
+			targetVersion = specVersion
+		}
+		if err := s.validateAMIVersion(*specAMI, targetVersion); err != nil {
+			record.Warnf(s.scope.ManagedMachinePool, "InvalidAMIVersion", "%s", err.Error())
+			return false, err
+		}
+	}
+
+	launchTemplateVersion, err := s.launchTemplateVersion()
+	if err != nil {
+		return false, err
+	}
 	var ngLaunchTemplateVersion *string
 	if ng.LaunchTemplate != nil {
 		ngLaunchTemplateVersion = ng.LaunchTemplate.Version
 	}
+	launchTemplateChanged := launchTemplateVersion != nil && *launchTemplateVersion != aws.ToString(ngLaunchTemplateVersion)
+
+	// The nodegroup using another launch template version than the one the controller applied,
+	// while the controller has no new version to roll out, means it was changed outside of it.
+	var launchTemplateDrifted bool
+	if applied := s.scope.ManagedMachinePool.Status.AppliedLaunchTemplateVersion; launchTemplateChanged && applied != nil && *applied == *launchTemplateVersion {
+		launchTemplateDrifted = true
+		if s.scope.ManagedMachinePool.Spec.LaunchTemplateVersionDriftPolicy == expinfrav1.LaunchTemplateVersionDriftPolicyAdopt {
+			s.scope.Info("Keeping the launch template version the nodegroup was moved to outside of the controller", "appliedVersion", *applied, "nodegroupVersion", aws.ToString(ngLaunchTemplateVersion))
+			launchTemplateChanged = false
+		}
+	} else if launchTemplateVersion != nil && !launchTemplateChanged {
+		s.scope.ManagedMachinePool.Status.AppliedLaunchTemplateVersion = launchTemplateVersion
+	}
+
+	// EKS only moves the Kubernetes version of a nodegroup forward, only the launch template
+	// can be rolled back.
+	if specVersion != nil && specVersion.LessThan(ngVersion) {
+		s.scope.Info("Ignoring the Kubernetes version of the spec, nodegroups can't be downgraded", "specVersion", *s.scope.Version(), "nodegroupVersion", *ng.Version)
+	}
 
 	eksClusterName := s.scope.KubernetesClusterName()
-	if (specVersion != nil && ngVersion.LessThan(specVersion)) || (specAMI != nil && *specAMI != ngAMI) || (statusLaunchTemplateVersion != nil && *statusLaunchTemplateVersion != *ngLaunchTemplateVersion) {
+	if (specVersion != nil && ngVersion.LessThan(specVersion)) || (specAMI != nil && *specAMI != ngAMI) || launchTemplateChanged {
 		input := &eks.UpdateNodegroupVersionInput{
 			ClusterName:   aws.String(eksClusterName),
 			NodegroupName: aws.String(s.scope.NodegroupName()),
+			Force:         ptr.Deref(s.scope.ManagedMachinePool.Spec.ForceUpdate, false),
 		}
 
 		var updateMsg string
 		// Either update k8s version or AMI version
 		switch {
-		case statusLaunchTemplateVersion != nil && *statusLaunchTemplateVersion != *ngLaunchTemplateVersion:
+		case launchTemplateChanged:
+			// A rollback only changes the launch template, the Kubernetes and AMI versions of
+			// the nodegroup are left as they are.
 			input.LaunchTemplate = &ekstypes.LaunchTemplateSpecification{
 				Id:      s.scope.ManagedMachinePool.Status.LaunchTemplateID,
-				Version: statusLaunchTemplateVersion,
+				Version: launchTemplateVersion,
+			}
+			updateMsg = fmt.Sprintf("to launch template version %s", *launchTemplateVersion)
+			if launchTemplateDrifted || isLaunchTemplateRollback(*launchTemplateVersion, aws.ToString(ngLaunchTemplateVersion)) {
+				updateMsg = fmt.Sprintf("back to launch template version %s", *launchTemplateVersion)
 			}
-			updateMsg = fmt.Sprintf("to launch template version %s", *statusLaunchTemplateVersion)
 		case specVersion != nil && ngVersion.LessThan(specVersion):
 			// NOTE: you can only upgrade increments of minor versions. If you want to upgrade 1.14 to 1.16 we
 			// need to go 1.14-> 1.15 and then 1.15 -> 1.16.
@@ -366,20 +650,90 @@ func (s *NodegroupService) reconcileNodegroupVersion(ctx context.Context, ng *ek
 			updateMsg = fmt.Sprintf("to AMI version %s", *input.ReleaseVersion)
 		}
 
-		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		// Updating the version replaces the nodes of the nodegroup.
+		if s.deferDisruptiveChange("update " + updateMsg) {
+			return false, nil
+		}
+		if updated, err := s.reconcileVersionUpdateConfig(ctx, ng); err != nil || updated {
+			return updated, err
+		}
+
+		if err := wait.WaitForWithClassifier(s.backoff, withThrottlingEvent(s.scope.ManagedMachinePool, "the nodegroup version update", func() (bool, error) {
 			if _, err := s.EKSClient.UpdateNodegroupVersion(ctx, input); err != nil {
 				return false, err
 			}
-			record.Eventf(s.scope.ManagedMachinePool, "SuccessfulUpdateEKSNodegroup", "Updated EKS nodegroup %s %s", eksClusterName, updateMsg)
+			if input.Force {
+				record.Warnf(s.scope.ManagedMachinePool, "ForcedUpdateEKSNodegroup", "Forced update of EKS nodegroup %s %s, pods are evicted regardless of their PodDisruptionBudgets", eventResource(s.scope.NodegroupName(), ng.NodegroupArn), updateMsg)
+			} else {
+				record.Eventf(s.scope.ManagedMachinePool, "SuccessfulUpdateEKSNodegroup", "Updated EKS nodegroup %s %s", eventResource(s.scope.NodegroupName(), ng.NodegroupArn), updateMsg)
+			}
 			return true, nil
-		}); err != nil {
-			record.Warnf(s.scope.ManagedMachinePool, "FailedUpdateEKSNodegroup", "failed to update the EKS nodegroup %s %s: %v", eksClusterName, updateMsg, err)
-			return errors.Wrapf(err, "failed to update EKS nodegroup")
+		}), awserrors.DefaultRetryClassifier); err != nil {
+			record.Warnf(s.scope.ManagedMachinePool, "FailedUpdateEKSNodegroup", "failed to update the EKS nodegroup %s %s: %v", eventResource(s.scope.NodegroupName(), ng.NodegroupArn), updateMsg, err)
+			return false, errors.Wrapf(err, "failed to update EKS nodegroup")
+		}
+		if input.LaunchTemplate != nil {
+			s.scope.ManagedMachinePool.Status.AppliedLaunchTemplateVersion = input.LaunchTemplate.Version
 		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// validateAMIVersion checks that an AMI release version, such as 1.30.4-20240917, is for the
+// minor Kubernetes version of the nodegroup, as EKS rejects the update otherwise. Bottlerocket
+// and Windows release versions don't embed the Kubernetes version and aren't checked.
+func (s *NodegroupService) validateAMIVersion(releaseVersion string, kubernetesVersion *version.Version) error {
+	if amiType := s.scope.ManagedMachinePool.Spec.AMIType; amiType != nil &&
+		(*amiType == expinfrav1.Custom || amiType.IsWindows() || strings.HasPrefix(string(*amiType), "BOTTLEROCKET")) {
+		return nil
+	}
+
+	amiKubernetesVersion, err := parseEKSVersion(strings.SplitN(releaseVersion, "-", 2)[0])
+	if err != nil {
+		return errors.Wrapf(ErrNodegroupAMIVersionMismatch, "AMI release version %s doesn't start with a Kubernetes version, expected a %s release version such as %s.0-20240101",
+			releaseVersion, versionToEKS(kubernetesVersion), versionToEKS(kubernetesVersion))
+	}
+	if amiKubernetesVersion.Major() != kubernetesVersion.Major() || amiKubernetesVersion.Minor() != kubernetesVersion.Minor() {
+		return errors.Wrapf(ErrNodegroupAMIVersionMismatch, "AMI release version %s is for Kubernetes %s but the nodegroup Kubernetes version is %s, set amiVersion to a %s release version",
+			releaseVersion, versionToEKS(amiKubernetesVersion), versionToEKS(kubernetesVersion), versionToEKS(kubernetesVersion))
 	}
 	return nil
 }
 
+// launchTemplateVersion returns the launch template version the nodegroup should use: the
+// version it's pinned to if any, otherwise the latest version recorded in the status.
+func (s *NodegroupService) launchTemplateVersion() (*string, error) {
+	latest := s.scope.ManagedMachinePool.Status.LaunchTemplateVersion
+	pinned := s.scope.ManagedMachinePool.Spec.LaunchTemplateVersion
+	if pinned == nil || latest == nil || s.scope.ManagedMachinePool.Spec.AWSLaunchTemplate == nil {
+		return latest, nil
+	}
+
+	latestVersion, err := strconv.ParseInt(*latest, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse launch template version %q", *latest)
+	}
+	if *pinned > latestVersion {
+		return nil, errors.Errorf("launch template version %d doesn't exist, the latest version is %d", *pinned, latestVersion)
+	}
+	return aws.String(strconv.FormatInt(*pinned, 10)), nil
+}
+
+// isLaunchTemplateRollback returns true if the target launch template version is older than
+// the current one.
+func isLaunchTemplateRollback(target, current string) bool {
+	targetVersion, err := strconv.ParseInt(target, 10, 64)
+	if err != nil {
+		return false
+	}
+	currentVersion, err := strconv.ParseInt(current, 10, 64)
+	if err != nil {
+		return false
+	}
+	return targetVersion < currentVersion
+}
+
 func createLabelUpdate(specLabels map[string]string, ng *ekstypes.Nodegroup) *ekstypes.UpdateLabelsPayload {
 	current := ng.Labels
 	payload := ekstypes.UpdateLabelsPayload{
@@ -395,6 +749,8 @@ func createLabelUpdate(specLabels map[string]string, ng *ekstypes.Nodegroup) *ek
 			payload.RemoveLabels = append(payload.RemoveLabels, k)
 		}
 	}
+	// Sort the keys to remove so the payload doesn't change between reconciles.
+	sort.Strings(payload.RemoveLabels)
 	if len(payload.AddOrUpdateLabels) > 0 || len(payload.RemoveLabels) > 0 {
 		return &payload
 	}
@@ -428,6 +784,8 @@ func (s *NodegroupService) createTaintsUpdate(specTaints expinfrav1.Taints, ng *
 			payload.RemoveTaints = append(payload.RemoveTaints, sdkTaint)
 		}
 	}
+	sortSDKTaints(payload.AddOrUpdateTaints)
+	sortSDKTaints(payload.RemoveTaints)
 	if len(payload.AddOrUpdateTaints) > 0 || len(payload.RemoveTaints) > 0 {
 		s.Debug("Node group taints update required", "name", *ng.NodegroupName, "addupdate", len(payload.AddOrUpdateTaints), "remove", len(payload.RemoveTaints))
 		return &payload, nil
@@ -437,46 +795,154 @@ func (s *NodegroupService) createTaintsUpdate(specTaints expinfrav1.Taints, ng *
 	return nil, nil
 }
 
+// sortSDKTaints sorts taints by key, effect and value so that update payloads are deterministic.
+func sortSDKTaints(taints []ekstypes.Taint) {
+	sort.SliceStable(taints, func(i, j int) bool {
+		ki, kj := aws.ToString(taints[i].Key), aws.ToString(taints[j].Key)
+		if ki != kj {
+			return ki < kj
+		}
+		if taints[i].Effect != taints[j].Effect {
+			return taints[i].Effect < taints[j].Effect
+		}
+		return aws.ToString(taints[i].Value) < aws.ToString(taints[j].Value)
+	})
+}
+
+// checkAMIType returns an error if the AMI type of the nodegroup differs from the spec, as
+// EKS can't change it in place. Nodegroups taking their AMI from a launch template have the
+// CUSTOM AMI type, whatever the spec says.
+func (s *NodegroupService) checkAMIType(ng *ekstypes.Nodegroup) error {
+	amiType := s.scope.ManagedMachinePool.Spec.AMIType
+	if amiType == nil || *amiType == expinfrav1.Custom || ng.AmiType == ekstypes.AMITypesCustom {
+		return nil
+	}
+	if desired := converters.AMITypeToSDK(*amiType); desired != ng.AmiType {
+		record.Warnf(s.scope.ManagedMachinePool, "NodegroupAMITypeChanged", "EKS nodegroup %s uses AMI type %s instead of %s, the nodegroup must be recreated to change its AMI type", eventResource(s.scope.NodegroupName(), ng.NodegroupArn), ng.AmiType, desired)
+		return errors.Wrapf(ErrNodegroupAMITypeChanged, "nodegroup uses AMI type %s instead of %s, the nodegroup must be recreated to change its AMI type", ng.AmiType, desired)
+	}
+	return nil
+}
+
+// checkInstanceTypes returns an error if the instance types of the nodegroup differ from the spec,
+// as EKS can't change the instance types of a nodegroup in place.
+func (s *NodegroupService) checkInstanceTypes(ng *ekstypes.Nodegroup) error {
+	desired := s.scope.InstanceTypes()
+	if len(desired) == 0 || cmp.Equal(desired, ng.InstanceTypes, cmpopts.SortSlices(func(a, b string) bool { return a < b })) {
+		return nil
+	}
+	err := errors.Wrapf(ErrNodegroupInstanceTypesChanged, "nodegroup uses instance types %v instead of %v, the nodegroup must be recreated to change its instance types", ng.InstanceTypes, desired)
+	if !s.reportedOnReadyCondition(err) {
+		record.Warnf(s.scope.ManagedMachinePool, "NodegroupInstanceTypesChanged", "EKS nodegroup %s uses instance types %v instead of %v, the nodegroup must be recreated to change its instance types", eventResource(s.scope.NodegroupName(), ng.NodegroupArn), ng.InstanceTypes, desired)
+	}
+	return err
+}
+
+// checkRemovedSubnets returns an error if subnets used by the nodegroup are no longer part of the
+// cluster network, as EKS can't change the subnets of a nodegroup in place.
+func (s *NodegroupService) checkRemovedSubnets(ng *ekstypes.Nodegroup) error {
+	removed := s.scope.RemovedSubnetIDs(ng.Subnets)
+	if len(removed) == 0 {
+		return nil
+	}
+	err := errors.Wrapf(ErrNodegroupSubnetsRemoved, "subnets %v can't be removed from the nodegroup, the nodegroup must be recreated to use other subnets", removed)
+	if !s.reportedOnReadyCondition(err) {
+		record.Warnf(s.scope.ManagedMachinePool, "NodegroupSubnetsRemoved", "Subnets %v used by EKS nodegroup %s are no longer part of the cluster network, the nodegroup must be recreated to use other subnets", removed, eventResource(s.scope.NodegroupName(), ng.NodegroupArn))
+	}
+	return err
+}
+
+// checkFailureDomains returns an error if the failure domains of the MachinePool changed since the
+// nodegroup was created, as EKS can't change the subnets of a nodegroup in place. The nodegroup
+// keeps running in its current availability zones until it is recreated.
+func (s *NodegroupService) checkFailureDomains(ng *ekstypes.Nodegroup) error {
+	zones, changed := s.scope.FailureDomainsChanged(ng.Subnets)
+	if !changed {
+		return nil
+	}
+	failureDomains := s.scope.MachinePool.Spec.FailureDomains
+	err := errors.Wrapf(ErrNodegroupFailureDomainsChanged, "nodegroup is in availability zones %v instead of the failure domains %v, the nodegroup must be recreated to use other subnets", zones, failureDomains)
+	if !s.reportedOnReadyCondition(err) {
+		record.Warnf(s.scope.ManagedMachinePool, "NodegroupFailureDomainsChanged", "EKS nodegroup %s is in availability zones %v instead of the failure domains %v, the nodegroup must be recreated to use other subnets", eventResource(s.scope.NodegroupName(), ng.NodegroupArn), zones, failureDomains)
+	}
+	return err
+}
+
+// reportedOnReadyCondition returns whether the EKSNodegroupReady condition already reports err, so
+// that the warning events of problems lasting across reconciles are only emitted when they appear.
+func (s *NodegroupService) reportedOnReadyCondition(err error) bool {
+	c := v1beta1conditions.Get(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupReadyCondition)
+	return c != nil && c.Status == corev1.ConditionFalse && strings.Contains(c.Message, err.Error())
+}
+
 func (s *NodegroupService) reconcileNodegroupConfig(ctx context.Context, ng *ekstypes.Nodegroup) error {
 	eksClusterName := s.scope.KubernetesClusterName()
 	s.Debug("reconciling node group config", "cluster", eksClusterName, "name", *ng.NodegroupName)
 
+	if err := s.checkAMIType(ng); err != nil {
+		return err
+	}
+
 	managedPool := s.scope.ManagedMachinePool.Spec
 	input := &eks.UpdateNodegroupConfigInput{
 		ClusterName:   aws.String(eksClusterName),
 		NodegroupName: aws.String(managedPool.EKSNodegroupName),
 	}
-	var needsUpdate bool
-	if labelPayload := createLabelUpdate(managedPool.Labels, ng); labelPayload != nil {
+	if labelPayload := createLabelUpdate(s.scope.NodeLabels(), ng); labelPayload != nil {
 		s.Debug("Nodegroup labels need an update", "nodegroup", ng.NodegroupName)
 		input.Labels = labelPayload
-		needsUpdate = true
 	}
-	taintsPayload, err := s.createTaintsUpdate(managedPool.Taints, ng)
+	taintsPayload, err := s.createTaintsUpdate(s.scope.NodeTaints(), ng)
 	if err != nil {
 		return fmt.Errorf("creating taints update payload: %w", err)
 	}
 	if taintsPayload != nil {
 		s.Debug("nodegroup taints need updating")
 		input.Taints = taintsPayload
-		needsUpdate = true
 	}
-	if machinePool := s.scope.MachinePool.Spec; machinePool.Replicas == nil {
-		if ng.ScalingConfig.DesiredSize != nil && *ng.ScalingConfig.DesiredSize != 1 {
-			s.Debug("Nodegroup desired size differs from spec, updating scaling configuration", "nodegroup", ng.NodegroupName)
+	externalReplicas := annotations.ReplicasManagedByExternalAutoscaler(s.scope.MachinePool)
+	var keptDesiredSize *int32
+	switch machinePool := s.scope.MachinePool.Spec; {
+	case externalReplicas:
+		// The desired size is owned by the external autoscaler, see reconcileExternallyManagedReplicas.
+	case machinePool.Replicas == nil:
+		// The nodegroup may have been scaled before the external autoscaler annotation was
+		// added, so its desired size is kept within the min and max sizes rather than reset.
+		keptDesiredSize = s.desiredSizeWithoutReplicas(ng)
+		switch {
+		case ng.ScalingConfig.DesiredSize == nil || *ng.ScalingConfig.DesiredSize != *keptDesiredSize:
+			s.Debug("Nodegroup desired size is outside of the min/max sizes, updating scaling configuration", "nodegroup", ng.NodegroupName)
 			input.ScalingConfig = s.scalingConfig()
-			needsUpdate = true
+			input.ScalingConfig.DesiredSize = keptDesiredSize
+		case *keptDesiredSize != 1:
+			s.Warn("MachinePool replicas aren't set, keeping the nodegroup desired size; set the replicas or the external autoscaler annotation to manage it",
+				"nodegroup", ng.NodegroupName, "desiredSize", *keptDesiredSize, "annotation", clusterv1beta1.ReplicasManagedByAnnotation)
 		}
-	} else if ng.ScalingConfig.DesiredSize == nil || *machinePool.Replicas != *ng.ScalingConfig.DesiredSize {
+	case ng.ScalingConfig.DesiredSize == nil || *machinePool.Replicas != *ng.ScalingConfig.DesiredSize:
 		s.Debug("Nodegroup has no desired size or differs from replicas, updating scaling configuration", "nodegroup", ng.NodegroupName)
 		input.ScalingConfig = s.scalingConfig()
-		needsUpdate = true
 	}
 	if managedPool.Scaling != nil && ((*ng.ScalingConfig.MaxSize != *managedPool.Scaling.MaxSize) ||
 		(*ng.ScalingConfig.MinSize != *managedPool.Scaling.MinSize)) {
 		s.Debug("Nodegroup min/max differ from spec, updating scaling configuration", "nodegroup", ng.NodegroupName)
 		input.ScalingConfig = s.scalingConfig()
-		needsUpdate = true
+		if externalReplicas {
+			// Even the current desired size could overwrite a change made by the
+			// autoscaler since the nodegroup was described, so leave it out.
+			input.ScalingConfig.DesiredSize = nil
+		}
+		if keptDesiredSize != nil {
+			input.ScalingConfig.DesiredSize = keptDesiredSize
+		}
+	}
+	if scaling := input.ScalingConfig; scaling != nil && scaling.DesiredSize != nil && ng.ScalingConfig.DesiredSize != nil &&
+		*scaling.DesiredSize < *ng.ScalingConfig.DesiredSize &&
+		s.deferDisruptiveChange(fmt.Sprintf("scale down from %d to %d nodes", *ng.ScalingConfig.DesiredSize, *scaling.DesiredSize)) {
+		// The min and max sizes wait with the desired size, as they may not allow the current one.
+		input.ScalingConfig = nil
+	}
+	if err := s.reconcileScaleDownBudgets(ctx, ng, input); err != nil {
+		return err
 	}
 	currentUpdateConfig := converters.NodegroupUpdateconfigFromSDK(ng.UpdateConfig)
 	updatedConfig, err := s.updateConfig()
@@ -487,40 +953,250 @@ func (s *NodegroupService) reconcileNodegroupConfig(ctx context.Context, ng *eks
 	if !cmp.Equal(managedPool.UpdateConfig, currentUpdateConfig) {
 		s.Debug("Nodegroup update configuration differs from spec, updating the nodegroup update config", "nodegroup", ng.NodegroupName)
 		input.UpdateConfig = updatedConfig
-		needsUpdate = true
 	}
 
 	specRepairConfig := s.nodeRepairConfig()
 	if !cmp.Equal(ng.NodeRepairConfig, specRepairConfig, cmpopts.IgnoreUnexported(ekstypes.NodeRepairConfig{})) {
 		s.Debug("Nodegroup repair configuration differs from spec, updating the nodegroup repair config", "nodegroup", ng.NodegroupName)
 		input.NodeRepairConfig = specRepairConfig
-		needsUpdate = true
 	}
 
-	if !needsUpdate {
+	dryRun, _ := strconv.ParseBool(s.scope.ManagedMachinePool.GetAnnotations()[expinfrav1.NodegroupConfigDryRunAnnotation])
+	if !dryRun {
+		v1beta1conditions.Delete(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupConfigUpToDateCondition)
+	}
+
+	if input.Labels == nil && input.Taints == nil && input.ScalingConfig == nil && input.UpdateConfig == nil && input.NodeRepairConfig == nil {
 		s.Debug("node group config update not needed", "cluster", eksClusterName, "name", *ng.NodegroupName)
+		if dryRun {
+			v1beta1conditions.MarkTrue(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupConfigUpToDateCondition)
+		}
 		return nil
 	}
 
-	_, err = s.EKSClient.UpdateNodegroupConfig(ctx, input)
-	if err != nil {
+	if dryRun {
+		plan := describeNodegroupConfigUpdate(input)
+		s.scope.Info("Dry run, not updating the nodegroup config", "nodegroup", *ng.NodegroupName, "plan", plan)
+		record.Eventf(s.scope.ManagedMachinePool, "PlannedUpdateEKSNodegroupConfig", "Dry run, would update the config of EKS nodegroup %s: %s", eventResource(*ng.NodegroupName, ng.NodegroupArn), plan)
+		v1beta1conditions.MarkFalse(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupConfigUpToDateCondition, expinfrav1.EKSNodegroupConfigDryRunReason, clusterv1beta1.ConditionSeverityInfo,
+			"Would update %s", plan)
+		return nil
+	}
+
+	if err := wait.WaitForWithClassifier(s.backoff, withThrottlingEvent(s.scope.ManagedMachinePool, "the nodegroup config update", func() (bool, error) {
+		if _, err := s.EKSClient.UpdateNodegroupConfig(ctx, input); err != nil {
+			return false, err
+		}
+		return true, nil
+	}), awserrors.DefaultRetryClassifier); err != nil {
 		return errors.Wrap(err, "failed to update nodegroup config")
 	}
 
 	return nil
 }
 
+// reconcileScaleDownBudgets checks, when the pool has a PodDisruptionBudgetPolicy, that the
+// PodDisruptionBudgets of the workload cluster allow the scale-down of the config update. With
+// the Defer policy, a blocked scale-down is left out of the update until they allow it.
+func (s *NodegroupService) reconcileScaleDownBudgets(ctx context.Context, ng *ekstypes.Nodegroup, input *eks.UpdateNodegroupConfigInput) error {
+	managedPool := s.scope.ManagedMachinePool
+	policy := managedPool.Spec.PodDisruptionBudgetPolicy
+	if policy == nil {
+		v1beta1conditions.Delete(managedPool, expinfrav1.EKSNodegroupScaleDownAllowedCondition)
+		return nil
+	}
+	scaling := input.ScalingConfig
+	if scaling == nil || scaling.DesiredSize == nil || ng.ScalingConfig.DesiredSize == nil || *scaling.DesiredSize >= *ng.ScalingConfig.DesiredSize {
+		v1beta1conditions.MarkTrue(managedPool, expinfrav1.EKSNodegroupScaleDownAllowedCondition)
+		return nil
+	}
+
+	current, desired := *ng.ScalingConfig.DesiredSize, *scaling.DesiredSize
+	blocking, err := s.blockingDisruptionBudgets(ctx, ng, current-desired)
+	if err != nil {
+		return errors.Wrap(err, "failed to check the PodDisruptionBudgets of the workload cluster")
+	}
+	if len(blocking) == 0 {
+		v1beta1conditions.MarkTrue(managedPool, expinfrav1.EKSNodegroupScaleDownAllowedCondition)
+		return nil
+	}
+
+	budgets := strings.Join(blocking, ", ")
+	if *policy == expinfrav1.PodDisruptionBudgetPolicyProceed {
+		record.Warnf(managedPool, "PodDisruptionBudgetsBlockingScaleDown", "Scaling EKS nodegroup %s down from %d to %d nodes although PodDisruptionBudgets would block draining them: %s",
+			eventResource(s.scope.NodegroupName(), ng.NodegroupArn), current, desired, budgets)
+		v1beta1conditions.MarkTrue(managedPool, expinfrav1.EKSNodegroupScaleDownAllowedCondition)
+		return nil
+	}
+
+	s.scope.Info("Deferring nodegroup scale-down blocked by PodDisruptionBudgets", "nodegroup", s.scope.NodegroupName(), "desiredSize", current, "budgets", budgets)
+	// The min and max sizes wait with the desired size, as they may not allow the current one.
+	input.ScalingConfig = nil
+	s.blockedScaleDown = fmt.Sprintf("scale down from %d to %d nodes", current, desired)
+	v1beta1conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupScaleDownAllowedCondition, expinfrav1.EKSNodegroupPodDisruptionBudgetsBlockingReason, clusterv1beta1.ConditionSeverityInfo,
+		"%s deferred, PodDisruptionBudgets would block draining the nodes: %s", s.blockedScaleDown, budgets)
+	return nil
+}
+
+// blockingDisruptionBudgets returns the PodDisruptionBudgets that don't allow evicting their
+// pods from the nodes removed from the nodegroup. The Auto Scaling group picks the instances
+// to terminate, so the nodes running most of the pods of a budget are assumed to be removed.
+func (s *NodegroupService) blockingDisruptionBudgets(ctx context.Context, ng *ekstypes.Nodegroup, removed int32) ([]string, error) {
+	remoteClient, err := s.remoteClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get client for the workload cluster")
+	}
+	nodes := &corev1.NodeList{}
+	if err := remoteClient.List(ctx, nodes, client.MatchingLabels{nodegroupNodeLabel: aws.ToString(ng.NodegroupName)}); err != nil {
+		return nil, errors.Wrap(err, "failed to list nodes")
+	}
+	// Only the pods of the nodegroup matter, listing them per node keeps the lists small
+	// in clusters running many pods outside of the nodegroup.
+	var pods []corev1.Pod
+	for _, node := range nodes.Items {
+		nodePods := &corev1.PodList{}
+		if err := remoteClient.List(ctx, nodePods, client.MatchingFields{podNodeNameField: node.Name}); err != nil {
+			return nil, errors.Wrapf(err, "failed to list pods of node %s", node.Name)
+		}
+		pods = append(pods, nodePods.Items...)
+	}
+	budgets := &policyv1.PodDisruptionBudgetList{}
+	if err := remoteClient.List(ctx, budgets); err != nil {
+		return nil, errors.Wrap(err, "failed to list PodDisruptionBudgets")
+	}
+
+	var blocking []string
+	for i := range budgets.Items {
+		budget := &budgets.Items[i]
+		selector, err := metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid selector of PodDisruptionBudget %s", klog.KObj(budget))
+		}
+		podsPerNode := map[string]int32{}
+		for _, pod := range pods {
+			if pod.Namespace != budget.Namespace ||
+				pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed ||
+				!selector.Matches(labels.Set(pod.Labels)) {
+				continue
+			}
+			podsPerNode[pod.Spec.NodeName]++
+		}
+
+		counts := slices.Sorted(maps.Values(podsPerNode))
+		slices.Reverse(counts)
+		var evicted int32
+		for _, count := range counts[:min(len(counts), int(removed))] {
+			evicted += count
+		}
+		if evicted > budget.Status.DisruptionsAllowed {
+			blocking = append(blocking, fmt.Sprintf("%s allows %d disruptions for %d pods", klog.KObj(budget), budget.Status.DisruptionsAllowed, evicted))
+		}
+	}
+	return blocking, nil
+}
+
+// describeNodegroupConfigUpdate summarizes the changes of a nodegroup config update, for
+// instance "labels to add or update: team; scaling config: min 1, max 3, desired 2".
+func describeNodegroupConfigUpdate(input *eks.UpdateNodegroupConfigInput) string {
+	var changes []string
+	if labels := input.Labels; labels != nil {
+		if len(labels.AddOrUpdateLabels) > 0 {
+			keys := make([]string, 0, len(labels.AddOrUpdateLabels))
+			for k := range labels.AddOrUpdateLabels {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			changes = append(changes, "labels to add or update: "+strings.Join(keys, ", "))
+		}
+		if len(labels.RemoveLabels) > 0 {
+			changes = append(changes, "labels to remove: "+strings.Join(labels.RemoveLabels, ", "))
+		}
+	}
+	if taints := input.Taints; taints != nil {
+		sdkTaintStrings := func(taints []ekstypes.Taint) string {
+			formatted := make([]string, 0, len(taints))
+			for _, t := range taints {
+				formatted = append(formatted, fmt.Sprintf("%s=%s:%s", aws.ToString(t.Key), aws.ToString(t.Value), t.Effect))
+			}
+			return strings.Join(formatted, ", ")
+		}
+		if len(taints.AddOrUpdateTaints) > 0 {
+			changes = append(changes, "taints to add or update: "+sdkTaintStrings(taints.AddOrUpdateTaints))
+		}
+		if len(taints.RemoveTaints) > 0 {
+			changes = append(changes, "taints to remove: "+sdkTaintStrings(taints.RemoveTaints))
+		}
+	}
+	if scaling := input.ScalingConfig; scaling != nil {
+		desired := "unchanged"
+		if scaling.DesiredSize != nil {
+			desired = strconv.Itoa(int(*scaling.DesiredSize))
+		}
+		changes = append(changes, fmt.Sprintf("scaling config: min %d, max %d, desired %s", aws.ToInt32(scaling.MinSize), aws.ToInt32(scaling.MaxSize), desired))
+	}
+	if update := input.UpdateConfig; update != nil {
+		switch {
+		case update.MaxUnavailable != nil:
+			changes = append(changes, fmt.Sprintf("update config: max unavailable %d", *update.MaxUnavailable))
+		case update.MaxUnavailablePercentage != nil:
+			changes = append(changes, fmt.Sprintf("update config: max unavailable %d%%", *update.MaxUnavailablePercentage))
+		default:
+			changes = append(changes, "update config: default")
+		}
+	}
+	if repair := input.NodeRepairConfig; repair != nil {
+		changes = append(changes, fmt.Sprintf("node repair: %t", aws.ToBool(repair.Enabled)))
+	}
+	return strings.Join(changes, "; ")
+}
+
 func (s *NodegroupService) reconcileNodegroup(ctx context.Context) error {
+	// Default taints from the control plane aren't checked by the machine pool's
+	// webhook, so catch a merged list that EKS would reject before calling it.
+	if n := len(s.scope.NodeTaints()); n > expinfrav1.MaxNodegroupTaints {
+		return errors.Wrapf(ErrNodegroupTaintLimitExceeded, "nodegroup %s would have %d taints including the cluster's default taints, EKS allows at most %d",
+			s.scope.NodegroupName(), n, expinfrav1.MaxNodegroupTaints)
+	}
+
 	ng, err := s.describeNodegroup(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to describe nodegroup")
 	}
 
+	s.maintenanceWindow, s.deferredChanges, s.blockedScaleDown = nil, nil, ""
+	if value, ok := s.scope.ManagedMachinePool.GetAnnotations()[expinfrav1.MaintenanceWindowAnnotation]; ok {
+		if s.maintenanceWindow, err = ekspkg.ParseMaintenanceWindow(value); err != nil {
+			return errors.Wrapf(err, "invalid %s annotation", expinfrav1.MaintenanceWindowAnnotation)
+		}
+	}
+
+	var externalLaunchTemplate *ec2types.LaunchTemplateVersion
+	if ref := s.scope.ManagedMachinePool.Spec.ExternalLaunchTemplate; ref != nil {
+		externalLaunchTemplate, err = s.resolveExternalLaunchTemplate(ctx, ref)
+		if err != nil {
+			return err
+		}
+		if err := s.validateLaunchTemplateInstanceProfile(ctx, externalLaunchTemplate); err != nil {
+			return err
+		}
+		if err := s.validateLaunchTemplateAMI(externalLaunchTemplate); err != nil {
+			return err
+		}
+	}
+
 	if eksClusterName, eksNodegroupName := s.scope.KubernetesClusterName(), s.scope.NodegroupName(); ng == nil {
-		ng, err = s.createNodegroup(ctx)
+		if err := s.validateWindowsSupport(ctx); err != nil {
+			return err
+		}
+		ng, err = s.createNodegroup(ctx, externalLaunchTemplate)
 		if err != nil {
 			return errors.Wrap(err, "failed to create nodegroup")
 		}
+		if ng == nil {
+			// EKS returns ResourceNotFound when the cluster doesn't exist yet, or anymore.
+			record.Warnf(s.scope.ManagedMachinePool, "EKSClusterNotFound", "Failed to create EKS nodegroup %s: EKS cluster %s not found", eksNodegroupName, eksClusterName)
+			return errors.Wrapf(ErrNodegroupClusterNotFound, "EKS cluster %s not found while creating nodegroup %s", eksClusterName, eksNodegroupName)
+		}
 		s.scope.Info("Created EKS nodegroup in AWS", "cluster-name", eksClusterName, "nodegroup-name", eksNodegroupName)
 	} else {
 		tagKey := infrav1.ClusterAWSCloudProviderTagKey(s.scope.ClusterName())
@@ -531,38 +1207,57 @@ func (s *NodegroupService) reconcileNodegroup(ctx context.Context) error {
 		s.scope.Debug("Found owned EKS nodegroup in AWS", "cluster-name", eksClusterName, "nodegroup-name", eksNodegroupName)
 	}
 
+	// The desired size last seen on the nodegroup tells apart changes made by an external
+	// autoscaler from changes made to the MachinePool's replicas.
+	var previousDesiredSize *int32
+	if scalingStatus := s.scope.ManagedMachinePool.Status.ScalingConfig; scalingStatus != nil {
+		previousDesiredSize = ptr.To(scalingStatus.DesiredSize)
+	}
+
 	if err := s.setStatus(ctx, ng); err != nil {
 		return errors.Wrap(err, "failed to set status")
 	}
 
 	switch ng.Status {
-	case ekstypes.NodegroupStatusCreating, ekstypes.NodegroupStatusUpdating:
+	case ekstypes.NodegroupStatusUpdating:
+		if !s.scope.WaitForNodegroupUpdates() {
+			return ErrNodegroupUpdating
+		}
+		ng, err = s.waitForNodegroupActive(ctx)
+	case ekstypes.NodegroupStatusCreating:
 		ng, err = s.waitForNodegroupActive(ctx)
 	default:
 		break
 	}
 
-	if annotations.ReplicasManagedByExternalAutoscaler(s.scope.MachinePool) {
-		// Set MachinePool replicas to the node group DesiredCapacity
-		ngDesiredCapacity := ng.ScalingConfig.DesiredSize //#nosec G115
-		if *s.scope.MachinePool.Spec.Replicas != *ngDesiredCapacity {
-			s.scope.Info("Setting MachinePool replicas to node group DesiredCapacity",
-				"local", *s.scope.MachinePool.Spec.Replicas,
-				"external", ngDesiredCapacity)
-			s.scope.MachinePool.Spec.Replicas = ngDesiredCapacity
-			if err := s.scope.PatchCAPIMachinePoolObject(ctx); err != nil {
-				return err
-			}
-		}
-	}
-
 	if err != nil {
 		return errors.Wrap(err, "failed to wait for nodegroup to be active")
 	}
 
-	if err := s.reconcileNodegroupVersion(ctx, ng); err != nil {
+	if err := s.reconcileExternallyManagedReplicas(ctx, ng, previousDesiredSize); err != nil {
+		return err
+	}
+
+	s.reconcileInstanceTypeFallback(ng)
+
+	updated, err := s.reconcileNodegroupVersion(ctx, ng)
+	if err != nil {
 		return errors.Wrap(err, "failed to reconcile nodegroup version")
 	}
+	if updated {
+		// EKS rejects other updates of the nodegroup while the version update is in progress,
+		// so the config is only reconciled once the nodegroup is active again.
+		if !s.scope.WaitForNodegroupUpdates() {
+			return ErrNodegroupUpdating
+		}
+		if ng, err = s.waitForNodegroupActive(ctx); err != nil {
+			return errors.Wrap(err, "failed to wait for nodegroup version update")
+		}
+	}
+
+	if ng, err = s.reconcileDesiredCapacityDrift(ctx, ng); err != nil {
+		return errors.Wrap(err, "failed to check the desired capacity of the nodegroup ASG")
+	}
 
 	if err := s.reconcileNodegroupConfig(ctx, ng); err != nil {
 		return errors.Wrap(err, "failed to reconcile nodegroup config")
@@ -572,8 +1267,228 @@ func (s *NodegroupService) reconcileNodegroup(ctx context.Context) error {
 		return errors.Wrapf(err, "failed to reconcile nodegroup tags")
 	}
 
-	if err := s.reconcileASGTags(ctx, ng); err != nil {
-		return errors.Wrapf(err, "failed to reconcile asg tags")
+	if err := s.reconcileASGHealthCheckConfig(ctx, ng); err != nil {
+		return errors.Wrapf(err, "failed to reconcile asg health check config")
+	}
+
+	if err := s.reconcileASGInstancesDistribution(ctx, ng); err != nil {
+		return errors.Wrapf(err, "failed to reconcile asg instances distribution")
+	}
+
+	if err := s.reconcileASGSuspendedProcesses(ctx, ng); err != nil {
+		return errors.Wrap(err, "failed to reconcile asg suspended processes")
+	}
+
+	if err := s.reconcileNodesJoined(ctx, ng); err != nil {
+		return errors.Wrap(err, "failed to check nodegroup nodes joined the workload cluster")
+	}
+
+	// The instance types and subnets of the nodegroup can't be changed, but everything else is
+	// still reconciled, including the deferred changes, and the changes are reported along with them.
+	recreateErrs := []error{s.checkInstanceTypes(ng), s.checkRemovedSubnets(ng), s.checkFailureDomains(ng)}
+
+	if err := s.reconcileDeferredChanges(); err != nil {
+		return kerrors.NewAggregate(append(recreateErrs, err))
+	}
+	if s.blockedScaleDown != "" {
+		return kerrors.NewAggregate(append(recreateErrs, errors.Wrapf(ErrNodegroupScaleDownBlocked, "%s deferred", s.blockedScaleDown)))
+	}
+	return kerrors.NewAggregate(recreateErrs)
+}
+
+// reconcileDesiredCapacityDrift detects a desired capacity of the nodegroup ASG changed
+// directly rather than through the nodegroup scaling config, which EKS doesn't revert. The
+// returned nodegroup carries the capacity of the ASG as its desired size, so that the config
+// reconcile corrects it back to the MachinePool's replicas through the scaling config, with
+// the same maintenance window and PodDisruptionBudget checks as any other scaling change.
+func (s *NodegroupService) reconcileDesiredCapacityDrift(ctx context.Context, ng *ekstypes.Nodegroup) (*ekstypes.Nodegroup, error) {
+	replicas := s.scope.MachinePool.Spec.Replicas
+	// A nodegroup desired size differing from the replicas is updated by the config reconcile
+	// anyway, and the ASG capacity is left to the external autoscaler when it owns the replicas.
+	if replicas == nil || annotations.ReplicasManagedByExternalAutoscaler(s.scope.MachinePool) ||
+		ng.ScalingConfig == nil || ng.ScalingConfig.DesiredSize == nil || *ng.ScalingConfig.DesiredSize != *replicas {
+		return ng, nil
+	}
+
+	group, err := s.describeASGs(ctx, ng)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil || group.DesiredCapacity == nil || *group.DesiredCapacity == *ng.ScalingConfig.DesiredSize {
+		return ng, nil
+	}
+
+	s.scope.Info("Nodegroup ASG desired capacity drifted from the nodegroup scaling config", "nodegroup", s.scope.NodegroupName(),
+		"asg", aws.ToString(group.AutoScalingGroupName), "desiredCapacity", *group.DesiredCapacity, "desiredSize", *ng.ScalingConfig.DesiredSize)
+	record.Eventf(s.scope.ManagedMachinePool, "NodegroupDesiredCapacityDrift", "Auto Scaling group %s of EKS nodegroup %s has a desired capacity of %d instead of %d, correcting it through the nodegroup scaling config",
+		aws.ToString(group.AutoScalingGroupName), eventResource(s.scope.NodegroupName(), ng.NodegroupArn), *group.DesiredCapacity, *ng.ScalingConfig.DesiredSize)
+
+	drifted := *ng
+	scaling := *ng.ScalingConfig
+	scaling.DesiredSize = aws.Int32(*group.DesiredCapacity)
+	drifted.ScalingConfig = &scaling
+	return &drifted, nil
+}
+
+// reconcileASGHealthCheckConfig applies the health check grace period and default instance
+// warmup to the Auto Scaling group backing the nodegroup. EKS does not expose these settings
+// on the nodegroup itself so they are set directly on the ASG.
+func (s *NodegroupService) reconcileASGHealthCheckConfig(ctx context.Context, ng *ekstypes.Nodegroup) error {
+	managedPool := s.scope.ManagedMachinePool.Spec
+	if managedPool.HealthCheckGracePeriod == nil && managedPool.DefaultInstanceWarmup == nil {
+		return nil
+	}
+
+	group, err := s.describeASGs(ctx, ng)
+	if err != nil {
+		return errors.Wrap(err, "failed to describe ASG for nodegroup")
+	}
+	if group == nil {
+		return nil
+	}
+
+	input := &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: group.AutoScalingGroupName,
+	}
+	var needsUpdate bool
+	if managedPool.HealthCheckGracePeriod != nil {
+		gracePeriod := int32(managedPool.HealthCheckGracePeriod.Seconds()) //#nosec G115
+		if aws.ToInt32(group.HealthCheckGracePeriod) != gracePeriod {
+			input.HealthCheckGracePeriod = aws.Int32(gracePeriod)
+			needsUpdate = true
+		}
+	}
+	if managedPool.DefaultInstanceWarmup != nil {
+		warmup := int32(managedPool.DefaultInstanceWarmup.Seconds()) //#nosec G115
+		if group.DefaultInstanceWarmup == nil || *group.DefaultInstanceWarmup != warmup {
+			input.DefaultInstanceWarmup = aws.Int32(warmup)
+			needsUpdate = true
+		}
+	}
+	if !needsUpdate {
+		s.scope.Debug("ASG health check config is up to date", "asg-name", aws.ToString(group.AutoScalingGroupName))
+		return nil
+	}
+
+	if _, err := s.AutoscalingClient.UpdateAutoScalingGroup(ctx, input); err != nil {
+		return errors.Wrap(err, "failed to update nodegroup's AutoScalingGroup")
+	}
+	s.scope.Info("Updated ASG health check config", "asg-name", aws.ToString(group.AutoScalingGroupName))
+
+	return nil
+}
+
+// reconcileASGInstancesDistribution sets the spot allocation strategy and the on-demand base
+// capacity of the Auto Scaling group backing a spot nodegroup. EKS creates the group with a mixed
+// instances policy, but doesn't allow configuring how it distributes instances.
+func (s *NodegroupService) reconcileASGInstancesDistribution(ctx context.Context, ng *ekstypes.Nodegroup) error {
+	strategy := s.scope.ManagedMachinePool.Spec.SpotAllocationStrategy
+	baseCapacity := s.scope.ManagedMachinePool.Spec.OnDemandBaseCapacity
+	if (strategy == nil && baseCapacity == nil) || ng.CapacityType != ekstypes.CapacityTypesSpot {
+		return nil
+	}
+
+	group, err := s.describeASGs(ctx, ng)
+	if err != nil {
+		return errors.Wrap(err, "failed to describe ASG for nodegroup")
+	}
+	if group == nil {
+		return nil
+	}
+
+	current := &autoscalingtypes.InstancesDistribution{}
+	if group.MixedInstancesPolicy != nil && group.MixedInstancesPolicy.InstancesDistribution != nil {
+		current = group.MixedInstancesPolicy.InstancesDistribution
+	}
+
+	distribution := &autoscalingtypes.InstancesDistribution{}
+	needsUpdate := false
+	if strategy != nil && aws.ToString(current.SpotAllocationStrategy) != string(*strategy) {
+		distribution.SpotAllocationStrategy = aws.String(string(*strategy))
+		needsUpdate = true
+	}
+	if baseCapacity != nil && aws.ToInt32(current.OnDemandBaseCapacity) != *baseCapacity {
+		distribution.OnDemandBaseCapacity = baseCapacity
+		needsUpdate = true
+	}
+	if !needsUpdate {
+		s.scope.Debug("ASG instances distribution is up to date", "asg-name", aws.ToString(group.AutoScalingGroupName))
+		return nil
+	}
+
+	input := &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: group.AutoScalingGroupName,
+		MixedInstancesPolicy: &autoscalingtypes.MixedInstancesPolicy{
+			InstancesDistribution: distribution,
+		},
+	}
+	if _, err := s.AutoscalingClient.UpdateAutoScalingGroup(ctx, input); err != nil {
+		return errors.Wrap(err, "failed to update nodegroup's AutoScalingGroup")
+	}
+	s.scope.Info("Updated ASG instances distribution", "asg-name", aws.ToString(group.AutoScalingGroupName))
+
+	return nil
+}
+
+// reconcileASGSuspendedProcesses suspends the processes of the Auto Scaling group backing the
+// nodegroup listed in the spec and resumes the others. EKS doesn't manage the suspended processes
+// of the group, so a suspended process stays suspended across nodegroup updates.
+func (s *NodegroupService) reconcileASGSuspendedProcesses(ctx context.Context, ng *ekstypes.Nodegroup) error {
+	if s.scope.ManagedMachinePool.Spec.SuspendProcesses == nil {
+		return nil
+	}
+
+	group, err := s.describeASGs(ctx, ng)
+	if err != nil {
+		return errors.Wrap(err, "failed to describe ASG for nodegroup")
+	}
+	if group == nil {
+		return nil
+	}
+
+	desired := make([]string, 0, len(*s.scope.ManagedMachinePool.Spec.SuspendProcesses))
+	for _, p := range *s.scope.ManagedMachinePool.Spec.SuspendProcesses {
+		desired = append(desired, string(p))
+	}
+	current := make([]string, 0, len(group.SuspendedProcesses))
+	for _, p := range group.SuspendedProcesses {
+		current = append(current, aws.ToString(p.ProcessName))
+	}
+
+	var toSuspend, toResume []string
+	for _, p := range desired {
+		if !slices.Contains(current, p) {
+			toSuspend = append(toSuspend, p)
+		}
+	}
+	for _, p := range current {
+		if !slices.Contains(desired, p) {
+			toResume = append(toResume, p)
+		}
+	}
+	slices.Sort(toSuspend)
+	slices.Sort(toResume)
+
+	if len(toSuspend) > 0 {
+		if _, err := s.AutoscalingClient.SuspendProcesses(ctx, &autoscaling.SuspendProcessesInput{
+			AutoScalingGroupName: group.AutoScalingGroupName,
+			ScalingProcesses:     toSuspend,
+		}); err != nil {
+			return errors.Wrap(err, "failed to suspend processes of nodegroup's AutoScalingGroup")
+		}
+		s.scope.Info("Suspended ASG processes", "asg-name", aws.ToString(group.AutoScalingGroupName), "processes", toSuspend)
+	}
+	if len(toResume) > 0 {
+		if _, err := s.AutoscalingClient.ResumeProcesses(ctx, &autoscaling.ResumeProcessesInput{
+			AutoScalingGroupName: group.AutoScalingGroupName,
+			ScalingProcesses:     toResume,
+		}); err != nil {
+			return errors.Wrap(err, "failed to resume processes of nodegroup's AutoScalingGroup")
+		}
+		s.scope.Info("Resumed ASG processes", "asg-name", aws.ToString(group.AutoScalingGroupName), "processes", toResume)
+	}
+	if len(toSuspend) == 0 && len(toResume) == 0 {
+		s.scope.Debug("ASG suspended processes are up to date", "asg-name", aws.ToString(group.AutoScalingGroupName))
 	}
 
 	return nil
@@ -597,10 +1512,26 @@ func (s *NodegroupService) setStatus(ctx context.Context, ng *ekstypes.Nodegroup
 		managedPool.Status.Ready = false
 	case ekstypes.NodegroupStatusUpdating:
 		managedPool.Status.Ready = true
+	case ekstypes.NodegroupStatusDegraded:
+		// The nodes of a degraded nodegroup keep running, so the pool stays ready unless the
+		// policy asks for strict readiness.
+		managedPool.Status.Ready = managedPool.Spec.DegradedPolicy != expinfrav1.NodegroupDegradedPolicyNotReady
+		managedPool.Status.FailureMessage = nil
 	default:
-		return errors.Errorf("unexpected EKS nodegroup status %s", ng.Status)
+		// Leave the pool as it is until the nodegroup is back in a known status.
+		return errors.Wrapf(ErrNodegroupStatusUnknown, "observed EKS nodegroup status %s", ng.Status)
+	}
+	if ng.ScalingConfig != nil {
+		managedPool.Status.ScalingConfig = &expinfrav1.NodegroupScalingStatus{
+			MinSize:     aws.ToInt32(ng.ScalingConfig.MinSize),
+			MaxSize:     aws.ToInt32(ng.ScalingConfig.MaxSize),
+			DesiredSize: aws.ToInt32(ng.ScalingConfig.DesiredSize),
+		}
 	}
-	if managedPool.Status.Ready && ng.Resources != nil && len(ng.Resources.AutoScalingGroups) > 0 {
+	s.setResources(ng)
+	s.setHealth(ng)
+	s.setEstimatedHourlyCost(ng)
+	if (managedPool.Status.Ready || ng.Status == ekstypes.NodegroupStatusDegraded) && ng.Resources != nil && len(ng.Resources.AutoScalingGroups) > 0 {
 		req := autoscaling.DescribeAutoScalingGroupsInput{}
 		for _, asg := range ng.Resources.AutoScalingGroups {
 			req.AutoScalingGroupNames = append(req.AutoScalingGroupNames, *asg.Name)
@@ -610,16 +1541,21 @@ func (s *NodegroupService) setStatus(ctx context.Context, ng *ekstypes.Nodegroup
 			return errors.Wrap(err, "failed to describe AutoScalingGroup for nodegroup")
 		}
 
-		var replicas int32
+		var replicas, inService int32
 		var providerIDList []string
 		for _, group := range groups.AutoScalingGroups {
 			replicas += int32(len(group.Instances)) //#nosec G115
 			for _, instance := range group.Instances {
 				providerIDList = append(providerIDList, fmt.Sprintf("aws:///%s/%s", *instance.AvailabilityZone, *instance.InstanceId))
+				if instance.LifecycleState == autoscalingtypes.LifecycleStateInService {
+					inService++
+				}
 			}
 		}
 		managedPool.Spec.ProviderIDList = providerIDList
 		managedPool.Status.Replicas = replicas
+		s.setReplicasAvailable(inService)
+		s.setBootstrapReadyReplicas(ctx, req.AutoScalingGroupNames)
 	}
 	if err := s.scope.PatchObject(); err != nil {
 		return errors.Wrap(err, "failed to update nodegroup")
@@ -627,6 +1563,401 @@ func (s *NodegroupService) setStatus(ctx context.Context, ng *ekstypes.Nodegroup
 	return nil
 }
 
+// setResources reports the resources EKS created for the nodegroup, so that they can be found
+// without calling AWS. They're left empty until EKS has created them.
+func (s *NodegroupService) setResources(ng *ekstypes.Nodegroup) {
+	status := &s.scope.ManagedMachinePool.Status
+	status.AutoScalingGroupName = nil
+	status.RemoteAccessSecurityGroupID = nil
+	if ng.Resources == nil {
+		return
+	}
+	if len(ng.Resources.AutoScalingGroups) > 0 && ng.Resources.AutoScalingGroups[0].Name != nil {
+		status.AutoScalingGroupName = ptr.To(*ng.Resources.AutoScalingGroups[0].Name)
+	}
+	if ng.Resources.RemoteAccessSecurityGroup != nil {
+		status.RemoteAccessSecurityGroupID = ptr.To(*ng.Resources.RemoteAccessSecurityGroup)
+	}
+}
+
+// setEstimatedHourlyCost reports a rough estimate of the hourly cost of the nodes of the
+// nodegroup when cost estimates are enabled.
+func (s *NodegroupService) setEstimatedHourlyCost(ng *ekstypes.Nodegroup) {
+	managedPool := s.scope.ManagedMachinePool
+	managedPool.Status.EstimatedHourlyCost = nil
+	priceTable := s.scope.PriceTable()
+	if priceTable == nil || ng.ScalingConfig == nil {
+		return
+	}
+
+	// EKS doesn't report the instance types of nodegroups using a launch template with an
+	// instance type, which is then the one of the launch template or its fallback.
+	instanceTypes := ng.InstanceTypes
+	if len(instanceTypes) == 0 {
+		switch {
+		case managedPool.Status.InstanceTypeFallback != nil:
+			instanceTypes = []string{managedPool.Status.InstanceTypeFallback.InstanceType}
+		case managedPool.Spec.AWSLaunchTemplate != nil && managedPool.Spec.AWSLaunchTemplate.InstanceType != "":
+			instanceTypes = []string{managedPool.Spec.AWSLaunchTemplate.InstanceType}
+		}
+	}
+
+	cost, ok := priceTable.EstimateHourlyCost(s.scope.ControlPlane.Spec.Region, instanceTypes,
+		ng.CapacityType == ekstypes.CapacityTypesSpot, aws.ToInt32(ng.ScalingConfig.DesiredSize))
+	if !ok {
+		s.scope.Debug("No price known for the instance types of the nodegroup, not estimating its cost", "instance-types", instanceTypes)
+		return
+	}
+	managedPool.Status.EstimatedHourlyCost = ptr.To(strconv.FormatFloat(cost, 'f', 4, 64))
+}
+
+// setHealth reports the health issues of the nodegroup, which often explain why it's stuck
+// creating or can't launch instances. A nodegroup EKS put in the DEGRADED status is reported
+// with its own reason, as the degraded policy decides whether it keeps the pool ready.
+func (s *NodegroupService) setHealth(ng *ekstypes.Nodegroup) {
+	managedPool := s.scope.ManagedMachinePool
+	issues := healthIssues(ng)
+	if ng.Status == ekstypes.NodegroupStatusDegraded {
+		message := "EKS nodegroup is degraded"
+		if len(issues) > 0 {
+			message = strings.Join(issues, "; ")
+		}
+		v1beta1conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupHealthyCondition, expinfrav1.EKSNodegroupStatusDegradedReason, clusterv1beta1.ConditionSeverityWarning,
+			"%s", message)
+		return
+	}
+	if len(issues) == 0 {
+		v1beta1conditions.MarkTrue(managedPool, expinfrav1.EKSNodegroupHealthyCondition)
+		return
+	}
+	v1beta1conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupHealthyCondition, expinfrav1.EKSNodegroupHealthIssuesReason, clusterv1beta1.ConditionSeverityWarning,
+		"%s", strings.Join(issues, "; "))
+}
+
+// healthIssues returns the health issues EKS reports for the nodegroup, as "code: message".
+func healthIssues(ng *ekstypes.Nodegroup) []string {
+	if ng.Health == nil {
+		return nil
+	}
+	issues := make([]string, 0, len(ng.Health.Issues))
+	for _, issue := range ng.Health.Issues {
+		issues = append(issues, fmt.Sprintf("%s: %s", issue.Code, aws.ToString(issue.Message)))
+	}
+	return issues
+}
+
+// setReplicasAvailable compares the nodegroup's InService instances against the
+// MachinePool's desired replicas. A shortfall is only flagged once it has lasted
+// longer than replicaShortfallGracePeriod, so that scaling up and rolling updates
+// aren't reported as a problem.
+func (s *NodegroupService) setReplicasAvailable(inService int32) {
+	managedPool := s.scope.ManagedMachinePool
+	if s.scope.MachinePool == nil || s.scope.MachinePool.Spec.Replicas == nil {
+		v1beta1conditions.Delete(managedPool, expinfrav1.EKSNodegroupReplicasAvailableCondition)
+		return
+	}
+
+	desired := *s.scope.MachinePool.Spec.Replicas
+	if inService >= desired {
+		v1beta1conditions.MarkTrue(managedPool, expinfrav1.EKSNodegroupReplicasAvailableCondition)
+		return
+	}
+
+	// The waiting message only depends on the desired replicas, so that the condition's
+	// transition time marks the start of the shortfall.
+	existing := v1beta1conditions.Get(managedPool, expinfrav1.EKSNodegroupReplicasAvailableCondition)
+	switch {
+	case existing != nil && existing.Status == corev1.ConditionFalse && existing.Reason == expinfrav1.EKSNodegroupReplicaShortfallReason,
+		existing != nil && existing.Status == corev1.ConditionFalse && existing.Reason == expinfrav1.EKSNodegroupWaitingForReplicasReason &&
+			time.Since(existing.LastTransitionTime.Time) >= replicaShortfallGracePeriod:
+		v1beta1conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupReplicasAvailableCondition, expinfrav1.EKSNodegroupReplicaShortfallReason, clusterv1beta1.ConditionSeverityWarning,
+			"only %d of %d desired replicas are InService", inService, desired)
+	default:
+		v1beta1conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupReplicasAvailableCondition, expinfrav1.EKSNodegroupWaitingForReplicasReason, clusterv1beta1.ConditionSeverityInfo,
+			"waiting for %d desired replicas to be InService", desired)
+	}
+}
+
+// reconcileNodesJoined checks, when NodeJoinTimeout is set, that at least the nodegroup's
+// minimum size has joined the workload cluster as ready nodes. EKS reports the nodegroup
+// active once its instances run, so nodes that can't join the cluster, for example because
+// of security groups or aws-auth, only show up here. As for the replicas, the condition's
+// transition time marks the start of the wait.
+func (s *NodegroupService) reconcileNodesJoined(ctx context.Context, ng *ekstypes.Nodegroup) error {
+	managedPool := s.scope.ManagedMachinePool
+	timeout := managedPool.Spec.NodeJoinTimeout
+	if timeout == nil {
+		v1beta1conditions.Delete(managedPool, expinfrav1.EKSNodegroupNodesJoinedCondition)
+		return nil
+	}
+	if ng.Status != ekstypes.NodegroupStatusActive {
+		return nil
+	}
+
+	var minSize int32
+	if ng.ScalingConfig != nil {
+		minSize = aws.ToInt32(ng.ScalingConfig.MinSize)
+	}
+
+	remoteClient, err := s.remoteClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to get client for the workload cluster")
+	}
+	nodes := &corev1.NodeList{}
+	if err := remoteClient.List(ctx, nodes, client.MatchingLabels{nodegroupNodeLabel: aws.ToString(ng.NodegroupName)}); err != nil {
+		return errors.Wrap(err, "failed to list nodes")
+	}
+	var joined int32
+	for _, node := range nodes.Items {
+		for _, c := range node.Status.Conditions {
+			if c.Type == corev1.NodeReady && c.Status == corev1.ConditionTrue {
+				joined++
+			}
+		}
+	}
+	if joined >= minSize {
+		v1beta1conditions.MarkTrue(managedPool, expinfrav1.EKSNodegroupNodesJoinedCondition)
+		return nil
+	}
+
+	existing := v1beta1conditions.Get(managedPool, expinfrav1.EKSNodegroupNodesJoinedCondition)
+	switch {
+	case existing != nil && existing.Status == corev1.ConditionFalse && existing.Reason == expinfrav1.EKSNodegroupNodesNotJoinedReason,
+		existing != nil && existing.Status == corev1.ConditionFalse && existing.Reason == expinfrav1.EKSNodegroupWaitingForNodesReason &&
+			time.Since(existing.LastTransitionTime.Time) >= timeout.Duration:
+		v1beta1conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupNodesJoinedCondition, expinfrav1.EKSNodegroupNodesNotJoinedReason, clusterv1beta1.ConditionSeverityWarning,
+			"only %d of %d nodes joined the workload cluster within %s, check the node security groups and the aws-auth config map", joined, minSize, timeout.Duration)
+	default:
+		v1beta1conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupNodesJoinedCondition, expinfrav1.EKSNodegroupWaitingForNodesReason, clusterv1beta1.ConditionSeverityInfo,
+			"waiting for %d nodes to join the workload cluster", minSize)
+	}
+	return nil
+}
+
+// deferDisruptiveChange returns true if the change has to wait for the maintenance window of
+// the pool to open, in which case it's reported by reconcileDeferredChanges.
+func (s *NodegroupService) deferDisruptiveChange(change string) bool {
+	if s.maintenanceWindow == nil || s.maintenanceWindow.Contains(s.now()) {
+		return false
+	}
+	s.scope.Info("Deferring nodegroup change until the maintenance window", "change", change)
+	s.deferredChanges = append(s.deferredChanges, change)
+	return true
+}
+
+// reconcileDeferredChanges reports the disruptive changes deferred until the maintenance window
+// of the pool opens, and returns ErrNodegroupChangesDeferred so that they're retried.
+func (s *NodegroupService) reconcileDeferredChanges() error {
+	managedPool := s.scope.ManagedMachinePool
+	switch {
+	case s.maintenanceWindow == nil:
+		v1beta1conditions.Delete(managedPool, expinfrav1.EKSNodegroupDisruptiveChangesAppliedCondition)
+		return nil
+	case len(s.deferredChanges) == 0:
+		v1beta1conditions.MarkTrue(managedPool, expinfrav1.EKSNodegroupDisruptiveChangesAppliedCondition)
+		return nil
+	}
+
+	changes := strings.Join(s.deferredChanges, ", ")
+	next := s.maintenanceWindow.Next(s.now()).Format(time.RFC3339)
+	v1beta1conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupDisruptiveChangesAppliedCondition, expinfrav1.EKSNodegroupOutsideMaintenanceWindowReason, clusterv1beta1.ConditionSeverityInfo,
+		"%s deferred until the maintenance window opens at %s", changes, next)
+	return errors.Wrapf(ErrNodegroupChangesDeferred, "%s deferred until %s", changes, next)
+}
+
+// reconcileExternallyManagedReplicas keeps the MachinePool's replicas in sync with the
+// nodegroup's desired size when they're managed by an external autoscaler. If the replicas
+// were changed while the nodegroup's desired size wasn't, both the MachinePool and the
+// autoscaler claim the desired size. Neither is applied and a warning is reported until the
+// replicas match the nodegroup again, the annotation is removed or the autoscaler scales
+// the nodegroup.
+func (s *NodegroupService) reconcileExternallyManagedReplicas(ctx context.Context, ng *ekstypes.Nodegroup, previousDesiredSize *int32) error {
+	managedPool := s.scope.ManagedMachinePool
+	if !annotations.ReplicasManagedByExternalAutoscaler(s.scope.MachinePool) {
+		v1beta1conditions.Delete(managedPool, expinfrav1.EKSNodegroupReplicasSourceCondition)
+		return nil
+	}
+
+	if ng == nil || ng.ScalingConfig == nil || ng.ScalingConfig.DesiredSize == nil {
+		return nil
+	}
+	ngDesiredCapacity := ng.ScalingConfig.DesiredSize
+	replicas := s.scope.MachinePool.Spec.Replicas
+	if replicas != nil && *replicas == *ngDesiredCapacity {
+		v1beta1conditions.MarkTrue(managedPool, expinfrav1.EKSNodegroupReplicasSourceCondition)
+		return nil
+	}
+
+	if replicas != nil && previousDesiredSize != nil && *previousDesiredSize == *ngDesiredCapacity {
+		s.scope.Info("MachinePool replicas changed while managed by an external autoscaler",
+			"local", *replicas,
+			"external", *ngDesiredCapacity)
+		v1beta1conditions.MarkFalse(managedPool, expinfrav1.EKSNodegroupReplicasSourceCondition, expinfrav1.EKSNodegroupReplicasSourceConflictReason, clusterv1beta1.ConditionSeverityWarning,
+			"MachinePool replicas set to %d but managed by an external autoscaler with a desired size of %d; remove the %s annotation or stop setting replicas",
+			*replicas, *ngDesiredCapacity, clusterv1beta1.ReplicasManagedByAnnotation)
+		return nil
+	}
+
+	// Set MachinePool replicas to the node group DesiredCapacity
+	s.scope.Info("Setting MachinePool replicas to node group DesiredCapacity",
+		"local", ptr.Deref(replicas, 0),
+		"external", *ngDesiredCapacity)
+	s.scope.MachinePool.Spec.Replicas = ptr.To(*ngDesiredCapacity)
+	if err := s.scope.PatchCAPIMachinePoolObject(ctx); err != nil {
+		return err
+	}
+	v1beta1conditions.MarkTrue(managedPool, expinfrav1.EKSNodegroupReplicasSourceCondition)
+	return nil
+}
+
+// insufficientCapacityIssue returns the nodegroup health issue reporting that instances
+// couldn't be launched because of insufficient capacity, if any.
+func insufficientCapacityIssue(ng *ekstypes.Nodegroup) *ekstypes.Issue {
+	if ng.Health == nil {
+		return nil
+	}
+	for i, issue := range ng.Health.Issues {
+		if issue.Code == ekstypes.NodegroupIssueCodeAsgInstanceLaunchFailures && strings.Contains(aws.ToString(issue.Message), insufficientInstanceCapacity) {
+			return &ng.Health.Issues[i]
+		}
+	}
+	return nil
+}
+
+// nextFallbackInstanceType returns the fallback instance type to use after current, which is
+// empty while the launch template's instance type is used. It returns an empty string once
+// all the fallback instance types have been tried.
+func nextFallbackInstanceType(fallbackInstanceTypes []string, current string) string {
+	next := 0
+	if current != "" {
+		next = slices.Index(fallbackInstanceTypes, current) + 1
+	}
+	if next >= len(fallbackInstanceTypes) {
+		return ""
+	}
+	return fallbackInstanceTypes[next]
+}
+
+// reconcileInstanceTypeFallback switches the launch template to the next fallback instance
+// type when the nodegroup reports insufficient capacity, and back to the launch template's
+// own instance type once the cooldown has passed. The launch template and then the nodegroup
+// are updated with the selected instance type by the following reconciliations.
+func (s *NodegroupService) reconcileInstanceTypeFallback(ng *ekstypes.Nodegroup) {
+	managedPool := s.scope.ManagedMachinePool
+	fallback := managedPool.Spec.InstanceTypeFallback
+	if fallback == nil || managedPool.Spec.AWSLaunchTemplate == nil {
+		managedPool.Status.InstanceTypeFallback = nil
+		return
+	}
+
+	var current string
+	if status := managedPool.Status.InstanceTypeFallback; status != nil {
+		if !slices.Contains(fallback.InstanceTypes, status.InstanceType) {
+			// The instance type was removed from the fallback instance types.
+			managedPool.Status.InstanceTypeFallback = nil
+		} else {
+			current = status.InstanceType
+		}
+	}
+
+	issue := insufficientCapacityIssue(ng)
+	if issue == nil {
+		cooldown := defaultInstanceTypeFallbackCooldown
+		if fallback.Cooldown != nil {
+			cooldown = fallback.Cooldown.Duration
+		}
+		if status := managedPool.Status.InstanceTypeFallback; status != nil && time.Since(status.LastTransitionTime.Time) >= cooldown {
+			s.scope.Info("Retrying the launch template instance type after the fallback cooldown", "nodegroup", s.scope.NodegroupName(),
+				"instance-type", managedPool.Spec.AWSLaunchTemplate.InstanceType, "fallback-instance-type", current)
+			managedPool.Status.InstanceTypeFallback = nil
+		}
+		return
+	}
+
+	// The issue can still be about the previous instance type until the nodegroup uses the
+	// launch template version with the selected one.
+	statusVersion := managedPool.Status.LaunchTemplateVersion
+	if ng.Status != ekstypes.NodegroupStatusActive || ng.LaunchTemplate == nil || statusVersion == nil || aws.ToString(ng.LaunchTemplate.Version) != *statusVersion {
+		return
+	}
+
+	instanceType := managedPool.Spec.AWSLaunchTemplate.InstanceType
+	if current != "" {
+		instanceType = current
+	}
+	next := nextFallbackInstanceType(fallback.InstanceTypes, current)
+	if next == "" {
+		s.scope.Info("Nodegroup has insufficient capacity and no fallback instance type is left", "nodegroup", s.scope.NodegroupName(), "instance-type", instanceType)
+		return
+	}
+
+	s.scope.Info("Nodegroup has insufficient capacity, switching to a fallback instance type", "nodegroup", s.scope.NodegroupName(),
+		"instance-type", instanceType, "fallback-instance-type", next, "issue", aws.ToString(issue.Message))
+	record.Warnf(managedPool, "InstanceTypeFallback", "EKS nodegroup %s has insufficient capacity for instance type %s, falling back to %s", eventResource(s.scope.NodegroupName(), ng.NodegroupArn), instanceType, next)
+	managedPool.Status.InstanceTypeFallback = &expinfrav1.InstanceTypeFallbackStatus{
+		InstanceType:       next,
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// setBootstrapReadyReplicas reports the number of instances whose bootstrap data tagged them as
+// having a healthy kubelet. The instances are only described when the bootstrap config of the pool
+// reports the readiness, and a failure keeps the last reported count rather than failing the status.
+func (s *NodegroupService) setBootstrapReadyReplicas(ctx context.Context, groupNames []string) {
+	managedPool := s.scope.ManagedMachinePool
+	enabled, err := s.scope.ReportsBootstrapReadiness(ctx)
+	if err != nil {
+		s.Warn("Failed to check the bootstrap readiness reporting of the nodegroup", "nodegroup", s.scope.NodegroupName(), "error", err)
+		return
+	}
+	if !enabled {
+		managedPool.Status.BootstrapReadyReplicas = 0
+		return
+	}
+
+	ready, err := s.bootstrapReadyReplicas(ctx, groupNames)
+	if err != nil {
+		s.Warn("Failed to get the bootstrap readiness of the nodegroup instances", "nodegroup", s.scope.NodegroupName(), "error", err)
+		return
+	}
+	managedPool.Status.BootstrapReadyReplicas = ready
+}
+
+// bootstrapReadyReplicas returns the number of running instances of the given Auto Scaling groups
+// that have been tagged by their bootstrap data as having a healthy kubelet.
+func (s *NodegroupService) bootstrapReadyReplicas(ctx context.Context, groupNames []string) (int32, error) {
+	if len(groupNames) == 0 {
+		return 0, nil
+	}
+
+	input := &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("tag:aws:autoscaling:groupName"),
+				Values: groupNames,
+			},
+			{
+				Name:   aws.String("tag:" + infrav1.NodeBootstrapReadyTagKey),
+				Values: []string{"true"},
+			},
+			filter.EC2.InstanceStates(ec2types.InstanceStateNameRunning),
+		},
+	}
+
+	var ready int32
+	paginator := ec2.NewDescribeInstancesPaginator(s.EC2Client, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to describe instances")
+		}
+		for _, reservation := range out.Reservations {
+			ready += int32(len(reservation.Instances)) //#nosec G115
+		}
+	}
+	return ready, nil
+}
+
 func (s *NodegroupService) waitForNodegroupActive(ctx context.Context) (*ekstypes.Nodegroup, error) {
 	eksClusterName := s.scope.KubernetesClusterName()
 	eksNodegroupName := s.scope.NodegroupName()
@@ -634,7 +1965,7 @@ func (s *NodegroupService) waitForNodegroupActive(ctx context.Context) (*ekstype
 		ClusterName:   aws.String(eksClusterName),
 		NodegroupName: aws.String(eksNodegroupName),
 	}
-	if err := s.EKSClient.WaitUntilNodegroupActive(ctx, &req, s.scope.MaxWaitActiveUpdateDelete); err != nil {
+	if err := s.EKSClient.WaitUntilNodegroupActive(ctx, &req, s.scope.MaxWaitCreateUpdate); err != nil {
 		return nil, errors.Wrapf(err, "failed to wait for EKS nodegroup %q", *req.NodegroupName)
 	}
 
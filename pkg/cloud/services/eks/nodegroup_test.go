@@ -0,0 +1,3859 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/smithy-go"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kwait "k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	ekspkg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/helpers"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+func newTestNodegroupService(controlPlaneSpec ekscontrolplanev1.AWSManagedControlPlaneSpec, poolSpec expinfrav1.AWSManagedMachinePoolSpec) *NodegroupService {
+	log := logger.NewLogger(klog.Background())
+	return &NodegroupService{
+		scope: &scope.ManagedMachinePoolScope{
+			Logger: *log,
+			ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: controlPlaneSpec,
+			},
+			ManagedMachinePool: &expinfrav1.AWSManagedMachinePool{
+				Spec: poolSpec,
+			},
+		},
+		IAMService: iam.IAMService{
+			Wrapper: log,
+		},
+		backoff: apiBackoff(nil),
+		now:     time.Now,
+	}
+}
+
+func TestNodegroupLabelUpdateWithClusterDefaults(t *testing.T) {
+	testCases := []struct {
+		name             string
+		defaultLabels    map[string]string
+		poolLabels       map[string]string
+		currentLabels    map[string]string
+		expectedAddOrUpd map[string]string
+		expectedRemove   []string
+		expectNoUpdate   bool
+	}{
+		{
+			name:             "defaults are added to the nodegroup",
+			defaultLabels:    map[string]string{"team": "platform"},
+			poolLabels:       map[string]string{"workload": "batch"},
+			currentLabels:    map[string]string{"workload": "batch"},
+			expectedAddOrUpd: map[string]string{"team": "platform"},
+		},
+		{
+			name:             "pool label overrides default",
+			defaultLabels:    map[string]string{"env": "dev"},
+			poolLabels:       map[string]string{"env": "prod"},
+			currentLabels:    map[string]string{"env": "dev"},
+			expectedAddOrUpd: map[string]string{"env": "prod"},
+		},
+		{
+			name:             "removed default is removed from the nodegroup",
+			defaultLabels:    map[string]string{},
+			poolLabels:       map[string]string{"workload": "batch"},
+			currentLabels:    map[string]string{"workload": "batch", "team": "platform"},
+			expectedAddOrUpd: map[string]string{},
+			expectedRemove:   []string{"team"},
+		},
+		{
+			name:           "no update when defaults and pool labels match",
+			defaultLabels:  map[string]string{"team": "platform"},
+			poolLabels:     map[string]string{"workload": "batch"},
+			currentLabels:  map[string]string{"workload": "batch", "team": "platform"},
+			expectNoUpdate: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupService(
+				ekscontrolplanev1.AWSManagedControlPlaneSpec{DefaultNodeLabels: tc.defaultLabels},
+				expinfrav1.AWSManagedMachinePoolSpec{Labels: tc.poolLabels},
+			)
+			payload := createLabelUpdate(s.scope.NodeLabels(), &ekstypes.Nodegroup{Labels: tc.currentLabels})
+			if tc.expectNoUpdate {
+				g.Expect(payload).To(BeNil())
+				return
+			}
+			g.Expect(payload).NotTo(BeNil())
+			g.Expect(payload.AddOrUpdateLabels).To(Equal(tc.expectedAddOrUpd))
+			g.Expect(payload.RemoveLabels).To(Equal(tc.expectedRemove))
+		})
+	}
+}
+
+func TestNodegroupTaintsUpdateWithClusterDefaults(t *testing.T) {
+	testCases := []struct {
+		name             string
+		defaultTaints    expinfrav1.Taints
+		poolTaints       expinfrav1.Taints
+		currentTaints    []ekstypes.Taint
+		expectedAddOrUpd []ekstypes.Taint
+		expectedRemove   []ekstypes.Taint
+		expectNoUpdate   bool
+	}{
+		{
+			name: "default taint is added to the nodegroup",
+			defaultTaints: expinfrav1.Taints{
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoSchedule},
+			},
+			expectedAddOrUpd: []ekstypes.Taint{
+				{Key: aws.String("dedicated"), Value: aws.String("infra"), Effect: ekstypes.TaintEffectNoSchedule},
+			},
+		},
+		{
+			name: "pool taint overrides default taint",
+			defaultTaints: expinfrav1.Taints{
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoSchedule},
+			},
+			poolTaints: expinfrav1.Taints{
+				{Key: "dedicated", Value: "gpu", Effect: expinfrav1.TaintEffectNoSchedule},
+			},
+			currentTaints: []ekstypes.Taint{
+				{Key: aws.String("dedicated"), Value: aws.String("infra"), Effect: ekstypes.TaintEffectNoSchedule},
+			},
+			expectedAddOrUpd: []ekstypes.Taint{
+				{Key: aws.String("dedicated"), Value: aws.String("gpu"), Effect: ekstypes.TaintEffectNoSchedule},
+			},
+			expectedRemove: []ekstypes.Taint{
+				{Key: aws.String("dedicated"), Value: aws.String("infra"), Effect: ekstypes.TaintEffectNoSchedule},
+			},
+		},
+		{
+			name: "removed default taint is removed from the nodegroup",
+			currentTaints: []ekstypes.Taint{
+				{Key: aws.String("dedicated"), Value: aws.String("infra"), Effect: ekstypes.TaintEffectNoSchedule},
+			},
+			expectedRemove: []ekstypes.Taint{
+				{Key: aws.String("dedicated"), Value: aws.String("infra"), Effect: ekstypes.TaintEffectNoSchedule},
+			},
+		},
+		{
+			name: "no update when default taints match",
+			defaultTaints: expinfrav1.Taints{
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoSchedule},
+			},
+			currentTaints: []ekstypes.Taint{
+				{Key: aws.String("dedicated"), Value: aws.String("infra"), Effect: ekstypes.TaintEffectNoSchedule},
+			},
+			expectNoUpdate: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupService(
+				ekscontrolplanev1.AWSManagedControlPlaneSpec{DefaultNodeTaints: tc.defaultTaints},
+				expinfrav1.AWSManagedMachinePoolSpec{Taints: tc.poolTaints},
+			)
+			payload, err := s.createTaintsUpdate(s.scope.NodeTaints(), &ekstypes.Nodegroup{
+				NodegroupName: aws.String("ng"),
+				Taints:        tc.currentTaints,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expectNoUpdate {
+				g.Expect(payload).To(BeNil())
+				return
+			}
+			g.Expect(payload).NotTo(BeNil())
+			g.Expect(payload.AddOrUpdateTaints).To(Equal(tc.expectedAddOrUpd))
+			g.Expect(payload.RemoveTaints).To(Equal(tc.expectedRemove))
+		})
+	}
+}
+
+func TestNodegroupTaintLimitWithClusterDefaults(t *testing.T) {
+	g := NewWithT(t)
+
+	// Each list is within the limit on its own, but not once merged.
+	s := newTestNodegroupService(
+		ekscontrolplanev1.AWSManagedControlPlaneSpec{DefaultNodeTaints: helpers.Taints("default", 30)},
+		expinfrav1.AWSManagedMachinePoolSpec{EKSNodegroupName: "ng", Taints: helpers.Taints("pool", 30)},
+	)
+
+	err := s.reconcileNodegroup(context.TODO())
+	g.Expect(err).To(MatchError(ErrNodegroupTaintLimitExceeded))
+	g.Expect(err.Error()).To(ContainSubstring("60 taints"))
+}
+
+func TestNodegroupConfigUpdateIsDeterministic(t *testing.T) {
+	g := NewWithT(t)
+
+	s := newTestNodegroupService(
+		ekscontrolplanev1.AWSManagedControlPlaneSpec{},
+		expinfrav1.AWSManagedMachinePoolSpec{
+			Taints: expinfrav1.Taints{
+				{Key: "zone", Value: "a", Effect: expinfrav1.TaintEffectNoSchedule},
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoExecute},
+				{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoSchedule},
+			},
+		},
+	)
+	ng := &ekstypes.Nodegroup{
+		NodegroupName: aws.String("ng"),
+		Labels:        map[string]string{"d": "1", "b": "1", "c": "1", "a": "1"},
+		Taints: []ekstypes.Taint{
+			{Key: aws.String("old-b"), Value: aws.String("x"), Effect: ekstypes.TaintEffectNoSchedule},
+			{Key: aws.String("old-a"), Value: aws.String("x"), Effect: ekstypes.TaintEffectNoSchedule},
+		},
+	}
+
+	for range 10 {
+		labelPayload := createLabelUpdate(s.scope.NodeLabels(), ng)
+		g.Expect(labelPayload).NotTo(BeNil())
+		g.Expect(labelPayload.RemoveLabels).To(Equal([]string{"a", "b", "c", "d"}))
+
+		taintPayload, err := s.createTaintsUpdate(s.scope.NodeTaints(), ng)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(taintPayload).NotTo(BeNil())
+		g.Expect(taintPayload.AddOrUpdateTaints).To(Equal([]ekstypes.Taint{
+			{Key: aws.String("dedicated"), Value: aws.String("infra"), Effect: ekstypes.TaintEffectNoExecute},
+			{Key: aws.String("dedicated"), Value: aws.String("infra"), Effect: ekstypes.TaintEffectNoSchedule},
+			{Key: aws.String("zone"), Value: aws.String("a"), Effect: ekstypes.TaintEffectNoSchedule},
+		}))
+		g.Expect(taintPayload.RemoveTaints).To(Equal([]ekstypes.Taint{
+			{Key: aws.String("old-a"), Value: aws.String("x"), Effect: ekstypes.TaintEffectNoSchedule},
+			{Key: aws.String("old-b"), Value: aws.String("x"), Effect: ekstypes.TaintEffectNoSchedule},
+		}))
+	}
+}
+
+func TestNodegroupReplicasAvailable(t *testing.T) {
+	waiting := func(since time.Time) clusterv1beta1.Condition {
+		return clusterv1beta1.Condition{
+			Type:               expinfrav1.EKSNodegroupReplicasAvailableCondition,
+			Status:             corev1.ConditionFalse,
+			Reason:             expinfrav1.EKSNodegroupWaitingForReplicasReason,
+			Severity:           clusterv1beta1.ConditionSeverityInfo,
+			Message:            "waiting for 3 desired replicas to be InService",
+			LastTransitionTime: metav1.NewTime(since),
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		replicas       *int32
+		inService      int32
+		existing       *clusterv1beta1.Condition
+		expectedStatus corev1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name:      "no condition without desired replicas",
+			inService: 1,
+		},
+		{
+			name:           "desired replicas are InService",
+			replicas:       aws.Int32(3),
+			inService:      3,
+			expectedStatus: corev1.ConditionTrue,
+		},
+		{
+			name:           "new shortfall is within the grace period",
+			replicas:       aws.Int32(3),
+			inService:      1,
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: expinfrav1.EKSNodegroupWaitingForReplicasReason,
+		},
+		{
+			name:           "shortfall within the grace period is not flagged",
+			replicas:       aws.Int32(3),
+			inService:      2,
+			existing:       ptr.To(waiting(time.Now().Add(-time.Minute))),
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: expinfrav1.EKSNodegroupWaitingForReplicasReason,
+		},
+		{
+			name:           "shortfall past the grace period is flagged",
+			replicas:       aws.Int32(3),
+			inService:      2,
+			existing:       ptr.To(waiting(time.Now().Add(-replicaShortfallGracePeriod - time.Minute))),
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: expinfrav1.EKSNodegroupReplicaShortfallReason,
+		},
+		{
+			name:      "flagged shortfall stays flagged",
+			replicas:  aws.Int32(3),
+			inService: 1,
+			existing: &clusterv1beta1.Condition{
+				Type:               expinfrav1.EKSNodegroupReplicasAvailableCondition,
+				Status:             corev1.ConditionFalse,
+				Reason:             expinfrav1.EKSNodegroupReplicaShortfallReason,
+				LastTransitionTime: metav1.Now(),
+			},
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: expinfrav1.EKSNodegroupReplicaShortfallReason,
+		},
+		{
+			name:           "recovered shortfall is cleared",
+			replicas:       aws.Int32(3),
+			inService:      3,
+			existing:       ptr.To(waiting(time.Now().Add(-replicaShortfallGracePeriod - time.Minute))),
+			expectedStatus: corev1.ConditionTrue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{})
+			s.scope.MachinePool = &clusterv1.MachinePool{Spec: clusterv1.MachinePoolSpec{Replicas: tc.replicas}}
+			if tc.existing != nil {
+				s.scope.ManagedMachinePool.Status.Conditions = clusterv1beta1.Conditions{*tc.existing}
+			}
+
+			s.setReplicasAvailable(tc.inService)
+
+			condition := v1beta1conditions.Get(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupReplicasAvailableCondition)
+			if tc.expectedStatus == "" {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tc.expectedStatus))
+			g.Expect(condition.Reason).To(Equal(tc.expectedReason))
+		})
+	}
+}
+
+func TestNodegroupReconcileNodesJoined(t *testing.T) {
+	node := func(name, nodegroup string, ready corev1.ConditionStatus) client.Object {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{nodegroupNodeLabel: nodegroup}},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+			},
+		}
+	}
+	waiting := func(since time.Time) clusterv1beta1.Condition {
+		return clusterv1beta1.Condition{
+			Type:               expinfrav1.EKSNodegroupNodesJoinedCondition,
+			Status:             corev1.ConditionFalse,
+			Reason:             expinfrav1.EKSNodegroupWaitingForNodesReason,
+			Severity:           clusterv1beta1.ConditionSeverityInfo,
+			Message:            "waiting for 2 nodes to join the workload cluster",
+			LastTransitionTime: metav1.NewTime(since),
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		timeout        *metav1.Duration
+		status         ekstypes.NodegroupStatus
+		nodes          []client.Object
+		existing       *clusterv1beta1.Condition
+		expectedStatus corev1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name:   "no condition without a timeout",
+			status: ekstypes.NodegroupStatusActive,
+		},
+		{
+			name:    "not checked until the nodegroup is active",
+			timeout: &metav1.Duration{Duration: 10 * time.Minute},
+			status:  ekstypes.NodegroupStatusCreating,
+		},
+		{
+			name:    "minimum size of ready nodes joined",
+			timeout: &metav1.Duration{Duration: 10 * time.Minute},
+			status:  ekstypes.NodegroupStatusActive,
+			nodes: []client.Object{
+				node("node-1", "nodegroup", corev1.ConditionTrue),
+				node("node-2", "nodegroup", corev1.ConditionTrue),
+			},
+			expectedStatus: corev1.ConditionTrue,
+		},
+		{
+			name:    "insufficient nodes within the timeout",
+			timeout: &metav1.Duration{Duration: 10 * time.Minute},
+			status:  ekstypes.NodegroupStatusActive,
+			nodes: []client.Object{
+				node("node-1", "nodegroup", corev1.ConditionTrue),
+				node("node-2", "nodegroup", corev1.ConditionFalse),
+				node("node-3", "other-nodegroup", corev1.ConditionTrue),
+			},
+			existing:       ptr.To(waiting(time.Now().Add(-time.Minute))),
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: expinfrav1.EKSNodegroupWaitingForNodesReason,
+		},
+		{
+			name:    "insufficient nodes past the timeout",
+			timeout: &metav1.Duration{Duration: 10 * time.Minute},
+			status:  ekstypes.NodegroupStatusActive,
+			nodes: []client.Object{
+				node("node-1", "nodegroup", corev1.ConditionTrue),
+			},
+			existing:       ptr.To(waiting(time.Now().Add(-11 * time.Minute))),
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: expinfrav1.EKSNodegroupNodesNotJoinedReason,
+		},
+		{
+			name:           "nodes joining after the timeout clear the condition",
+			timeout:        &metav1.Duration{Duration: 10 * time.Minute},
+			status:         ekstypes.NodegroupStatusActive,
+			nodes:          []client.Object{node("node-1", "nodegroup", corev1.ConditionTrue), node("node-2", "nodegroup", corev1.ConditionTrue)},
+			existing:       ptr.To(waiting(time.Now().Add(-11 * time.Minute))),
+			expectedStatus: corev1.ConditionTrue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{
+				NodeJoinTimeout: tc.timeout,
+			})
+			s.remoteClient = func() (client.Client, error) {
+				return fake.NewClientBuilder().WithObjects(tc.nodes...).Build(), nil
+			}
+			if tc.existing != nil {
+				s.scope.ManagedMachinePool.Status.Conditions = clusterv1beta1.Conditions{*tc.existing}
+			}
+
+			err := s.reconcileNodesJoined(context.TODO(), &ekstypes.Nodegroup{
+				NodegroupName: aws.String("nodegroup"),
+				Status:        tc.status,
+				ScalingConfig: &ekstypes.NodegroupScalingConfig{MinSize: aws.Int32(2)},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			condition := v1beta1conditions.Get(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupNodesJoinedCondition)
+			if tc.expectedStatus == "" {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tc.expectedStatus))
+			g.Expect(condition.Reason).To(Equal(tc.expectedReason))
+		})
+	}
+}
+
+func TestNodegroupSetHealth(t *testing.T) {
+	testCases := []struct {
+		name            string
+		health          *ekstypes.NodegroupHealth
+		expectedStatus  corev1.ConditionStatus
+		expectedMessage string
+	}{
+		{
+			name:           "no health reported",
+			expectedStatus: corev1.ConditionTrue,
+		},
+		{
+			name:           "no health issues",
+			health:         &ekstypes.NodegroupHealth{},
+			expectedStatus: corev1.ConditionTrue,
+		},
+		{
+			name: "health issues are reported",
+			health: &ekstypes.NodegroupHealth{
+				Issues: []ekstypes.Issue{
+					{
+						Code:    ekstypes.NodegroupIssueCodeAsgInstanceLaunchFailures,
+						Message: aws.String("Could not launch On-Demand Instances."),
+					},
+					{
+						Code:    ekstypes.NodegroupIssueCodeEc2SubnetInvalidConfiguration,
+						Message: aws.String("One or more Amazon EC2 Subnets does not automatically assign public IP addresses."),
+					},
+				},
+			},
+			expectedStatus: corev1.ConditionFalse,
+			expectedMessage: "AsgInstanceLaunchFailures: Could not launch On-Demand Instances.; " +
+				"Ec2SubnetInvalidConfiguration: One or more Amazon EC2 Subnets does not automatically assign public IP addresses.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{})
+
+			s.setHealth(&ekstypes.Nodegroup{Health: tc.health})
+
+			condition := v1beta1conditions.Get(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupHealthyCondition)
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tc.expectedStatus))
+			g.Expect(condition.Message).To(Equal(tc.expectedMessage))
+			if tc.expectedStatus == corev1.ConditionFalse {
+				g.Expect(condition.Reason).To(Equal(expinfrav1.EKSNodegroupHealthIssuesReason))
+				g.Expect(condition.Severity).To(Equal(clusterv1beta1.ConditionSeverityWarning))
+			}
+		})
+	}
+}
+
+func TestNodegroupBootstrapReadyReplicas(t *testing.T) {
+	running := &ec2types.InstanceState{Name: ec2types.InstanceStateNameRunning}
+	expectedFilters := []ec2types.Filter{
+		{
+			Name:   aws.String("tag:aws:autoscaling:groupName"),
+			Values: []string{"eks-ng-asg"},
+		},
+		{
+			Name:   aws.String("tag:" + infrav1.NodeBootstrapReadyTagKey),
+			Values: []string{"true"},
+		},
+		{
+			Name:   aws.String("instance-state-name"),
+			Values: []string{"running"},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		groupNames  []string
+		expect      func(m *mocks.MockEC2APIMockRecorder)
+		expected    int32
+		expectError bool
+	}{
+		{
+			name:     "no groups",
+			expected: 0,
+		},
+		{
+			name:       "tagged running instances of every page are counted",
+			groupNames: []string{"eks-ng-asg"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstances(gomock.Any(), &ec2.DescribeInstancesInput{Filters: expectedFilters}, gomock.Any()).Return(&ec2.DescribeInstancesOutput{
+					Reservations: []ec2types.Reservation{
+						{Instances: []ec2types.Instance{{InstanceId: aws.String("i-1"), State: running}}},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.DescribeInstances(gomock.Any(), &ec2.DescribeInstancesInput{Filters: expectedFilters, NextToken: aws.String("next")}, gomock.Any()).Return(&ec2.DescribeInstancesOutput{
+					Reservations: []ec2types.Reservation{
+						{Instances: []ec2types.Instance{{InstanceId: aws.String("i-2"), State: running}, {InstanceId: aws.String("i-3"), State: running}}},
+					},
+				}, nil)
+			},
+			expected: 3,
+		},
+		{
+			name:       "describe error is returned",
+			groupNames: []string{"eks-ng-asg"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstances(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("boom"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{})
+			s.EC2Client = ec2Mock
+
+			ready, err := s.bootstrapReadyReplicas(context.TODO(), tc.groupNames)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(ready).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestNodegroupReconcileASGHealthCheckConfig(t *testing.T) {
+	asgName := "eks-ng-asg"
+	ng := &ekstypes.Nodegroup{
+		NodegroupName: aws.String("ng"),
+		Resources: &ekstypes.NodegroupResources{
+			AutoScalingGroups: []ekstypes.AutoScalingGroup{{Name: aws.String(asgName)}},
+		},
+	}
+	describeASG := func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder, gracePeriod, warmup *int32) {
+		m.DescribeAutoScalingGroups(gomock.Any(), &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []string{asgName},
+		}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []autoscalingtypes.AutoScalingGroup{
+				{
+					AutoScalingGroupName:   aws.String(asgName),
+					HealthCheckGracePeriod: gracePeriod,
+					DefaultInstanceWarmup:  warmup,
+				},
+			},
+		}, nil)
+	}
+
+	testCases := []struct {
+		name        string
+		gracePeriod *metav1.Duration
+		warmup      *metav1.Duration
+		expect      func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+	}{
+		{
+			name: "nothing to do when not configured",
+		},
+		{
+			name:        "applies grace period and warmup",
+			gracePeriod: &metav1.Duration{Duration: 5 * time.Minute},
+			warmup:      &metav1.Duration{Duration: time.Minute},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				describeASG(m, aws.Int32(15), nil)
+				m.UpdateAutoScalingGroup(gomock.Any(), &autoscaling.UpdateAutoScalingGroupInput{
+					AutoScalingGroupName:   aws.String(asgName),
+					HealthCheckGracePeriod: aws.Int32(300),
+					DefaultInstanceWarmup:  aws.Int32(60),
+				}).Return(&autoscaling.UpdateAutoScalingGroupOutput{}, nil)
+			},
+		},
+		{
+			name:        "updates only drifted values",
+			gracePeriod: &metav1.Duration{Duration: 5 * time.Minute},
+			warmup:      &metav1.Duration{Duration: 2 * time.Minute},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				describeASG(m, aws.Int32(300), aws.Int32(60))
+				m.UpdateAutoScalingGroup(gomock.Any(), &autoscaling.UpdateAutoScalingGroupInput{
+					AutoScalingGroupName:  aws.String(asgName),
+					DefaultInstanceWarmup: aws.Int32(120),
+				}).Return(&autoscaling.UpdateAutoScalingGroupOutput{}, nil)
+			},
+		},
+		{
+			name:        "no update when values match",
+			gracePeriod: &metav1.Duration{Duration: 5 * time.Minute},
+			warmup:      &metav1.Duration{Duration: time.Minute},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				describeASG(m, aws.Int32(300), aws.Int32(60))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(asgMock.EXPECT())
+			}
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{
+				HealthCheckGracePeriod: tc.gracePeriod,
+				DefaultInstanceWarmup:  tc.warmup,
+			})
+			s.AutoscalingClient = asgMock
+
+			g.Expect(s.reconcileASGHealthCheckConfig(context.TODO(), ng)).To(Succeed())
+		})
+	}
+}
+
+func TestNodegroupReconcileASGInstancesDistribution(t *testing.T) {
+	asgName := "eks-ng-asg"
+	spotNodegroup := &ekstypes.Nodegroup{
+		NodegroupName: aws.String("ng"),
+		CapacityType:  ekstypes.CapacityTypesSpot,
+		Resources: &ekstypes.NodegroupResources{
+			AutoScalingGroups: []ekstypes.AutoScalingGroup{{Name: aws.String(asgName)}},
+		},
+	}
+	describeASG := func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder, strategy string, baseCapacity int32) {
+		m.DescribeAutoScalingGroups(gomock.Any(), &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []string{asgName},
+		}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []autoscalingtypes.AutoScalingGroup{
+				{
+					AutoScalingGroupName: aws.String(asgName),
+					MixedInstancesPolicy: &autoscalingtypes.MixedInstancesPolicy{
+						InstancesDistribution: &autoscalingtypes.InstancesDistribution{
+							SpotAllocationStrategy: aws.String(strategy),
+							OnDemandBaseCapacity:   aws.Int32(baseCapacity),
+						},
+					},
+				},
+			},
+		}, nil)
+	}
+
+	testCases := []struct {
+		name         string
+		strategy     *expinfrav1.SpotAllocationStrategy
+		baseCapacity *int32
+		nodegroup    *ekstypes.Nodegroup
+		expect       func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+	}{
+		{
+			name:      "nothing to do when not configured",
+			nodegroup: spotNodegroup,
+		},
+		{
+			name:     "nothing to do for on-demand nodegroups",
+			strategy: ptr.To(expinfrav1.SpotAllocationStrategyCapacityOptimized),
+			nodegroup: &ekstypes.Nodegroup{
+				NodegroupName: aws.String("ng"),
+				CapacityType:  ekstypes.CapacityTypesOnDemand,
+			},
+		},
+		{
+			name:      "updates a drifted strategy",
+			strategy:  ptr.To(expinfrav1.SpotAllocationStrategyPriceCapacityOptimized),
+			nodegroup: spotNodegroup,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				describeASG(m, "capacity-optimized", 0)
+				m.UpdateAutoScalingGroup(gomock.Any(), &autoscaling.UpdateAutoScalingGroupInput{
+					AutoScalingGroupName: aws.String(asgName),
+					MixedInstancesPolicy: &autoscalingtypes.MixedInstancesPolicy{
+						InstancesDistribution: &autoscalingtypes.InstancesDistribution{
+							SpotAllocationStrategy: aws.String("price-capacity-optimized"),
+						},
+					},
+				}).Return(&autoscaling.UpdateAutoScalingGroupOutput{}, nil)
+			},
+		},
+		{
+			name:         "updates a drifted on-demand base capacity",
+			baseCapacity: ptr.To[int32](2),
+			nodegroup:    spotNodegroup,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				describeASG(m, "capacity-optimized", 0)
+				m.UpdateAutoScalingGroup(gomock.Any(), &autoscaling.UpdateAutoScalingGroupInput{
+					AutoScalingGroupName: aws.String(asgName),
+					MixedInstancesPolicy: &autoscalingtypes.MixedInstancesPolicy{
+						InstancesDistribution: &autoscalingtypes.InstancesDistribution{
+							OnDemandBaseCapacity: aws.Int32(2),
+						},
+					},
+				}).Return(&autoscaling.UpdateAutoScalingGroupOutput{}, nil)
+			},
+		},
+		{
+			name:         "no update when the distribution matches",
+			strategy:     ptr.To(expinfrav1.SpotAllocationStrategyCapacityOptimized),
+			baseCapacity: ptr.To[int32](0),
+			nodegroup:    spotNodegroup,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				describeASG(m, "capacity-optimized", 0)
+			},
+		},
+		{
+			name:      "no update when the strategy matches",
+			strategy:  ptr.To(expinfrav1.SpotAllocationStrategyCapacityOptimized),
+			nodegroup: spotNodegroup,
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				describeASG(m, "capacity-optimized", 0)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(asgMock.EXPECT())
+			}
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{
+				SpotAllocationStrategy: tc.strategy,
+				OnDemandBaseCapacity:   tc.baseCapacity,
+			})
+			s.AutoscalingClient = asgMock
+
+			g.Expect(s.reconcileASGInstancesDistribution(context.TODO(), tc.nodegroup)).To(Succeed())
+		})
+	}
+}
+
+func TestNodegroupReconcileASGSuspendedProcesses(t *testing.T) {
+	asgName := "eks-ng-asg"
+	ng := &ekstypes.Nodegroup{
+		NodegroupName: aws.String("ng"),
+		Resources: &ekstypes.NodegroupResources{
+			AutoScalingGroups: []ekstypes.AutoScalingGroup{{Name: aws.String(asgName)}},
+		},
+	}
+	describeASG := func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder, suspended ...string) {
+		group := autoscalingtypes.AutoScalingGroup{AutoScalingGroupName: aws.String(asgName)}
+		for _, process := range suspended {
+			group.SuspendedProcesses = append(group.SuspendedProcesses, autoscalingtypes.SuspendedProcess{ProcessName: aws.String(process)})
+		}
+		m.DescribeAutoScalingGroups(gomock.Any(), &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []string{asgName},
+		}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []autoscalingtypes.AutoScalingGroup{group},
+		}, nil)
+	}
+
+	testCases := []struct {
+		name    string
+		suspend *[]expinfrav1.ScalingProcess
+		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+	}{
+		{
+			name: "nothing to do when not configured",
+		},
+		{
+			name:    "suspends the configured processes",
+			suspend: &[]expinfrav1.ScalingProcess{expinfrav1.ScalingProcessTerminate, expinfrav1.ScalingProcessReplaceUnhealthy, expinfrav1.ScalingProcessAZRebalance},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				describeASG(m, "AZRebalance")
+				m.SuspendProcesses(gomock.Any(), &autoscaling.SuspendProcessesInput{
+					AutoScalingGroupName: aws.String(asgName),
+					ScalingProcesses:     []string{"ReplaceUnhealthy", "Terminate"},
+				}).Return(&autoscaling.SuspendProcessesOutput{}, nil)
+			},
+		},
+		{
+			name:    "no update when the configured processes are suspended",
+			suspend: &[]expinfrav1.ScalingProcess{expinfrav1.ScalingProcessTerminate, expinfrav1.ScalingProcessAZRebalance},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				describeASG(m, "AZRebalance", "Terminate")
+			},
+		},
+		{
+			name:    "resumes the processes that aren't configured",
+			suspend: &[]expinfrav1.ScalingProcess{expinfrav1.ScalingProcessAZRebalance},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				describeASG(m, "Terminate", "AZRebalance", "ReplaceUnhealthy")
+				m.ResumeProcesses(gomock.Any(), &autoscaling.ResumeProcessesInput{
+					AutoScalingGroupName: aws.String(asgName),
+					ScalingProcesses:     []string{"ReplaceUnhealthy", "Terminate"},
+				}).Return(&autoscaling.ResumeProcessesOutput{}, nil)
+			},
+		},
+		{
+			name:    "suspends and resumes processes when the configured set changes",
+			suspend: &[]expinfrav1.ScalingProcess{expinfrav1.ScalingProcessLaunch},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				describeASG(m, "AZRebalance")
+				gomock.InOrder(
+					m.SuspendProcesses(gomock.Any(), &autoscaling.SuspendProcessesInput{
+						AutoScalingGroupName: aws.String(asgName),
+						ScalingProcesses:     []string{"Launch"},
+					}).Return(&autoscaling.SuspendProcessesOutput{}, nil),
+					m.ResumeProcesses(gomock.Any(), &autoscaling.ResumeProcessesInput{
+						AutoScalingGroupName: aws.String(asgName),
+						ScalingProcesses:     []string{"AZRebalance"},
+					}).Return(&autoscaling.ResumeProcessesOutput{}, nil),
+				)
+			},
+		},
+		{
+			name:    "an empty list resumes all the processes",
+			suspend: &[]expinfrav1.ScalingProcess{},
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				describeASG(m, "Launch", "AZRebalance")
+				m.ResumeProcesses(gomock.Any(), &autoscaling.ResumeProcessesInput{
+					AutoScalingGroupName: aws.String(asgName),
+					ScalingProcesses:     []string{"AZRebalance", "Launch"},
+				}).Return(&autoscaling.ResumeProcessesOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(asgMock.EXPECT())
+			}
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{
+				SuspendProcesses: tc.suspend,
+			})
+			s.AutoscalingClient = asgMock
+
+			g.Expect(s.reconcileASGSuspendedProcesses(context.TODO(), ng)).To(Succeed())
+		})
+	}
+}
+
+func TestNodegroupValidateWindowsSupport(t *testing.T) {
+	vpcCNIConfig := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "amazon-vpc-cni"},
+			Data:       data,
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		amiType     *expinfrav1.ManagedMachineAMIType
+		objects     []client.Object
+		expectedErr error
+	}{
+		{
+			name:    "linux nodegroups aren't checked",
+			amiType: ptr.To(expinfrav1.Al2023x86_64),
+		},
+		{
+			name:    "windows support enabled",
+			amiType: ptr.To(expinfrav1.WindowsCore2022x86_64),
+			objects: []client.Object{vpcCNIConfig(map[string]string{"enable-windows-ipam": "true"})},
+		},
+		{
+			name:        "windows support disabled",
+			amiType:     ptr.To(expinfrav1.WindowsCore2022x86_64),
+			objects:     []client.Object{vpcCNIConfig(map[string]string{"enable-windows-ipam": "false"})},
+			expectedErr: ErrNodegroupWindowsSupportDisabled,
+		},
+		{
+			name:        "vpc cni config map missing",
+			amiType:     ptr.To(expinfrav1.WindowsFull2019x86_64),
+			expectedErr: ErrNodegroupWindowsSupportDisabled,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{
+				AMIType: tc.amiType,
+			})
+			s.remoteClient = func() (client.Client, error) {
+				return fake.NewClientBuilder().WithObjects(tc.objects...).Build(), nil
+			}
+
+			err := s.validateWindowsSupport(context.TODO())
+			if tc.expectedErr != nil {
+				g.Expect(errors.Is(err, tc.expectedErr)).To(BeTrue())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestNodegroupClusterSecurityGroupID(t *testing.T) {
+	controlPlaneSpec := ekscontrolplanev1.AWSManagedControlPlaneSpec{
+		NetworkSpec: infrav1.NetworkSpec{
+			VPC: infrav1.VPCSpec{ID: "vpc-cluster"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		override    *infrav1.AWSResourceReference
+		expect      func(m *mocks.MockEC2APIMockRecorder)
+		expectedID  string
+		expectedErr bool
+	}{
+		{
+			name:       "no override falls back to the cluster security group",
+			expect:     func(m *mocks.MockEC2APIMockRecorder) {},
+			expectedID: "sg-cluster",
+		},
+		{
+			name:     "override by ID",
+			override: &infrav1.AWSResourceReference{ID: aws.String("sg-override")},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeSecurityGroups(gomock.Any(), &ec2.DescribeSecurityGroupsInput{
+					GroupIds: []string{"sg-override"},
+				}).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []ec2types.SecurityGroup{{GroupId: aws.String("sg-override"), VpcId: aws.String("vpc-cluster")}},
+				}, nil)
+			},
+			expectedID: "sg-override",
+		},
+		{
+			name: "override by tag",
+			override: &infrav1.AWSResourceReference{Filters: []infrav1.Filter{
+				{Name: "tag:role", Values: []string{"nodes"}},
+			}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeSecurityGroups(gomock.Any(), &ec2.DescribeSecurityGroupsInput{
+					Filters: []ec2types.Filter{{Name: aws.String("tag:role"), Values: []string{"nodes"}}},
+				}).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []ec2types.SecurityGroup{{GroupId: aws.String("sg-tagged"), VpcId: aws.String("vpc-cluster")}},
+				}, nil)
+			},
+			expectedID: "sg-tagged",
+		},
+		{
+			name:     "override outside the cluster vpc",
+			override: &infrav1.AWSResourceReference{ID: aws.String("sg-other")},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeSecurityGroups(gomock.Any(), gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []ec2types.SecurityGroup{{GroupId: aws.String("sg-other"), VpcId: aws.String("vpc-other")}},
+				}, nil)
+			},
+			expectedErr: true,
+		},
+		{
+			name: "override matching several security groups",
+			override: &infrav1.AWSResourceReference{Filters: []infrav1.Filter{
+				{Name: "tag:role", Values: []string{"nodes"}},
+			}},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeSecurityGroups(gomock.Any(), gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []ec2types.SecurityGroup{
+						{GroupId: aws.String("sg-1"), VpcId: aws.String("vpc-cluster")},
+						{GroupId: aws.String("sg-2"), VpcId: aws.String("vpc-cluster")},
+					},
+				}, nil)
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			s := newTestNodegroupService(controlPlaneSpec, expinfrav1.AWSManagedMachinePoolSpec{
+				ClusterSecurityGroupOverride: tc.override,
+			})
+			s.scope.ControlPlane.Status.Network.SecurityGroups = map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+				ekscontrolplanev1.SecurityGroupCluster: {ID: "sg-cluster"},
+			}
+			s.EC2Client = ec2Mock
+
+			id, err := s.clusterSecurityGroupID(context.TODO())
+			if tc.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(id).To(Equal(tc.expectedID))
+		})
+	}
+}
+
+func TestNodegroupResolveExternalLaunchTemplate(t *testing.T) {
+	const templateID = "lt-0123456789abcdef0"
+
+	tests := []struct {
+		name            string
+		version         *string
+		expect          func(m *mocks.MockEC2APIMockRecorder)
+		expectedVersion string
+		expectedErr     bool
+	}{
+		{
+			name: "default version is used when no version is set",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersions(gomock.Any(), &ec2.DescribeLaunchTemplateVersionsInput{
+					LaunchTemplateId: aws.String(templateID),
+					Versions:         []string{"$Default"},
+				}).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+					LaunchTemplateVersions: []ec2types.LaunchTemplateVersion{
+						{LaunchTemplateId: aws.String(templateID), VersionNumber: aws.Int64(2)},
+					},
+				}, nil)
+			},
+			expectedVersion: "2",
+		},
+		{
+			name:    "latest version is resolved to its number",
+			version: aws.String("$Latest"),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersions(gomock.Any(), &ec2.DescribeLaunchTemplateVersionsInput{
+					LaunchTemplateId: aws.String(templateID),
+					Versions:         []string{"$Latest"},
+				}).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+					LaunchTemplateVersions: []ec2types.LaunchTemplateVersion{
+						{LaunchTemplateId: aws.String(templateID), VersionNumber: aws.Int64(7)},
+					},
+				}, nil)
+			},
+			expectedVersion: "7",
+		},
+		{
+			name:    "missing launch template is an error",
+			version: aws.String("3"),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersions(gomock.Any(), gomock.Any()).Return(nil, errors.New("InvalidLaunchTemplateId.NotFound"))
+			},
+			expectedErr: true,
+		},
+		{
+			name:    "missing launch template version is an error",
+			version: aws.String("3"),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersions(gomock.Any(), gomock.Any()).Return(&ec2.DescribeLaunchTemplateVersionsOutput{}, nil)
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			ref := &expinfrav1.ExternalLaunchTemplate{ID: templateID, Version: tc.version}
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{
+				ExternalLaunchTemplate: ref,
+			})
+			s.EC2Client = ec2Mock
+
+			_, err := s.resolveExternalLaunchTemplate(context.TODO(), ref)
+			if tc.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(s.scope.ManagedMachinePool.Status.LaunchTemplateID).To(BeNil())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(s.scope.ManagedMachinePool.Status.LaunchTemplateID).To(Equal(aws.String(templateID)))
+			g.Expect(s.scope.ManagedMachinePool.Status.LaunchTemplateVersion).To(Equal(aws.String(tc.expectedVersion)))
+		})
+	}
+}
+
+func TestNodegroupVersionFollowsExternalLaunchTemplate(t *testing.T) {
+	const templateID = "lt-0123456789abcdef0"
+
+	tests := []struct {
+		name             string
+		nodegroupVersion string
+		resolvedVersion  int64
+		expectUpdate     bool
+	}{
+		{
+			name:             "nodegroup is updated to the new launch template version",
+			nodegroupVersion: "1",
+			resolvedVersion:  2,
+			expectUpdate:     true,
+		},
+		{
+			name:             "nodegroup already uses the launch template version",
+			nodegroupVersion: "2",
+			resolvedVersion:  2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+
+			ec2Mock.EXPECT().DescribeLaunchTemplateVersions(gomock.Any(), gomock.Any()).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+				LaunchTemplateVersions: []ec2types.LaunchTemplateVersion{
+					{LaunchTemplateId: aws.String(templateID), VersionNumber: aws.Int64(tc.resolvedVersion)},
+				},
+			}, nil)
+			if tc.expectUpdate {
+				eksMock.EXPECT().UpdateNodegroupVersion(gomock.Any(), &eks.UpdateNodegroupVersionInput{
+					ClusterName:   aws.String("cluster"),
+					NodegroupName: aws.String("nodegroup"),
+					LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+						Id:      aws.String(templateID),
+						Version: aws.String("2"),
+					},
+				}).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+			}
+
+			ref := &expinfrav1.ExternalLaunchTemplate{ID: templateID, Version: aws.String("$Latest")}
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "cluster"}, expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName:       "nodegroup",
+				ExternalLaunchTemplate: ref,
+			})
+			s.scope.MachinePool = &clusterv1.MachinePool{}
+			s.EC2Client = ec2Mock
+			s.EKSClient = eksMock
+
+			_, err := s.resolveExternalLaunchTemplate(context.TODO(), ref)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			ng := &ekstypes.Nodegroup{
+				Version:        aws.String("1.30"),
+				ReleaseVersion: aws.String("1.30.0-20240101"),
+				LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+					Id:      aws.String(templateID),
+					Version: aws.String(tc.nodegroupVersion),
+				},
+			}
+			updated, err := s.reconcileNodegroupVersion(context.TODO(), ng)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(updated).To(Equal(tc.expectUpdate))
+		})
+	}
+}
+
+func TestNodegroupValidateLaunchTemplateInstanceProfile(t *testing.T) {
+	const (
+		templateID = "lt-0123456789abcdef0"
+		nodeRole   = "arn:aws:iam::123456789012:role/nodes"
+	)
+
+	tests := []struct {
+		name            string
+		instanceProfile *ec2types.LaunchTemplateIamInstanceProfileSpecification
+		profileName     string
+		profileRoles    []iamtypes.Role
+		expectedErr     error
+	}{
+		{
+			name: "launch template without an instance profile",
+		},
+		{
+			name:            "instance profile name with the node role",
+			instanceProfile: &ec2types.LaunchTemplateIamInstanceProfileSpecification{Name: aws.String("nodes-profile")},
+			profileName:     "nodes-profile",
+			profileRoles:    []iamtypes.Role{{RoleName: aws.String("nodes"), Arn: aws.String(nodeRole)}},
+		},
+		{
+			name:            "instance profile ARN with the node role",
+			instanceProfile: &ec2types.LaunchTemplateIamInstanceProfileSpecification{Arn: aws.String("arn:aws:iam::123456789012:instance-profile/eks/nodes-profile")},
+			profileName:     "nodes-profile",
+			profileRoles:    []iamtypes.Role{{RoleName: aws.String("nodes"), Arn: aws.String(nodeRole)}},
+		},
+		{
+			name:            "instance profile with another role",
+			instanceProfile: &ec2types.LaunchTemplateIamInstanceProfileSpecification{Name: aws.String("other-profile")},
+			profileName:     "other-profile",
+			profileRoles:    []iamtypes.Role{{RoleName: aws.String("other"), Arn: aws.String("arn:aws:iam::123456789012:role/other")}},
+			expectedErr:     ErrNodegroupInstanceProfileMismatch,
+		},
+		{
+			name:            "instance profile without a role",
+			instanceProfile: &ec2types.LaunchTemplateIamInstanceProfileSpecification{Name: aws.String("empty-profile")},
+			profileName:     "empty-profile",
+			expectedErr:     ErrNodegroupInstanceProfileMismatch,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+
+			if tc.profileName != "" {
+				iamMock.EXPECT().GetInstanceProfile(gomock.Any(), &awsiam.GetInstanceProfileInput{
+					InstanceProfileName: aws.String(tc.profileName),
+				}).Return(&awsiam.GetInstanceProfileOutput{
+					InstanceProfile: &iamtypes.InstanceProfile{
+						InstanceProfileName: aws.String(tc.profileName),
+						Roles:               tc.profileRoles,
+					},
+				}, nil)
+				iamMock.EXPECT().GetRole(gomock.Any(), &awsiam.GetRoleInput{RoleName: aws.String("nodes")}).Return(&awsiam.GetRoleOutput{
+					Role: &iamtypes.Role{RoleName: aws.String("nodes"), Arn: aws.String(nodeRole)},
+				}, nil)
+			}
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{
+				RoleName: "nodes",
+			})
+			s.IAMClient = iamMock
+
+			lt := &ec2types.LaunchTemplateVersion{
+				LaunchTemplateId: aws.String(templateID),
+				LaunchTemplateData: &ec2types.ResponseLaunchTemplateData{
+					IamInstanceProfile: tc.instanceProfile,
+				},
+			}
+			err := s.validateLaunchTemplateInstanceProfile(context.TODO(), lt)
+			if tc.expectedErr != nil {
+				g.Expect(err).To(MatchError(tc.expectedErr))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestNodegroupReconcileTags(t *testing.T) {
+	asgName := "eks-ng-asg"
+	ngArn := "arn:aws:eks:us-east-1:123456789012:nodegroup/eks-cluster/ng/1"
+	ownedTag := infrav1.ClusterAWSCloudProviderTagKey("eks-cluster")
+	ng := &ekstypes.Nodegroup{
+		NodegroupName: aws.String("ng"),
+		NodegroupArn:  aws.String(ngArn),
+		Tags: map[string]string{
+			ownedTag: string(infrav1.ResourceLifecycleOwned),
+			"team":   "a",
+			"stale":  "true",
+		},
+		Resources: &ekstypes.NodegroupResources{
+			AutoScalingGroups: []ekstypes.AutoScalingGroup{{Name: aws.String(asgName)}},
+		},
+	}
+
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+	asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+
+	eksMock.EXPECT().TagResource(gomock.Any(), &eks.TagResourceInput{
+		ResourceArn: aws.String(ngArn),
+		Tags:        map[string]string{"team": "b"},
+	}).Return(&eks.TagResourceOutput{}, nil)
+	eksMock.EXPECT().UntagResource(gomock.Any(), &eks.UntagResourceInput{
+		ResourceArn: aws.String(ngArn),
+		TagKeys:     []string{"stale"},
+	}).Return(&eks.UntagResourceOutput{}, nil)
+
+	asgMock.EXPECT().DescribeAutoScalingGroups(gomock.Any(), &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{asgName},
+	}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []autoscalingtypes.AutoScalingGroup{
+			{
+				AutoScalingGroupName: aws.String(asgName),
+				Tags: []autoscalingtypes.TagDescription{
+					{Key: aws.String(eksClusterNameTag), Value: aws.String("eks-cluster")},
+					{Key: aws.String(ownedTag), Value: aws.String(string(infrav1.ResourceLifecycleOwned)), PropagateAtLaunch: aws.Bool(true)},
+					{Key: aws.String("team"), Value: aws.String("a"), PropagateAtLaunch: aws.Bool(true)},
+					{Key: aws.String("stale"), Value: aws.String("true")},
+				},
+			},
+		},
+	}, nil)
+	asgMock.EXPECT().CreateOrUpdateTags(gomock.Any(), &autoscaling.CreateOrUpdateTagsInput{
+		Tags: []autoscalingtypes.Tag{
+			{
+				Key:               aws.String("team"),
+				PropagateAtLaunch: aws.Bool(true),
+				ResourceId:        aws.String(asgName),
+				ResourceType:      aws.String("auto-scaling-group"),
+				Value:             aws.String("b"),
+			},
+		},
+	}).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil)
+	asgMock.EXPECT().DeleteTags(gomock.Any(), &autoscaling.DeleteTagsInput{
+		Tags: []autoscalingtypes.Tag{
+			{
+				Key:          aws.String("stale"),
+				ResourceId:   aws.String(asgName),
+				ResourceType: aws.String("auto-scaling-group"),
+			},
+		},
+	}).Return(&autoscaling.DeleteTagsOutput{}, nil)
+
+	controlPlaneSpec := ekscontrolplanev1.AWSManagedControlPlaneSpec{
+		EKSClusterName: "eks-cluster",
+		AdditionalTags: infrav1.Tags{"team": "b"},
+	}
+	s := newTestNodegroupService(controlPlaneSpec, expinfrav1.AWSManagedMachinePoolSpec{})
+	s.scope.EC2Scope = &scope.ManagedControlPlaneScope{ControlPlane: s.scope.ControlPlane}
+	s.EKSClient = eksMock
+	s.AutoscalingClient = asgMock
+
+	g.Expect(s.reconcileTags(context.TODO(), ng)).To(Succeed())
+}
+
+func TestNodegroupASGTagsPropagateAtLaunch(t *testing.T) {
+	asgName := "eks-ng-asg"
+	ownedTag := infrav1.ClusterAWSCloudProviderTagKey("eks-cluster")
+	ng := &ekstypes.Nodegroup{
+		NodegroupName: aws.String("ng"),
+		NodegroupArn:  aws.String("arn:aws:eks:us-east-1:123456789012:nodegroup/eks-cluster/ng/1"),
+		Tags: map[string]string{
+			ownedTag:      string(infrav1.ResourceLifecycleOwned),
+			"cost-center": "42",
+			"team":        "a",
+		},
+		Resources: &ekstypes.NodegroupResources{
+			AutoScalingGroups: []ekstypes.AutoScalingGroup{{Name: aws.String(asgName)}},
+		},
+	}
+
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+	asgMock.EXPECT().DescribeAutoScalingGroups(gomock.Any(), gomock.Any()).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []autoscalingtypes.AutoScalingGroup{
+			{
+				AutoScalingGroupName: aws.String(asgName),
+				Tags: []autoscalingtypes.TagDescription{
+					{Key: aws.String(eksClusterNameTag), Value: aws.String("eks-cluster"), PropagateAtLaunch: aws.Bool(false)},
+					{Key: aws.String(ownedTag), Value: aws.String(string(infrav1.ResourceLifecycleOwned)), PropagateAtLaunch: aws.Bool(false)},
+					{Key: aws.String("team"), Value: aws.String("a"), PropagateAtLaunch: aws.Bool(true)},
+				},
+			},
+		},
+	}, nil)
+	// The cluster-owned tag and the additional tags are propagated at launch, including the
+	// ones already set on the ASG without being propagated. The tags set by EKS are left as is.
+	asgMock.EXPECT().CreateOrUpdateTags(gomock.Any(), &autoscaling.CreateOrUpdateTagsInput{
+		Tags: []autoscalingtypes.Tag{
+			{
+				Key:               aws.String("cost-center"),
+				PropagateAtLaunch: aws.Bool(true),
+				ResourceId:        aws.String(asgName),
+				ResourceType:      aws.String("auto-scaling-group"),
+				Value:             aws.String("42"),
+			},
+			{
+				Key:               aws.String(ownedTag),
+				PropagateAtLaunch: aws.Bool(true),
+				ResourceId:        aws.String(asgName),
+				ResourceType:      aws.String("auto-scaling-group"),
+				Value:             aws.String(string(infrav1.ResourceLifecycleOwned)),
+			},
+		},
+	}).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil)
+
+	controlPlaneSpec := ekscontrolplanev1.AWSManagedControlPlaneSpec{
+		EKSClusterName: "eks-cluster",
+		AdditionalTags: infrav1.Tags{"team": "a", "cost-center": "42"},
+	}
+	s := newTestNodegroupService(controlPlaneSpec, expinfrav1.AWSManagedMachinePoolSpec{})
+	s.scope.EC2Scope = &scope.ManagedControlPlaneScope{ControlPlane: s.scope.ControlPlane}
+	s.EKSClient = mock_eksiface.NewMockEKSAPI(mockCtrl)
+	s.AutoscalingClient = asgMock
+
+	g.Expect(s.reconcileTags(context.TODO(), ng)).To(Succeed())
+}
+
+func TestNodegroupReconcileTagsClusterAutoscalerExclusion(t *testing.T) {
+	asgName := "eks-ng-asg"
+	ownedTag := infrav1.ClusterAWSCloudProviderTagKey("eks-cluster")
+	discoveryTag := "k8s.io/cluster-autoscaler/eks-cluster"
+	asgTag := func(key, value string) autoscalingtypes.Tag {
+		return autoscalingtypes.Tag{
+			Key:               aws.String(key),
+			PropagateAtLaunch: aws.Bool(true),
+			ResourceId:        aws.String(asgName),
+			ResourceType:      aws.String("auto-scaling-group"),
+			Value:             aws.String(value),
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		exclude    bool
+		asgTags    map[string]string
+		wantCreate []autoscalingtypes.Tag
+		wantDelete []string
+	}{
+		{
+			name:    "not excluded keeps the tags set by EKS",
+			asgTags: map[string]string{eksClusterAutoscalerEnabledTag: "true", discoveryTag: "owned"},
+		},
+		{
+			name:       "excluded disables the ASG and removes the discovery tag",
+			exclude:    true,
+			asgTags:    map[string]string{eksClusterAutoscalerEnabledTag: "true", discoveryTag: "owned"},
+			wantCreate: []autoscalingtypes.Tag{asgTag(eksClusterAutoscalerEnabledTag, "false")},
+			wantDelete: []string{discoveryTag},
+		},
+		{
+			name:    "excluded ASG is left as is",
+			exclude: true,
+			asgTags: map[string]string{eksClusterAutoscalerEnabledTag: "false"},
+		},
+		{
+			name:    "removing the exclusion restores the tags set by EKS",
+			asgTags: map[string]string{eksClusterAutoscalerEnabledTag: "false"},
+			wantCreate: []autoscalingtypes.Tag{
+				asgTag(discoveryTag, "owned"),
+				asgTag(eksClusterAutoscalerEnabledTag, "true"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			asgTags := []autoscalingtypes.TagDescription{
+				{Key: aws.String(ownedTag), Value: aws.String(string(infrav1.ResourceLifecycleOwned)), PropagateAtLaunch: aws.Bool(true)},
+			}
+			for k, v := range tc.asgTags {
+				asgTags = append(asgTags, autoscalingtypes.TagDescription{Key: aws.String(k), Value: aws.String(v), PropagateAtLaunch: aws.Bool(true)})
+			}
+
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			asgMock.EXPECT().DescribeAutoScalingGroups(gomock.Any(), gomock.Any()).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+				AutoScalingGroups: []autoscalingtypes.AutoScalingGroup{{AutoScalingGroupName: aws.String(asgName), Tags: asgTags}},
+			}, nil)
+			if len(tc.wantCreate) > 0 {
+				asgMock.EXPECT().CreateOrUpdateTags(gomock.Any(), &autoscaling.CreateOrUpdateTagsInput{Tags: tc.wantCreate}).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil)
+			}
+			if len(tc.wantDelete) > 0 {
+				input := &autoscaling.DeleteTagsInput{}
+				for _, k := range tc.wantDelete {
+					input.Tags = append(input.Tags, autoscalingtypes.Tag{
+						Key:          aws.String(k),
+						ResourceId:   aws.String(asgName),
+						ResourceType: aws.String("auto-scaling-group"),
+					})
+				}
+				asgMock.EXPECT().DeleteTags(gomock.Any(), input).Return(&autoscaling.DeleteTagsOutput{}, nil)
+			}
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "eks-cluster",
+			}, expinfrav1.AWSManagedMachinePoolSpec{
+				ExcludeFromClusterAutoscaler: tc.exclude,
+			})
+			s.scope.EC2Scope = &scope.ManagedControlPlaneScope{ControlPlane: s.scope.ControlPlane}
+			s.EKSClient = mock_eksiface.NewMockEKSAPI(mockCtrl)
+			s.AutoscalingClient = asgMock
+
+			ng := &ekstypes.Nodegroup{
+				NodegroupName: aws.String("ng"),
+				NodegroupArn:  aws.String("arn:aws:eks:us-east-1:123456789012:nodegroup/eks-cluster/ng/1"),
+				Tags:          map[string]string{ownedTag: string(infrav1.ResourceLifecycleOwned)},
+				Resources: &ekstypes.NodegroupResources{
+					AutoScalingGroups: []ekstypes.AutoScalingGroup{{Name: aws.String(asgName)}},
+				},
+			}
+			g.Expect(s.reconcileTags(context.TODO(), ng)).To(Succeed())
+		})
+	}
+}
+
+func TestNodegroupReconcileIgnoresOrdering(t *testing.T) {
+	asgName := "eks-ng-asg"
+	ownedTag := infrav1.ClusterAWSCloudProviderTagKey("eks-cluster")
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	// The mocks fail the test on any update, only the ordering differs from the spec.
+	asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+	asgMock.EXPECT().DescribeAutoScalingGroups(gomock.Any(), gomock.Any()).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []autoscalingtypes.AutoScalingGroup{
+			{
+				AutoScalingGroupName: aws.String(asgName),
+				Tags: []autoscalingtypes.TagDescription{
+					{Key: aws.String("team"), Value: aws.String("a"), PropagateAtLaunch: aws.Bool(true)},
+					{Key: aws.String(eksClusterNameTag), Value: aws.String("eks-cluster"), PropagateAtLaunch: aws.Bool(false)},
+					{Key: aws.String(ownedTag), Value: aws.String(string(infrav1.ResourceLifecycleOwned)), PropagateAtLaunch: aws.Bool(true)},
+					{Key: aws.String("cost-center"), Value: aws.String("42"), PropagateAtLaunch: aws.Bool(true)},
+				},
+			},
+		},
+	}, nil)
+
+	s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{
+		EKSClusterName: "eks-cluster",
+		AdditionalTags: infrav1.Tags{"cost-center": "42", "team": "a"},
+	}, expinfrav1.AWSManagedMachinePoolSpec{
+		EKSNodegroupName: "ng",
+		Labels:           map[string]string{"zone": "a", "team": "platform"},
+		Taints: expinfrav1.Taints{
+			{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoSchedule},
+			{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoExecute},
+			{Key: "arch", Value: "arm64", Effect: expinfrav1.TaintEffectNoSchedule},
+		},
+	})
+	s.scope.EC2Scope = &scope.ManagedControlPlaneScope{ControlPlane: s.scope.ControlPlane}
+	s.scope.MachinePool = &clusterv1.MachinePool{Spec: clusterv1.MachinePoolSpec{Replicas: ptr.To[int32](3)}}
+	s.EKSClient = mock_eksiface.NewMockEKSAPI(mockCtrl)
+	s.AutoscalingClient = asgMock
+
+	ng := &ekstypes.Nodegroup{
+		NodegroupName: aws.String("ng"),
+		NodegroupArn:  aws.String("arn:aws:eks:us-east-1:123456789012:nodegroup/eks-cluster/ng/1"),
+		Labels:        map[string]string{"team": "platform", "zone": "a"},
+		Taints: []ekstypes.Taint{
+			{Key: aws.String("arch"), Value: aws.String("arm64"), Effect: ekstypes.TaintEffectNoSchedule},
+			{Key: aws.String("dedicated"), Value: aws.String("infra"), Effect: ekstypes.TaintEffectNoExecute},
+			{Key: aws.String("dedicated"), Value: aws.String("infra"), Effect: ekstypes.TaintEffectNoSchedule},
+		},
+		Tags: map[string]string{
+			"team":        "a",
+			ownedTag:      string(infrav1.ResourceLifecycleOwned),
+			"cost-center": "42",
+		},
+		ScalingConfig:    &ekstypes.NodegroupScalingConfig{DesiredSize: aws.Int32(3)},
+		NodeRepairConfig: &ekstypes.NodeRepairConfig{Enabled: aws.Bool(false)},
+		Resources: &ekstypes.NodegroupResources{
+			AutoScalingGroups: []ekstypes.AutoScalingGroup{{Name: aws.String(asgName)}},
+		},
+	}
+
+	g.Expect(s.reconcileNodegroupConfig(context.TODO(), ng)).To(Succeed())
+	g.Expect(s.reconcileTags(context.TODO(), ng)).To(Succeed())
+}
+
+func TestNodegroupSetStatusScalingConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ng       *ekstypes.Nodegroup
+		expected *expinfrav1.NodegroupScalingStatus
+	}{
+		{
+			name: "scaling config of a nodegroup being created",
+			ng: &ekstypes.Nodegroup{
+				Status: ekstypes.NodegroupStatusCreating,
+				ScalingConfig: &ekstypes.NodegroupScalingConfig{
+					MinSize:     aws.Int32(1),
+					MaxSize:     aws.Int32(5),
+					DesiredSize: aws.Int32(2),
+				},
+			},
+			expected: &expinfrav1.NodegroupScalingStatus{MinSize: 1, MaxSize: 5, DesiredSize: 2},
+		},
+		{
+			name: "desired size changed by an external autoscaler",
+			ng: &ekstypes.Nodegroup{
+				Status: ekstypes.NodegroupStatusActive,
+				ScalingConfig: &ekstypes.NodegroupScalingConfig{
+					MinSize:     aws.Int32(1),
+					MaxSize:     aws.Int32(10),
+					DesiredSize: aws.Int32(7),
+				},
+			},
+			expected: &expinfrav1.NodegroupScalingStatus{MinSize: 1, MaxSize: 10, DesiredSize: 7},
+		},
+		{
+			name: "no scaling config",
+			ng: &ekstypes.Nodegroup{
+				Status: ekstypes.NodegroupStatusCreating,
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupRoleService(g, nil, false)
+
+			g.Expect(s.setStatus(context.TODO(), tc.ng)).To(Succeed())
+			g.Expect(s.scope.ManagedMachinePool.Status.ScalingConfig).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestNodegroupSetStatusEstimatedHourlyCost(t *testing.T) {
+	priceTable := &ekspkg.PriceTable{
+		SpotFactor: 0.5,
+		OnDemand: map[string]map[string]float64{
+			"us-east-1": {
+				"m5.large":  0.1,
+				"m5.xlarge": 0.2,
+			},
+		},
+	}
+	scalingConfig := &ekstypes.NodegroupScalingConfig{
+		MinSize:     aws.Int32(1),
+		MaxSize:     aws.Int32(5),
+		DesiredSize: aws.Int32(3),
+	}
+
+	testCases := []struct {
+		name       string
+		priceTable *ekspkg.PriceTable
+		pool       func(*expinfrav1.AWSManagedMachinePool)
+		ng         *ekstypes.Nodegroup
+		expected   *string
+	}{
+		{
+			name:       "on-demand nodegroup",
+			priceTable: priceTable,
+			ng: &ekstypes.Nodegroup{
+				Status:        ekstypes.NodegroupStatusActive,
+				InstanceTypes: []string{"m5.large"},
+				CapacityType:  ekstypes.CapacityTypesOnDemand,
+				ScalingConfig: scalingConfig,
+			},
+			expected: aws.String("0.3000"),
+		},
+		{
+			name:       "spot nodegroup",
+			priceTable: priceTable,
+			ng: &ekstypes.Nodegroup{
+				Status:        ekstypes.NodegroupStatusActive,
+				InstanceTypes: []string{"m5.large", "m5.xlarge"},
+				CapacityType:  ekstypes.CapacityTypesSpot,
+				ScalingConfig: scalingConfig,
+			},
+			expected: aws.String("0.2250"),
+		},
+		{
+			name:       "instance type of the launch template fallback",
+			priceTable: priceTable,
+			pool: func(pool *expinfrav1.AWSManagedMachinePool) {
+				pool.Spec.AWSLaunchTemplate = &expinfrav1.AWSLaunchTemplate{InstanceType: "m5.large"}
+				pool.Status.InstanceTypeFallback = &expinfrav1.InstanceTypeFallbackStatus{InstanceType: "m5.xlarge"}
+			},
+			ng: &ekstypes.Nodegroup{
+				Status:        ekstypes.NodegroupStatusActive,
+				ScalingConfig: scalingConfig,
+			},
+			expected: aws.String("0.6000"),
+		},
+		{
+			name:       "unknown instance type",
+			priceTable: priceTable,
+			ng: &ekstypes.Nodegroup{
+				Status:        ekstypes.NodegroupStatusActive,
+				InstanceTypes: []string{"x2idn.metal"},
+				ScalingConfig: scalingConfig,
+			},
+		},
+		{
+			name: "cost estimates disabled",
+			ng: &ekstypes.Nodegroup{
+				Status:        ekstypes.NodegroupStatusActive,
+				InstanceTypes: []string{"m5.large"},
+				ScalingConfig: scalingConfig,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupScopeService(g, nil, false, func(params *scope.ManagedMachinePoolScopeParams) {
+				params.PriceTable = tc.priceTable
+				if tc.pool != nil {
+					tc.pool(params.ManagedMachinePool)
+				}
+			})
+
+			g.Expect(s.setStatus(context.TODO(), tc.ng)).To(Succeed())
+			g.Expect(s.scope.ManagedMachinePool.Status.EstimatedHourlyCost).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestNodegroupSetStatusResources(t *testing.T) {
+	testCases := []struct {
+		name           string
+		resources      *ekstypes.NodegroupResources
+		expectASGName  *string
+		expectRemoteSG *string
+	}{
+		{
+			name: "resources not created yet",
+		},
+		{
+			name: "auto scaling group without remote access",
+			resources: &ekstypes.NodegroupResources{
+				AutoScalingGroups: []ekstypes.AutoScalingGroup{{Name: aws.String("eks-ng-asg")}},
+			},
+			expectASGName: aws.String("eks-ng-asg"),
+		},
+		{
+			name: "auto scaling group and remote access security group",
+			resources: &ekstypes.NodegroupResources{
+				AutoScalingGroups:         []ekstypes.AutoScalingGroup{{Name: aws.String("eks-ng-asg")}},
+				RemoteAccessSecurityGroup: aws.String("sg-123"),
+			},
+			expectASGName:  aws.String("eks-ng-asg"),
+			expectRemoteSG: aws.String("sg-123"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupRoleService(g, nil, false)
+
+			g.Expect(s.setStatus(context.TODO(), &ekstypes.Nodegroup{
+				Status:    ekstypes.NodegroupStatusCreating,
+				Resources: tc.resources,
+			})).To(Succeed())
+			g.Expect(s.scope.ManagedMachinePool.Status.AutoScalingGroupName).To(Equal(tc.expectASGName))
+			g.Expect(s.scope.ManagedMachinePool.Status.RemoteAccessSecurityGroupID).To(Equal(tc.expectRemoteSG))
+		})
+	}
+}
+
+func TestNodegroupSetStatusUnknown(t *testing.T) {
+	g := NewWithT(t)
+	s := newTestNodegroupRoleService(g, nil, false)
+	s.scope.ManagedMachinePool.Status.Ready = true
+
+	err := s.setStatus(context.TODO(), &ekstypes.Nodegroup{Status: ekstypes.NodegroupStatus("MIGRATING")})
+	g.Expect(errors.Is(err, ErrNodegroupStatusUnknown)).To(BeTrue())
+	g.Expect(err.Error()).To(ContainSubstring("MIGRATING"))
+	g.Expect(s.scope.ManagedMachinePool.Status.Ready).To(BeTrue())
+	g.Expect(s.scope.ManagedMachinePool.Status.FailureMessage).To(BeNil())
+}
+
+func TestNodegroupSetStatusDegraded(t *testing.T) {
+	health := &ekstypes.NodegroupHealth{
+		Issues: []ekstypes.Issue{
+			{
+				Code:    ekstypes.NodegroupIssueCodeAsgInstanceLaunchFailures,
+				Message: aws.String("Could not launch On-Demand Instances."),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		policy          expinfrav1.NodegroupDegradedPolicy
+		nodegroup       *ekstypes.Nodegroup
+		expectReady     bool
+		expectHealthy   corev1.ConditionStatus
+		expectedMessage string
+	}{
+		{
+			name:            "degraded nodegroup keeps the pool ready by default",
+			nodegroup:       &ekstypes.Nodegroup{Status: ekstypes.NodegroupStatusDegraded, Health: health},
+			expectReady:     true,
+			expectHealthy:   corev1.ConditionFalse,
+			expectedMessage: "AsgInstanceLaunchFailures: Could not launch On-Demand Instances.",
+		},
+		{
+			name:            "degraded nodegroup marks the pool not ready with the NotReady policy",
+			policy:          expinfrav1.NodegroupDegradedPolicyNotReady,
+			nodegroup:       &ekstypes.Nodegroup{Status: ekstypes.NodegroupStatusDegraded, Health: health},
+			expectReady:     false,
+			expectHealthy:   corev1.ConditionFalse,
+			expectedMessage: "AsgInstanceLaunchFailures: Could not launch On-Demand Instances.",
+		},
+		{
+			name:            "degraded nodegroup without health issues",
+			nodegroup:       &ekstypes.Nodegroup{Status: ekstypes.NodegroupStatusDegraded},
+			expectReady:     true,
+			expectHealthy:   corev1.ConditionFalse,
+			expectedMessage: "EKS nodegroup is degraded",
+		},
+		{
+			name:          "active nodegroup is healthy",
+			policy:        expinfrav1.NodegroupDegradedPolicyNotReady,
+			nodegroup:     &ekstypes.Nodegroup{Status: ekstypes.NodegroupStatusActive},
+			expectReady:   true,
+			expectHealthy: corev1.ConditionTrue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupRoleService(g, nil, false)
+			s.scope.ManagedMachinePool.Spec.DegradedPolicy = tc.policy
+
+			g.Expect(s.setStatus(context.TODO(), tc.nodegroup)).To(Succeed())
+			g.Expect(s.scope.ManagedMachinePool.Status.Ready).To(Equal(tc.expectReady))
+			g.Expect(s.scope.ManagedMachinePool.Status.FailureMessage).To(BeNil())
+
+			condition := v1beta1conditions.Get(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupHealthyCondition)
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tc.expectHealthy))
+			g.Expect(condition.Message).To(Equal(tc.expectedMessage))
+			if tc.expectHealthy == corev1.ConditionFalse {
+				g.Expect(condition.Reason).To(Equal(expinfrav1.EKSNodegroupStatusDegradedReason))
+				g.Expect(condition.Severity).To(Equal(clusterv1beta1.ConditionSeverityWarning))
+			}
+		})
+	}
+}
+
+func TestNodegroupVersionUpdateAppliesUpdateConfig(t *testing.T) {
+	nodegroup := &ekstypes.Nodegroup{
+		NodegroupName:  aws.String("nodegroup"),
+		Version:        aws.String("1.30"),
+		ReleaseVersion: aws.String("1.30.0-20240101"),
+		UpdateConfig:   &ekstypes.NodegroupUpdateConfig{MaxUnavailable: aws.Int32(1)},
+	}
+
+	tests := []struct {
+		name         string
+		updateConfig *expinfrav1.UpdateConfig
+		expect       func(m *mock_eksiface.MockEKSAPIMockRecorder)
+	}{
+		{
+			name:         "update config is applied before the version update",
+			updateConfig: &expinfrav1.UpdateConfig{MaxUnavailablePercentage: aws.Int(20)},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateNodegroupConfig(gomock.Any(), &eks.UpdateNodegroupConfigInput{
+					ClusterName:   aws.String("eks-cluster"),
+					NodegroupName: aws.String("nodegroup"),
+					UpdateConfig:  &ekstypes.NodegroupUpdateConfig{MaxUnavailablePercentage: aws.Int32(20)},
+				}).Return(&eks.UpdateNodegroupConfigOutput{}, nil)
+			},
+		},
+		{
+			name:         "version is updated when the update config matches",
+			updateConfig: &expinfrav1.UpdateConfig{MaxUnavailable: aws.Int(1)},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateNodegroupVersion(gomock.Any(), &eks.UpdateNodegroupVersionInput{
+					ClusterName:    aws.String("eks-cluster"),
+					NodegroupName:  aws.String("nodegroup"),
+					ReleaseVersion: aws.String("1.30.0-20240201"),
+				}).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+			},
+		},
+		{
+			name: "version is updated without an update config",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateNodegroupVersion(gomock.Any(), &eks.UpdateNodegroupVersionInput{
+					ClusterName:    aws.String("eks-cluster"),
+					NodegroupName:  aws.String("nodegroup"),
+					ReleaseVersion: aws.String("1.30.0-20240201"),
+				}).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			tc.expect(eksMock.EXPECT())
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "eks-cluster",
+			}, expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName: "nodegroup",
+				AMIVersion:       aws.String("1.30.0-20240201"),
+				UpdateConfig:     tc.updateConfig,
+			})
+			s.scope.MachinePool = &clusterv1.MachinePool{}
+			s.EKSClient = eksMock
+
+			updated, err := s.reconcileNodegroupVersion(context.TODO(), nodegroup)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(updated).To(BeTrue())
+		})
+	}
+}
+
+func TestNodegroupForcedVersionUpdate(t *testing.T) {
+	tests := []struct {
+		name        string
+		forceUpdate *bool
+		expectForce bool
+	}{
+		{
+			name: "version update isn't forced by default",
+		},
+		{
+			name:        "version update isn't forced when disabled",
+			forceUpdate: ptr.To(false),
+		},
+		{
+			name:        "version update is forced when enabled",
+			forceUpdate: ptr.To(true),
+			expectForce: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			eksMock.EXPECT().UpdateNodegroupVersion(gomock.Any(), &eks.UpdateNodegroupVersionInput{
+				ClusterName:    aws.String("eks-cluster"),
+				NodegroupName:  aws.String("nodegroup"),
+				ReleaseVersion: aws.String("1.30.0-20240201"),
+				Force:          tc.expectForce,
+			}).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "eks-cluster",
+			}, expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName: "nodegroup",
+				AMIVersion:       aws.String("1.30.0-20240201"),
+				ForceUpdate:      tc.forceUpdate,
+			})
+			s.scope.MachinePool = &clusterv1.MachinePool{}
+			s.EKSClient = eksMock
+
+			updated, err := s.reconcileNodegroupVersion(context.TODO(), &ekstypes.Nodegroup{
+				NodegroupName:  aws.String("nodegroup"),
+				Version:        aws.String("1.30"),
+				ReleaseVersion: aws.String("1.30.0-20240101"),
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(updated).To(BeTrue())
+		})
+	}
+}
+
+func TestNodegroupAMIVersionValidation(t *testing.T) {
+	tests := []struct {
+		name               string
+		amiVersion         string
+		amiType            *expinfrav1.ManagedMachineAMIType
+		machinePoolVersion string
+		expectUpdate       *eks.UpdateNodegroupVersionInput
+		expectErr          string
+	}{
+		{
+			name:       "AMI release version of the nodegroup Kubernetes version",
+			amiVersion: "1.30.4-20240917",
+			expectUpdate: &eks.UpdateNodegroupVersionInput{
+				ClusterName:    aws.String("eks-cluster"),
+				NodegroupName:  aws.String("nodegroup"),
+				ReleaseVersion: aws.String("1.30.4-20240917"),
+			},
+		},
+		{
+			name:       "AMI release version of another Kubernetes version",
+			amiVersion: "1.31.0-20240917",
+			expectErr:  "AMI release version 1.31.0-20240917 is for Kubernetes 1.31 but the nodegroup Kubernetes version is 1.30",
+		},
+		{
+			name:       "AMI release version without a Kubernetes version",
+			amiVersion: "20240917",
+			expectErr:  "AMI release version 20240917 doesn't start with a Kubernetes version",
+		},
+		{
+			name:               "AMI release version of the Kubernetes version the nodegroup is upgraded to",
+			amiVersion:         "1.31.0-20240917",
+			machinePoolVersion: "v1.31.0",
+			expectUpdate: &eks.UpdateNodegroupVersionInput{
+				ClusterName:   aws.String("eks-cluster"),
+				NodegroupName: aws.String("nodegroup"),
+				Version:       aws.String("1.31"),
+			},
+		},
+		{
+			name:               "AMI release version of another Kubernetes version than the upgrade",
+			amiVersion:         "1.30.4-20240917",
+			machinePoolVersion: "v1.31.0",
+			expectErr:          "AMI release version 1.30.4-20240917 is for Kubernetes 1.30 but the nodegroup Kubernetes version is 1.31",
+		},
+		{
+			name:       "Bottlerocket release versions aren't checked",
+			amiVersion: "1.20.1-7c3e9198",
+			amiType:    ptr.To(expinfrav1.BottleRocketx86_64),
+			expectUpdate: &eks.UpdateNodegroupVersionInput{
+				ClusterName:    aws.String("eks-cluster"),
+				NodegroupName:  aws.String("nodegroup"),
+				ReleaseVersion: aws.String("1.20.1-7c3e9198"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			if tc.expectUpdate != nil {
+				eksMock.EXPECT().UpdateNodegroupVersion(gomock.Any(), tc.expectUpdate).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+			}
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "eks-cluster",
+			}, expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName: "nodegroup",
+				AMIVersion:       aws.String(tc.amiVersion),
+				AMIType:          tc.amiType,
+			})
+			s.scope.MachinePool = &clusterv1.MachinePool{}
+			s.scope.MachinePool.Spec.Template.Spec.Version = tc.machinePoolVersion
+			s.EKSClient = eksMock
+
+			updated, err := s.reconcileNodegroupVersion(context.TODO(), &ekstypes.Nodegroup{
+				NodegroupName:  aws.String("nodegroup"),
+				Version:        aws.String("1.30"),
+				ReleaseVersion: aws.String("1.30.0-20240101"),
+			})
+			if tc.expectErr != "" {
+				g.Expect(err).To(MatchError(ErrNodegroupAMIVersionMismatch))
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectErr))
+				g.Expect(updated).To(BeFalse())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(updated).To(BeTrue())
+		})
+	}
+}
+
+func TestNodegroupLaunchTemplateRollback(t *testing.T) {
+	const templateID = "lt-0123456789abcdef0"
+
+	tests := []struct {
+		name               string
+		pinnedVersion      *int64
+		machinePoolVersion string
+		nodegroupVersion   string
+		expectLTVersion    string
+		expectErr          bool
+		expectNoUpdate     bool
+	}{
+		{
+			name:             "nodegroup follows the latest version when it isn't pinned",
+			nodegroupVersion: "2",
+			expectLTVersion:  "3",
+		},
+		{
+			name:             "nodegroup is rolled back to the pinned version",
+			pinnedVersion:    ptr.To[int64](2),
+			nodegroupVersion: "3",
+			expectLTVersion:  "2",
+		},
+		{
+			name:               "rollback doesn't downgrade the Kubernetes version",
+			pinnedVersion:      ptr.To[int64](2),
+			machinePoolVersion: "v1.29.0",
+			nodegroupVersion:   "3",
+			expectLTVersion:    "2",
+		},
+		{
+			name:             "nodegroup already uses the pinned version",
+			pinnedVersion:    ptr.To[int64](2),
+			nodegroupVersion: "2",
+			expectNoUpdate:   true,
+		},
+		{
+			name:             "pinned version newer than the latest one",
+			pinnedVersion:    ptr.To[int64](4),
+			nodegroupVersion: "3",
+			expectErr:        true,
+			expectNoUpdate:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			if !tc.expectNoUpdate {
+				eksMock.EXPECT().UpdateNodegroupVersion(gomock.Any(), &eks.UpdateNodegroupVersionInput{
+					ClusterName:   aws.String("eks-cluster"),
+					NodegroupName: aws.String("nodegroup"),
+					LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+						Id:      aws.String(templateID),
+						Version: aws.String(tc.expectLTVersion),
+					},
+				}).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+			}
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "eks-cluster",
+			}, expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName:      "nodegroup",
+				AWSLaunchTemplate:     &expinfrav1.AWSLaunchTemplate{},
+				LaunchTemplateVersion: tc.pinnedVersion,
+			})
+			s.scope.ManagedMachinePool.Status.LaunchTemplateID = aws.String(templateID)
+			s.scope.ManagedMachinePool.Status.LaunchTemplateVersion = aws.String("3")
+			s.scope.MachinePool = &clusterv1.MachinePool{}
+			s.scope.MachinePool.Spec.Template.Spec.Version = tc.machinePoolVersion
+			s.EKSClient = eksMock
+
+			updated, err := s.reconcileNodegroupVersion(context.TODO(), &ekstypes.Nodegroup{
+				NodegroupName:  aws.String("nodegroup"),
+				Version:        aws.String("1.30"),
+				ReleaseVersion: aws.String("1.30.0-20240101"),
+				LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+					Id:      aws.String(templateID),
+					Version: aws.String(tc.nodegroupVersion),
+				},
+			})
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(updated).To(Equal(!tc.expectNoUpdate))
+		})
+	}
+}
+
+func TestNodegroupLaunchTemplateVersionDrift(t *testing.T) {
+	const templateID = "lt-0123456789abcdef0"
+
+	tests := []struct {
+		name             string
+		policy           expinfrav1.LaunchTemplateVersionDriftPolicy
+		appliedVersion   *string
+		nodegroupVersion string
+		expectUpdate     bool
+		expectApplied    string
+	}{
+		{
+			name:             "nodegroup moved to another version is reconciled back by default",
+			appliedVersion:   aws.String("3"),
+			nodegroupVersion: "1",
+			expectUpdate:     true,
+			expectApplied:    "3",
+		},
+		{
+			name:             "nodegroup moved to another version is reconciled back",
+			policy:           expinfrav1.LaunchTemplateVersionDriftPolicyReconcile,
+			appliedVersion:   aws.String("3"),
+			nodegroupVersion: "4",
+			expectUpdate:     true,
+			expectApplied:    "3",
+		},
+		{
+			name:             "nodegroup moved to another version is adopted",
+			policy:           expinfrav1.LaunchTemplateVersionDriftPolicyAdopt,
+			appliedVersion:   aws.String("3"),
+			nodegroupVersion: "1",
+			expectApplied:    "3",
+		},
+		{
+			name:             "new launch template version is rolled out over an adopted version",
+			policy:           expinfrav1.LaunchTemplateVersionDriftPolicyAdopt,
+			appliedVersion:   aws.String("2"),
+			nodegroupVersion: "1",
+			expectUpdate:     true,
+			expectApplied:    "3",
+		},
+		{
+			name:             "applied version is recorded when the nodegroup is up to date",
+			policy:           expinfrav1.LaunchTemplateVersionDriftPolicyAdopt,
+			nodegroupVersion: "3",
+			expectApplied:    "3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			if tc.expectUpdate {
+				eksMock.EXPECT().UpdateNodegroupVersion(gomock.Any(), &eks.UpdateNodegroupVersionInput{
+					ClusterName:   aws.String("eks-cluster"),
+					NodegroupName: aws.String("nodegroup"),
+					LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+						Id:      aws.String(templateID),
+						Version: aws.String("3"),
+					},
+				}).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+			}
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "eks-cluster",
+			}, expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName:                 "nodegroup",
+				AWSLaunchTemplate:                &expinfrav1.AWSLaunchTemplate{},
+				LaunchTemplateVersionDriftPolicy: tc.policy,
+			})
+			s.scope.ManagedMachinePool.Status.LaunchTemplateID = aws.String(templateID)
+			s.scope.ManagedMachinePool.Status.LaunchTemplateVersion = aws.String("3")
+			s.scope.ManagedMachinePool.Status.AppliedLaunchTemplateVersion = tc.appliedVersion
+			s.scope.MachinePool = &clusterv1.MachinePool{}
+			s.EKSClient = eksMock
+
+			updated, err := s.reconcileNodegroupVersion(context.TODO(), &ekstypes.Nodegroup{
+				NodegroupName:  aws.String("nodegroup"),
+				Version:        aws.String("1.30"),
+				ReleaseVersion: aws.String("1.30.0-20240101"),
+				LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+					Id:      aws.String(templateID),
+					Version: aws.String(tc.nodegroupVersion),
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(updated).To(Equal(tc.expectUpdate))
+			g.Expect(s.scope.ManagedMachinePool.Status.AppliedLaunchTemplateVersion).To(Equal(aws.String(tc.expectApplied)))
+		})
+	}
+}
+
+func TestNodegroupVersionAndConfigUpdatesAreSerialized(t *testing.T) {
+	const amiVersion = "1.30.0-20240201"
+
+	nodegroup := func(status ekstypes.NodegroupStatus, releaseVersion string) *ekstypes.Nodegroup {
+		return &ekstypes.Nodegroup{
+			NodegroupName:  aws.String("nodegroup"),
+			NodegroupArn:   aws.String("arn:aws:eks:us-east-1:123456789012:nodegroup/eks-cluster/nodegroup/id"),
+			Status:         status,
+			Version:        aws.String("1.30"),
+			ReleaseVersion: aws.String(releaseVersion),
+			ScalingConfig: &ekstypes.NodegroupScalingConfig{
+				MinSize:     aws.Int32(1),
+				MaxSize:     aws.Int32(3),
+				DesiredSize: aws.Int32(3),
+			},
+			Resources: &ekstypes.NodegroupResources{},
+			Tags:      ngTags("eks-cluster", infrav1.Tags{}),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		waitUpdates bool
+		expect      func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectedErr error
+	}{
+		{
+			name: "nothing is updated while a nodegroup update is in progress",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeNodegroup(gomock.Any(), gomock.Any()).Return(&eks.DescribeNodegroupOutput{
+					Nodegroup: nodegroup(ekstypes.NodegroupStatusUpdating, "1.30.0-20240101"),
+				}, nil)
+			},
+			expectedErr: ErrNodegroupUpdating,
+		},
+		{
+			name: "nothing is updated while the nodegroup is in an unknown status",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeNodegroup(gomock.Any(), gomock.Any()).Return(&eks.DescribeNodegroupOutput{
+					Nodegroup: nodegroup(ekstypes.NodegroupStatus("MIGRATING"), "1.30.0-20240101"),
+				}, nil)
+			},
+			expectedErr: ErrNodegroupStatusUnknown,
+		},
+		{
+			name: "config isn't updated after starting a version update",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeNodegroup(gomock.Any(), gomock.Any()).Return(&eks.DescribeNodegroupOutput{
+					Nodegroup: nodegroup(ekstypes.NodegroupStatusActive, "1.30.0-20240101"),
+				}, nil)
+				m.UpdateNodegroupVersion(gomock.Any(), gomock.Any()).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+			},
+			expectedErr: ErrNodegroupUpdating,
+		},
+		{
+			name:        "config is updated once the version update has completed",
+			waitUpdates: true,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				gomock.InOrder(
+					m.DescribeNodegroup(gomock.Any(), gomock.Any()).Return(&eks.DescribeNodegroupOutput{
+						Nodegroup: nodegroup(ekstypes.NodegroupStatusActive, "1.30.0-20240101"),
+					}, nil),
+					m.UpdateNodegroupVersion(gomock.Any(), gomock.Any()).Return(&eks.UpdateNodegroupVersionOutput{}, nil),
+					m.WaitUntilNodegroupActive(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+					m.DescribeNodegroup(gomock.Any(), gomock.Any()).Return(&eks.DescribeNodegroupOutput{
+						Nodegroup: nodegroup(ekstypes.NodegroupStatusActive, amiVersion),
+					}, nil),
+					m.UpdateNodegroupConfig(gomock.Any(), gomock.Any()).Return(&eks.UpdateNodegroupConfigOutput{}, nil),
+				)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			tc.expect(eksMock.EXPECT())
+
+			s := newTestNodegroupScopeService(g, nil, false, func(params *scope.ManagedMachinePoolScopeParams) {
+				params.WaitForNodegroupUpdates = tc.waitUpdates
+			})
+			s.scope.ManagedMachinePool.Spec.AMIVersion = aws.String(amiVersion)
+			s.EKSClient = eksMock
+
+			err := s.reconcileNodegroup(context.TODO())
+			if tc.expectedErr != nil {
+				g.Expect(errors.Is(err, tc.expectedErr)).To(BeTrue())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestNodegroupReconcileExternallyManagedReplicas(t *testing.T) {
+	tests := []struct {
+		name                string
+		annotated           bool
+		replicas            *int32
+		previousDesiredSize *int32
+		desiredSize         *int32
+		expectReplicas      *int32
+		expectCondition     *clusterv1beta1.Condition
+	}{
+		{
+			name:            "replicas not managed by an external autoscaler",
+			replicas:        ptr.To[int32](3),
+			desiredSize:     ptr.To[int32](5),
+			expectReplicas:  ptr.To[int32](3),
+			expectCondition: nil,
+		},
+		{
+			name:                "replicas in sync with the nodegroup",
+			annotated:           true,
+			replicas:            ptr.To[int32](5),
+			previousDesiredSize: ptr.To[int32](5),
+			desiredSize:         ptr.To[int32](5),
+			expectReplicas:      ptr.To[int32](5),
+			expectCondition:     v1beta1conditions.TrueCondition(expinfrav1.EKSNodegroupReplicasSourceCondition),
+		},
+		{
+			name:                "nodegroup scaled by the external autoscaler",
+			annotated:           true,
+			replicas:            ptr.To[int32](3),
+			previousDesiredSize: ptr.To[int32](3),
+			desiredSize:         ptr.To[int32](5),
+			expectReplicas:      ptr.To[int32](5),
+			expectCondition:     v1beta1conditions.TrueCondition(expinfrav1.EKSNodegroupReplicasSourceCondition),
+		},
+		{
+			name:            "nodegroup not seen before",
+			annotated:       true,
+			replicas:        ptr.To[int32](3),
+			desiredSize:     ptr.To[int32](5),
+			expectReplicas:  ptr.To[int32](5),
+			expectCondition: v1beta1conditions.TrueCondition(expinfrav1.EKSNodegroupReplicasSourceCondition),
+		},
+		{
+			name:            "nodegroup without a desired size",
+			annotated:       true,
+			replicas:        ptr.To[int32](3),
+			expectReplicas:  ptr.To[int32](3),
+			expectCondition: nil,
+		},
+		{
+			name:                "replicas changed while managed by the external autoscaler",
+			annotated:           true,
+			replicas:            ptr.To[int32](3),
+			previousDesiredSize: ptr.To[int32](5),
+			desiredSize:         ptr.To[int32](5),
+			expectReplicas:      ptr.To[int32](3),
+			expectCondition: v1beta1conditions.FalseCondition(expinfrav1.EKSNodegroupReplicasSourceCondition, expinfrav1.EKSNodegroupReplicasSourceConflictReason, clusterv1beta1.ConditionSeverityWarning,
+				"MachinePool replicas set to 3 but managed by an external autoscaler with a desired size of 5; remove the %s annotation or stop setting replicas", clusterv1beta1.ReplicasManagedByAnnotation),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupScopeService(g, nil, false, func(params *scope.ManagedMachinePoolScopeParams) {
+				if tt.annotated {
+					params.MachinePool.Annotations = map[string]string{clusterv1beta1.ReplicasManagedByAnnotation: "cluster-autoscaler"}
+				}
+				params.MachinePool.Spec.Replicas = tt.replicas
+			})
+			ng := &ekstypes.Nodegroup{
+				ScalingConfig: &ekstypes.NodegroupScalingConfig{DesiredSize: tt.desiredSize},
+			}
+
+			g.Expect(s.reconcileExternallyManagedReplicas(context.TODO(), ng, tt.previousDesiredSize)).To(Succeed())
+			g.Expect(s.scope.MachinePool.Spec.Replicas).To(Equal(tt.expectReplicas))
+			g.Expect(s.scope.MachinePool.Spec.Replicas).NotTo(BeIdenticalTo(ng.ScalingConfig.DesiredSize))
+
+			condition := v1beta1conditions.Get(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupReplicasSourceCondition)
+			if tt.expectCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.expectCondition.Status))
+			g.Expect(condition.Reason).To(Equal(tt.expectCondition.Reason))
+			g.Expect(condition.Severity).To(Equal(tt.expectCondition.Severity))
+			g.Expect(condition.Message).To(Equal(tt.expectCondition.Message))
+		})
+	}
+}
+
+func TestNodegroupExternallyManagedReplicasWaitFailed(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+
+	eksMock.EXPECT().DescribeNodegroup(gomock.Any(), gomock.Any()).Return(&eks.DescribeNodegroupOutput{
+		Nodegroup: &ekstypes.Nodegroup{
+			NodegroupName: aws.String("nodegroup"),
+			Status:        ekstypes.NodegroupStatusCreating,
+			ScalingConfig: &ekstypes.NodegroupScalingConfig{
+				MinSize:     aws.Int32(1),
+				MaxSize:     aws.Int32(3),
+				DesiredSize: aws.Int32(3),
+			},
+			Resources: &ekstypes.NodegroupResources{},
+			Tags:      ngTags("eks-cluster", infrav1.Tags{}),
+		},
+	}, nil)
+	eksMock.EXPECT().WaitUntilNodegroupActive(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("exceeded max wait time"))
+
+	s := newTestNodegroupScopeService(g, nil, false, func(params *scope.ManagedMachinePoolScopeParams) {
+		params.MachinePool.Annotations = map[string]string{clusterv1beta1.ReplicasManagedByAnnotation: "cluster-autoscaler"}
+		params.MachinePool.Spec.Replicas = ptr.To[int32](1)
+	})
+	s.EKSClient = eksMock
+
+	err := s.reconcileNodegroup(context.TODO())
+	g.Expect(err).To(MatchError(ContainSubstring("failed to wait for nodegroup to be active")))
+	g.Expect(s.scope.MachinePool.Spec.Replicas).To(Equal(ptr.To[int32](1)))
+}
+
+func TestNodegroupConfigDryRun(t *testing.T) {
+	tests := []struct {
+		name            string
+		dryRun          string
+		labels          map[string]string
+		expectUpdate    bool
+		expectCondition *clusterv1beta1.Condition
+	}{
+		{
+			name:         "changes are applied without the annotation",
+			labels:       map[string]string{"team": "platform"},
+			expectUpdate: true,
+		},
+		{
+			name:   "changes are only planned in dry run",
+			dryRun: "true",
+			labels: map[string]string{"team": "platform"},
+			expectCondition: v1beta1conditions.FalseCondition(expinfrav1.EKSNodegroupConfigUpToDateCondition, expinfrav1.EKSNodegroupConfigDryRunReason, clusterv1beta1.ConditionSeverityInfo,
+				"Would update labels to add or update: team; labels to remove: stale; taints to add or update: dedicated=infra:NO_SCHEDULE; scaling config: min 1, max 6, desired 3"),
+		},
+		{
+			name:            "config matching the spec is up to date in dry run",
+			dryRun:          "true",
+			expectCondition: v1beta1conditions.TrueCondition(expinfrav1.EKSNodegroupConfigUpToDateCondition),
+		},
+		{
+			name:         "changes are applied when the annotation isn't true",
+			dryRun:       "false",
+			labels:       map[string]string{"team": "platform"},
+			expectUpdate: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			spec := expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName: "nodegroup",
+				Scaling:          &expinfrav1.ManagedMachinePoolScaling{MinSize: ptr.To[int32](1), MaxSize: ptr.To[int32](3)},
+			}
+			ng := &ekstypes.Nodegroup{
+				NodegroupName: aws.String("nodegroup"),
+				ScalingConfig: &ekstypes.NodegroupScalingConfig{
+					DesiredSize: aws.Int32(3),
+					MinSize:     aws.Int32(1),
+					MaxSize:     aws.Int32(3),
+				},
+				NodeRepairConfig: &ekstypes.NodeRepairConfig{Enabled: aws.Bool(false)},
+			}
+			if tt.labels != nil {
+				spec.Labels = tt.labels
+				spec.Taints = expinfrav1.Taints{{Key: "dedicated", Value: "infra", Effect: expinfrav1.TaintEffectNoSchedule}}
+				spec.Scaling.MaxSize = ptr.To[int32](6)
+				ng.Labels = map[string]string{"stale": "true"}
+			}
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "eks-cluster"}, spec)
+			if tt.dryRun != "" {
+				s.scope.ManagedMachinePool.Annotations = map[string]string{expinfrav1.NodegroupConfigDryRunAnnotation: tt.dryRun}
+			}
+			s.scope.MachinePool = &clusterv1.MachinePool{Spec: clusterv1.MachinePoolSpec{Replicas: ptr.To[int32](3)}}
+			// The mock fails the test on any call that isn't expected, such as an update in dry run.
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			s.EKSClient = eksMock
+			if tt.expectUpdate {
+				eksMock.EXPECT().UpdateNodegroupConfig(gomock.Any(), gomock.Any()).Return(&eks.UpdateNodegroupConfigOutput{}, nil)
+			}
+
+			g.Expect(s.reconcileNodegroupConfig(context.TODO(), ng)).To(Succeed())
+
+			condition := v1beta1conditions.Get(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupConfigUpToDateCondition)
+			if tt.expectCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.expectCondition.Status))
+			g.Expect(condition.Reason).To(Equal(tt.expectCondition.Reason))
+			g.Expect(condition.Message).To(Equal(tt.expectCondition.Message))
+		})
+	}
+}
+
+func TestNodegroupScaleDownPodDisruptionBudgets(t *testing.T) {
+	tests := []struct {
+		name               string
+		policy             *expinfrav1.PodDisruptionBudgetPolicy
+		disruptionsAllowed int32
+		expectUpdate       bool
+		expectCondition    *clusterv1beta1.Condition
+		expectEvents       []string
+	}{
+		{
+			name:         "budgets aren't checked without a policy",
+			expectUpdate: true,
+		},
+		{
+			name:   "blocked scale-down is deferred",
+			policy: ptr.To(expinfrav1.PodDisruptionBudgetPolicyDefer),
+			expectCondition: v1beta1conditions.FalseCondition(expinfrav1.EKSNodegroupScaleDownAllowedCondition, expinfrav1.EKSNodegroupPodDisruptionBudgetsBlockingReason, clusterv1beta1.ConditionSeverityInfo,
+				"scale down from 3 to 2 nodes deferred, PodDisruptionBudgets would block draining the nodes: default/web allows 1 disruptions for 2 pods"),
+		},
+		{
+			name:               "scale-down allowed by the budgets isn't deferred",
+			policy:             ptr.To(expinfrav1.PodDisruptionBudgetPolicyDefer),
+			disruptionsAllowed: 1,
+			expectUpdate:       true,
+			expectCondition:    v1beta1conditions.TrueCondition(expinfrav1.EKSNodegroupScaleDownAllowedCondition),
+		},
+		{
+			name:            "blocked scale-down proceeds with a warning",
+			policy:          ptr.To(expinfrav1.PodDisruptionBudgetPolicyProceed),
+			expectUpdate:    true,
+			expectCondition: v1beta1conditions.TrueCondition(expinfrav1.EKSNodegroupScaleDownAllowedCondition),
+			expectEvents: []string{
+				"Scaling EKS nodegroup nodegroup down from 3 to 2 nodes although PodDisruptionBudgets would block draining them: default/web allows 1 disruptions for 2 pods",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			recorded := newEventCollector(t)
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "eks-cluster"}, expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName:          "nodegroup",
+				PodDisruptionBudgetPolicy: tt.policy,
+			})
+			s.scope.MachinePool = &clusterv1.MachinePool{Spec: clusterv1.MachinePoolSpec{Replicas: ptr.To[int32](2)}}
+			ng := &ekstypes.Nodegroup{
+				NodegroupName:    aws.String("nodegroup"),
+				ScalingConfig:    &ekstypes.NodegroupScalingConfig{DesiredSize: aws.Int32(3)},
+				NodeRepairConfig: &ekstypes.NodeRepairConfig{Enabled: aws.Bool(false)},
+			}
+
+			node := func(name, nodegroup string) *corev1.Node {
+				return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{nodegroupNodeLabel: nodegroup}}}
+			}
+			pod := func(namespace, name, nodeName string) *corev1.Pod {
+				return &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: map[string]string{"app": "web"}},
+					Spec:       corev1.PodSpec{NodeName: nodeName},
+				}
+			}
+			objects := []client.Object{
+				node("node-1", "nodegroup"), node("node-2", "nodegroup"), node("node-3", "nodegroup"), node("other-node", "other"),
+				// Removing node-1 evicts two pods of the budget, the most a single node runs.
+				pod("default", "web-1", "node-1"), pod("default", "web-2", "node-1"), pod("default", "web-3", "node-2"),
+				// Pods of other namespaces or nodegroups aren't covered by the budget.
+				pod("default", "web-4", "other-node"), pod("other", "web-5", "node-3"), pod("other", "web-6", "node-3"), pod("other", "web-7", "node-3"),
+				&policyv1.PodDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+					Spec:       policyv1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+					Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: tt.disruptionsAllowed + 1},
+				},
+			}
+			s.remoteClient = func() (client.Client, error) {
+				return fake.NewClientBuilder().WithObjects(objects...).WithStatusSubresource(&policyv1.PodDisruptionBudget{}).
+					WithIndex(&corev1.Pod{}, podNodeNameField, func(o client.Object) []string {
+						return []string{o.(*corev1.Pod).Spec.NodeName}
+					}).Build(), nil
+			}
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			s.EKSClient = eksMock
+			if tt.expectUpdate {
+				eksMock.EXPECT().UpdateNodegroupConfig(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *eks.UpdateNodegroupConfigInput, _ ...func(*eks.Options)) (*eks.UpdateNodegroupConfigOutput, error) {
+						g.Expect(input.ScalingConfig.DesiredSize).To(Equal(aws.Int32(2)))
+						return &eks.UpdateNodegroupConfigOutput{}, nil
+					})
+			}
+
+			g.Expect(s.reconcileNodegroupConfig(context.TODO(), ng)).To(Succeed())
+
+			g.Expect(s.blockedScaleDown != "").To(Equal(!tt.expectUpdate))
+			var events []string
+			for _, event := range recorded.take() {
+				if strings.Contains(event, "PodDisruptionBudgets would block") {
+					events = append(events, event)
+				}
+			}
+			g.Expect(events).To(Equal(tt.expectEvents))
+			condition := v1beta1conditions.Get(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupScaleDownAllowedCondition)
+			if tt.expectCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.expectCondition.Status))
+			g.Expect(condition.Reason).To(Equal(tt.expectCondition.Reason))
+			g.Expect(condition.Message).To(Equal(tt.expectCondition.Message))
+		})
+	}
+}
+
+func TestNodegroupDesiredCapacityDrift(t *testing.T) {
+	tests := []struct {
+		name             string
+		desiredSize      int32
+		asgCapacity      *int32
+		externalReplicas bool
+		expectDescribe   bool
+		expectScaling    *ekstypes.NodegroupScalingConfig
+		expectEvents     []string
+	}{
+		{
+			name:           "ASG scaled up directly is scaled back down",
+			desiredSize:    3,
+			asgCapacity:    aws.Int32(5),
+			expectDescribe: true,
+			expectScaling:  &ekstypes.NodegroupScalingConfig{DesiredSize: aws.Int32(3)},
+			expectEvents: []string{
+				"Auto Scaling group eks-nodegroup-asg of EKS nodegroup nodegroup has a desired capacity of 5 instead of 3, correcting it through the nodegroup scaling config",
+			},
+		},
+		{
+			name:           "ASG scaled down directly is scaled back up",
+			desiredSize:    3,
+			asgCapacity:    aws.Int32(1),
+			expectDescribe: true,
+			expectScaling:  &ekstypes.NodegroupScalingConfig{DesiredSize: aws.Int32(3)},
+			expectEvents: []string{
+				"Auto Scaling group eks-nodegroup-asg of EKS nodegroup nodegroup has a desired capacity of 1 instead of 3, correcting it through the nodegroup scaling config",
+			},
+		},
+		{
+			name:           "ASG in sync with the scaling config isn't updated",
+			desiredSize:    3,
+			asgCapacity:    aws.Int32(3),
+			expectDescribe: true,
+		},
+		{
+			name:             "ASG capacity owned by an external autoscaler isn't checked",
+			desiredSize:      3,
+			asgCapacity:      aws.Int32(5),
+			externalReplicas: true,
+		},
+		{
+			name:          "scaling config differing from the replicas is updated without checking the ASG",
+			desiredSize:   2,
+			asgCapacity:   aws.Int32(5),
+			expectScaling: &ekstypes.NodegroupScalingConfig{DesiredSize: aws.Int32(3)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			recorded := newEventCollector(t)
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "eks-cluster"}, expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName: "nodegroup",
+			})
+			s.scope.MachinePool = &clusterv1.MachinePool{Spec: clusterv1.MachinePoolSpec{Replicas: ptr.To[int32](3)}}
+			if tt.externalReplicas {
+				s.scope.MachinePool.Annotations = map[string]string{clusterv1beta1.ReplicasManagedByAnnotation: "cluster-autoscaler"}
+			}
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			s.AutoscalingClient = asgMock
+			if tt.expectDescribe {
+				asgMock.EXPECT().DescribeAutoScalingGroups(gomock.Any(), gomock.Any()).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+					AutoScalingGroups: []autoscalingtypes.AutoScalingGroup{{
+						AutoScalingGroupName: aws.String("eks-nodegroup-asg"),
+						DesiredCapacity:      tt.asgCapacity,
+					}},
+				}, nil)
+			}
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			s.EKSClient = eksMock
+			if tt.expectScaling != nil {
+				eksMock.EXPECT().UpdateNodegroupConfig(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, input *eks.UpdateNodegroupConfigInput, _ ...func(*eks.Options)) (*eks.UpdateNodegroupConfigOutput, error) {
+						g.Expect(input.ScalingConfig).To(Equal(tt.expectScaling))
+						return &eks.UpdateNodegroupConfigOutput{}, nil
+					})
+			}
+			ng := &ekstypes.Nodegroup{
+				NodegroupName:    aws.String("nodegroup"),
+				ScalingConfig:    &ekstypes.NodegroupScalingConfig{DesiredSize: aws.Int32(tt.desiredSize)},
+				NodeRepairConfig: &ekstypes.NodeRepairConfig{Enabled: aws.Bool(false)},
+				Resources: &ekstypes.NodegroupResources{
+					AutoScalingGroups: []ekstypes.AutoScalingGroup{{Name: aws.String("eks-nodegroup-asg")}},
+				},
+			}
+
+			drifted, err := s.reconcileDesiredCapacityDrift(context.TODO(), ng)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(s.reconcileNodegroupConfig(context.TODO(), drifted)).To(Succeed())
+
+			// The described nodegroup is left untouched.
+			g.Expect(ng.ScalingConfig.DesiredSize).To(Equal(aws.Int32(tt.desiredSize)))
+			g.Expect(recorded.take()).To(Equal(tt.expectEvents))
+		})
+	}
+}
+
+func TestNodegroupNodeRepairConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         *expinfrav1.NodeRepairConfig
+		current      *ekstypes.NodeRepairConfig
+		expectUpdate *ekstypes.NodeRepairConfig
+	}{
+		{
+			name:         "auto repair is enabled on an existing nodegroup",
+			spec:         &expinfrav1.NodeRepairConfig{Enabled: ptr.To(true)},
+			current:      &ekstypes.NodeRepairConfig{Enabled: aws.Bool(false)},
+			expectUpdate: &ekstypes.NodeRepairConfig{Enabled: aws.Bool(true)},
+		},
+		{
+			name:         "auto repair is disabled on an existing nodegroup",
+			spec:         &expinfrav1.NodeRepairConfig{Enabled: ptr.To(false)},
+			current:      &ekstypes.NodeRepairConfig{Enabled: aws.Bool(true)},
+			expectUpdate: &ekstypes.NodeRepairConfig{Enabled: aws.Bool(false)},
+		},
+		{
+			name:         "auto repair is disabled when removed from the spec",
+			current:      &ekstypes.NodeRepairConfig{Enabled: aws.Bool(true)},
+			expectUpdate: &ekstypes.NodeRepairConfig{Enabled: aws.Bool(false)},
+		},
+		{
+			name:    "matching config isn't updated",
+			spec:    &expinfrav1.NodeRepairConfig{Enabled: ptr.To(true)},
+			current: &ekstypes.NodeRepairConfig{Enabled: aws.Bool(true)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "eks-cluster"}, expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName: "nodegroup",
+				NodeRepairConfig: tt.spec,
+			})
+			s.scope.MachinePool = &clusterv1.MachinePool{Spec: clusterv1.MachinePoolSpec{Replicas: ptr.To[int32](3)}}
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			s.EKSClient = eksMock
+			if tt.expectUpdate != nil {
+				eksMock.EXPECT().UpdateNodegroupConfig(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, input *eks.UpdateNodegroupConfigInput, _ ...func(*eks.Options)) (*eks.UpdateNodegroupConfigOutput, error) {
+						g.Expect(input.NodeRepairConfig).To(Equal(tt.expectUpdate))
+						g.Expect(input.ScalingConfig).To(BeNil())
+						return &eks.UpdateNodegroupConfigOutput{}, nil
+					})
+			}
+
+			g.Expect(s.reconcileNodegroupConfig(context.TODO(), &ekstypes.Nodegroup{
+				NodegroupName:    aws.String("nodegroup"),
+				ScalingConfig:    &ekstypes.NodegroupScalingConfig{DesiredSize: aws.Int32(3)},
+				NodeRepairConfig: tt.current,
+			})).To(Succeed())
+		})
+	}
+}
+
+func TestNodegroupConfigKeepsExternallyManagedDesiredSize(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxSize       int32
+		expectScaling *ekstypes.NodegroupScalingConfig
+	}{
+		{
+			name:    "min/max are updated without the desired size",
+			maxSize: 6,
+			expectScaling: &ekstypes.NodegroupScalingConfig{
+				MinSize: aws.Int32(1),
+				MaxSize: aws.Int32(10),
+			},
+		},
+		{
+			name:          "desired size differing from replicas isn't updated",
+			maxSize:       10,
+			expectScaling: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "eks-cluster"}, expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName: "nodegroup",
+				Scaling:          &expinfrav1.ManagedMachinePoolScaling{MinSize: ptr.To[int32](1), MaxSize: ptr.To[int32](10)},
+			})
+			s.scope.MachinePool = &clusterv1.MachinePool{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{clusterv1beta1.ReplicasManagedByAnnotation: "cluster-autoscaler"}},
+				Spec:       clusterv1.MachinePoolSpec{Replicas: ptr.To[int32](3)},
+			}
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			s.EKSClient = eksMock
+
+			ng := &ekstypes.Nodegroup{
+				NodegroupName: aws.String("nodegroup"),
+				ScalingConfig: &ekstypes.NodegroupScalingConfig{
+					DesiredSize: aws.Int32(5),
+					MinSize:     aws.Int32(1),
+					MaxSize:     aws.Int32(tt.maxSize),
+				},
+				NodeRepairConfig: &ekstypes.NodeRepairConfig{Enabled: aws.Bool(false)},
+			}
+
+			if tt.expectScaling != nil {
+				eksMock.EXPECT().UpdateNodegroupConfig(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, input *eks.UpdateNodegroupConfigInput, _ ...func(*eks.Options)) (*eks.UpdateNodegroupConfigOutput, error) {
+						g.Expect(input.ScalingConfig).To(Equal(tt.expectScaling))
+						return &eks.UpdateNodegroupConfigOutput{}, nil
+					})
+			}
+
+			g.Expect(s.reconcileNodegroupConfig(context.TODO(), ng)).To(Succeed())
+		})
+	}
+}
+
+func TestNodegroupConfigKeepsDesiredSizeWithoutReplicas(t *testing.T) {
+	tests := []struct {
+		name          string
+		scaling       *expinfrav1.ManagedMachinePoolScaling
+		desiredSize   *int32
+		expectScaling *ekstypes.NodegroupScalingConfig
+	}{
+		{
+			name:          "desired size within min/max is kept",
+			scaling:       &expinfrav1.ManagedMachinePoolScaling{MinSize: ptr.To[int32](1), MaxSize: ptr.To[int32](10)},
+			desiredSize:   aws.Int32(5),
+			expectScaling: nil,
+		},
+		{
+			name:        "desired size above a lowered max is capped",
+			scaling:     &expinfrav1.ManagedMachinePoolScaling{MinSize: ptr.To[int32](1), MaxSize: ptr.To[int32](3)},
+			desiredSize: aws.Int32(5),
+			expectScaling: &ekstypes.NodegroupScalingConfig{
+				DesiredSize: aws.Int32(3),
+				MinSize:     aws.Int32(1),
+				MaxSize:     aws.Int32(3),
+			},
+		},
+		{
+			name:        "desired size is kept when min/max change",
+			scaling:     &expinfrav1.ManagedMachinePoolScaling{MinSize: ptr.To[int32](2), MaxSize: ptr.To[int32](8)},
+			desiredSize: aws.Int32(5),
+			expectScaling: &ekstypes.NodegroupScalingConfig{
+				DesiredSize: aws.Int32(5),
+				MinSize:     aws.Int32(2),
+				MaxSize:     aws.Int32(8),
+			},
+		},
+		{
+			name:          "desired size is kept within the nodegroup min/max without scaling",
+			desiredSize:   aws.Int32(5),
+			expectScaling: nil,
+		},
+		{
+			name:    "missing desired size is set to 1",
+			scaling: &expinfrav1.ManagedMachinePoolScaling{MinSize: ptr.To[int32](1), MaxSize: ptr.To[int32](10)},
+			expectScaling: &ekstypes.NodegroupScalingConfig{
+				DesiredSize: aws.Int32(1),
+				MinSize:     aws.Int32(1),
+				MaxSize:     aws.Int32(10),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "eks-cluster"}, expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName: "nodegroup",
+				Scaling:          tt.scaling,
+			})
+			s.scope.MachinePool = &clusterv1.MachinePool{}
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			s.EKSClient = eksMock
+
+			ng := &ekstypes.Nodegroup{
+				NodegroupName: aws.String("nodegroup"),
+				ScalingConfig: &ekstypes.NodegroupScalingConfig{
+					DesiredSize: tt.desiredSize,
+					MinSize:     aws.Int32(1),
+					MaxSize:     aws.Int32(10),
+				},
+				NodeRepairConfig: &ekstypes.NodeRepairConfig{Enabled: aws.Bool(false)},
+			}
+
+			if tt.expectScaling != nil {
+				eksMock.EXPECT().UpdateNodegroupConfig(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, input *eks.UpdateNodegroupConfigInput, _ ...func(*eks.Options)) (*eks.UpdateNodegroupConfigOutput, error) {
+						g.Expect(input.ScalingConfig).To(Equal(tt.expectScaling))
+						return &eks.UpdateNodegroupConfigOutput{}, nil
+					})
+			}
+
+			g.Expect(s.reconcileNodegroupConfig(context.TODO(), ng)).To(Succeed())
+		})
+	}
+}
+
+func TestNodegroupMaintenanceWindow(t *testing.T) {
+	window, err := ekspkg.ParseMaintenanceWindow("Sat 02:00-06:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2026-10-17 is a Saturday.
+	inside := time.Date(2026, time.October, 17, 3, 0, 0, 0, time.UTC)
+	outside := time.Date(2026, time.October, 14, 3, 0, 0, 0, time.UTC)
+
+	t.Run("version update", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			now           time.Time
+			expectUpdated bool
+		}{
+			{
+				name: "is deferred outside the window",
+				now:  outside,
+			},
+			{
+				name:          "is applied inside the window",
+				now:           inside,
+				expectUpdated: true,
+			},
+		}
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				g := NewWithT(t)
+				mockCtrl := gomock.NewController(t)
+				defer mockCtrl.Finish()
+				eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+				if tc.expectUpdated {
+					eksMock.EXPECT().UpdateNodegroupVersion(gomock.Any(), gomock.Any()).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+				}
+
+				s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName: "eks-cluster",
+				}, expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "nodegroup",
+					AMIVersion:       aws.String("1.30.0-20240201"),
+				})
+				s.scope.MachinePool = &clusterv1.MachinePool{}
+				s.EKSClient = eksMock
+				s.maintenanceWindow = window
+				s.now = func() time.Time { return tc.now }
+
+				updated, err := s.reconcileNodegroupVersion(context.TODO(), &ekstypes.Nodegroup{
+					NodegroupName:  aws.String("nodegroup"),
+					Version:        aws.String("1.30"),
+					ReleaseVersion: aws.String("1.30.0-20240101"),
+				})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(updated).To(Equal(tc.expectUpdated))
+				if tc.expectUpdated {
+					g.Expect(s.deferredChanges).To(BeEmpty())
+				} else {
+					g.Expect(s.deferredChanges).To(ConsistOf("update to AMI version 1.30.0-20240201"))
+				}
+			})
+		}
+	})
+
+	t.Run("scaling", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			now           time.Time
+			replicas      int32
+			expectScaling bool
+		}{
+			{
+				name:     "scale-down is deferred outside the window",
+				now:      outside,
+				replicas: 3,
+			},
+			{
+				name:          "scale-down is applied inside the window",
+				now:           inside,
+				replicas:      3,
+				expectScaling: true,
+			},
+			{
+				name:          "scale-up is applied outside the window",
+				now:           outside,
+				replicas:      7,
+				expectScaling: true,
+			},
+		}
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				g := NewWithT(t)
+				mockCtrl := gomock.NewController(t)
+				defer mockCtrl.Finish()
+				eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+				if tc.expectScaling {
+					eksMock.EXPECT().UpdateNodegroupConfig(gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, input *eks.UpdateNodegroupConfigInput, _ ...func(*eks.Options)) (*eks.UpdateNodegroupConfigOutput, error) {
+							g.Expect(input.ScalingConfig.DesiredSize).To(Equal(aws.Int32(tc.replicas)))
+							return &eks.UpdateNodegroupConfigOutput{}, nil
+						})
+				}
+
+				s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "eks-cluster"}, expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "nodegroup",
+					Scaling:          &expinfrav1.ManagedMachinePoolScaling{MinSize: ptr.To[int32](1), MaxSize: ptr.To[int32](10)},
+				})
+				s.scope.MachinePool = &clusterv1.MachinePool{
+					Spec: clusterv1.MachinePoolSpec{Replicas: ptr.To(tc.replicas)},
+				}
+				s.EKSClient = eksMock
+				s.maintenanceWindow = window
+				s.now = func() time.Time { return tc.now }
+
+				g.Expect(s.reconcileNodegroupConfig(context.TODO(), &ekstypes.Nodegroup{
+					NodegroupName: aws.String("nodegroup"),
+					ScalingConfig: &ekstypes.NodegroupScalingConfig{
+						DesiredSize: aws.Int32(5),
+						MinSize:     aws.Int32(1),
+						MaxSize:     aws.Int32(10),
+					},
+					NodeRepairConfig: &ekstypes.NodeRepairConfig{Enabled: aws.Bool(false)},
+				})).To(Succeed())
+				if tc.expectScaling {
+					g.Expect(s.deferredChanges).To(BeEmpty())
+				} else {
+					g.Expect(s.deferredChanges).To(ConsistOf("scale down from 5 to 3 nodes"))
+				}
+			})
+		}
+	})
+
+	t.Run("deferred changes", func(t *testing.T) {
+		tests := []struct {
+			name            string
+			window          *ekspkg.MaintenanceWindow
+			deferredChanges []string
+			expectCondition *clusterv1beta1.Condition
+			expectErr       bool
+		}{
+			{
+				name: "no condition without a window",
+			},
+			{
+				name:            "condition is true when nothing is deferred",
+				window:          window,
+				expectCondition: v1beta1conditions.TrueCondition(expinfrav1.EKSNodegroupDisruptiveChangesAppliedCondition),
+			},
+			{
+				name:            "deferred changes are reported until the window opens",
+				window:          window,
+				deferredChanges: []string{"update to version 1.31", "scale down from 5 to 3 nodes"},
+				expectCondition: v1beta1conditions.FalseCondition(expinfrav1.EKSNodegroupDisruptiveChangesAppliedCondition, expinfrav1.EKSNodegroupOutsideMaintenanceWindowReason, clusterv1beta1.ConditionSeverityInfo,
+					"update to version 1.31, scale down from 5 to 3 nodes deferred until the maintenance window opens at 2026-10-17T02:00:00Z"),
+				expectErr: true,
+			},
+		}
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				g := NewWithT(t)
+				s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{})
+				v1beta1conditions.MarkTrue(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupDisruptiveChangesAppliedCondition)
+				s.maintenanceWindow = tc.window
+				s.deferredChanges = tc.deferredChanges
+				s.now = func() time.Time { return outside }
+
+				err := s.reconcileDeferredChanges()
+				if tc.expectErr {
+					g.Expect(err).To(MatchError(ErrNodegroupChangesDeferred))
+				} else {
+					g.Expect(err).NotTo(HaveOccurred())
+				}
+				condition := v1beta1conditions.Get(s.scope.ManagedMachinePool, expinfrav1.EKSNodegroupDisruptiveChangesAppliedCondition)
+				if tc.expectCondition == nil {
+					g.Expect(condition).To(BeNil())
+					return
+				}
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Status).To(Equal(tc.expectCondition.Status))
+				g.Expect(condition.Reason).To(Equal(tc.expectCondition.Reason))
+				g.Expect(condition.Message).To(Equal(tc.expectCondition.Message))
+			})
+		}
+	})
+}
+
+func TestNodegroupRetriesThrottledCalls(t *testing.T) {
+	throttled := &smithy.GenericAPIError{Code: awserrors.ThrottlingException}
+
+	tests := []struct {
+		name   string
+		expect func(eksMock *mock_eksiface.MockEKSAPIMockRecorder, iamMock *mock_iamauth.MockIAMAPIMockRecorder)
+		call   func(s *NodegroupService) error
+	}{
+		{
+			name: "creation",
+			expect: func(eksMock *mock_eksiface.MockEKSAPIMockRecorder, iamMock *mock_iamauth.MockIAMAPIMockRecorder) {
+				iamMock.GetRole(gomock.Any(), gomock.Any()).Return(&awsiam.GetRoleOutput{
+					Role: &iamtypes.Role{Arn: aws.String("arn:aws:iam::123456789012:role/nodes")},
+				}, nil)
+				gomock.InOrder(
+					eksMock.CreateNodegroup(gomock.Any(), gomock.Any()).Return(nil, throttled).Times(2),
+					eksMock.CreateNodegroup(gomock.Any(), gomock.Any()).Return(&eks.CreateNodegroupOutput{
+						Nodegroup: &ekstypes.Nodegroup{NodegroupName: aws.String("nodegroup")},
+					}, nil),
+				)
+			},
+			call: func(s *NodegroupService) error {
+				ng, err := s.createNodegroup(context.TODO(), nil)
+				if err == nil && aws.ToString(ng.NodegroupName) != "nodegroup" {
+					return errors.New("unexpected nodegroup")
+				}
+				return err
+			},
+		},
+		{
+			name: "deletion",
+			expect: func(eksMock *mock_eksiface.MockEKSAPIMockRecorder, _ *mock_iamauth.MockIAMAPIMockRecorder) {
+				gomock.InOrder(
+					eksMock.DeleteNodegroup(gomock.Any(), gomock.Any()).Return(nil, throttled).Times(2),
+					eksMock.DeleteNodegroup(gomock.Any(), gomock.Any()).Return(&eks.DeleteNodegroupOutput{}, nil),
+					eksMock.WaitUntilNodegroupDeleted(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+				)
+			},
+			call: func(s *NodegroupService) error {
+				return s.deleteNodegroupAndWait(context.TODO())
+			},
+		},
+		{
+			name: "config update",
+			expect: func(eksMock *mock_eksiface.MockEKSAPIMockRecorder, _ *mock_iamauth.MockIAMAPIMockRecorder) {
+				gomock.InOrder(
+					eksMock.UpdateNodegroupConfig(gomock.Any(), gomock.Any()).Return(nil, throttled).Times(2),
+					eksMock.UpdateNodegroupConfig(gomock.Any(), gomock.Any()).Return(&eks.UpdateNodegroupConfigOutput{}, nil),
+				)
+			},
+			call: func(s *NodegroupService) error {
+				return s.reconcileNodegroupConfig(context.TODO(), &ekstypes.Nodegroup{
+					NodegroupName: aws.String("nodegroup"),
+					ScalingConfig: &ekstypes.NodegroupScalingConfig{DesiredSize: aws.Int32(3)},
+				})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+			tt.expect(eksMock.EXPECT(), iamMock.EXPECT())
+
+			s := newTestNodegroupScopeService(g, iamMock, false, func(params *scope.ManagedMachinePoolScopeParams) {
+				params.ManagedMachinePool.Spec.SubnetIDs = []string{"subnet-1"}
+			})
+			s.EKSClient = eksMock
+			s.backoff = kwait.Backoff{Duration: time.Millisecond, Factor: 1, Steps: 3}
+
+			g.Expect(tt.call(s)).To(Succeed())
+		})
+	}
+}
+
+func TestReconcileNodegroupClusterNotFoundOnCreate(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+	iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+	notFound := &ekstypes.ResourceNotFoundException{Message: aws.String("No cluster found for name: eks-cluster.")}
+
+	eksMock.EXPECT().DescribeNodegroup(gomock.Any(), gomock.Any()).Return(nil, notFound)
+	iamMock.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(&awsiam.GetRoleOutput{
+		Role: &iamtypes.Role{Arn: aws.String("arn:aws:iam::123456789012:role/nodes")},
+	}, nil)
+	eksMock.EXPECT().CreateNodegroup(gomock.Any(), gomock.Any()).Return(nil, notFound)
+
+	s := newTestNodegroupScopeService(g, iamMock, false, func(params *scope.ManagedMachinePoolScopeParams) {
+		params.ManagedMachinePool.Spec.SubnetIDs = []string{"subnet-1"}
+	})
+	s.EKSClient = eksMock
+
+	err := s.reconcileNodegroup(context.TODO())
+	g.Expect(err).To(MatchError(ErrNodegroupClusterNotFound))
+	g.Expect(s.scope.ManagedMachinePool.Status.Ready).To(BeFalse())
+}
+
+func TestNodegroupWaitDurations(t *testing.T) {
+	tests := []struct {
+		name                string
+		maxWaitCreateUpdate time.Duration
+		maxWaitDelete       time.Duration
+		expectCreateUpdate  time.Duration
+		expectDelete        time.Duration
+	}{
+		{
+			name:               "both default to the wait for managed resources",
+			expectCreateUpdate: 30 * time.Minute,
+			expectDelete:       30 * time.Minute,
+		},
+		{
+			name:                "separate create and delete durations",
+			maxWaitCreateUpdate: 90 * time.Minute,
+			maxWaitDelete:       20 * time.Minute,
+			expectCreateUpdate:  90 * time.Minute,
+			expectDelete:        20 * time.Minute,
+		},
+		{
+			name:                "only the create duration is set",
+			maxWaitCreateUpdate: 90 * time.Minute,
+			expectCreateUpdate:  90 * time.Minute,
+			expectDelete:        30 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+			eksMock.EXPECT().WaitUntilNodegroupActive(gomock.Any(), gomock.Any(), tt.expectCreateUpdate).Return(nil)
+			eksMock.EXPECT().DescribeNodegroup(gomock.Any(), gomock.Any()).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &ekstypes.Nodegroup{NodegroupName: aws.String("nodegroup"), Status: ekstypes.NodegroupStatusActive},
+			}, nil)
+			eksMock.EXPECT().DeleteNodegroup(gomock.Any(), gomock.Any()).Return(&eks.DeleteNodegroupOutput{}, nil)
+			eksMock.EXPECT().WaitUntilNodegroupDeleted(gomock.Any(), gomock.Any(), tt.expectDelete).Return(nil)
+
+			s := newTestNodegroupScopeService(g, nil, false, func(params *scope.ManagedMachinePoolScopeParams) {
+				params.MaxWaitActiveUpdateDelete = 30 * time.Minute
+				params.MaxWaitCreateUpdate = tt.maxWaitCreateUpdate
+				params.MaxWaitDelete = tt.maxWaitDelete
+			})
+			s.EKSClient = eksMock
+
+			_, err := s.waitForNodegroupActive(context.TODO())
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(s.deleteNodegroupAndWait(context.TODO())).To(Succeed())
+		})
+	}
+}
+
+func TestNodegroupThrottledCallsFailOnceBackoffIsExhausted(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	eksMock := mock_eksiface.NewMockEKSAPI(mockCtrl)
+	eksMock.EXPECT().DeleteNodegroup(gomock.Any(), gomock.Any()).
+		Return(nil, &smithy.GenericAPIError{Code: awserrors.ThrottlingException}).Times(2)
+
+	s := newTestNodegroupScopeService(g, nil, false, func(*scope.ManagedMachinePoolScopeParams) {})
+	s.EKSClient = eksMock
+	s.backoff = kwait.Backoff{Duration: time.Millisecond, Factor: 1, Steps: 2}
+
+	err := s.deleteNodegroupAndWait(context.TODO())
+	g.Expect(awserrors.IsThrottlingError(err)).To(BeTrue())
+}
+
+func TestNodegroupNextFallbackInstanceType(t *testing.T) {
+	fallbackInstanceTypes := []string{"m5a.large", "m6i.large"}
+	tests := []struct {
+		name     string
+		current  string
+		expected string
+	}{
+		{
+			name:     "launch template instance type falls back to the first instance type",
+			expected: "m5a.large",
+		},
+		{
+			name:     "fallback instance type falls back to the next one",
+			current:  "m5a.large",
+			expected: "m6i.large",
+		},
+		{
+			name:     "last fallback instance type has no fallback",
+			current:  "m6i.large",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(nextFallbackInstanceType(fallbackInstanceTypes, tt.current)).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestNodegroupReconcileInstanceTypeFallback(t *testing.T) {
+	capacityIssue := ekstypes.Issue{
+		Code:    ekstypes.NodegroupIssueCodeAsgInstanceLaunchFailures,
+		Message: aws.String("Could not launch On-Demand Instances. InsufficientInstanceCapacity - We currently do not have sufficient m5.large capacity in the Availability Zone you requested."),
+	}
+	otherIssue := ekstypes.Issue{
+		Code:    ekstypes.NodegroupIssueCodeAsgInstanceLaunchFailures,
+		Message: aws.String("Could not launch On-Demand Instances. VcpuLimitExceeded - You have requested more vCPU capacity than your current vCPU limit allows."),
+	}
+	tests := []struct {
+		name             string
+		fallback         *expinfrav1.InstanceTypeFallback
+		status           *expinfrav1.InstanceTypeFallbackStatus
+		ngStatus         ekstypes.NodegroupStatus
+		ngVersion        string
+		issues           []ekstypes.Issue
+		expectedFallback string
+	}{
+		{
+			name:             "no fallback configured",
+			status:           &expinfrav1.InstanceTypeFallbackStatus{InstanceType: "m5a.large", LastTransitionTime: metav1.Now()},
+			issues:           []ekstypes.Issue{capacityIssue},
+			expectedFallback: "",
+		},
+		{
+			name:             "insufficient capacity selects the first fallback",
+			fallback:         &expinfrav1.InstanceTypeFallback{InstanceTypes: []string{"m5a.large", "m6i.large"}},
+			issues:           []ekstypes.Issue{capacityIssue},
+			expectedFallback: "m5a.large",
+		},
+		{
+			name:             "insufficient capacity of a fallback selects the next one",
+			fallback:         &expinfrav1.InstanceTypeFallback{InstanceTypes: []string{"m5a.large", "m6i.large"}},
+			status:           &expinfrav1.InstanceTypeFallbackStatus{InstanceType: "m5a.large", LastTransitionTime: metav1.Now()},
+			issues:           []ekstypes.Issue{capacityIssue},
+			expectedFallback: "m6i.large",
+		},
+		{
+			name:             "insufficient capacity of the last fallback keeps it",
+			fallback:         &expinfrav1.InstanceTypeFallback{InstanceTypes: []string{"m5a.large", "m6i.large"}},
+			status:           &expinfrav1.InstanceTypeFallbackStatus{InstanceType: "m6i.large", LastTransitionTime: metav1.Now()},
+			issues:           []ekstypes.Issue{capacityIssue},
+			expectedFallback: "m6i.large",
+		},
+		{
+			name:             "other launch failures don't select a fallback",
+			fallback:         &expinfrav1.InstanceTypeFallback{InstanceTypes: []string{"m5a.large"}},
+			issues:           []ekstypes.Issue{otherIssue},
+			expectedFallback: "",
+		},
+		{
+			name:             "insufficient capacity is ignored until the selected instance type is rolled out",
+			fallback:         &expinfrav1.InstanceTypeFallback{InstanceTypes: []string{"m5a.large", "m6i.large"}},
+			status:           &expinfrav1.InstanceTypeFallbackStatus{InstanceType: "m5a.large", LastTransitionTime: metav1.Now()},
+			ngVersion:        "1",
+			issues:           []ekstypes.Issue{capacityIssue},
+			expectedFallback: "m5a.large",
+		},
+		{
+			name:             "insufficient capacity is ignored while the nodegroup is updating",
+			fallback:         &expinfrav1.InstanceTypeFallback{InstanceTypes: []string{"m5a.large", "m6i.large"}},
+			ngStatus:         ekstypes.NodegroupStatusUpdating,
+			issues:           []ekstypes.Issue{capacityIssue},
+			expectedFallback: "",
+		},
+		{
+			name:             "fallback is kept during the cooldown",
+			fallback:         &expinfrav1.InstanceTypeFallback{InstanceTypes: []string{"m5a.large"}},
+			status:           &expinfrav1.InstanceTypeFallbackStatus{InstanceType: "m5a.large", LastTransitionTime: metav1.NewTime(time.Now().Add(-10 * time.Minute))},
+			expectedFallback: "m5a.large",
+		},
+		{
+			name:             "launch template instance type is retried after the default cooldown",
+			fallback:         &expinfrav1.InstanceTypeFallback{InstanceTypes: []string{"m5a.large"}},
+			status:           &expinfrav1.InstanceTypeFallbackStatus{InstanceType: "m5a.large", LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour))},
+			expectedFallback: "",
+		},
+		{
+			name: "launch template instance type is retried after the configured cooldown",
+			fallback: &expinfrav1.InstanceTypeFallback{
+				InstanceTypes: []string{"m5a.large"},
+				Cooldown:      &metav1.Duration{Duration: 5 * time.Minute},
+			},
+			status:           &expinfrav1.InstanceTypeFallbackStatus{InstanceType: "m5a.large", LastTransitionTime: metav1.NewTime(time.Now().Add(-10 * time.Minute))},
+			expectedFallback: "",
+		},
+		{
+			name:             "fallback removed from the spec isn't used",
+			fallback:         &expinfrav1.InstanceTypeFallback{InstanceTypes: []string{"m6i.large"}},
+			status:           &expinfrav1.InstanceTypeFallbackStatus{InstanceType: "m5a.large", LastTransitionTime: metav1.Now()},
+			expectedFallback: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName:     "nodegroup",
+				AWSLaunchTemplate:    &expinfrav1.AWSLaunchTemplate{InstanceType: "m5.large"},
+				InstanceTypeFallback: tt.fallback,
+			})
+			s.scope.ManagedMachinePool.Status.InstanceTypeFallback = tt.status
+			s.scope.ManagedMachinePool.Status.LaunchTemplateVersion = aws.String("2")
+
+			ngStatus := ekstypes.NodegroupStatusActive
+			if tt.ngStatus != "" {
+				ngStatus = tt.ngStatus
+			}
+			ngVersion := "2"
+			if tt.ngVersion != "" {
+				ngVersion = tt.ngVersion
+			}
+			ng := &ekstypes.Nodegroup{
+				Status:         ngStatus,
+				LaunchTemplate: &ekstypes.LaunchTemplateSpecification{Version: aws.String(ngVersion)},
+				Health:         &ekstypes.NodegroupHealth{Issues: tt.issues},
+			}
+
+			s.reconcileInstanceTypeFallback(ng)
+
+			if tt.expectedFallback == "" {
+				g.Expect(s.scope.ManagedMachinePool.Status.InstanceTypeFallback).To(BeNil())
+				return
+			}
+			g.Expect(s.scope.ManagedMachinePool.Status.InstanceTypeFallback).NotTo(BeNil())
+			g.Expect(s.scope.ManagedMachinePool.Status.InstanceTypeFallback.InstanceType).To(Equal(tt.expectedFallback))
+		})
+	}
+}
+
+func TestNodegroupConfigAMITypeChanged(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "eks-cluster"}, expinfrav1.AWSManagedMachinePoolSpec{
+		EKSNodegroupName: "nodegroup",
+		AMIType:          ptr.To(expinfrav1.Al2023x86_64),
+	})
+	s.scope.MachinePool = &clusterv1.MachinePool{}
+	// No UpdateNodegroupConfig call is expected.
+	s.EKSClient = mock_eksiface.NewMockEKSAPI(mockCtrl)
+
+	ng := &ekstypes.Nodegroup{
+		NodegroupName: aws.String("nodegroup"),
+		AmiType:       ekstypes.AMITypesAl2X8664,
+		ScalingConfig: &ekstypes.NodegroupScalingConfig{DesiredSize: aws.Int32(1)},
+	}
+
+	err := s.reconcileNodegroupConfig(context.TODO(), ng)
+	g.Expect(err).To(MatchError(ErrNodegroupAMITypeChanged))
+	g.Expect(err.Error()).To(ContainSubstring("the nodegroup must be recreated"))
+}
+
+func TestNodegroupCheckAMIType(t *testing.T) {
+	tests := []struct {
+		name      string
+		amiType   *expinfrav1.ManagedMachineAMIType
+		ngAMIType ekstypes.AMITypes
+		expectErr bool
+	}{
+		{
+			name:      "no AMI type in the spec",
+			ngAMIType: ekstypes.AMITypesAl2X8664,
+		},
+		{
+			name:      "matching AMI type",
+			amiType:   ptr.To(expinfrav1.Al2023x86_64),
+			ngAMIType: ekstypes.AMITypesAl2023X8664Standard,
+		},
+		{
+			name:      "AMI taken from the launch template",
+			amiType:   ptr.To(expinfrav1.WindowsCore2022x86_64),
+			ngAMIType: ekstypes.AMITypesCustom,
+		},
+		{
+			name:      "changed AMI type",
+			amiType:   ptr.To(expinfrav1.Al2023x86_64),
+			ngAMIType: ekstypes.AMITypesBottlerocketX8664,
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{AMIType: tt.amiType})
+
+			err := s.checkAMIType(&ekstypes.Nodegroup{AmiType: tt.ngAMIType})
+			if tt.expectErr {
+				g.Expect(err).To(MatchError(ErrNodegroupAMITypeChanged))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestNodegroupCheckFailureDomains(t *testing.T) {
+	tests := []struct {
+		name           string
+		failureDomains []string
+		ngSubnets      []string
+		expectErr      bool
+	}{
+		{
+			name:      "no failure domains",
+			ngSubnets: []string{"subnet-1a"},
+		},
+		{
+			name:           "nodegroup in the failure domains",
+			failureDomains: []string{"us-east-1a", "us-east-1b"},
+			ngSubnets:      []string{"subnet-1a", "subnet-1b"},
+		},
+		{
+			name:           "changed failure domains",
+			failureDomains: []string{"us-east-1a", "us-east-1c"},
+			ngSubnets:      []string{"subnet-1a", "subnet-1b"},
+			expectErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				NetworkSpec: infrav1.NetworkSpec{Subnets: infrav1.Subnets{
+					{ID: "subnet-1a", AvailabilityZone: "us-east-1a"},
+					{ID: "subnet-1b", AvailabilityZone: "us-east-1b"},
+					{ID: "subnet-1c", AvailabilityZone: "us-east-1c"},
+				}},
+			}, expinfrav1.AWSManagedMachinePoolSpec{EKSNodegroupName: "nodegroup"})
+			s.scope.MachinePool = &clusterv1.MachinePool{
+				Spec: clusterv1.MachinePoolSpec{FailureDomains: tt.failureDomains},
+			}
+
+			err := s.checkFailureDomains(&ekstypes.Nodegroup{Subnets: tt.ngSubnets})
+			if tt.expectErr {
+				g.Expect(err).To(MatchError(ErrNodegroupFailureDomainsChanged))
+				g.Expect(err.Error()).To(ContainSubstring("nodegroup is in availability zones [us-east-1a us-east-1b] instead of the failure domains [us-east-1a us-east-1c]"))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestNodegroupCheckInstanceTypes(t *testing.T) {
+	tests := []struct {
+		name          string
+		instanceTypes []string
+		ngTypes       []string
+		expectErr     bool
+	}{
+		{
+			name:    "no instance types",
+			ngTypes: []string{"t3.medium"},
+		},
+		{
+			name:          "same instance types in another order",
+			instanceTypes: []string{"m5.large", "m5a.large"},
+			ngTypes:       []string{"m5a.large", "m5.large"},
+		},
+		{
+			name:          "changed instance types",
+			instanceTypes: []string{"m5.large", "m6i.large"},
+			ngTypes:       []string{"m5.large", "m5a.large"},
+			expectErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{InstanceTypes: tt.instanceTypes})
+
+			err := s.checkInstanceTypes(&ekstypes.Nodegroup{InstanceTypes: tt.ngTypes})
+			if tt.expectErr {
+				g.Expect(err).To(MatchError(ErrNodegroupInstanceTypesChanged))
+				g.Expect(err.Error()).To(ContainSubstring("nodegroup uses instance types [m5.large m5a.large] instead of [m5.large m6i.large]"))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestNodegroupCheckRemovedSubnets(t *testing.T) {
+	tests := []struct {
+		name      string
+		ngSubnets []string
+		expectErr bool
+	}{
+		{
+			name:      "subnets in the cluster network",
+			ngSubnets: []string{"subnet-1a", "subnet-1b"},
+		},
+		{
+			name:      "subnet removed from the cluster network",
+			ngSubnets: []string{"subnet-1a", "subnet-removed"},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				NetworkSpec: infrav1.NetworkSpec{Subnets: infrav1.Subnets{
+					{ID: "subnet-1a", AvailabilityZone: "us-east-1a"},
+					{ID: "subnet-1b", AvailabilityZone: "us-east-1b"},
+				}},
+			}, expinfrav1.AWSManagedMachinePoolSpec{EKSNodegroupName: "nodegroup"})
+
+			err := s.checkRemovedSubnets(&ekstypes.Nodegroup{Subnets: tt.ngSubnets})
+			if tt.expectErr {
+				g.Expect(err).To(MatchError(ErrNodegroupSubnetsRemoved))
+				g.Expect(err.Error()).To(ContainSubstring("subnets [subnet-removed] can't be removed from the nodegroup"))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestNodegroupReportedOnReadyCondition(t *testing.T) {
+	err := errors.Wrap(ErrNodegroupFailureDomainsChanged, "nodegroup is in availability zones [us-east-1a]")
+	tests := []struct {
+		name     string
+		mark     func(pool *expinfrav1.AWSManagedMachinePool)
+		expected bool
+	}{
+		{
+			name: "no condition",
+		},
+		{
+			name: "ready",
+			mark: func(pool *expinfrav1.AWSManagedMachinePool) {
+				v1beta1conditions.MarkTrue(pool, expinfrav1.EKSNodegroupReadyCondition)
+			},
+		},
+		{
+			name: "reporting another problem",
+			mark: func(pool *expinfrav1.AWSManagedMachinePool) {
+				v1beta1conditions.MarkFalse(pool, expinfrav1.EKSNodegroupReadyCondition, expinfrav1.EKSNodegroupAMITypeChangedReason, clusterv1beta1.ConditionSeverityWarning, "nodegroup uses AMI type AL2_x86_64")
+			},
+		},
+		{
+			name: "already reporting the problem along with others",
+			mark: func(pool *expinfrav1.AWSManagedMachinePool) {
+				v1beta1conditions.MarkFalse(pool, expinfrav1.EKSNodegroupReadyCondition, expinfrav1.EKSNodegroupFailureDomainsChangedReason, clusterv1beta1.ConditionSeverityWarning,
+					"[%s, changes deferred]", err.Error())
+			},
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{})
+			if tt.mark != nil {
+				tt.mark(s.scope.ManagedMachinePool)
+			}
+			g.Expect(s.reportedOnReadyCondition(err)).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestNodegroupRoleArnEmptyRoleName(t *testing.T) {
+	g := NewWithT(t)
+	s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{})
+
+	arn, err := s.roleArn(context.TODO())
+	g.Expect(err).To(MatchError("node group IAM role name is empty"))
+	g.Expect(arn).To(BeNil())
+}
+
+func TestNodegroupValidateLaunchTemplateAMI(t *testing.T) {
+	const templateID = "lt-0123456789abcdef0"
+
+	tests := []struct {
+		name        string
+		amiType     expinfrav1.ManagedMachineAMIType
+		imageID     *string
+		expectedErr error
+	}{
+		{
+			name:        "custom AMI type requires an AMI in the launch template",
+			amiType:     expinfrav1.Custom,
+			expectedErr: ErrNodegroupLaunchTemplateAMIMissing,
+		},
+		{
+			name:    "custom AMI type with an AMI in the launch template",
+			amiType: expinfrav1.Custom,
+			imageID: aws.String("ami-0123456789abcdef0"),
+		},
+		{
+			name:    "EKS picks the AMI of other AMI types",
+			amiType: expinfrav1.BottleRocketx86_64,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestNodegroupService(ekscontrolplanev1.AWSManagedControlPlaneSpec{}, expinfrav1.AWSManagedMachinePoolSpec{
+				AMIType:                ptr.To(tc.amiType),
+				ExternalLaunchTemplate: &expinfrav1.ExternalLaunchTemplate{ID: templateID},
+			})
+
+			err := s.validateLaunchTemplateAMI(&ec2types.LaunchTemplateVersion{
+				LaunchTemplateId:   aws.String(templateID),
+				LaunchTemplateData: &ec2types.ResponseLaunchTemplateData{ImageId: tc.imageID},
+			})
+			if tc.expectedErr != nil {
+				g.Expect(errors.Is(err, tc.expectedErr)).To(BeTrue())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
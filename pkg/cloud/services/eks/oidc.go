@@ -20,8 +20,10 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/pkg/errors"
@@ -31,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/converters"
 	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
 	tagConverter "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
@@ -45,9 +48,12 @@ var (
 )
 
 func (s *Service) reconcileOIDCProvider(ctx context.Context, cluster *ekstypes.Cluster) error {
-	if !s.scope.ControlPlane.Spec.AssociateOIDCProvider || s.scope.ControlPlane.Status.OIDCProvider.ARN != "" {
+	if !s.scope.ControlPlane.Spec.AssociateOIDCProvider {
 		return nil
 	}
+	if s.scope.ControlPlane.Status.OIDCProvider.ARN != "" {
+		return s.reconcileOIDCProviderTags(ctx, cluster)
+	}
 
 	if !s.scope.EnableIAM() {
 		return errors.New("'AssociateOIDCProvider' provided without enabling the 'EKSEnableIAM' feature flag")
@@ -92,6 +98,54 @@ func (s *Service) reconcileOIDCProvider(ctx context.Context, cluster *ekstypes.C
 	return nil
 }
 
+// reconcileOIDCProviderTags keeps the tags of the OIDC provider in sync with the
+// tags of the cluster, removing tags which are no longer set. The owned tag is
+// part of the desired tags and the cloud provider cluster tag is never removed.
+func (s *Service) reconcileOIDCProviderTags(ctx context.Context, cluster *ekstypes.Cluster) error {
+	providerARN := s.scope.ControlPlane.Status.OIDCProvider.ARN
+
+	provider, err := s.IAMClient.GetOpenIDConnectProvider(ctx, &iam.GetOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(providerARN),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get OIDC provider")
+	}
+
+	currentTags := make(map[string]string, len(provider.Tags))
+	for _, tag := range provider.Tags {
+		currentTags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	desiredTags := infrav1.Build(*s.getEKSTagParams(aws.ToString(cluster.Arn)))
+	cloudProviderTagKey := infrav1.ClusterAWSCloudProviderTagKey(s.scope.KubernetesClusterName())
+
+	untagKeys, newTags := getTagUpdates(currentTags, desiredTags)
+	untagKeys = slices.DeleteFunc(untagKeys, func(key string) bool { return key == cloudProviderTagKey })
+	slices.Sort(untagKeys)
+
+	if len(newTags) > 0 {
+		s.scope.Debug("Tagging OIDC provider", "arn", providerARN, "tags", newTags)
+		if _, err := s.IAMClient.TagOpenIDConnectProvider(ctx, &iam.TagOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: aws.String(providerARN),
+			Tags:                     tagConverter.MapToIAMTags(newTags),
+		}); err != nil {
+			return errors.Wrap(err, "failed to tag OIDC provider")
+		}
+	}
+
+	if len(untagKeys) > 0 {
+		s.scope.Debug("Untagging OIDC provider", "arn", providerARN, "keys", untagKeys)
+		if _, err := s.IAMClient.UntagOpenIDConnectProvider(ctx, &iam.UntagOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: aws.String(providerARN),
+			TagKeys:                  untagKeys,
+		}); err != nil {
+			return errors.Wrap(err, "failed to untag OIDC provider")
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) reconcileTrustPolicy(ctx context.Context) error {
 	clusterKey := client.ObjectKey{
 		Name:      s.scope.Name(),
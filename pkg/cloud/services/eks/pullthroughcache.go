@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+)
+
+// reconcilePullThroughCache creates the ECR pull-through cache rules of the cluster and,
+// when the VPC is managed, the VPC endpoints the nodes in private subnets need to pull
+// images through them without internet access.
+func (s *Service) reconcilePullThroughCache(ctx context.Context) error {
+	cache := s.scope.ControlPlane.Spec.ECRPullThroughCache
+	if cache == nil {
+		return nil
+	}
+	s.scope.Debug("Reconciling ECR pull-through cache")
+
+	if err := s.reconcilePullThroughCacheRules(ctx, cache.Rules); err != nil {
+		return err
+	}
+	return s.reconcilePullThroughCacheEndpoints(ctx)
+}
+
+// reconcilePullThroughCacheRules creates the missing rules and updates the credentials of
+// the existing ones. The rules belong to the account and region, so rules that aren't in
+// the spec are left in place.
+func (s *Service) reconcilePullThroughCacheRules(ctx context.Context, rules []ekscontrolplanev1.ECRPullThroughCacheRule) error {
+	existing := map[string]ecrtypes.PullThroughCacheRule{}
+	paginator := ecr.NewDescribePullThroughCacheRulesPaginator(s.ECRClient, &ecr.DescribePullThroughCacheRulesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to describe ecr pull-through cache rules")
+		}
+		for _, rule := range page.PullThroughCacheRules {
+			existing[aws.ToString(rule.EcrRepositoryPrefix)] = rule
+		}
+	}
+
+	for _, rule := range rules {
+		current, ok := existing[rule.RepositoryPrefix]
+		switch {
+		case !ok:
+			s.scope.Info("Creating ECR pull-through cache rule", "prefix", rule.RepositoryPrefix, "upstream", rule.UpstreamRegistryURL)
+			if _, err := s.ECRClient.CreatePullThroughCacheRule(ctx, &ecr.CreatePullThroughCacheRuleInput{
+				EcrRepositoryPrefix: aws.String(rule.RepositoryPrefix),
+				UpstreamRegistryUrl: aws.String(rule.UpstreamRegistryURL),
+				CredentialArn:       rule.CredentialARN,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to create ecr pull-through cache rule %q", rule.RepositoryPrefix)
+			}
+		case aws.ToString(current.UpstreamRegistryUrl) != rule.UpstreamRegistryURL:
+			// The upstream registry of a rule can't be changed, and replacing the rule
+			// could break the other clusters of the account using it.
+			return errors.Errorf("ecr pull-through cache rule %q already exists for upstream registry %q",
+				rule.RepositoryPrefix, aws.ToString(current.UpstreamRegistryUrl))
+		case rule.CredentialARN != nil && *rule.CredentialARN != aws.ToString(current.CredentialArn):
+			s.scope.Info("Updating ECR pull-through cache rule credential", "prefix", rule.RepositoryPrefix)
+			if _, err := s.ECRClient.UpdatePullThroughCacheRule(ctx, &ecr.UpdatePullThroughCacheRuleInput{
+				EcrRepositoryPrefix: aws.String(rule.RepositoryPrefix),
+				CredentialArn:       rule.CredentialARN,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to update ecr pull-through cache rule %q", rule.RepositoryPrefix)
+			}
+		}
+	}
+
+	return nil
+}
+
+// pullThroughCacheInterfaceServices returns the services of the interface endpoints nodes
+// need to authenticate to ECR and pull images.
+func (s *Service) pullThroughCacheInterfaceServices() []string {
+	return []string{
+		fmt.Sprintf("com.amazonaws.%s.ecr.api", s.scope.Region()),
+		fmt.Sprintf("com.amazonaws.%s.ecr.dkr", s.scope.Region()),
+	}
+}
+
+// pullThroughCacheGatewayService returns the service of the gateway endpoint nodes need to
+// download the image layers, which ECR stores in S3.
+func (s *Service) pullThroughCacheGatewayService() string {
+	return fmt.Sprintf("com.amazonaws.%s.s3", s.scope.Region())
+}
+
+// reconcilePullThroughCacheEndpoints creates the missing ECR and S3 endpoints in the private
+// subnets of a managed VPC. Endpoints that already exist for these services, such as the
+// S3 endpoint of the network service, are left as they are.
+func (s *Service) reconcilePullThroughCacheEndpoints(ctx context.Context) error {
+	// The endpoints of unmanaged VPCs are left to their owners, like the other VPC endpoints.
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) || s.scope.VPC().ID == "" {
+		return nil
+	}
+
+	subnetIDs := []string{}
+	routeTableIDs := sets.New[string]()
+	zones := sets.New[string]()
+	for _, subnet := range s.scope.Subnets().FilterPrivate() {
+		if subnet.IsEdge() {
+			continue
+		}
+		if subnet.RouteTableID != nil && *subnet.RouteTableID != "" {
+			routeTableIDs.Insert(*subnet.RouteTableID)
+		}
+		// An interface endpoint has at most one subnet in each availability zone.
+		if !zones.Has(subnet.AvailabilityZone) {
+			zones.Insert(subnet.AvailabilityZone)
+			subnetIDs = append(subnetIDs, subnet.GetResourceID())
+		}
+	}
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+
+	// Nodes of managed node groups are in the cluster security group, which allows all
+	// traffic from its members.
+	clusterSG, ok := s.scope.ControlPlane.Status.Network.SecurityGroups[ekscontrolplanev1.SecurityGroupCluster]
+	if !ok {
+		return errors.Errorf("%s security group not found on control plane", ekscontrolplanev1.SecurityGroupCluster)
+	}
+
+	services := append(s.pullThroughCacheInterfaceServices(), s.pullThroughCacheGatewayService())
+	endpoints, err := s.describePullThroughCacheEndpoints(ctx, ec2types.Filter{
+		Name:   aws.String("service-name"),
+		Values: services,
+	})
+	if err != nil {
+		return err
+	}
+	existing := sets.New[string]()
+	for _, ep := range endpoints {
+		existing.Insert(aws.ToString(ep.ServiceName))
+	}
+
+	tagSpecification := tags.BuildParamsToTagSpecification(ec2types.ResourceTypeVpcEndpoint, infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	})
+	for _, service := range s.pullThroughCacheInterfaceServices() {
+		if existing.Has(service) {
+			continue
+		}
+		s.scope.Info("Creating VPC endpoint for the ECR pull-through cache", "service", service)
+		if _, err := s.EC2Client.CreateVpcEndpoint(ctx, &ec2.CreateVpcEndpointInput{
+			VpcId:             aws.String(s.scope.VPC().ID),
+			ServiceName:       aws.String(service),
+			VpcEndpointType:   ec2types.VpcEndpointTypeInterface,
+			SubnetIds:         subnetIDs,
+			SecurityGroupIds:  []string{clusterSG.ID},
+			PrivateDnsEnabled: aws.Bool(true),
+			TagSpecifications: []ec2types.TagSpecification{tagSpecification},
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create vpc endpoint for service %q", service)
+		}
+	}
+
+	if service := s.pullThroughCacheGatewayService(); !existing.Has(service) && routeTableIDs.Len() > 0 {
+		s.scope.Info("Creating VPC endpoint for the ECR pull-through cache", "service", service)
+		if _, err := s.EC2Client.CreateVpcEndpoint(ctx, &ec2.CreateVpcEndpointInput{
+			VpcId:             aws.String(s.scope.VPC().ID),
+			ServiceName:       aws.String(service),
+			VpcEndpointType:   ec2types.VpcEndpointTypeGateway,
+			RouteTableIds:     sets.List(routeTableIDs),
+			TagSpecifications: []ec2types.TagSpecification{tagSpecification},
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create vpc endpoint for service %q", service)
+		}
+	}
+
+	return nil
+}
+
+// deletePullThroughCacheEndpoints deletes the ECR endpoints owned by the cluster before the
+// cluster, as they use its security group.
+func (s *Service) deletePullThroughCacheEndpoints(ctx context.Context) error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) || s.scope.VPC().ID == "" {
+		return nil
+	}
+
+	endpoints, err := s.describePullThroughCacheEndpoints(ctx,
+		ec2types.Filter{
+			Name:   aws.String("service-name"),
+			Values: s.pullThroughCacheInterfaceServices(),
+		},
+		filter.EC2.ClusterOwned(s.scope.Name()),
+	)
+	if err != nil {
+		return err
+	}
+
+	ids := []string{}
+	for _, ep := range endpoints {
+		if ep.VpcEndpointId != nil && *ep.VpcEndpointId != "" {
+			ids = append(ids, *ep.VpcEndpointId)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	s.scope.Info("Deleting VPC endpoints of the ECR pull-through cache", "ids", ids)
+	if _, err := s.EC2Client.DeleteVpcEndpoints(ctx, &ec2.DeleteVpcEndpointsInput{
+		VpcEndpointIds: ids,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to delete vpc endpoints %v", ids)
+	}
+	return nil
+}
+
+func (s *Service) describePullThroughCacheEndpoints(ctx context.Context, filters ...ec2types.Filter) ([]ec2types.VpcEndpoint, error) {
+	input := &ec2.DescribeVpcEndpointsInput{
+		Filters: append(filters, ec2types.Filter{
+			Name:   aws.String("vpc-id"),
+			Values: []string{s.scope.VPC().ID},
+		}),
+	}
+	endpoints := []ec2types.VpcEndpoint{}
+	paginator := ec2.NewDescribeVpcEndpointsPaginator(s.EC2Client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe vpc endpoints")
+		}
+		endpoints = append(endpoints, page.VpcEndpoints...)
+	}
+	return endpoints, nil
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func newPullThroughCacheTestService(t *testing.T, spec ekscontrolplanev1.AWSManagedControlPlaneSpec) *Service {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	spec.EKSClusterName = clusterName
+	spec.Region = "us-east-1"
+	controlPlaneScope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: clusterName},
+		},
+		ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: clusterName},
+			Spec:       spec,
+			Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+				Network: infrav1.NetworkStatus{
+					SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+						ekscontrolplanev1.SecurityGroupCluster: {ID: "sg-cluster"},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewService(controlPlaneScope)
+}
+
+func TestReconcilePullThroughCacheRules(t *testing.T) {
+	dockerHub := ekscontrolplanev1.ECRPullThroughCacheRule{
+		RepositoryPrefix:    "docker-hub",
+		UpstreamRegistryURL: "registry-1.docker.io",
+		CredentialARN:       aws.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:ecr-pullthroughcache/docker-hub"),
+	}
+	k8s := ekscontrolplanev1.ECRPullThroughCacheRule{
+		RepositoryPrefix:    "k8s",
+		UpstreamRegistryURL: "registry.k8s.io",
+	}
+
+	tests := []struct {
+		name        string
+		existing    []ecrtypes.PullThroughCacheRule
+		expect      func(m *mock_eksiface.MockECRAPIMockRecorder)
+		expectError bool
+	}{
+		{
+			name: "missing rules are created",
+			expect: func(m *mock_eksiface.MockECRAPIMockRecorder) {
+				m.CreatePullThroughCacheRule(gomock.Any(), &ecr.CreatePullThroughCacheRuleInput{
+					EcrRepositoryPrefix: aws.String("docker-hub"),
+					UpstreamRegistryUrl: aws.String("registry-1.docker.io"),
+					CredentialArn:       dockerHub.CredentialARN,
+				}).Return(&ecr.CreatePullThroughCacheRuleOutput{}, nil)
+				m.CreatePullThroughCacheRule(gomock.Any(), &ecr.CreatePullThroughCacheRuleInput{
+					EcrRepositoryPrefix: aws.String("k8s"),
+					UpstreamRegistryUrl: aws.String("registry.k8s.io"),
+				}).Return(&ecr.CreatePullThroughCacheRuleOutput{}, nil)
+			},
+		},
+		{
+			name: "existing rules are left as they are",
+			existing: []ecrtypes.PullThroughCacheRule{
+				{EcrRepositoryPrefix: aws.String("docker-hub"), UpstreamRegistryUrl: aws.String("registry-1.docker.io"), CredentialArn: dockerHub.CredentialARN},
+				{EcrRepositoryPrefix: aws.String("k8s"), UpstreamRegistryUrl: aws.String("registry.k8s.io")},
+				{EcrRepositoryPrefix: aws.String("quay"), UpstreamRegistryUrl: aws.String("quay.io")},
+			},
+			expect: func(m *mock_eksiface.MockECRAPIMockRecorder) {},
+		},
+		{
+			name: "changed credentials are updated",
+			existing: []ecrtypes.PullThroughCacheRule{
+				{EcrRepositoryPrefix: aws.String("docker-hub"), UpstreamRegistryUrl: aws.String("registry-1.docker.io"), CredentialArn: aws.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:ecr-pullthroughcache/old")},
+				{EcrRepositoryPrefix: aws.String("k8s"), UpstreamRegistryUrl: aws.String("registry.k8s.io")},
+			},
+			expect: func(m *mock_eksiface.MockECRAPIMockRecorder) {
+				m.UpdatePullThroughCacheRule(gomock.Any(), &ecr.UpdatePullThroughCacheRuleInput{
+					EcrRepositoryPrefix: aws.String("docker-hub"),
+					CredentialArn:       dockerHub.CredentialARN,
+				}).Return(&ecr.UpdatePullThroughCacheRuleOutput{}, nil)
+			},
+		},
+		{
+			name: "rule with another upstream registry is an error",
+			existing: []ecrtypes.PullThroughCacheRule{
+				{EcrRepositoryPrefix: aws.String("docker-hub"), UpstreamRegistryUrl: aws.String("public.ecr.aws")},
+			},
+			expect:      func(m *mock_eksiface.MockECRAPIMockRecorder) {},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ecrMock := mock_eksiface.NewMockECRAPI(mockControl)
+			ecrMock.EXPECT().DescribePullThroughCacheRules(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&ecr.DescribePullThroughCacheRulesOutput{PullThroughCacheRules: tc.existing}, nil)
+			tc.expect(ecrMock.EXPECT())
+
+			s := newPullThroughCacheTestService(t, ekscontrolplanev1.AWSManagedControlPlaneSpec{})
+			s.ECRClient = ecrMock
+
+			err := s.reconcilePullThroughCacheRules(context.TODO(), []ekscontrolplanev1.ECRPullThroughCacheRule{dockerHub, k8s})
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestReconcilePullThroughCacheEndpoints(t *testing.T) {
+	managedVPC := infrav1.VPCSpec{
+		ID:   "vpc-1",
+		Tags: infrav1.Tags{infrav1.ClusterTagKey(clusterName): string(infrav1.ResourceLifecycleOwned)},
+	}
+	subnets := infrav1.Subnets{
+		{ID: "subnet-private-a", AvailabilityZone: "us-east-1a", RouteTableID: aws.String("rtb-a")},
+		{ID: "subnet-private-a2", AvailabilityZone: "us-east-1a", RouteTableID: aws.String("rtb-a2")},
+		{ID: "subnet-private-b", AvailabilityZone: "us-east-1b", RouteTableID: aws.String("rtb-b")},
+		{ID: "subnet-public-a", AvailabilityZone: "us-east-1a", IsPublic: true, RouteTableID: aws.String("rtb-public")},
+	}
+
+	tests := []struct {
+		name     string
+		vpc      infrav1.VPCSpec
+		existing []ec2types.VpcEndpoint
+		expect   func(m *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name: "missing endpoints are created in the private subnets",
+			vpc:  managedVPC,
+			existing: []ec2types.VpcEndpoint{
+				{ServiceName: aws.String("com.amazonaws.us-east-1.ecr.api")},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.CreateVpcEndpoint(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *ec2.CreateVpcEndpointInput, _ ...func(*ec2.Options)) (*ec2.CreateVpcEndpointOutput, error) {
+					g := NewWithT(t)
+					g.Expect(input.ServiceName).To(Equal(aws.String("com.amazonaws.us-east-1.ecr.dkr")))
+					g.Expect(input.VpcEndpointType).To(Equal(ec2types.VpcEndpointTypeInterface))
+					g.Expect(input.SubnetIds).To(Equal([]string{"subnet-private-a", "subnet-private-b"}))
+					g.Expect(input.SecurityGroupIds).To(Equal([]string{"sg-cluster"}))
+					g.Expect(input.PrivateDnsEnabled).To(Equal(aws.Bool(true)))
+					return &ec2.CreateVpcEndpointOutput{}, nil
+				})
+				m.CreateVpcEndpoint(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *ec2.CreateVpcEndpointInput, _ ...func(*ec2.Options)) (*ec2.CreateVpcEndpointOutput, error) {
+					g := NewWithT(t)
+					g.Expect(input.ServiceName).To(Equal(aws.String("com.amazonaws.us-east-1.s3")))
+					g.Expect(input.VpcEndpointType).To(Equal(ec2types.VpcEndpointTypeGateway))
+					g.Expect(input.RouteTableIds).To(Equal([]string{"rtb-a", "rtb-a2", "rtb-b"}))
+					return &ec2.CreateVpcEndpointOutput{}, nil
+				})
+			},
+		},
+		{
+			name: "existing endpoints are left as they are",
+			vpc:  managedVPC,
+			existing: []ec2types.VpcEndpoint{
+				{ServiceName: aws.String("com.amazonaws.us-east-1.ecr.api")},
+				{ServiceName: aws.String("com.amazonaws.us-east-1.ecr.dkr")},
+				{ServiceName: aws.String("com.amazonaws.us-east-1.s3")},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name: "endpoints of unmanaged VPCs aren't created",
+			vpc:  infrav1.VPCSpec{ID: "vpc-1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mocks.NewMockEC2API(mockControl)
+			if tc.expect != nil {
+				ec2Mock.EXPECT().DescribeVpcEndpoints(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{VpcEndpoints: tc.existing}, nil)
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			s := newPullThroughCacheTestService(t, ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				NetworkSpec: infrav1.NetworkSpec{VPC: tc.vpc, Subnets: subnets},
+			})
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.reconcilePullThroughCacheEndpoints(context.TODO())).To(Succeed())
+		})
+	}
+}
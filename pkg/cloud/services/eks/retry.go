@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	awswait "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// withThrottlingEvent wraps a retried condition so that a warning event is recorded
+// on the object the first time AWS throttles it. Further throttled attempts within
+// the same backoff don't record another event, keeping the events to one per
+// backoff rather than one per attempt.
+func withThrottlingEvent(obj runtime.Object, operation string, condition wait.ConditionFunc) wait.ConditionFunc {
+	throttled := false
+	return func() (bool, error) {
+		done, err := condition()
+		if err != nil && !throttled && awserrors.IsThrottlingError(err) {
+			throttled = true
+			record.Warnf(obj, "AWSThrottling", "Backing off %s because AWS is throttling requests: %v", operation, err)
+		}
+		return done, err
+	}
+}
+
+// apiBackoff returns the backoff of the retried AWS API calls, overriding the default
+// backoff with the fields set in spec. A factor that doesn't parse, which the webhook
+// rejects, keeps the default.
+func apiBackoff(spec *ekscontrolplanev1.APIBackoff) wait.Backoff {
+	backoff := awswait.NewBackoff()
+	if spec == nil {
+		return backoff
+	}
+	if spec.Steps != nil {
+		backoff.Steps = int(*spec.Steps)
+	}
+	if spec.Factor != nil {
+		if factor, err := strconv.ParseFloat(*spec.Factor, 64); err == nil && factor >= 1 {
+			backoff.Factor = factor
+		}
+	}
+	if spec.Cap != nil {
+		backoff.Cap = spec.Cap.Duration
+	}
+	return backoff
+}
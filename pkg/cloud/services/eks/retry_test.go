@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kwait "k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+)
+
+func TestWithThrottlingEvent(t *testing.T) {
+	backoff := kwait.Backoff{Duration: time.Millisecond, Factor: 1, Steps: 10}
+	pool := &expinfrav1.AWSManagedMachinePool{}
+
+	// throttledCondition is throttled for the given number of attempts and then succeeds.
+	throttledCondition := func(throttledAttempts int) kwait.ConditionFunc {
+		attempts := 0
+		return func() (bool, error) {
+			attempts++
+			if attempts <= throttledAttempts {
+				return false, &smithy.GenericAPIError{Code: awserrors.Throttling}
+			}
+			return true, nil
+		}
+	}
+
+	t.Run("one event per backoff", func(t *testing.T) {
+		g := NewWithT(t)
+		recorded := newEventCollector(t)
+
+		err := wait.WaitForWithClassifier(backoff, withThrottlingEvent(pool, "the update", throttledCondition(3)), awserrors.DefaultRetryClassifier)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(recorded.take()).To(ConsistOf(HavePrefix("Backing off the update because AWS is throttling requests")))
+
+		err = wait.WaitForWithClassifier(backoff, withThrottlingEvent(pool, "the update", throttledCondition(2)), awserrors.DefaultRetryClassifier)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(recorded.take()).To(HaveLen(1))
+	})
+
+	t.Run("no event without throttling", func(t *testing.T) {
+		g := NewWithT(t)
+		recorded := newEventCollector(t)
+
+		err := wait.WaitForWithClassifier(backoff, withThrottlingEvent(pool, "the update", throttledCondition(0)), awserrors.DefaultRetryClassifier)
+		g.Expect(err).NotTo(HaveOccurred())
+
+		err = wait.WaitForWithClassifier(backoff, withThrottlingEvent(pool, "the update", func() (bool, error) {
+			return false, errors.New("boom")
+		}), awserrors.DefaultRetryClassifier)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(recorded.take()).To(BeEmpty())
+	})
+}
+
+func TestAPIBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     *ekscontrolplanev1.APIBackoff
+		expected kwait.Backoff
+	}{
+		{
+			name:     "defaults",
+			expected: wait.NewBackoff(),
+		},
+		{
+			name: "all fields set",
+			spec: &ekscontrolplanev1.APIBackoff{
+				Steps:  ptr.To[int32](5),
+				Factor: ptr.To("2.5"),
+				Cap:    &metav1.Duration{Duration: time.Minute},
+			},
+			expected: kwait.Backoff{Duration: time.Second, Factor: 2.5, Steps: 5, Jitter: 0.4, Cap: time.Minute},
+		},
+		{
+			name: "invalid factor keeps the default",
+			spec: &ekscontrolplanev1.APIBackoff{
+				Steps:  ptr.To[int32](3),
+				Factor: ptr.To("0.5"),
+			},
+			expected: kwait.Backoff{Duration: time.Second, Factor: 1.71, Steps: 3, Jitter: 0.4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(apiBackoff(tt.spec)).To(Equal(tt.expected))
+		})
+	}
+}
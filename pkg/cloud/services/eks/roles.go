@@ -19,9 +19,11 @@ package eks
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/smithy-go"
 	"github.com/pkg/errors"
 
@@ -170,13 +172,19 @@ func (s *Service) deleteControlPlaneIAMRole(ctx context.Context) error {
 	return nil
 }
 
+// canCreateNodegroupIAMRole returns true if the nodegroup IAM role can be created, and
+// later deleted, by the controller.
+func (s *NodegroupService) canCreateNodegroupIAMRole() bool {
+	return s.scope.EnableIAM() || s.scope.ManagedMachinePool.Spec.CreateRoleIfMissing
+}
+
 func (s *NodegroupService) reconcileNodegroupIAMRole(ctx context.Context) error {
 	s.scope.Debug("Reconciling EKS Nodegroup IAM Role")
 
 	if s.scope.RoleName() == "" {
 		var roleName string
 		var err error
-		if !s.scope.EnableIAM() {
+		if !s.canCreateNodegroupIAMRole() {
 			s.scope.Info("no EKS nodegroup role specified, using default EKS nodegroup role")
 			roleName = expinfrav1.DefaultEKSNodegroupRole
 		} else {
@@ -199,14 +207,18 @@ func (s *NodegroupService) reconcileNodegroupIAMRole(ctx context.Context) error
 			return err
 		}
 
-		// If the disable IAM flag is used then the role must exist
-		if !s.scope.EnableIAM() {
+		// If the disable IAM flag is used then the role must exist, unless
+		// the machine pool opted in to creating it
+		if !s.canCreateNodegroupIAMRole() {
 			return ErrNodegroupRoleNotFound
 		}
 
 		role, err = s.CreateRole(ctx, s.scope.ManagedMachinePool.Spec.RoleName, s.scope.ClusterName(), eksiam.NodegroupTrustRelationship(), s.scope.AdditionalTags(), s.scope.ManagedMachinePool.Spec.RolePath, s.scope.ManagedMachinePool.Spec.RolePermissionsBoundary)
 		if err != nil {
 			record.Warnf(s.scope.ManagedMachinePool, "FailedIAMRoleCreation", "Failed to create nodegroup IAM role %q: %v", s.scope.RoleName(), err)
+			if isAccessDenied(err) {
+				return errors.Wrapf(ErrNodegroupRoleCreationDenied, "creating role %q requires the controller policy to allow IAM role creation, which clusterawsadm grants with iamRoleCreation set to true: %v", s.scope.RoleName(), err)
+			}
 			return err
 		}
 		record.Eventf(s.scope.ManagedMachinePool, "SuccessfulIAMRoleCreation", "Created nodegroup IAM role %q", s.scope.RoleName())
@@ -214,7 +226,7 @@ func (s *NodegroupService) reconcileNodegroupIAMRole(ctx context.Context) error
 
 	if s.IsUnmanaged(role, s.scope.ClusterName()) {
 		s.scope.Debug("Skipping, EKS nodegroup role policy assignment as role is unmanaged")
-		return nil
+		return s.checkNodegroupRequiredPolicies(ctx)
 	}
 
 	_, err = s.EnsureTagsAndPolicy(ctx, role, s.scope.ClusterName(), eksiam.NodegroupTrustRelationship(), s.scope.AdditionalTags())
@@ -227,6 +239,12 @@ func (s *NodegroupService) reconcileNodegroupIAMRole(ctx context.Context) error
 		policies = NodegroupRolePoliciesUSGov()
 	}
 
+	for _, policy := range s.requiredNodegroupRolePolicies() {
+		if !slices.Contains(policies, policy) {
+			policies = append(policies, policy)
+		}
+	}
+
 	if len(s.scope.ManagedMachinePool.Spec.RoleAdditionalPolicies) > 0 {
 		if !s.scope.AllowAdditionalRoles() {
 			return ErrCannotUseAdditionalRoles
@@ -243,6 +261,41 @@ func (s *NodegroupService) reconcileNodegroupIAMRole(ctx context.Context) error
 	return nil
 }
 
+// requiredNodegroupRolePolicies returns the ARNs of the policies the nodegroup role is
+// required to have on top of the default node policies.
+func (s *NodegroupService) requiredNodegroupRolePolicies() []string {
+	required := s.scope.RequiredRolePolicies()
+	policies := make([]string, 0, len(required))
+	for _, policy := range required {
+		if !arn.IsARN(policy) {
+			policy = fmt.Sprintf("arn:%s:iam::aws:policy/%s", s.scope.Partition(), policy)
+		}
+		policies = append(policies, policy)
+	}
+	return policies
+}
+
+// checkNodegroupRequiredPolicies checks the required policies are attached to a nodegroup
+// role that isn't managed by the controller. AWS occasionally adds policies that nodes need
+// to join clusters running newer Kubernetes versions, which have to be attached to the role
+// by its owner.
+func (s *NodegroupService) checkNodegroupRequiredPolicies(ctx context.Context) error {
+	required := s.requiredNodegroupRolePolicies()
+	if len(required) == 0 {
+		return nil
+	}
+
+	missing, err := s.MissingPolicies(ctx, s.scope.RoleName(), required)
+	if err != nil {
+		return errors.Wrapf(err, "error checking the required policies of node role %q", s.scope.RoleName())
+	}
+	if len(missing) > 0 {
+		return errors.Wrapf(ErrNodegroupRolePoliciesMissing, "node role %q doesn't have policies %v attached", s.scope.RoleName(), missing)
+	}
+
+	return nil
+}
+
 func (s *NodegroupService) deleteNodegroupIAMRole(ctx context.Context) (reterr error) {
 	if err := s.scope.IAMReadyFalse(clusterv1beta1.DeletingReason, ""); err != nil {
 		return err
@@ -260,7 +313,7 @@ func (s *NodegroupService) deleteNodegroupIAMRole(ctx context.Context) (reterr e
 		}
 	}()
 	roleName := s.scope.RoleName()
-	if !s.scope.EnableIAM() {
+	if !s.canCreateNodegroupIAMRole() {
 		s.scope.Debug("EKS IAM disabled, skipping deleting EKS Nodegroup IAM Role")
 		return nil
 	}
@@ -293,6 +346,10 @@ func (s *NodegroupService) deleteNodegroupIAMRole(ctx context.Context) (reterr e
 }
 
 func (s *FargateService) reconcileFargateIAMRole(ctx context.Context) (requeue bool, err error) {
+	if roleARN := s.scope.FargateProfile.Spec.PodExecutionRoleARN; roleARN != nil {
+		s.scope.Debug("Using the EKS Fargate pod execution role from the spec", "arn", *roleARN)
+		return false, nil
+	}
 	s.scope.Debug("Reconciling EKS Fargate IAM Role")
 
 	if s.scope.RoleName() == "" {
@@ -376,6 +433,10 @@ func (s *FargateService) deleteFargateIAMRole(ctx context.Context) (reterr error
 		s.scope.Debug("EKS IAM disabled, skipping deleting EKS fargate IAM Role")
 		return nil
 	}
+	if s.scope.FargateProfile.Spec.PodExecutionRoleARN != nil {
+		s.scope.Debug("EKS fargate pod execution role isn't managed, skipping deleting it")
+		return nil
+	}
 
 	s.scope.Debug("Deleting EKS fargate IAM Role")
 
@@ -412,3 +473,8 @@ func isNotFound(err error) bool {
 
 	return false
 }
+
+func isAccessDenied(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "AccessDenied"
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/smithy-go"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/converters"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	eksiam "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func newTestNodegroupRoleService(g *WithT, iamMock *mock_iamauth.MockIAMAPI, createRoleIfMissing bool, requiredPolicies ...string) *NodegroupService {
+	return newTestNodegroupScopeService(g, iamMock, createRoleIfMissing, func(params *scope.ManagedMachinePoolScopeParams) {
+		params.RequiredRolePolicies = requiredPolicies
+	})
+}
+
+func newTestNodegroupScopeService(g *WithT, iamMock *mock_iamauth.MockIAMAPI, createRoleIfMissing bool, setParams func(*scope.ManagedMachinePoolScopeParams)) *NodegroupService {
+	scheme := runtime.NewScheme()
+	_ = expinfrav1.AddToScheme(scheme)
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+	_ = clusterv1.AddToScheme(scheme)
+
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cp"},
+		Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+			EKSClusterName: "eks-cluster",
+			Region:         "us-east-1",
+		},
+	}
+	managedMachinePool := &expinfrav1.AWSManagedMachinePool{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "pool"},
+		Spec: expinfrav1.AWSManagedMachinePoolSpec{
+			EKSNodegroupName:    "nodegroup",
+			RoleName:            "nodes",
+			CreateRoleIfMissing: createRoleIfMissing,
+		},
+	}
+	machinePool := &clusterv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "pool"},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(managedMachinePool, machinePool).WithStatusSubresource(managedMachinePool).Build()
+
+	log := logger.NewLogger(klog.Background())
+	params := scope.ManagedMachinePoolScopeParams{
+		Client:             client,
+		Logger:             log,
+		Cluster:            &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cluster"}},
+		ControlPlane:       controlPlane,
+		ManagedMachinePool: managedMachinePool,
+		MachinePool:        machinePool,
+		InfraCluster:       &scope.ManagedControlPlaneScope{ControlPlane: controlPlane},
+	}
+	setParams(&params)
+	machinePoolScope, err := scope.NewManagedMachinePoolScope(params)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return &NodegroupService{
+		scope: machinePoolScope,
+		IAMService: eksiam.IAMService{
+			Wrapper:   log,
+			IAMClient: iamMock,
+		},
+		backoff: apiBackoff(nil),
+		now:     time.Now,
+	}
+}
+
+func TestReconcileNodegroupIAMRoleCreateIfMissing(t *testing.T) {
+	trustPolicy, err := converters.IAMPolicyDocumentToJSON(*eksiam.NodegroupTrustRelationship())
+	if err != nil {
+		t.Fatal(err)
+	}
+	policies := NodegroupRolePolicies()
+
+	t.Run("missing role is an error without opting in", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+		s := newTestNodegroupRoleService(g, iamMock, false)
+
+		iamMock.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(nil, &iamtypes.NoSuchEntityException{})
+
+		g.Expect(s.reconcileNodegroupIAMRole(context.TODO())).To(MatchError(ErrNodegroupRoleNotFound))
+	})
+
+	t.Run("role creation denied by the controller policy", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+		s := newTestNodegroupRoleService(g, iamMock, true)
+
+		iamMock.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(nil, &iamtypes.NoSuchEntityException{})
+		iamMock.EXPECT().CreateRole(gomock.Any(), gomock.Any()).Return(nil, &smithy.GenericAPIError{Code: "AccessDenied", Message: "not authorized to perform: iam:CreateRole"})
+
+		err := s.reconcileNodegroupIAMRole(context.TODO())
+		g.Expect(err).To(MatchError(ErrNodegroupRoleCreationDenied))
+		g.Expect(err.Error()).To(ContainSubstring("iamRoleCreation"))
+	})
+
+	t.Run("missing role is created with the node policies", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+		s := newTestNodegroupRoleService(g, iamMock, true)
+
+		iamMock.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(nil, &iamtypes.NoSuchEntityException{})
+		iamMock.EXPECT().CreateRole(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, input *iam.CreateRoleInput, _ ...func(*iam.Options)) (*iam.CreateRoleOutput, error) {
+				g.Expect(input.RoleName).To(Equal(aws.String("nodes")))
+				g.Expect(input.AssumeRolePolicyDocument).To(Equal(aws.String(trustPolicy)))
+				g.Expect(input.Tags).To(ContainElement(iamtypes.Tag{
+					Key:   aws.String(infrav1.ClusterAWSCloudProviderTagKey("eks-cluster")),
+					Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+				}))
+				return &iam.CreateRoleOutput{
+					Role: &iamtypes.Role{
+						RoleName:                 input.RoleName,
+						AssumeRolePolicyDocument: input.AssumeRolePolicyDocument,
+						Tags:                     input.Tags,
+					},
+				}, nil
+			})
+		iamMock.EXPECT().ListAttachedRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+		iamMock.EXPECT().GetPolicy(gomock.Any(), gomock.Any()).Return(&iam.GetPolicyOutput{}, nil).Times(len(policies))
+		for _, policy := range policies {
+			iamMock.EXPECT().AttachRolePolicy(gomock.Any(), &iam.AttachRolePolicyInput{
+				RoleName:  aws.String("nodes"),
+				PolicyArn: aws.String(policy),
+			}).Return(&iam.AttachRolePolicyOutput{}, nil)
+		}
+
+		g.Expect(s.reconcileNodegroupIAMRole(context.TODO())).To(Succeed())
+	})
+}
+
+func TestDeleteNodegroupIAMRoleCreateIfMissing(t *testing.T) {
+	ownedTags := []iamtypes.Tag{
+		{
+			Key:   aws.String(infrav1.ClusterAWSCloudProviderTagKey("eks-cluster")),
+			Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+		},
+	}
+
+	t.Run("created role is deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+		s := newTestNodegroupRoleService(g, iamMock, true)
+
+		iamMock.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{
+			Role: &iamtypes.Role{RoleName: aws.String("nodes"), Tags: ownedTags},
+		}, nil)
+		iamMock.EXPECT().ListAttachedRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListAttachedRolePoliciesOutput{
+			AttachedPolicies: []iamtypes.AttachedPolicy{{PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy")}},
+		}, nil)
+		iamMock.EXPECT().DetachRolePolicy(gomock.Any(), gomock.Any()).Return(&iam.DetachRolePolicyOutput{}, nil)
+		iamMock.EXPECT().DeleteRole(gomock.Any(), &iam.DeleteRoleInput{RoleName: aws.String("nodes")}).Return(&iam.DeleteRoleOutput{}, nil)
+
+		g.Expect(s.deleteNodegroupIAMRole(context.TODO())).To(Succeed())
+	})
+
+	t.Run("pre-existing role is kept", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+		s := newTestNodegroupRoleService(g, iamMock, true)
+
+		iamMock.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{
+			Role: &iamtypes.Role{RoleName: aws.String("nodes")},
+		}, nil)
+
+		g.Expect(s.deleteNodegroupIAMRole(context.TODO())).To(Succeed())
+	})
+}
+
+func TestReconcileNodegroupIAMRoleRequiredPolicies(t *testing.T) {
+	requiredPolicy := "arn:aws:iam::aws:policy/AmazonEKSRequiredNodePolicy"
+
+	t.Run("missing policy on an unmanaged role is reported", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+		s := newTestNodegroupRoleService(g, iamMock, false, "AmazonEKSRequiredNodePolicy")
+
+		iamMock.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{
+			Role: &iamtypes.Role{RoleName: aws.String("nodes")},
+		}, nil)
+		iamMock.EXPECT().ListAttachedRolePolicies(gomock.Any(), &iam.ListAttachedRolePoliciesInput{RoleName: aws.String("nodes")}).Return(&iam.ListAttachedRolePoliciesOutput{
+			AttachedPolicies: []iamtypes.AttachedPolicy{{PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy")}},
+		}, nil)
+
+		err := s.reconcileNodegroupIAMRole(context.TODO())
+		g.Expect(err).To(MatchError(ErrNodegroupRolePoliciesMissing))
+		g.Expect(err.Error()).To(ContainSubstring(requiredPolicy))
+	})
+
+	t.Run("unmanaged role with the required policies is ready", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+		s := newTestNodegroupRoleService(g, iamMock, false, requiredPolicy)
+
+		iamMock.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{
+			Role: &iamtypes.Role{RoleName: aws.String("nodes")},
+		}, nil)
+		iamMock.EXPECT().ListAttachedRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListAttachedRolePoliciesOutput{
+			AttachedPolicies: []iamtypes.AttachedPolicy{{PolicyArn: aws.String(requiredPolicy)}},
+		}, nil)
+
+		g.Expect(s.reconcileNodegroupIAMRole(context.TODO())).To(Succeed())
+	})
+
+	t.Run("unmanaged role isn't checked without required policies", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+		s := newTestNodegroupRoleService(g, iamMock, false)
+
+		iamMock.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{
+			Role: &iamtypes.Role{RoleName: aws.String("nodes")},
+		}, nil)
+
+		g.Expect(s.reconcileNodegroupIAMRole(context.TODO())).To(Succeed())
+	})
+
+	t.Run("required policies are attached to a managed role", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+		s := newTestNodegroupRoleService(g, iamMock, true, "AmazonEKSRequiredNodePolicy")
+		policies := append(NodegroupRolePolicies(), requiredPolicy)
+
+		iamMock.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(nil, &iamtypes.NoSuchEntityException{})
+		iamMock.EXPECT().CreateRole(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, input *iam.CreateRoleInput, _ ...func(*iam.Options)) (*iam.CreateRoleOutput, error) {
+				return &iam.CreateRoleOutput{
+					Role: &iamtypes.Role{
+						RoleName:                 input.RoleName,
+						AssumeRolePolicyDocument: input.AssumeRolePolicyDocument,
+						Tags:                     input.Tags,
+					},
+				}, nil
+			})
+		iamMock.EXPECT().ListAttachedRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+		iamMock.EXPECT().GetPolicy(gomock.Any(), gomock.Any()).Return(&iam.GetPolicyOutput{}, nil).Times(len(policies))
+		for _, policy := range policies {
+			iamMock.EXPECT().AttachRolePolicy(gomock.Any(), &iam.AttachRolePolicyInput{
+				RoleName:  aws.String("nodes"),
+				PolicyArn: aws.String(policy),
+			}).Return(&iam.AttachRolePolicyOutput{}, nil)
+		}
+
+		g.Expect(s.reconcileNodegroupIAMRole(context.TODO())).To(Succeed())
+	})
+}
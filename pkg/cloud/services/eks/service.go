@@ -21,14 +21,18 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
+	kwait "k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	asg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/common"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
 	stsservice "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/sts"
+	ekspkg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
 )
 
 // EKSAPI defines the EKS API interface.
@@ -59,6 +63,7 @@ type EKSAPI interface {
 	CreateFargateProfile(ctx context.Context, params *eks.CreateFargateProfileInput, optFns ...func(*eks.Options)) (*eks.CreateFargateProfileOutput, error)
 	DeleteFargateProfile(ctx context.Context, params *eks.DeleteFargateProfileInput, optFns ...func(*eks.Options)) (*eks.DeleteFargateProfileOutput, error)
 	DescribeFargateProfile(ctx context.Context, params *eks.DescribeFargateProfileInput, optFns ...func(*eks.Options)) (*eks.DescribeFargateProfileOutput, error)
+	ListFargateProfiles(ctx context.Context, params *eks.ListFargateProfilesInput, optFns ...func(*eks.Options)) (*eks.ListFargateProfilesOutput, error)
 	TagResource(ctx context.Context, params *eks.TagResourceInput, optFns ...func(*eks.Options)) (*eks.TagResourceOutput, error)
 	UntagResource(ctx context.Context, params *eks.UntagResourceInput, optFns ...func(*eks.Options)) (*eks.UntagResourceOutput, error)
 	DisassociateIdentityProviderConfig(ctx context.Context, params *eks.DisassociateIdentityProviderConfigInput, optFns ...func(*eks.Options)) (*eks.DisassociateIdentityProviderConfigOutput, error)
@@ -84,6 +89,13 @@ type EKSAPI interface {
 	WaitUntilAddonDeleted(ctx context.Context, params *eks.DescribeAddonInput, maxWait time.Duration) error
 }
 
+// ECRAPI defines the ECR API interface used to manage the pull-through cache of a cluster.
+type ECRAPI interface {
+	DescribePullThroughCacheRules(ctx context.Context, params *ecr.DescribePullThroughCacheRulesInput, optFns ...func(*ecr.Options)) (*ecr.DescribePullThroughCacheRulesOutput, error)
+	CreatePullThroughCacheRule(ctx context.Context, params *ecr.CreatePullThroughCacheRuleInput, optFns ...func(*ecr.Options)) (*ecr.CreatePullThroughCacheRuleOutput, error)
+	UpdatePullThroughCacheRule(ctx context.Context, params *ecr.UpdatePullThroughCacheRuleInput, optFns ...func(*ecr.Options)) (*ecr.UpdatePullThroughCacheRuleOutput, error)
+}
+
 // EKSClient is a wrapper over eks.Client for implementing custom methods of EKSAPI.
 type EKSClient struct {
 	*eks.Client
@@ -96,6 +108,7 @@ type Service struct {
 	scope     *scope.ManagedControlPlaneScope
 	EC2Client common.EC2API
 	EKSClient EKSAPI
+	ECRClient ECRAPI
 	iam.IAMService
 	STSClient stsservice.STSClient
 }
@@ -115,9 +128,10 @@ func NewService(controlPlaneScope *scope.ManagedControlPlaneScope, opts ...Servi
 	s := &Service{
 		scope:     controlPlaneScope,
 		EC2Client: scope.NewEC2Client(controlPlaneScope, controlPlaneScope, controlPlaneScope, controlPlaneScope.ControlPlane),
-		EKSClient: &EKSClient{
+		EKSClient: newCachedEKSClient(&EKSClient{
 			Client: scope.NewEKSClient(controlPlaneScope, controlPlaneScope, controlPlaneScope, controlPlaneScope.ControlPlane),
-		},
+		}, describeCacheKeyPrefix(controlPlaneScope.Region(), controlPlaneScope.ControlPlane)),
+		ECRClient: scope.NewECRClient(controlPlaneScope, controlPlaneScope, controlPlaneScope, controlPlaneScope.ControlPlane),
 		IAMService: iam.IAMService{
 			Wrapper:   &controlPlaneScope.Logger,
 			IAMClient: scope.NewIAMClient(controlPlaneScope, controlPlaneScope, controlPlaneScope, controlPlaneScope.ControlPlane),
@@ -139,25 +153,45 @@ func NewService(controlPlaneScope *scope.ManagedControlPlaneScope, opts ...Servi
 type NodegroupService struct {
 	scope             *scope.ManagedMachinePoolScope
 	ASGService        services.ASGInterface
-	AutoscalingClient *autoscaling.Client
+	AutoscalingClient asg.AutoScalingAPI
+	EC2Client         common.EC2API
 	EKSClient         EKSAPI
 	iam.IAMService
 	STSClient stsservice.STSClient
+
+	// backoff is the backoff of the nodegroup calls retried when AWS throttles them.
+	backoff      kwait.Backoff
+	remoteClient func() (client.Client, error)
+	now          func() time.Time
+
+	// maintenanceWindow is the window of the pool outside of which disruptive changes of the
+	// nodegroup are deferred, nil when the pool doesn't have one. deferredChanges lists the
+	// changes deferred by the reconcile.
+	maintenanceWindow *ekspkg.MaintenanceWindow
+	deferredChanges   []string
+	// blockedScaleDown is the scale-down deferred by the reconcile because PodDisruptionBudgets
+	// would block draining the nodes, empty when there is none.
+	blockedScaleDown string
 }
 
 // NewNodegroupService returns a new service given the api clients.
 func NewNodegroupService(machinePoolScope *scope.ManagedMachinePoolScope) *NodegroupService {
+	keyPrefix := describeCacheKeyPrefix(machinePoolScope.ControlPlane.Spec.Region, machinePoolScope.ControlPlane)
 	return &NodegroupService{
 		scope:             machinePoolScope,
-		AutoscalingClient: scope.NewASGClient(machinePoolScope, machinePoolScope, machinePoolScope, machinePoolScope.ManagedMachinePool),
-		EKSClient: &EKSClient{
+		AutoscalingClient: asg.NewCachedClient(scope.NewASGClient(machinePoolScope, machinePoolScope, machinePoolScope, machinePoolScope.ManagedMachinePool), keyPrefix),
+		EC2Client:         scope.NewEC2Client(machinePoolScope, machinePoolScope, machinePoolScope, machinePoolScope.ManagedMachinePool),
+		EKSClient: newCachedEKSClient(&EKSClient{
 			Client: scope.NewEKSClient(machinePoolScope, machinePoolScope, machinePoolScope, machinePoolScope.ManagedMachinePool),
-		},
+		}, keyPrefix),
 		IAMService: iam.IAMService{
 			Wrapper:   &machinePoolScope.Logger,
 			IAMClient: scope.NewIAMClient(machinePoolScope, machinePoolScope, machinePoolScope, machinePoolScope.ManagedMachinePool),
 		},
-		STSClient: scope.NewSTSClient(machinePoolScope, machinePoolScope, machinePoolScope, machinePoolScope.ManagedMachinePool),
+		STSClient:    scope.NewSTSClient(machinePoolScope, machinePoolScope, machinePoolScope, machinePoolScope.ManagedMachinePool),
+		backoff:      apiBackoff(machinePoolScope.ControlPlane.Spec.NodegroupAPIBackoff),
+		remoteClient: machinePoolScope.RemoteClient,
+		now:          time.Now,
 	}
 }
 
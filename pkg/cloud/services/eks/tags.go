@@ -19,6 +19,8 @@ package eks
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
@@ -71,6 +73,8 @@ func getTagUpdates(currentTags map[string]string, tags map[string]string) (untag
 			untagKeys = append(untagKeys, key)
 		}
 	}
+	// Sort the keys to remove so the untag call doesn't change between reconciles.
+	slices.Sort(untagKeys)
 	for key, value := range tags {
 		if currentV, ok := currentTags[key]; !ok || value != currentV {
 			newTags[key] = value
@@ -79,41 +83,85 @@ func getTagUpdates(currentTags map[string]string, tags map[string]string) (untag
 	return untagKeys, newTags
 }
 
-func getASGTagUpdates(clusterName string, currentTags map[string]string, tags map[string]string) (tagsToDelete map[string]string, tagsToAdd map[string]string) {
+// clusterAutoscalerDiscoveryTag returns the tag the cluster autoscaler discovers the ASGs of
+// the cluster with.
+func clusterAutoscalerDiscoveryTag(clusterName string) string {
+	return fmt.Sprintf("k8s.io/cluster-autoscaler/%s", clusterName)
+}
+
+// eksManagedASGTag returns a function reporting whether a tag of a nodegroup's ASG is
+// set by EKS or the cluster autoscaler, and so mustn't be removed from it.
+func eksManagedASGTag(clusterName string) func(key string) bool {
 	officialASGTagsByEKS := []string{
 		eksClusterNameTag,
 		eksNodeGroupNameTag,
-		fmt.Sprintf("k8s.io/cluster-autoscaler/%s", clusterName),
+		clusterAutoscalerDiscoveryTag(clusterName),
 		eksClusterAutoscalerEnabledTag,
 		infrav1.ClusterAWSCloudProviderTagKey(clusterName),
 	}
-	tagsToDelete = make(map[string]string)
-	tagsToAdd = make(map[string]string)
-	for k, v := range currentTags {
-		if _, ok := tags[k]; !ok {
-			isOfficialTag := false
-			for _, tag := range officialASGTagsByEKS {
-				if tag == k {
-					isOfficialTag = true
-					break
-				}
-			}
-			if !isOfficialTag {
-				tagsToDelete[k] = v
-			}
-		}
+	return func(key string) bool {
+		return slices.Contains(officialASGTagsByEKS, key)
 	}
-	for key, value := range tags {
-		if currentV, ok := currentTags[key]; !ok || value != currentV {
-			tagsToAdd[key] = value
-		}
-	}
-	return tagsToDelete, tagsToAdd
 }
 
+// reconcileTags reconciles the tags of the nodegroup and of its ASG in a single sweep.
 func (s *NodegroupService) reconcileTags(ctx context.Context, ng *ekstypes.Nodegroup) error {
-	tags := ngTags(s.scope.ClusterName(), s.scope.AdditionalTags())
-	return updateTags(ctx, s.EKSClient, ng.NodegroupArn, ng.Tags, tags)
+	resources := []tags.SweepResource{
+		{
+			Kind:    "nodegroup",
+			ID:      aws.ToString(ng.NodegroupName),
+			Current: ng.Tags,
+			Desired: ngTags(s.scope.ClusterName(), s.scope.AdditionalTags()),
+			Update: func(ctx context.Context, create map[string]string, remove []string) error {
+				return tagEKSResource(ctx, s.EKSClient, ng.NodegroupArn, create, remove)
+			},
+		},
+	}
+
+	asg, err := s.describeASGs(ctx, ng)
+	if err != nil {
+		return errors.Wrap(err, "failed to describe ASG for nodegroup")
+	}
+	if asg != nil {
+		desired := ngTags(s.scope.ClusterName(), s.scope.AdditionalTags())
+		keep := s.clusterAutoscalerASGTags(tagDescriptionsToMap(asg.Tags), desired)
+		resources = append(resources, tags.SweepResource{
+			Kind:    "AutoScalingGroup",
+			ID:      aws.ToString(asg.AutoScalingGroupName),
+			Current: propagatedTagDescriptionsToMap(asg.Tags, desired),
+			Desired: desired,
+			Keep:    keep,
+			Update: func(ctx context.Context, create map[string]string, remove []string) error {
+				return s.tagASG(ctx, asg.AutoScalingGroupName, create, remove)
+			},
+		})
+	}
+
+	s.scope.Info("Reconciling nodegroup tags", "cluster-name", s.scope.ClusterName(), "nodegroup-name", aws.ToString(ng.NodegroupName))
+	return tags.Sweep(ctx, resources...)
+}
+
+// clusterAutoscalerASGTags adds the cluster autoscaler tags to the desired tags of the
+// nodegroup's ASG and returns the function reporting the tags to keep on it. An ASG excluded
+// from the cluster autoscaler has the enabled tag set to false and loses the discovery tag set
+// by EKS. Once the exclusion is removed, both tags are restored to the values EKS sets.
+func (s *NodegroupService) clusterAutoscalerASGTags(current, desired map[string]string) func(key string) bool {
+	clusterName := s.scope.ClusterName()
+	discoveryTag := clusterAutoscalerDiscoveryTag(clusterName)
+	keep := eksManagedASGTag(clusterName)
+
+	if s.scope.ManagedMachinePool.Spec.ExcludeFromClusterAutoscaler {
+		desired[eksClusterAutoscalerEnabledTag] = "false"
+		return func(key string) bool {
+			return key != discoveryTag && keep(key)
+		}
+	}
+
+	if current[eksClusterAutoscalerEnabledTag] == "false" {
+		desired[eksClusterAutoscalerEnabledTag] = "true"
+		desired[discoveryTag] = string(infrav1.ResourceLifecycleOwned)
+	}
+	return keep
 }
 
 func tagDescriptionsToMap(input []autoscalingtypes.TagDescription) map[string]string {
@@ -124,51 +172,47 @@ func tagDescriptionsToMap(input []autoscalingtypes.TagDescription) map[string]st
 	return tags
 }
 
-func (s *NodegroupService) reconcileASGTags(ctx context.Context, ng *ekstypes.Nodegroup) error {
-	s.scope.Info("Reconciling ASG tags", "cluster-name", s.scope.ClusterName(), "nodegroup-name", *ng.NodegroupName)
-	asg, err := s.describeASGs(ctx, ng)
-	if err != nil {
-		return errors.Wrap(err, "failed to describe ASG for nodegroup")
+// propagatedTagDescriptionsToMap returns the tags of an ASG, leaving out the desired tags that
+// aren't propagated at launch so that they're updated to be inherited by the instances.
+func propagatedTagDescriptionsToMap(input []autoscalingtypes.TagDescription, desired map[string]string) map[string]string {
+	tags := tagDescriptionsToMap(input)
+	for _, v := range input {
+		if _, ok := desired[*v.Key]; ok && !aws.ToBool(v.PropagateAtLaunch) {
+			delete(tags, *v.Key)
+		}
 	}
+	return tags
+}
 
-	tagsToDelete, tagsToAdd := getASGTagUpdates(s.scope.ClusterName(), tagDescriptionsToMap(asg.Tags), s.scope.AdditionalTags())
-	s.scope.Debug("Tags", "tagsToAdd", tagsToAdd, "tagsToDelete", tagsToDelete)
-
-	if len(tagsToAdd) > 0 {
+func (s *NodegroupService) tagASG(ctx context.Context, asgName *string, create map[string]string, remove []string) error {
+	if len(create) > 0 {
+		// The tags are propagated at launch so that the instances of the nodegroup inherit
+		// them, for instance for cost allocation.
 		input := &autoscaling.CreateOrUpdateTagsInput{}
-		for k, v := range tagsToAdd {
-			// The k/vCopy is used to address the "Implicit memory aliasing in for loop" issue
-			// https://stackoverflow.com/questions/62446118/implicit-memory-aliasing-in-for-loop
-			kCopy := k
-			vCopy := v
+		for _, k := range slices.Sorted(maps.Keys(create)) {
 			input.Tags = append(input.Tags, autoscalingtypes.Tag{
-				Key:               &kCopy,
+				Key:               aws.String(k),
 				PropagateAtLaunch: aws.Bool(true),
-				ResourceId:        asg.AutoScalingGroupName,
+				ResourceId:        asgName,
 				ResourceType:      ptr.To[string]("auto-scaling-group"),
-				Value:             &vCopy,
+				Value:             aws.String(create[k]),
 			})
 		}
-		_, err = s.AutoscalingClient.CreateOrUpdateTags(ctx, input)
-		if err != nil {
+		if _, err := s.AutoscalingClient.CreateOrUpdateTags(ctx, input); err != nil {
 			return errors.Wrap(err, "failed to add tags to nodegroup's AutoScalingGroup")
 		}
 	}
 
-	if len(tagsToDelete) > 0 {
+	if len(remove) > 0 {
 		input := &autoscaling.DeleteTagsInput{}
-		for k := range tagsToDelete {
-			// The k/vCopy is used to address the "Implicit memory aliasing in for loop" issue
-			// https://stackoverflow.com/questions/62446118/implicit-memory-aliasing-in-for-loop
-			kCopy := k
+		for _, k := range remove {
 			input.Tags = append(input.Tags, autoscalingtypes.Tag{
-				Key:          &kCopy,
-				ResourceId:   asg.AutoScalingGroupName,
+				Key:          aws.String(k),
+				ResourceId:   asgName,
 				ResourceType: ptr.To[string]("auto-scaling-group"),
 			})
 		}
-		_, err = s.AutoscalingClient.DeleteTags(ctx, input)
-		if err != nil {
+		if _, err := s.AutoscalingClient.DeleteTags(ctx, input); err != nil {
 			return errors.Wrap(err, "failed to delete tags to nodegroup's AutoScalingGroup")
 		}
 	}
@@ -177,17 +221,22 @@ func (s *NodegroupService) reconcileASGTags(ctx context.Context, ng *ekstypes.No
 }
 
 func (s *FargateService) reconcileTags(ctx context.Context, fp *ekstypes.FargateProfile) error {
-	tags := ngTags(s.scope.ClusterName(), s.scope.AdditionalTags())
-	return updateTags(ctx, s.EKSClient, fp.FargateProfileArn, fp.Tags, tags)
+	return tags.Sweep(ctx, tags.SweepResource{
+		Kind:    "Fargate profile",
+		ID:      aws.ToString(fp.FargateProfileName),
+		Current: fp.Tags,
+		Desired: ngTags(s.scope.ClusterName(), s.scope.AdditionalTags()),
+		Update: func(ctx context.Context, create map[string]string, remove []string) error {
+			return tagEKSResource(ctx, s.EKSClient, fp.FargateProfileArn, create, remove)
+		},
+	})
 }
 
-func updateTags(ctx context.Context, client EKSAPI, arn *string, existingTags, desiredTags map[string]string) error {
-	untagKeys, newTags := getTagUpdates(existingTags, desiredTags)
-
-	if len(newTags) > 0 {
+func tagEKSResource(ctx context.Context, client EKSAPI, arn *string, create map[string]string, remove []string) error {
+	if len(create) > 0 {
 		tagInput := &eks.TagResourceInput{
 			ResourceArn: arn,
-			Tags:        newTags,
+			Tags:        create,
 		}
 		_, err := client.TagResource(ctx, tagInput)
 		if err != nil {
@@ -195,10 +244,10 @@ func updateTags(ctx context.Context, client EKSAPI, arn *string, existingTags, d
 		}
 	}
 
-	if len(untagKeys) > 0 {
+	if len(remove) > 0 {
 		untagInput := &eks.UntagResourceInput{
 			ResourceArn: arn,
-			TagKeys:     untagKeys,
+			TagKeys:     remove,
 		}
 		_, err := client.UntagResource(ctx, untagInput)
 		if err != nil {
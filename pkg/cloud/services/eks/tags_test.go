@@ -42,6 +42,19 @@ func TestGetTagUpdates(t *testing.T) {
 				"x": "2",
 			},
 		},
+		{
+			current: map[string]string{
+				"c": "1",
+				"a": "1",
+				"b": "1",
+				"x": "1",
+			},
+			next: map[string]string{
+				"x": "1",
+			},
+			expectUntag: []string{"a", "b", "c"},
+			expectTag:   map[string]string{},
+		},
 	}
 	for i, tc := range testCases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
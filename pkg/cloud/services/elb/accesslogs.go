@@ -0,0 +1,322 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	iam "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/endpoints"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// elbAccountIDs are the Elastic Load Balancing accounts that deliver access logs
+// in regions available before August 2022. Newer regions use the log delivery
+// service principal instead.
+// see: https://docs.aws.amazon.com/elasticloadbalancing/latest/application/enable-access-logging.html
+var elbAccountIDs = map[string]string{
+	"us-east-1":      "127311923021",
+	"us-east-2":      "033677994240",
+	"us-west-1":      "027434742980",
+	"us-west-2":      "797873946194",
+	"af-south-1":     "098369216593",
+	"ap-east-1":      "754344448648",
+	"ap-southeast-3": "589379963580",
+	"ap-south-1":     "718504428378",
+	"ap-northeast-3": "383597477331",
+	"ap-northeast-2": "600734575887",
+	"ap-southeast-1": "114774131450",
+	"ap-southeast-2": "783225319266",
+	"ap-northeast-1": "582318560864",
+	"ca-central-1":   "985666609251",
+	"eu-central-1":   "054676820928",
+	"eu-west-1":      "156460612806",
+	"eu-west-2":      "652711504416",
+	"eu-south-1":     "635631232127",
+	"eu-west-3":      "009996457667",
+	"eu-north-1":     "897822967062",
+	"me-south-1":     "076674570225",
+	"sa-east-1":      "507241528517",
+	"us-gov-west-1":  "048591011584",
+	"us-gov-east-1":  "190560391635",
+}
+
+var accessLogsAttributeKeys = []string{
+	infrav1.LoadBalancerAttributeAccessLogsEnabled,
+	infrav1.LoadBalancerAttributeAccessLogsS3Bucket,
+	infrav1.LoadBalancerAttributeAccessLogsS3Prefix,
+}
+
+// accessLogsAttributes returns the V2 load balancer attributes for the given access logs configuration.
+func accessLogsAttributes(accessLogs *infrav1.LoadBalancerAccessLogs) map[string]*string {
+	return map[string]*string{
+		infrav1.LoadBalancerAttributeAccessLogsEnabled:  aws.String(strconv.FormatBool(accessLogs.Enabled)),
+		infrav1.LoadBalancerAttributeAccessLogsS3Bucket: aws.String(accessLogs.S3BucketName),
+		infrav1.LoadBalancerAttributeAccessLogsS3Prefix: aws.String(accessLogs.S3BucketPrefix),
+	}
+}
+
+// accessLogsAttributesChanged reports whether the access logs attributes of the desired
+// V2 load balancer differ from the ones currently set on it.
+func accessLogsAttributesChanged(desired, current map[string]*string) bool {
+	for _, key := range accessLogsAttributeKeys {
+		want, ok := desired[key]
+		if !ok {
+			continue
+		}
+		if aws.ToString(want) != aws.ToString(current[key]) {
+			return true
+		}
+	}
+	return false
+}
+
+// reconcileClassicELBAccessLogs makes sure the access logs of a classic load balancer match the given
+// configuration. Access logging is disabled once the configuration is removed.
+func (s *Service) reconcileClassicELBAccessLogs(ctx context.Context, name string, accessLogs *infrav1.LoadBalancerAccessLogs) error {
+	out, err := s.ELBClient.DescribeLoadBalancerAttributes(ctx, &elb.DescribeLoadBalancerAttributesInput{
+		LoadBalancerName: aws.String(name),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe classic load balancer %q attributes", name)
+	}
+
+	current := out.LoadBalancerAttributes.AccessLog
+	desired := &elbtypes.AccessLog{Enabled: false}
+	if accessLogs == nil {
+		if current == nil || !current.Enabled {
+			return nil
+		}
+	} else {
+		if current != nil &&
+			current.Enabled == accessLogs.Enabled &&
+			aws.ToString(current.S3BucketName) == accessLogs.S3BucketName &&
+			aws.ToString(current.S3BucketPrefix) == accessLogs.S3BucketPrefix {
+			return nil
+		}
+
+		if err := s.ensureAccessLogsBucketPolicy(ctx, accessLogs); err != nil {
+			return err
+		}
+
+		desired = &elbtypes.AccessLog{
+			Enabled:        accessLogs.Enabled,
+			S3BucketName:   aws.String(accessLogs.S3BucketName),
+			S3BucketPrefix: aws.String(accessLogs.S3BucketPrefix),
+		}
+	}
+
+	input := &elb.ModifyLoadBalancerAttributesInput{
+		LoadBalancerName: aws.String(name),
+		LoadBalancerAttributes: &elbtypes.LoadBalancerAttributes{
+			AccessLog: desired,
+		},
+	}
+
+	s.scope.Debug("Reconciling access logs for classic load balancer", "name", name, "enabled", desired.Enabled, "bucket", aws.ToString(desired.S3BucketName))
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if _, err := s.ELBClient.ModifyLoadBalancerAttributes(ctx, input); err != nil {
+			return false, err
+		}
+		return true, nil
+	}, awserrors.LoadBalancerNotFound); err != nil {
+		return errors.Wrapf(err, "failed to configure access logs for classic load balancer %q", name)
+	}
+
+	return nil
+}
+
+// ensureAccessLogsBucketPolicy adds the statements allowing Elastic Load Balancing to deliver
+// access logs to the configured S3 bucket to the bucket policy, if requested. The other
+// statements of the bucket policy are kept.
+func (s *Service) ensureAccessLogsBucketPolicy(ctx context.Context, accessLogs *infrav1.LoadBalancerAccessLogs) error {
+	if accessLogs == nil || !accessLogs.Enabled || !accessLogs.ManageBucketPolicy {
+		return nil
+	}
+
+	current := "{}"
+	out, err := s.S3Client.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+		Bucket: aws.String(accessLogs.S3BucketName),
+	})
+	switch {
+	case err == nil:
+		current = aws.ToString(out.Policy)
+	case isNoSuchBucketPolicy(err):
+	default:
+		return errors.Wrapf(err, "failed to get policy of bucket %q", accessLogs.S3BucketName)
+	}
+
+	policy, changed, err := mergeBucketPolicy(current, s.accessLogsBucketPolicyStatements(accessLogs))
+	if err != nil {
+		return errors.Wrapf(err, "failed to merge access logs policy into the policy of bucket %q", accessLogs.S3BucketName)
+	}
+	if !changed {
+		return nil
+	}
+
+	if _, err := s.S3Client.PutBucketPolicy(ctx, &s3.PutBucketPolicyInput{
+		Bucket: aws.String(accessLogs.S3BucketName),
+		Policy: aws.String(policy),
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedPutBucketPolicy", "Failed to set access logs policy on bucket %q: %v", accessLogs.S3BucketName, err)
+		return errors.Wrapf(err, "failed to set access logs policy on bucket %q", accessLogs.S3BucketName)
+	}
+
+	s.scope.Debug("Set access logs policy on bucket", "bucket", accessLogs.S3BucketName)
+	return nil
+}
+
+func isNoSuchBucketPolicy(err error) bool {
+	code, ok := awserrors.Code(err)
+	return ok && code == awserrors.NoSuchBucketPolicy
+}
+
+// mergeBucketPolicy adds the statements missing from the given bucket policy document. The document
+// is handled as generic JSON so that the statements CAPA doesn't know about are kept as they are.
+func mergeBucketPolicy(current string, statements []iam.StatementEntry) (string, bool, error) {
+	policy := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(current), &policy); err != nil {
+		return "", false, errors.Wrap(err, "parsing bucket policy")
+	}
+	if _, ok := policy["Version"]; !ok {
+		policy["Version"] = "2012-10-17"
+	}
+
+	// A policy with a single statement may set it as an object instead of a list.
+	var existing []interface{}
+	switch v := policy["Statement"].(type) {
+	case []interface{}:
+		existing = v
+	case map[string]interface{}:
+		existing = []interface{}{v}
+	}
+
+	changed := false
+	for _, statement := range statements {
+		raw, err := json.Marshal(statement)
+		if err != nil {
+			return "", false, errors.Wrap(err, "building access logs bucket policy")
+		}
+		var desired interface{}
+		if err := json.Unmarshal(raw, &desired); err != nil {
+			return "", false, errors.Wrap(err, "building access logs bucket policy")
+		}
+		desiredNormalized := normalizePolicyValue(desired)
+		if slices.ContainsFunc(existing, func(s interface{}) bool { return reflect.DeepEqual(normalizePolicyValue(s), desiredNormalized) }) {
+			continue
+		}
+		existing = append(existing, desired)
+		changed = true
+	}
+	if !changed {
+		return current, false, nil
+	}
+	policy["Statement"] = existing
+
+	policyRaw, err := json.Marshal(policy)
+	if err != nil {
+		return "", false, errors.Wrap(err, "building bucket policy")
+	}
+	return string(policyRaw), true, nil
+}
+
+// normalizePolicyValue returns the given policy JSON value with single element lists replaced by
+// their element. S3 returns bucket policies in this form, while a single value and a list holding
+// only that value are equivalent in a policy.
+func normalizePolicyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		if len(v) == 1 {
+			return normalizePolicyValue(v[0])
+		}
+		normalized := make([]interface{}, len(v))
+		for i := range v {
+			normalized[i] = normalizePolicyValue(v[i])
+		}
+		return normalized
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			normalized[key] = normalizePolicyValue(value)
+		}
+		return normalized
+	}
+	return value
+}
+
+// accessLogsBucketPolicyStatements returns the bucket policy statements allowing Elastic Load
+// Balancing to deliver access logs to the configured S3 bucket and prefix. They have no Sid, so
+// that clusters delivering to different prefixes of the same bucket don't conflict.
+func (s *Service) accessLogsBucketPolicyStatements(accessLogs *infrav1.LoadBalancerAccessLogs) []iam.StatementEntry {
+	partition := endpoints.GetPartitionFromRegion(s.scope.Region())
+	bucketARN := fmt.Sprintf("arn:%s:s3:::%s", partition, accessLogs.S3BucketName)
+	logsARN := fmt.Sprintf("arn:%s:s3:::%s", partition, path.Join(accessLogs.S3BucketName, accessLogs.S3BucketPrefix, "AWSLogs", "*"))
+
+	elbPrincipal := map[iam.PrincipalType]iam.PrincipalID{
+		iam.PrincipalService: []string{"logdelivery.elasticloadbalancing.amazonaws.com"},
+	}
+	if accountID, ok := elbAccountIDs[s.scope.Region()]; ok {
+		elbPrincipal = map[iam.PrincipalType]iam.PrincipalID{
+			iam.PrincipalAWS: []string{fmt.Sprintf("arn:%s:iam::%s:root", partition, accountID)},
+		}
+	}
+
+	logDeliveryPrincipal := map[iam.PrincipalType]iam.PrincipalID{
+		iam.PrincipalService: []string{"delivery.logs.amazonaws.com"},
+	}
+
+	return []iam.StatementEntry{
+		{
+			Effect:    iam.EffectAllow,
+			Principal: elbPrincipal,
+			Action:    []string{"s3:PutObject"},
+			Resource:  []string{logsARN},
+		},
+		{
+			Effect:    iam.EffectAllow,
+			Principal: logDeliveryPrincipal,
+			Action:    []string{"s3:PutObject"},
+			Resource:  []string{logsARN},
+			Condition: iam.Conditions{
+				iam.StringEquals: map[string]interface{}{
+					"s3:x-amz-acl": "bucket-owner-full-control",
+				},
+			},
+		},
+		{
+			Effect:    iam.EffectAllow,
+			Principal: logDeliveryPrincipal,
+			Action:    []string{"s3:GetBucketAcl"},
+			Resource:  []string{bucketARN},
+		},
+	}
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	iam "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/s3/mock_s3iface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileClassicELBAccessLogs(t *testing.T) {
+	const elbName = "bar-apiserver"
+
+	tests := []struct {
+		name       string
+		accessLogs *infrav1.LoadBalancerAccessLogs
+		elbMocks   func(m *mocks.MockELBAPIMockRecorder)
+		s3Mocks    func(m *mock_s3iface.MockS3APIMockRecorder)
+	}{
+		{
+			name:       "access logs not configured",
+			accessLogs: nil,
+			elbMocks: func(m *mocks.MockELBAPIMockRecorder) {
+				m.DescribeLoadBalancerAttributes(gomock.Any(), gomock.Any()).Return(&elb.DescribeLoadBalancerAttributesOutput{
+					LoadBalancerAttributes: &elbtypes.LoadBalancerAttributes{
+						AccessLog: &elbtypes.AccessLog{Enabled: false},
+					},
+				}, nil)
+			},
+			s3Mocks: func(m *mock_s3iface.MockS3APIMockRecorder) {},
+		},
+		{
+			name:       "removing the access logs configuration disables them",
+			accessLogs: nil,
+			elbMocks: func(m *mocks.MockELBAPIMockRecorder) {
+				m.DescribeLoadBalancerAttributes(gomock.Any(), gomock.Any()).Return(&elb.DescribeLoadBalancerAttributesOutput{
+					LoadBalancerAttributes: &elbtypes.LoadBalancerAttributes{
+						AccessLog: &elbtypes.AccessLog{
+							Enabled:      true,
+							S3BucketName: aws.String("logs"),
+						},
+					},
+				}, nil)
+				m.ModifyLoadBalancerAttributes(gomock.Any(), &elb.ModifyLoadBalancerAttributesInput{
+					LoadBalancerName: aws.String(elbName),
+					LoadBalancerAttributes: &elbtypes.LoadBalancerAttributes{
+						AccessLog: &elbtypes.AccessLog{Enabled: false},
+					},
+				}).Return(&elb.ModifyLoadBalancerAttributesOutput{}, nil)
+			},
+			s3Mocks: func(m *mock_s3iface.MockS3APIMockRecorder) {},
+		},
+		{
+			name: "enabling access logs sets the bucket policy and the attributes",
+			accessLogs: &infrav1.LoadBalancerAccessLogs{
+				Enabled:            true,
+				S3BucketName:       "logs",
+				S3BucketPrefix:     "apiserver",
+				ManageBucketPolicy: true,
+			},
+			elbMocks: func(m *mocks.MockELBAPIMockRecorder) {
+				m.DescribeLoadBalancerAttributes(gomock.Any(), &elb.DescribeLoadBalancerAttributesInput{
+					LoadBalancerName: aws.String(elbName),
+				}).Return(&elb.DescribeLoadBalancerAttributesOutput{
+					LoadBalancerAttributes: &elbtypes.LoadBalancerAttributes{
+						AccessLog: &elbtypes.AccessLog{Enabled: false},
+					},
+				}, nil)
+				m.ModifyLoadBalancerAttributes(gomock.Any(), &elb.ModifyLoadBalancerAttributesInput{
+					LoadBalancerName: aws.String(elbName),
+					LoadBalancerAttributes: &elbtypes.LoadBalancerAttributes{
+						AccessLog: &elbtypes.AccessLog{
+							Enabled:        true,
+							S3BucketName:   aws.String("logs"),
+							S3BucketPrefix: aws.String("apiserver"),
+						},
+					},
+				}).Return(&elb.ModifyLoadBalancerAttributesOutput{}, nil)
+			},
+			s3Mocks: func(m *mock_s3iface.MockS3APIMockRecorder) {
+				m.GetBucketPolicy(gomock.Any(), &s3.GetBucketPolicyInput{Bucket: aws.String("logs")}).Return(&s3.GetBucketPolicyOutput{
+					Policy: aws.String(`{"Version":"2012-10-17","Statement":{"Sid":"Existing","Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::logs/*"}}`),
+				}, nil)
+				m.PutBucketPolicy(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, input *s3.PutBucketPolicyInput, _ ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.ToString(input.Bucket)).To(Equal("logs"))
+						g.Expect(aws.ToString(input.Policy)).To(ContainSubstring(`"Sid":"Existing"`))
+						g.Expect(aws.ToString(input.Policy)).To(ContainSubstring("arn:aws:iam::127311923021:root"))
+						g.Expect(aws.ToString(input.Policy)).To(ContainSubstring("arn:aws:s3:::logs/apiserver/AWSLogs/*"))
+						return &s3.PutBucketPolicyOutput{}, nil
+					})
+			},
+		},
+		{
+			name: "changing the bucket updates the attributes",
+			accessLogs: &infrav1.LoadBalancerAccessLogs{
+				Enabled:      true,
+				S3BucketName: "new-logs",
+			},
+			elbMocks: func(m *mocks.MockELBAPIMockRecorder) {
+				m.DescribeLoadBalancerAttributes(gomock.Any(), gomock.Any()).Return(&elb.DescribeLoadBalancerAttributesOutput{
+					LoadBalancerAttributes: &elbtypes.LoadBalancerAttributes{
+						AccessLog: &elbtypes.AccessLog{
+							Enabled:        true,
+							S3BucketName:   aws.String("logs"),
+							S3BucketPrefix: aws.String(""),
+						},
+					},
+				}, nil)
+				m.ModifyLoadBalancerAttributes(gomock.Any(), &elb.ModifyLoadBalancerAttributesInput{
+					LoadBalancerName: aws.String(elbName),
+					LoadBalancerAttributes: &elbtypes.LoadBalancerAttributes{
+						AccessLog: &elbtypes.AccessLog{
+							Enabled:        true,
+							S3BucketName:   aws.String("new-logs"),
+							S3BucketPrefix: aws.String(""),
+						},
+					},
+				}).Return(&elb.ModifyLoadBalancerAttributesOutput{}, nil)
+			},
+			s3Mocks: func(m *mock_s3iface.MockS3APIMockRecorder) {},
+		},
+		{
+			name: "access logs already up to date",
+			accessLogs: &infrav1.LoadBalancerAccessLogs{
+				Enabled:            true,
+				S3BucketName:       "logs",
+				ManageBucketPolicy: true,
+			},
+			elbMocks: func(m *mocks.MockELBAPIMockRecorder) {
+				m.DescribeLoadBalancerAttributes(gomock.Any(), gomock.Any()).Return(&elb.DescribeLoadBalancerAttributesOutput{
+					LoadBalancerAttributes: &elbtypes.LoadBalancerAttributes{
+						AccessLog: &elbtypes.AccessLog{
+							Enabled:      true,
+							S3BucketName: aws.String("logs"),
+						},
+					},
+				}, nil)
+			},
+			s3Mocks: func(m *mock_s3iface.MockS3APIMockRecorder) {},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			elbAPIMocks := mocks.NewMockELBAPI(mockCtrl)
+			s3Mock := mock_s3iface.NewMockS3API(mockCtrl)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "bar"},
+					Spec:       infrav1.AWSClusterSpec{Region: "us-east-1"},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.elbMocks(elbAPIMocks.EXPECT())
+			tc.s3Mocks(s3Mock.EXPECT())
+
+			s := &Service{
+				scope:     clusterScope,
+				ELBClient: elbAPIMocks,
+				S3Client:  s3Mock,
+			}
+
+			g.Expect(s.reconcileClassicELBAccessLogs(context.TODO(), elbName, tc.accessLogs)).To(Succeed())
+		})
+	}
+}
+
+func TestMergeBucketPolicy(t *testing.T) {
+	statement := iam.StatementEntry{
+		Effect:    iam.EffectAllow,
+		Principal: map[iam.PrincipalType]iam.PrincipalID{iam.PrincipalService: []string{"delivery.logs.amazonaws.com"}},
+		Action:    []string{"s3:GetBucketAcl"},
+		Resource:  []string{"arn:aws:s3:::logs"},
+	}
+	existing := `{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::logs/*"}`
+	merged := `{"Effect":"Allow","Principal":{"Service":["delivery.logs.amazonaws.com"]},"Action":["s3:GetBucketAcl"],"Resource":["arn:aws:s3:::logs"]}`
+
+	tests := []struct {
+		name          string
+		current       string
+		expectChanged bool
+		expected      string
+	}{
+		{
+			name:          "no bucket policy",
+			current:       `{}`,
+			expectChanged: true,
+			expected:      `{"Version":"2012-10-17","Statement":[` + merged + `]}`,
+		},
+		{
+			name:          "existing statements are kept",
+			current:       `{"Version":"2012-10-17","Id":"custom","Statement":[` + existing + `]}`,
+			expectChanged: true,
+			expected:      `{"Version":"2012-10-17","Id":"custom","Statement":[` + existing + `,` + merged + `]}`,
+		},
+		{
+			name:          "single statement object",
+			current:       `{"Version":"2012-10-17","Statement":` + existing + `}`,
+			expectChanged: true,
+			expected:      `{"Version":"2012-10-17","Statement":[` + existing + `,` + merged + `]}`,
+		},
+		{
+			name:    "statement already present",
+			current: `{"Version":"2012-10-17","Statement":[` + existing + `,` + merged + `]}`,
+		},
+		{
+			name:    "statement already present in the form returned by S3",
+			current: `{"Version":"2012-10-17","Statement":[` + existing + `,{"Effect":"Allow","Principal":{"Service":"delivery.logs.amazonaws.com"},"Action":"s3:GetBucketAcl","Resource":"arn:aws:s3:::logs"}]}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			policy, changed, err := mergeBucketPolicy(tc.current, []iam.StatementEntry{statement})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(changed).To(Equal(tc.expectChanged))
+			if tc.expectChanged {
+				g.Expect(policy).To(MatchJSON(tc.expected))
+			} else {
+				g.Expect(policy).To(Equal(tc.current))
+			}
+		})
+	}
+}
+
+func TestAccessLogsAttributesChanged(t *testing.T) {
+	current := map[string]*string{
+		infrav1.LoadBalancerAttributeEnableLoadBalancingCrossZone: aws.String("false"),
+		infrav1.LoadBalancerAttributeAccessLogsEnabled:            aws.String("true"),
+		infrav1.LoadBalancerAttributeAccessLogsS3Bucket:           aws.String("logs"),
+		infrav1.LoadBalancerAttributeAccessLogsS3Prefix:           aws.String(""),
+	}
+
+	tests := []struct {
+		name     string
+		desired  map[string]*string
+		expected bool
+	}{
+		{
+			name: "access logs not configured",
+			desired: map[string]*string{
+				infrav1.LoadBalancerAttributeEnableLoadBalancingCrossZone: aws.String("true"),
+			},
+			expected: false,
+		},
+		{
+			name:     "access logs unchanged",
+			desired:  accessLogsAttributes(&infrav1.LoadBalancerAccessLogs{Enabled: true, S3BucketName: "logs"}),
+			expected: false,
+		},
+		{
+			name:     "bucket changed",
+			desired:  accessLogsAttributes(&infrav1.LoadBalancerAccessLogs{Enabled: true, S3BucketName: "new-logs"}),
+			expected: true,
+		},
+		{
+			name:     "access logs disabled",
+			desired:  accessLogsAttributes(&infrav1.LoadBalancerAccessLogs{Enabled: false, S3BucketName: "logs"}),
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(accessLogsAttributesChanged(tc.desired, current)).To(Equal(tc.expected))
+		})
+	}
+}
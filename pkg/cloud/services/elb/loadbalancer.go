@@ -44,6 +44,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/hash"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
@@ -178,7 +179,17 @@ func (s *Service) reconcileV2LB(ctx context.Context, lb *infrav1.LoadBalancer, d
 			return errors.Wrapf(err, "failed to create target groups/listeners for load balancer %q", lb.Name)
 		}
 
+		// Access logging is disabled once the access logs configuration is removed.
+		if lbSpec.AccessLogs == nil && aws.ToString(lb.ELBAttributes[infrav1.LoadBalancerAttributeAccessLogsEnabled]) == "true" {
+			desiredLB.ELBAttributes[infrav1.LoadBalancerAttributeAccessLogsEnabled] = aws.String("false")
+		}
+
 		if !cmp.Equal(desiredLB.ELBAttributes, lb.ELBAttributes) {
+			if accessLogsAttributesChanged(desiredLB.ELBAttributes, lb.ELBAttributes) {
+				if err := s.ensureAccessLogsBucketPolicy(ctx, lbSpec.AccessLogs); err != nil {
+					return err
+				}
+			}
 			if err := s.configureLBAttributes(ctx, lb.ARN, desiredLB.ELBAttributes); err != nil {
 				return err
 			}
@@ -437,6 +448,12 @@ func (s *Service) getAPIServerLBSpec(ctx context.Context, elbName string, lbSpec
 		res.ELBAttributes[infrav1.LoadBalancerAttributeEnableLoadBalancingCrossZone] = aws.String(strconv.FormatBool(isCrossZoneLB))
 	}
 
+	if lbSpec != nil && lbSpec.AccessLogs != nil {
+		for k, v := range accessLogsAttributes(lbSpec.AccessLogs) {
+			res.ELBAttributes[k] = v
+		}
+	}
+
 	res.Tags = infrav1.Build(infrav1.BuildParams{
 		ClusterName: s.scope.Name(),
 		Lifecycle:   infrav1.ResourceLifecycleOwned,
@@ -661,6 +678,14 @@ func (s *Service) reconcileClassicLoadBalancer(ctx context.Context, apiELB *infr
 			}
 		}
 
+		var accessLogs *infrav1.LoadBalancerAccessLogs
+		if lbSpec := s.scope.ControlPlaneLoadBalancer(); lbSpec != nil {
+			accessLogs = lbSpec.AccessLogs
+		}
+		if err := s.reconcileClassicELBAccessLogs(ctx, apiELB.Name, accessLogs); err != nil {
+			return err
+		}
+
 		// BUG: note that describeClassicELB doesn't set HealthCheck in its output,
 		// so we're configuring the health check on every reconcile whether it's
 		// needed or not.
@@ -1626,83 +1651,65 @@ func (s *Service) describeLBTags(ctx context.Context, arn string) ([]elbv2types.
 }
 
 func (s *Service) reconcileELBTags(ctx context.Context, lb *infrav1.LoadBalancer, desiredTags map[string]string) error {
-	addTagsInput := &elb.AddTagsInput{
-		LoadBalancerNames: []string{lb.Name},
-	}
-
-	removeTagsInput := &elb.RemoveTagsInput{
-		LoadBalancerNames: []string{lb.Name},
-	}
-
-	currentTags := infrav1.Tags(lb.Tags)
-
-	for k, v := range desiredTags {
-		if val, ok := currentTags[k]; !ok || val != v {
-			s.scope.Trace("adding tag to load balancer", "elb-name", lb.Name, "key", k, "value", v)
-			addTagsInput.Tags = append(addTagsInput.Tags, elbtypes.Tag{Key: aws.String(k), Value: aws.String(v)})
-		}
-	}
-
-	for k := range currentTags {
-		if _, ok := desiredTags[k]; !ok {
-			s.scope.Trace("removing tag from load balancer", "elb-name", lb.Name, "key", k)
-			removeTagsInput.Tags = append(removeTagsInput.Tags, elbtypes.TagKeyOnly{Key: aws.String(k)})
-		}
-	}
-
-	if len(addTagsInput.Tags) > 0 {
-		if _, err := s.ELBClient.AddTags(ctx, addTagsInput); err != nil {
-			return err
-		}
-	}
-
-	if len(removeTagsInput.Tags) > 0 {
-		if _, err := s.ELBClient.RemoveTags(ctx, removeTagsInput); err != nil {
-			return err
-		}
-	}
+	return tags.Sweep(ctx, tags.SweepResource{
+		Kind:    "load balancer",
+		ID:      lb.Name,
+		Current: lb.Tags,
+		Desired: desiredTags,
+		Update: func(ctx context.Context, create map[string]string, remove []string) error {
+			if len(create) > 0 {
+				input := &elb.AddTagsInput{LoadBalancerNames: []string{lb.Name}}
+				for k, v := range create {
+					s.scope.Trace("adding tag to load balancer", "elb-name", lb.Name, "key", k, "value", v)
+					input.Tags = append(input.Tags, elbtypes.Tag{Key: aws.String(k), Value: aws.String(v)})
+				}
+				if _, err := s.ELBClient.AddTags(ctx, input); err != nil {
+					return err
+				}
+			}
 
-	return nil
+			if len(remove) > 0 {
+				input := &elb.RemoveTagsInput{LoadBalancerNames: []string{lb.Name}}
+				for _, k := range remove {
+					s.scope.Trace("removing tag from load balancer", "elb-name", lb.Name, "key", k)
+					input.Tags = append(input.Tags, elbtypes.TagKeyOnly{Key: aws.String(k)})
+				}
+				if _, err := s.ELBClient.RemoveTags(ctx, input); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
 }
 
 func (s *Service) reconcileV2LBTags(ctx context.Context, lb *infrav1.LoadBalancer, desiredTags map[string]string) error {
-	addTagsInput := &elbv2.AddTagsInput{
-		ResourceArns: []string{lb.ARN},
-	}
-
-	removeTagsInput := &elbv2.RemoveTagsInput{
-		ResourceArns: []string{lb.ARN},
-	}
-
-	currentTags := infrav1.Tags(lb.Tags)
-
-	for k, v := range desiredTags {
-		if val, ok := currentTags[k]; !ok || val != v {
-			s.scope.Trace("adding tag to load balancer", "elb-name", lb.Name, "key", k, "value", v)
-			addTagsInput.Tags = append(addTagsInput.Tags, elbv2types.Tag{Key: aws.String(k), Value: aws.String(v)})
-		}
-	}
-
-	for k := range currentTags {
-		if _, ok := desiredTags[k]; !ok {
-			s.scope.Trace("removing tag from load balancer", "elb-name", lb.Name, "key", k)
-			removeTagsInput.TagKeys = append(removeTagsInput.TagKeys, k)
-		}
-	}
-
-	if len(addTagsInput.Tags) > 0 {
-		if _, err := s.ELBV2Client.AddTags(ctx, addTagsInput); err != nil {
-			return err
-		}
-	}
-
-	if len(removeTagsInput.TagKeys) > 0 {
-		if _, err := s.ELBV2Client.RemoveTags(ctx, removeTagsInput); err != nil {
-			return err
-		}
-	}
+	return tags.Sweep(ctx, tags.SweepResource{
+		Kind:    "load balancer",
+		ID:      lb.Name,
+		Current: lb.Tags,
+		Desired: desiredTags,
+		Update: func(ctx context.Context, create map[string]string, remove []string) error {
+			if len(create) > 0 {
+				input := &elbv2.AddTagsInput{ResourceArns: []string{lb.ARN}}
+				for k, v := range create {
+					s.scope.Trace("adding tag to load balancer", "elb-name", lb.Name, "key", k, "value", v)
+					input.Tags = append(input.Tags, elbv2types.Tag{Key: aws.String(k), Value: aws.String(v)})
+				}
+				if _, err := s.ELBV2Client.AddTags(ctx, input); err != nil {
+					return err
+				}
+			}
 
-	return nil
+			if len(remove) > 0 {
+				s.scope.Trace("removing tags from load balancer", "elb-name", lb.Name, "keys", remove)
+				if _, err := s.ELBV2Client.RemoveTags(ctx, &elbv2.RemoveTagsInput{ResourceArns: []string{lb.ARN}, TagKeys: remove}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
 }
 
 // reconcileTargetGroupsAndListeners reconciles a Load Balancer's defined listeners with corresponding AWS Target Groups and Listeners.
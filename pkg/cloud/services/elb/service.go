@@ -28,6 +28,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/common"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/network"
+	s3svc "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/s3"
 )
 
 // Service holds a collection of interfaces.
@@ -39,6 +40,7 @@ type Service struct {
 	ELBClient             ELBAPI
 	ELBV2Client           ELBV2API
 	ResourceTaggingClient ResourceGroupsTaggingAPIAPI
+	S3Client              s3svc.S3API
 	netService            *network.Service
 }
 
@@ -129,6 +131,7 @@ func NewService(elbScope scope.ELBScope) *Service {
 		ResourceTaggingClient: &ResourceGroupsTaggingAPIClient{
 			Client: scope.NewResourgeTaggingClient(elbScope, elbScope, elbScope, elbScope.InfraCluster()),
 		},
+		S3Client:   scope.NewS3Client(elbScope, elbScope, elbScope, elbScope.InfraCluster()),
 		netService: network.NewService(elbScope.(scope.NetworkScope)),
 	}
 }
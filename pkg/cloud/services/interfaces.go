@@ -58,12 +58,16 @@ const (
 	LaunchTemplateNeedsUpdateReasonSpotMarketOptions LaunchTemplateNeedsUpdateReason = "SpotMarketOptions"
 	// LaunchTemplateNeedsUpdateReasonCapacityReservationID means a difference in the capacity reservation ID was found.
 	LaunchTemplateNeedsUpdateReasonCapacityReservationID LaunchTemplateNeedsUpdateReason = "CapacityReservationID"
+	// LaunchTemplateNeedsUpdateReasonCapacityReservationPreference means a difference in the capacity reservation preference was found.
+	LaunchTemplateNeedsUpdateReasonCapacityReservationPreference LaunchTemplateNeedsUpdateReason = "CapacityReservationPreference"
 	// LaunchTemplateNeedsUpdateReasonPrivateDNSName means a difference in the private DNS name was found.
 	LaunchTemplateNeedsUpdateReasonPrivateDNSName LaunchTemplateNeedsUpdateReason = "PrivateDNSName"
 	// LaunchTemplateNeedsUpdateReasonSSHKeyName means a difference in the SSH key name was found.
 	LaunchTemplateNeedsUpdateReasonSSHKeyName LaunchTemplateNeedsUpdateReason = "SSHKeyName"
 	// LaunchTemplateNeedsUpdateReasonAdditionalSecurityGroupIDs means a difference in the additional security group IDs was found.
 	LaunchTemplateNeedsUpdateReasonAdditionalSecurityGroupIDs LaunchTemplateNeedsUpdateReason = "AdditionalSecurityGroupIDs"
+	// LaunchTemplateNeedsUpdateReasonVolumeEncryption means a difference in the encryption of the volumes was found.
+	LaunchTemplateNeedsUpdateReasonVolumeEncryption LaunchTemplateNeedsUpdateReason = "VolumeEncryption"
 )
 
 // ASGInterface encapsulates the methods exposed to the machinepool
@@ -54,6 +54,11 @@ const (
 
 	// IPProtocolICMPv6 is how EC2 represents the ICMPv6 protocol in ingress rules.
 	IPProtocolICMPv6 = "icmpv6"
+
+	// TagsLastAppliedAnnotation records the additional tags last applied to the security groups
+	// of the cluster, so that the ones removed from the spec can be removed from the security
+	// groups without removing the tags set out of band.
+	TagsLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-aws-last-applied-security-group-tags"
 )
 
 // ReconcileSecurityGroups will reconcile security groups against the Service object.
@@ -95,6 +100,12 @@ func (s *Service) ReconcileSecurityGroups() error {
 		sgs[sg.Name] = sg
 	}
 
+	lastAppliedTags, err := tags.LastAppliedTags(s.scope.InfraCluster(), TagsLastAppliedAnnotation)
+	if err != nil {
+		return err
+	}
+	var tagSweep []tags.SweepResource
+
 	// First iteration makes sure that the security group are valid and fully created.
 	for i := range s.roles {
 		role := s.roles[i]
@@ -131,20 +142,28 @@ func (s *Service) ReconcileSecurityGroups() error {
 		}
 
 		if !s.securityGroupIsAnOverride(existing.ID) {
-			// Make sure tags are up to date.
-			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-				buildParams := s.getSecurityGroupTagParams(existing.Name, existing.ID, role)
-				tagsBuilder := tags.New(&buildParams, tags.WithEC2(s.EC2Client))
-				if err := tagsBuilder.Ensure(existing.Tags); err != nil {
-					return false, err
-				}
-				return true, nil
-			}, awserrors.GroupNotFound); err != nil {
-				return errors.Wrapf(err, "failed to ensure tags on security group %q", existing.ID)
-			}
+			id := existing.ID
+			tagSweep = append(tagSweep, tags.SweepResource{
+				Kind:    "security group",
+				ID:      id,
+				Current: existing.Tags,
+				Desired: infrav1.Build(s.getSecurityGroupTagParams(existing.Name, id, role)),
+				Keep:    tags.KeepUnlessApplied(lastAppliedTags),
+				Update: func(ctx context.Context, create map[string]string, remove []string) error {
+					return tags.UpdateEC2Tags(ctx, s.EC2Client, id, create, remove)
+				},
+			})
 		}
 	}
 
+	// Make sure tags are up to date.
+	if err := tags.Sweep(context.TODO(), tagSweep...); err != nil {
+		return err
+	}
+	if err := tags.SetLastAppliedTags(s.scope.InfraCluster(), TagsLastAppliedAnnotation, s.scope.AdditionalTags()); err != nil {
+		return err
+	}
+
 	// Second iteration creates or updates all permissions on the security group to match
 	// the specified ingress rules.
 	for role := range s.scope.SecurityGroups() {
@@ -170,6 +189,23 @@ func (s *Service) ReconcileSecurityGroups() error {
 		want := expandIngressRules(specRules)
 
 		toRevoke := current.Difference(want)
+		toAuthorize := want.Difference(current)
+
+		// Rules that only differ by their description are updated in place rather than recreated.
+		toRevoke, toAuthorize, toDescribe := splitIngressRuleDescriptionChanges(toRevoke, toAuthorize)
+		if len(toDescribe) > 0 {
+			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+				if err := s.updateSecurityGroupIngressRuleDescriptions(sg.ID, toDescribe); err != nil {
+					return false, err
+				}
+				return true, nil
+			}, awserrors.GroupNotFound); err != nil {
+				return errors.Wrapf(err, "failed to update security group ingress rule descriptions for %q", sg.ID)
+			}
+
+			s.scope.Debug("Updated ingress rule descriptions in security group", "updated-ingress-rules", toDescribe, "security-group-id", sg.ID)
+		}
+
 		if len(toRevoke) > 0 {
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 				if err := s.revokeSecurityGroupIngressRules(sg.ID, toRevoke); err != nil {
@@ -183,7 +219,6 @@ func (s *Service) ReconcileSecurityGroups() error {
 			s.scope.Debug("Revoked ingress rules from security group", "revoked-ingress-rules", toRevoke, "security-group-id", sg.ID)
 		}
 
-		toAuthorize := want.Difference(current)
 		if len(toAuthorize) > 0 {
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 				if err := s.authorizeSecurityGroupIngressRules(sg.ID, toAuthorize); err != nil {
@@ -201,6 +236,41 @@ func (s *Service) ReconcileSecurityGroups() error {
 	return nil
 }
 
+// splitIngressRuleDescriptionChanges finds the rules to authorize that match a rule to revoke in everything but
+// their description, and returns them separately so their description can be updated without recreating the rule.
+func splitIngressRuleDescriptionChanges(toRevoke, toAuthorize infrav1.IngressRules) (revoke, authorize, describe infrav1.IngressRules) {
+	matched := make([]bool, len(toRevoke))
+	for _, rule := range toAuthorize {
+		found := false
+		for i := range toRevoke {
+			if matched[i] {
+				continue
+			}
+			existing := toRevoke[i]
+			existing.Description = rule.Description
+			if existing.Equals(&rule) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+
+		if found {
+			describe = append(describe, rule)
+		} else {
+			authorize = append(authorize, rule)
+		}
+	}
+
+	for i := range toRevoke {
+		if !matched[i] {
+			revoke = append(revoke, toRevoke[i])
+		}
+	}
+
+	return revoke, authorize, describe
+}
+
 // expandIngressRules expand the given ingress rules so that it's compatible with the list generated by
 // ingressRulesFromSDKType.
 // We assume that processIngressRulesSGs has been already called on the input, so the SourceSecurityGroupRoles have
@@ -510,6 +580,22 @@ func (s *Service) authorizeSecurityGroupIngressRules(id string, rules infrav1.In
 	return nil
 }
 
+func (s *Service) updateSecurityGroupIngressRuleDescriptions(id string, rules infrav1.IngressRules) error {
+	input := &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{GroupId: aws.String(id)}
+	for i := range rules {
+		rule := rules[i]
+		input.IpPermissions = append(input.IpPermissions, *ingressRuleToSDKType(s.scope, &rule))
+	}
+
+	if _, err := s.EC2Client.UpdateSecurityGroupRuleDescriptionsIngress(context.TODO(), input); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedUpdateSecurityGroupRuleDescriptions", "Failed to update security group ingress rule descriptions %v for SecurityGroup %q: %v", rules, id, err)
+		return errors.Wrapf(err, "failed to update security group %q ingress rule descriptions: %v", id, rules)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulUpdateSecurityGroupRuleDescriptions", "Updated security group ingress rule descriptions %v for SecurityGroup %q", rules, id)
+	return nil
+}
+
 func (s *Service) revokeSecurityGroupIngressRules(id string, rules infrav1.IngressRules) error {
 	input := &ec2.RevokeSecurityGroupIngressInput{GroupId: aws.String(id)}
 	for i := range rules {
@@ -1167,6 +1167,77 @@ func TestReconcileSecurityGroups(t *testing.T) {
 	}
 }
 
+func TestReconcileSecurityGroupsTags(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+				Annotations: map[string]string{
+					TagsLastAppliedAnnotation: `{"team":"a","cost-center":"1"}`,
+				},
+			},
+			Spec: infrav1.AWSClusterSpec{
+				AdditionalTags: infrav1.Tags{"team": "b"},
+				NetworkSpec: infrav1.NetworkSpec{
+					VPC: infrav1.VPCSpec{
+						ID:   "vpc-securitygroups",
+						Tags: infrav1.Tags{infrav1.ClusterTagKey("test-cluster"): "owned"},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ec2Mock.EXPECT().DescribeSecurityGroups(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
+		Return(&ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []types.SecurityGroup{
+				{
+					GroupId:   aws.String("sg-node"),
+					GroupName: aws.String("test-cluster-node"),
+					VpcId:     aws.String("vpc-securitygroups"),
+					Tags: []types.Tag{
+						{Key: aws.String("Name"), Value: aws.String("test-cluster-node")},
+						{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"), Value: aws.String("owned")},
+						{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/role"), Value: aws.String("node")},
+						{Key: aws.String("team"), Value: aws.String("a")},
+						{Key: aws.String("cost-center"), Value: aws.String("1")},
+						{Key: aws.String("set-out-of-band"), Value: aws.String("x")},
+					},
+				},
+			},
+		}, nil)
+	// The changed tag is updated and the tag removed from the spec is removed, the tag set
+	// out of band is kept.
+	ec2Mock.EXPECT().CreateTags(gomock.Any(), &ec2.CreateTagsInput{
+		Resources: []string{"sg-node"},
+		Tags:      []types.Tag{{Key: aws.String("team"), Value: aws.String("b")}},
+	}).Return(&ec2.CreateTagsOutput{}, nil)
+	ec2Mock.EXPECT().DeleteTags(gomock.Any(), &ec2.DeleteTagsInput{
+		Resources: []string{"sg-node"},
+		Tags:      []types.Tag{{Key: aws.String("cost-center")}},
+	}).Return(&ec2.DeleteTagsOutput{}, nil)
+	ec2Mock.EXPECT().AuthorizeSecurityGroupIngress(context.TODO(), gomock.AssignableToTypeOf(&ec2.AuthorizeSecurityGroupIngressInput{})).
+		Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil).AnyTimes()
+
+	s := NewService(cs, []infrav1.SecurityGroupRole{infrav1.SecurityGroupNode})
+	s.EC2Client = ec2Mock
+
+	g.Expect(s.ReconcileSecurityGroups()).To(Succeed())
+	g.Expect(cs.AWSCluster.Annotations).To(HaveKeyWithValue(TagsLastAppliedAnnotation, `{"team":"b"}`))
+}
+
 func TestControlPlaneSecurityGroupNotOpenToAnyCIDR(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = infrav1.AddToScheme(scheme)
@@ -2541,3 +2612,140 @@ func TestNodePortServicesIngressRules(t *testing.T) {
 		})
 	}
 }
+
+func TestSecurityGroupIngressRulesHaveDescriptions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{},
+				Bastion:                  infrav1.Bastion{Enabled: true},
+				NetworkSpec: infrav1.NetworkSpec{
+					VPC: infrav1.VPCSpec{CidrBlock: "10.0.0.0/16"},
+					CNI: &infrav1.CNISpec{
+						CNIIngressRules: infrav1.CNIIngressRules{
+							{Description: "bgp (calico)", Protocol: infrav1.SecurityGroupProtocolTCP, FromPort: 179, ToPort: 179},
+						},
+					},
+				},
+			},
+			Status: infrav1.AWSClusterStatus{
+				Network: infrav1.NetworkStatus{
+					SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+						infrav1.SecurityGroupBastion:      {ID: "sg-bastion"},
+						infrav1.SecurityGroupAPIServerLB:  {ID: "sg-apiserver-lb"},
+						infrav1.SecurityGroupControlPlane: {ID: "sg-control"},
+						infrav1.SecurityGroupNode:         {ID: "sg-node"},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	s := NewService(cs, testSecurityGroupRoles)
+	for _, role := range testSecurityGroupRoles {
+		t.Run(string(role), func(t *testing.T) {
+			g := NewWithT(t)
+			rules, err := s.getSecurityGroupIngressRules(role)
+			g.Expect(err).NotTo(HaveOccurred())
+			for _, rule := range rules {
+				g.Expect(rule.Description).NotTo(BeEmpty(), "rule %v has no description", rule)
+			}
+		})
+	}
+}
+
+func TestSplitIngressRuleDescriptionChanges(t *testing.T) {
+	kubelet := infrav1.IngressRule{
+		Description:            "Kubelet API",
+		Protocol:               infrav1.SecurityGroupProtocolTCP,
+		FromPort:               10250,
+		ToPort:                 10250,
+		SourceSecurityGroupIDs: []string{"sg-control"},
+	}
+	oldKubelet := kubelet
+	oldKubelet.Description = "kubelet"
+
+	etcd := infrav1.IngressRule{
+		Description:            "etcd",
+		Protocol:               infrav1.SecurityGroupProtocolTCP,
+		FromPort:               2379,
+		ToPort:                 2379,
+		SourceSecurityGroupIDs: []string{"sg-control"},
+	}
+	ssh := infrav1.IngressRule{
+		Description: "SSH",
+		Protocol:    infrav1.SecurityGroupProtocolTCP,
+		FromPort:    22,
+		ToPort:      22,
+		CidrBlocks:  []string{"0.0.0.0/0"},
+	}
+
+	g := NewWithT(t)
+	revoke, authorize, describe := splitIngressRuleDescriptionChanges(
+		infrav1.IngressRules{oldKubelet, ssh},
+		infrav1.IngressRules{kubelet, etcd},
+	)
+	g.Expect(revoke).To(Equal(infrav1.IngressRules{ssh}))
+	g.Expect(authorize).To(Equal(infrav1.IngressRules{etcd}))
+	g.Expect(describe).To(Equal(infrav1.IngressRules{kubelet}))
+}
+
+func TestUpdateSecurityGroupIngressRuleDescriptions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	ec2Mock.EXPECT().UpdateSecurityGroupRuleDescriptionsIngress(context.TODO(), gomock.Eq(&ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+		GroupId: aws.String("sg-node"),
+		IpPermissions: []types.IpPermission{
+			{
+				FromPort:   aws.Int32(10250),
+				ToPort:     aws.Int32(10250),
+				IpProtocol: aws.String("tcp"),
+				UserIdGroupPairs: []types.UserIdGroupPair{
+					{
+						Description: aws.String("Kubelet API"),
+						GroupId:     aws.String("sg-control"),
+					},
+				},
+			},
+		},
+	})).Return(&ec2.UpdateSecurityGroupRuleDescriptionsIngressOutput{}, nil)
+
+	s := NewService(cs, testSecurityGroupRoles)
+	s.EC2Client = ec2Mock
+
+	g := NewWithT(t)
+	g.Expect(s.updateSecurityGroupIngressRuleDescriptions("sg-node", infrav1.IngressRules{
+		{
+			Description:            "Kubelet API",
+			Protocol:               infrav1.SecurityGroupProtocolTCP,
+			FromPort:               10250,
+			ToPort:                 10250,
+			SourceSecurityGroupIDs: []string{"sg-control"},
+		},
+	})).To(Succeed())
+}
@@ -51,6 +51,12 @@ func NewBackoff() wait.Backoff {
 
 // WaitForWithRetryable repeats a condition check with exponential backoff.
 func WaitForWithRetryable(backoff wait.Backoff, condition wait.ConditionFunc, retryableErrors ...string) error {
+	return WaitForWithClassifier(backoff, condition, awserrors.NewRetryClassifier(retryableErrors...))
+}
+
+// WaitForWithClassifier repeats a condition check with exponential backoff,
+// retrying the errors the classifier considers retryable.
+func WaitForWithClassifier(backoff wait.Backoff, condition wait.ConditionFunc, classifier *awserrors.RetryClassifier) error {
 	var errToReturn error
 	waitErr := wait.ExponentialBackoff(backoff, func() (bool, error) {
 		// clear errToReturn value from previous iteration
@@ -80,12 +86,10 @@ func WaitForWithRetryable(backoff wait.Backoff, condition wait.ConditionFunc, re
 			}
 		}
 
-		for _, r := range retryableErrors {
-			if code == r {
-				// We should retry.
-				errToReturn = err
-				return false, nil
-			}
+		if classifier.IsRetryableCode(code) {
+			// We should retry.
+			errToReturn = err
+			return false, nil
 		}
 
 		// Got an error that we can't retry, so return it.
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tags
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/common"
+)
+
+// SweepResource is a cluster-owned resource whose tags are reconciled by Sweep.
+type SweepResource struct {
+	// Kind is the kind of the resource, used to report errors.
+	Kind string
+	// ID identifies the resource, used to report errors.
+	ID string
+	// Current are the tags the resource has.
+	Current map[string]string
+	// Desired are the tags the resource should have.
+	Desired map[string]string
+	// Keep returns true for tags that mustn't be removed from the resource even though
+	// they aren't desired, such as tags set by AWS. It's optional.
+	Keep func(key string) bool
+	// Update creates or updates the create tags and removes the remove tags on the resource.
+	Update func(ctx context.Context, create map[string]string, remove []string) error
+}
+
+// Sweep reconciles the tags of all the given resources in a single pass, so that a change
+// to the cluster's additional tags reaches every resource owned by it at the same time.
+// A failure to update one resource doesn't stop the others from being updated.
+func Sweep(ctx context.Context, resources ...SweepResource) error {
+	var errs []error
+	for _, resource := range resources {
+		create, remove := Diff(resource.Current, resource.Desired, resource.Keep)
+		if len(create) == 0 && len(remove) == 0 {
+			continue
+		}
+		if err := resource.Update(ctx, create, remove); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to update tags of %s %s", resource.Kind, resource.ID))
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// Diff returns the tags that have to be created or updated, and the keys of the tags that
+// have to be removed, for a resource to go from the current to the desired tags. Tags
+// reserved for internal AWS use and tags for which keep returns true are never removed.
+func Diff(current, desired map[string]string, keep func(key string) bool) (create map[string]string, remove []string) {
+	create = map[string]string{}
+	for key, value := range desired {
+		if currentValue, ok := current[key]; !ok || currentValue != value {
+			create[key] = value
+		}
+	}
+
+	remove = []string{}
+	for key := range current {
+		if _, ok := desired[key]; ok || strings.HasPrefix(key, AwsInternalTagPrefix) {
+			continue
+		}
+		if keep != nil && keep(key) {
+			continue
+		}
+		remove = append(remove, key)
+	}
+	sort.Strings(remove)
+
+	return create, remove
+}
+
+// KeepUnlessApplied returns a SweepResource Keep function that keeps the tags which weren't
+// applied by the controller, so that only the tags it previously applied and are no longer
+// desired are removed. This is used for resources whose tags can also be set out of band.
+func KeepUnlessApplied(applied map[string]string) func(key string) bool {
+	return func(key string) bool {
+		_, ok := applied[key]
+		return !ok
+	}
+}
+
+// LastAppliedTags returns the tags recorded in the given annotation of obj by SetLastAppliedTags.
+func LastAppliedTags(obj metav1.Object, annotation string) (map[string]string, error) {
+	applied := map[string]string{}
+	value, ok := obj.GetAnnotations()[annotation]
+	if !ok || value == "" {
+		return applied, nil
+	}
+	if err := json.Unmarshal([]byte(value), &applied); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse annotation %s", annotation)
+	}
+	return applied, nil
+}
+
+// SetLastAppliedTags records the tags applied to the resources in the given annotation of obj.
+func SetLastAppliedTags(obj metav1.Object, annotation string, applied map[string]string) error {
+	value, err := json.Marshal(applied)
+	if err != nil {
+		return errors.Wrapf(err, "failed to build annotation %s", annotation)
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[annotation] = string(value)
+	obj.SetAnnotations(annotations)
+	return nil
+}
+
+// UpdateEC2Tags creates or updates the create tags and removes the remove tags on the EC2
+// resource with the given ID.
+func UpdateEC2Tags(ctx context.Context, client common.EC2API, id string, create map[string]string, remove []string) error {
+	if len(create) > 0 {
+		keys := make([]string, 0, len(create))
+		for k := range create {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		input := &ec2.CreateTagsInput{Resources: []string{id}}
+		for _, k := range keys {
+			input.Tags = append(input.Tags, ec2types.Tag{Key: aws.String(k), Value: aws.String(create[k])})
+		}
+		if _, err := client.CreateTags(ctx, input); err != nil {
+			return errors.Wrapf(err, "failed to create tags on resource %q", id)
+		}
+	}
+
+	if len(remove) > 0 {
+		input := &ec2.DeleteTagsInput{Resources: []string{id}}
+		for _, k := range remove {
+			input.Tags = append(input.Tags, ec2types.Tag{Key: aws.String(k)})
+		}
+		if _, err := client.DeleteTags(ctx, input); err != nil {
+			return errors.Wrapf(err, "failed to delete tags on resource %q", id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name           string
+		current        map[string]string
+		desired        map[string]string
+		keep           func(key string) bool
+		expectedCreate map[string]string
+		expectedRemove []string
+	}{
+		{
+			name:           "no changes",
+			current:        map[string]string{"a": "1"},
+			desired:        map[string]string{"a": "1"},
+			expectedCreate: map[string]string{},
+			expectedRemove: []string{},
+		},
+		{
+			name:           "created, updated and removed tags",
+			current:        map[string]string{"a": "1", "b": "2", "d": "4", "c": "3"},
+			desired:        map[string]string{"a": "1", "b": "changed", "e": "5"},
+			expectedCreate: map[string]string{"b": "changed", "e": "5"},
+			expectedRemove: []string{"c", "d"},
+		},
+		{
+			name:           "AWS internal and kept tags are not removed",
+			current:        map[string]string{"aws:eks:cluster-name": "cluster", "kept": "1", "stale": "2"},
+			desired:        map[string]string{},
+			keep:           func(key string) bool { return key == "kept" },
+			expectedCreate: map[string]string{},
+			expectedRemove: []string{"stale"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			create, remove := Diff(tc.current, tc.desired, tc.keep)
+			g.Expect(create).To(Equal(tc.expectedCreate))
+			g.Expect(remove).To(Equal(tc.expectedRemove))
+		})
+	}
+}
+
+func TestSweep(t *testing.T) {
+	type update struct {
+		create map[string]string
+		remove []string
+	}
+
+	t.Run("every resource is updated", func(t *testing.T) {
+		g := NewWithT(t)
+		updates := map[string]update{}
+		record := func(id string) func(context.Context, map[string]string, []string) error {
+			return func(_ context.Context, create map[string]string, remove []string) error {
+				updates[id] = update{create: create, remove: remove}
+				return nil
+			}
+		}
+
+		err := Sweep(context.TODO(),
+			SweepResource{
+				Kind:    "nodegroup",
+				ID:      "ng",
+				Current: map[string]string{"team": "a"},
+				Desired: map[string]string{"team": "b"},
+				Update:  record("ng"),
+			},
+			SweepResource{
+				Kind:    "autoscaling group",
+				ID:      "asg",
+				Current: map[string]string{"team": "a", "k8s.io/cluster-autoscaler/enabled": "true"},
+				Desired: map[string]string{"team": "b"},
+				Keep:    func(key string) bool { return key == "k8s.io/cluster-autoscaler/enabled" },
+				Update:  record("asg"),
+			},
+			SweepResource{
+				Kind:    "fargate profile",
+				ID:      "unchanged",
+				Current: map[string]string{"team": "b"},
+				Desired: map[string]string{"team": "b"},
+				Update:  record("unchanged"),
+			},
+		)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(updates).To(Equal(map[string]update{
+			"ng":  {create: map[string]string{"team": "b"}, remove: []string{}},
+			"asg": {create: map[string]string{"team": "b"}, remove: []string{}},
+		}))
+	})
+
+	t.Run("errors are aggregated and don't stop the sweep", func(t *testing.T) {
+		g := NewWithT(t)
+		var updated []string
+		fail := func(context.Context, map[string]string, []string) error {
+			return errors.New("access denied")
+		}
+
+		err := Sweep(context.TODO(),
+			SweepResource{
+				Kind:    "nodegroup",
+				ID:      "ng",
+				Desired: map[string]string{"team": "b"},
+				Update:  fail,
+			},
+			SweepResource{
+				Kind:    "autoscaling group",
+				ID:      "asg",
+				Desired: map[string]string{"team": "b"},
+				Update: func(context.Context, map[string]string, []string) error {
+					updated = append(updated, "asg")
+					return nil
+				},
+			},
+			SweepResource{
+				Kind:    "fargate profile",
+				ID:      "fp",
+				Desired: map[string]string{"team": "b"},
+				Update:  fail,
+			},
+		)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("failed to update tags of nodegroup ng"))
+		g.Expect(err.Error()).To(ContainSubstring("failed to update tags of fargate profile fp"))
+		g.Expect(updated).To(ConsistOf("asg"))
+	})
+}
+
+func TestLastAppliedTags(t *testing.T) {
+	g := NewWithT(t)
+	const annotation = "example.com/last-applied-tags"
+	obj := &metav1.ObjectMeta{}
+
+	applied, err := LastAppliedTags(obj, annotation)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(applied).To(BeEmpty())
+
+	g.Expect(SetLastAppliedTags(obj, annotation, map[string]string{"team": "a", "cost-center": "1"})).To(Succeed())
+	applied, err = LastAppliedTags(obj, annotation)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(applied).To(Equal(map[string]string{"team": "a", "cost-center": "1"}))
+
+	// Only the tags that were applied and are no longer desired are removed.
+	create, remove := Diff(
+		map[string]string{"team": "a", "cost-center": "1", "set-out-of-band": "x"},
+		map[string]string{"team": "b"},
+		KeepUnlessApplied(applied),
+	)
+	g.Expect(create).To(Equal(map[string]string{"team": "b"}))
+	g.Expect(remove).To(Equal([]string{"cost-center"}))
+}
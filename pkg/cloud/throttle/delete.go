@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"regexp"
+	"sync"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// deleteOperations matches the operations of the AWS services that delete or detach resources.
+var deleteOperations = regexp.MustCompile(NewMultiOperationMatch(
+	"Delete",
+	"Terminate",
+	"Deregister",
+	"Detach",
+	"Disassociate",
+	"Release",
+	"Revoke",
+))
+
+// DefaultDeleteLimiter is the delete limiter shared by all the controllers. It doesn't limit
+// delete operations until a limit is set.
+var DefaultDeleteLimiter = NewDeleteLimiter(0)
+
+// DeleteLimiter caps the number of AWS delete operations in flight at once, so that tearing
+// down many clusters doesn't trip the API limits of the account.
+type DeleteLimiter struct {
+	mu    sync.Mutex
+	slots chan struct{}
+}
+
+// NewDeleteLimiter returns a limiter allowing limit delete operations at once. A limit of
+// zero or less disables the limiter.
+func NewDeleteLimiter(limit int) *DeleteLimiter {
+	l := &DeleteLimiter{}
+	l.SetLimit(limit)
+	return l
+}
+
+// SetLimit changes the number of delete operations allowed at once. A limit of zero or less
+// disables the limiter. Operations already in flight aren't counted against the new limit.
+func (l *DeleteLimiter) SetLimit(limit int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if limit <= 0 {
+		l.slots = nil
+		return
+	}
+	l.slots = make(chan struct{}, limit)
+}
+
+// Acquire waits until a delete operation can start, and returns the function releasing its
+// slot once it is done. It returns an error when the context is done first.
+func (l *DeleteLimiter) Acquire(ctx context.Context) (func(), error) {
+	l.mu.Lock()
+	slots := l.slots
+	l.mu.Unlock()
+
+	if slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// IsDeleteOperation returns true if the AWS operation deletes or detaches resources.
+func IsDeleteOperation(operation string) bool {
+	return deleteOperations.MatchString(operation)
+}
+
+// WithDeleteLimiterMiddleware returns a middleware stack holding the delete operations of
+// AWS GO SDK V2 service clients until the limiter lets them start. Retries of an operation
+// reuse its slot.
+func WithDeleteLimiterMiddleware(limiter *DeleteLimiter) func(stack *middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(getDeleteLimiterMiddleware(limiter), middleware.After)
+	}
+}
+
+// getDeleteLimiterMiddleware implements the delete limiter middleware.
+func getDeleteLimiterMiddleware(limiter *DeleteLimiter) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc("capa/DeleteLimiterMiddleware", func(ctx context.Context, input middleware.InitializeInput, handler middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		if !IsDeleteOperation(awsmiddleware.GetOperationName(ctx)) {
+			return handler.HandleInitialize(ctx, input)
+		}
+
+		release, err := limiter.Acquire(ctx)
+		if err != nil {
+			return middleware.InitializeOutput{}, middleware.Metadata{}, err
+		}
+		defer release()
+		return handler.HandleInitialize(ctx, input)
+	})
+}
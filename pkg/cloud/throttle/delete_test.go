@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	. "github.com/onsi/gomega"
+)
+
+// concurrencyRecorder is an HTTP client recording the highest number of requests in flight.
+type concurrencyRecorder struct {
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (r *concurrencyRecorder) Do(req *http.Request) (*http.Response, error) {
+	n := r.inFlight.Add(1)
+	defer r.inFlight.Add(-1)
+	for {
+		highest := r.maxInFlight.Load()
+		if n <= highest || r.maxInFlight.CompareAndSwap(highest, n) {
+			break
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/xml"}},
+		Body:       io.NopCloser(strings.NewReader("<Response></Response>")),
+		Request:    req,
+	}, nil
+}
+
+func newRecordedEC2Client(recorder *concurrencyRecorder, limiter *DeleteLimiter) *ec2.Client {
+	return ec2.NewFromConfig(aws.Config{
+		Region:      "us-east-1",
+		Credentials: credentials.NewStaticCredentialsProvider("id", "secret", ""),
+		HTTPClient:  recorder,
+		Retryer:     func() aws.Retryer { return aws.NopRetryer{} },
+	}, ec2.WithAPIOptions(WithDeleteLimiterMiddleware(limiter)))
+}
+
+func TestDeleteLimiterMiddleware(t *testing.T) {
+	testCases := []struct {
+		name            string
+		limit           int
+		call            func(context.Context, *ec2.Client) error
+		wantMaxInFlight int32
+	}{
+		{
+			name:  "delete operations respect the limit",
+			limit: 2,
+			call: func(ctx context.Context, c *ec2.Client) error {
+				_, err := c.DeleteSecurityGroup(ctx, &ec2.DeleteSecurityGroupInput{GroupId: aws.String("sg-1")})
+				return err
+			},
+			wantMaxInFlight: 2,
+		},
+		{
+			name:  "terminate operations respect the limit",
+			limit: 1,
+			call: func(ctx context.Context, c *ec2.Client) error {
+				_, err := c.TerminateInstances(ctx, &ec2.TerminateInstancesInput{InstanceIds: []string{"i-1"}})
+				return err
+			},
+			wantMaxInFlight: 1,
+		},
+		{
+			name:  "other operations aren't limited",
+			limit: 1,
+			call: func(ctx context.Context, c *ec2.Client) error {
+				_, err := c.CreateSecurityGroup(ctx, &ec2.CreateSecurityGroupInput{GroupName: aws.String("sg"), Description: aws.String("sg")})
+				return err
+			},
+			wantMaxInFlight: 8,
+		},
+		{
+			name:  "no limit",
+			limit: 0,
+			call: func(ctx context.Context, c *ec2.Client) error {
+				_, err := c.DeleteSecurityGroup(ctx, &ec2.DeleteSecurityGroupInput{GroupId: aws.String("sg-1")})
+				return err
+			},
+			wantMaxInFlight: 8,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			recorder := &concurrencyRecorder{}
+			client := newRecordedEC2Client(recorder, NewDeleteLimiter(tc.limit))
+
+			// Start the calls together so that they would all be in flight without the limiter.
+			start := make(chan struct{})
+			errs := make(chan error, 8)
+			var wg sync.WaitGroup
+			for range 8 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					<-start
+					errs <- tc.call(context.Background(), client)
+				}()
+			}
+			close(start)
+			wg.Wait()
+			close(errs)
+
+			for err := range errs {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			if tc.wantMaxInFlight < 8 {
+				g.Expect(recorder.maxInFlight.Load()).To(Equal(tc.wantMaxInFlight))
+			} else {
+				g.Expect(recorder.maxInFlight.Load()).To(BeNumerically(">", 1))
+			}
+		})
+	}
+}
+
+func TestDeleteLimiterAcquire(t *testing.T) {
+	g := NewWithT(t)
+	limiter := NewDeleteLimiter(1)
+
+	release, err := limiter.Acquire(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// A second delete waits for the first one and gives up with its context.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = limiter.Acquire(ctx)
+	g.Expect(err).To(MatchError(context.DeadlineExceeded))
+
+	release()
+	release, err = limiter.Acquire(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	release()
+}
+
+func TestIsDeleteOperation(t *testing.T) {
+	g := NewWithT(t)
+	for _, op := range []string{"DeleteNodegroup", "TerminateInstances", "DeregisterTargets", "DetachRolePolicy", "DisassociateRouteTable", "ReleaseAddress", "RevokeSecurityGroupIngress"} {
+		g.Expect(IsDeleteOperation(op)).To(BeTrue(), op)
+	}
+	for _, op := range []string{"CreateNodegroup", "DescribeInstances", "UpdateNodegroupConfig", "ListTagsForResource"} {
+		g.Expect(IsDeleteOperation(op)).To(BeFalse(), op)
+	}
+}
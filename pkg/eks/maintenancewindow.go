@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// MaintenanceWindow is a weekly time range in UTC, opening at the same time on each of its days.
+type MaintenanceWindow struct {
+	days  [7]bool
+	start time.Duration
+	end   time.Duration
+}
+
+// ParseMaintenanceWindow parses a maintenance window written as days and a UTC time range,
+// such as "Sat,Sun 02:00-06:00". The days are either "*" for every day or a comma separated
+// list of the first three letters of weekdays. A range ending before it starts, such as
+// "Fri 22:00-02:00", ends the day after it opens.
+func ParseMaintenanceWindow(value string) (*MaintenanceWindow, error) {
+	fields := strings.Fields(value)
+	if len(fields) != 2 {
+		return nil, fmt.Errorf("maintenance window %q must be days and a time range, such as \"Sat,Sun 02:00-06:00\"", value)
+	}
+
+	w := &MaintenanceWindow{}
+	if fields[0] == "*" {
+		for i := range w.days {
+			w.days[i] = true
+		}
+	} else {
+		for _, day := range strings.Split(fields[0], ",") {
+			weekday, ok := weekdays[strings.ToLower(day)]
+			if !ok {
+				return nil, fmt.Errorf("maintenance window %q has an invalid day %q, use \"*\" or Mon, Tue, Wed, Thu, Fri, Sat and Sun", value, day)
+			}
+			w.days[weekday] = true
+		}
+	}
+
+	start, end, ok := strings.Cut(fields[1], "-")
+	if !ok {
+		return nil, fmt.Errorf("maintenance window %q has an invalid time range %q, such as 02:00-06:00", value, fields[1])
+	}
+	var err error
+	if w.start, err = parseTimeOfDay(start); err != nil {
+		return nil, fmt.Errorf("maintenance window %q has an invalid start: %w", value, err)
+	}
+	if w.end, err = parseTimeOfDay(end); err != nil {
+		return nil, fmt.Errorf("maintenance window %q has an invalid end: %w", value, err)
+	}
+	if w.start == w.end {
+		return nil, fmt.Errorf("maintenance window %q must end at another time than it starts", value)
+	}
+	return w, nil
+}
+
+func parseTimeOfDay(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("%q isn't a time of day such as 02:00", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func (w *MaintenanceWindow) length() time.Duration {
+	if w.end > w.start {
+		return w.end - w.start
+	}
+	return 24*time.Hour - w.start + w.end
+}
+
+// Contains returns true if the window is open at t.
+func (w *MaintenanceWindow) Contains(t time.Time) bool {
+	t = t.UTC()
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	// A window ending the day after it opens may have opened the day before.
+	for _, day := range []time.Time{midnight.AddDate(0, 0, -1), midnight} {
+		if !w.days[day.Weekday()] {
+			continue
+		}
+		open := day.Add(w.start)
+		if !t.Before(open) && t.Before(open.Add(w.length())) {
+			return true
+		}
+	}
+	return false
+}
+
+// Next returns when the window opens next after t.
+func (w *MaintenanceWindow) Next(t time.Time) time.Time {
+	t = t.UTC()
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	for i := range 8 {
+		day := midnight.AddDate(0, 0, i)
+		if open := day.Add(w.start); w.days[day.Weekday()] && open.After(t) {
+			return open
+		}
+	}
+	// A parsed window opens at least once a week.
+	return time.Time{}
+}
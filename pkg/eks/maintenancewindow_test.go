@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseMaintenanceWindow(t *testing.T) {
+	testCases := []struct {
+		value   string
+		wantErr bool
+	}{
+		{value: "Sat,Sun 02:00-06:00"},
+		{value: "* 22:00-02:00"},
+		{value: "mon 00:00-23:59"},
+		{value: "Sat,Sun", wantErr: true},
+		{value: "Sat 02:00 06:00", wantErr: true},
+		{value: "Saturday 02:00-06:00", wantErr: true},
+		{value: "Sat 02:00", wantErr: true},
+		{value: "Sat 2am-6am", wantErr: true},
+		{value: "Sat 24:00-02:00", wantErr: true},
+		{value: "Sat 02:00-02:00", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := ParseMaintenanceWindow(tc.value)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindow(t *testing.T) {
+	// 2026-10-17 is a Saturday.
+	at := func(day int, hour, minute int) time.Time {
+		return time.Date(2026, time.October, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	testCases := []struct {
+		name     string
+		window   string
+		t        time.Time
+		contains bool
+		next     time.Time
+	}{
+		{
+			name:     "inside the window",
+			window:   "Sat,Sun 02:00-06:00",
+			t:        at(17, 3, 0),
+			contains: true,
+			next:     at(18, 2, 0),
+		},
+		{
+			name:     "the window end is excluded",
+			window:   "Sat,Sun 02:00-06:00",
+			t:        at(17, 6, 0),
+			contains: false,
+			next:     at(18, 2, 0),
+		},
+		{
+			name:     "before the window on another day",
+			window:   "Sat,Sun 02:00-06:00",
+			t:        at(14, 3, 0),
+			contains: false,
+			next:     at(17, 2, 0),
+		},
+		{
+			name:     "after the last window of the week",
+			window:   "Sat 02:00-06:00",
+			t:        at(17, 7, 0),
+			contains: false,
+			next:     at(24, 2, 0),
+		},
+		{
+			name:     "window crossing midnight opened the day before",
+			window:   "Fri 22:00-02:00",
+			t:        at(17, 1, 0),
+			contains: true,
+			next:     at(23, 22, 0),
+		},
+		{
+			name:     "window crossing midnight doesn't open on the next day",
+			window:   "Fri 22:00-02:00",
+			t:        at(17, 23, 0),
+			contains: false,
+			next:     at(23, 22, 0),
+		},
+		{
+			name:     "times are compared in UTC",
+			window:   "* 02:00-06:00",
+			t:        at(17, 3, 0).In(time.FixedZone("UTC+10", 10*60*60)),
+			contains: true,
+			next:     at(18, 2, 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			w, err := ParseMaintenanceWindow(tc.window)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(w.Contains(tc.t)).To(Equal(tc.contains))
+			g.Expect(w.Next(tc.t)).To(Equal(tc.next))
+		})
+	}
+}
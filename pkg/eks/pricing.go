@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// defaultSpotFactor is the share of the on-demand price assumed for spot capacity.
+const defaultSpotFactor = 0.35
+
+// PriceTable holds the hourly prices used to estimate the cost of node groups.
+type PriceTable struct {
+	// SpotFactor is the share of the on-demand price paid for spot capacity, between 0 and 1.
+	SpotFactor float64 `json:"spotFactor"`
+	// OnDemand is the hourly on-demand price in USD of instance types, by region and
+	// instance type.
+	OnDemand map[string]map[string]float64 `json:"onDemand"`
+}
+
+// DefaultPriceTable returns the bundled price table. It holds the Linux on-demand prices
+// of common instance types in a few regions, and is only meant for rough estimates.
+func DefaultPriceTable() *PriceTable {
+	usPrices := map[string]float64{
+		"t3.medium":  0.0416,
+		"t3.large":   0.0832,
+		"t3.xlarge":  0.1664,
+		"m5.large":   0.096,
+		"m5.xlarge":  0.192,
+		"m5.2xlarge": 0.384,
+		"m6i.large":  0.096,
+		"m6i.xlarge": 0.192,
+		"m6g.large":  0.077,
+		"m7i.large":  0.1008,
+		"c5.large":   0.085,
+		"c5.xlarge":  0.17,
+		"r5.large":   0.126,
+		"r5.xlarge":  0.252,
+	}
+	return &PriceTable{
+		SpotFactor: defaultSpotFactor,
+		OnDemand: map[string]map[string]float64{
+			"us-east-1": usPrices,
+			"us-east-2": usPrices,
+			"us-west-2": usPrices,
+			"eu-west-1": {
+				"t3.medium":  0.0456,
+				"t3.large":   0.0912,
+				"t3.xlarge":  0.1824,
+				"m5.large":   0.107,
+				"m5.xlarge":  0.214,
+				"m5.2xlarge": 0.428,
+				"m6i.large":  0.107,
+				"m6i.xlarge": 0.214,
+				"m6g.large":  0.086,
+				"m7i.large":  0.1124,
+				"c5.large":   0.096,
+				"c5.xlarge":  0.192,
+				"r5.large":   0.141,
+				"r5.xlarge":  0.282,
+			},
+		},
+	}
+}
+
+// LoadPriceTable reads a price table from a YAML or JSON file, such as:
+//
+//	spotFactor: 0.3
+//	onDemand:
+//	  us-east-1:
+//	    m5.large: 0.096
+//
+// The spot factor defaults to the one of the bundled table when it isn't set.
+func LoadPriceTable(path string) (*PriceTable, error) {
+	data, err := os.ReadFile(path) //#nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read price table")
+	}
+	// Fields missing from the file keep the values of the bundled table, an explicit spot factor
+	// of 0 is kept.
+	t := &PriceTable{SpotFactor: defaultSpotFactor}
+	if err := yaml.UnmarshalStrict(data, t); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse price table %q", path)
+	}
+	if t.SpotFactor < 0 || t.SpotFactor > 1 {
+		return nil, errors.Errorf("price table %q has a spot factor of %v, it must be between 0 and 1", path, t.SpotFactor)
+	}
+	for region, prices := range t.OnDemand {
+		for instanceType, price := range prices {
+			if price < 0 {
+				return nil, errors.Errorf("price table %q has a negative price for %s in %s", path, instanceType, region)
+			}
+		}
+	}
+	return t, nil
+}
+
+// EstimateHourlyCost returns the hourly cost in USD of running the given number of nodes
+// with the instance types in a region. When there are several instance types, the nodes
+// are assumed to be spread evenly across the ones with a known price. It returns false when
+// none of the instance types has a known price.
+func (t *PriceTable) EstimateHourlyCost(region string, instanceTypes []string, spot bool, nodes int32) (float64, bool) {
+	prices, ok := t.OnDemand[region]
+	if !ok {
+		return 0, false
+	}
+
+	var sum float64
+	var known int
+	for _, instanceType := range instanceTypes {
+		if price, ok := prices[instanceType]; ok {
+			sum += price
+			known++
+		}
+	}
+	if known == 0 {
+		return 0, false
+	}
+
+	cost := sum / float64(known) * float64(nodes)
+	if spot {
+		cost *= t.SpotFactor
+	}
+	return cost, true
+}
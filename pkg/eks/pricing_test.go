@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestEstimateHourlyCost(t *testing.T) {
+	table := &PriceTable{
+		SpotFactor: 0.5,
+		OnDemand: map[string]map[string]float64{
+			"us-east-1": {
+				"m5.large":  0.1,
+				"m5.xlarge": 0.2,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		region        string
+		instanceTypes []string
+		spot          bool
+		nodes         int32
+		wantCost      float64
+		wantOK        bool
+	}{
+		{
+			name:          "on-demand nodes",
+			region:        "us-east-1",
+			instanceTypes: []string{"m5.large"},
+			nodes:         3,
+			wantCost:      0.3,
+			wantOK:        true,
+		},
+		{
+			name:          "spot nodes",
+			region:        "us-east-1",
+			instanceTypes: []string{"m5.xlarge"},
+			spot:          true,
+			nodes:         2,
+			wantCost:      0.2,
+			wantOK:        true,
+		},
+		{
+			name:          "nodes spread across instance types",
+			region:        "us-east-1",
+			instanceTypes: []string{"m5.large", "m5.xlarge"},
+			nodes:         2,
+			wantCost:      0.3,
+			wantOK:        true,
+		},
+		{
+			name:          "instance types without a price are ignored",
+			region:        "us-east-1",
+			instanceTypes: []string{"m5.large", "x2idn.metal"},
+			nodes:         1,
+			wantCost:      0.1,
+			wantOK:        true,
+		},
+		{
+			name:          "no nodes",
+			region:        "us-east-1",
+			instanceTypes: []string{"m5.large"},
+			nodes:         0,
+			wantCost:      0,
+			wantOK:        true,
+		},
+		{
+			name:          "unknown instance types",
+			region:        "us-east-1",
+			instanceTypes: []string{"x2idn.metal"},
+			nodes:         1,
+		},
+		{
+			name:          "unknown region",
+			region:        "ap-south-1",
+			instanceTypes: []string{"m5.large"},
+			nodes:         1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cost, ok := table.EstimateHourlyCost(tc.region, tc.instanceTypes, tc.spot, tc.nodes)
+			g.Expect(ok).To(Equal(tc.wantOK))
+			g.Expect(cost).To(BeNumerically("~", tc.wantCost, 1e-9))
+		})
+	}
+}
+
+func TestDefaultPriceTable(t *testing.T) {
+	g := NewWithT(t)
+	// The default instance type of managed node groups must have a price.
+	cost, ok := DefaultPriceTable().EstimateHourlyCost("us-east-1", []string{"t3.medium"}, false, 2)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(cost).To(BeNumerically("~", 0.0832, 1e-9))
+}
+
+func TestLoadPriceTable(t *testing.T) {
+	testCases := []struct {
+		name           string
+		content        string
+		wantSpotFactor float64
+		wantErr        bool
+	}{
+		{
+			name:           "yaml table",
+			content:        "spotFactor: 0.3\nonDemand:\n  us-east-1:\n    m5.large: 0.096\n",
+			wantSpotFactor: 0.3,
+		},
+		{
+			name:           "json table without spot factor",
+			content:        `{"onDemand": {"us-east-1": {"m5.large": 0.096}}}`,
+			wantSpotFactor: defaultSpotFactor,
+		},
+		{
+			name:           "explicit zero spot factor",
+			content:        "spotFactor: 0\nonDemand:\n  us-east-1:\n    m5.large: 0.096\n",
+			wantSpotFactor: 0,
+		},
+		{
+			name:    "spot factor out of range",
+			content: "spotFactor: 1.5\n",
+			wantErr: true,
+		},
+		{
+			name:    "negative price",
+			content: "onDemand:\n  us-east-1:\n    m5.large: -1\n",
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			content: "prices: {}\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			path := filepath.Join(t.TempDir(), "prices.yaml")
+			g.Expect(os.WriteFile(path, []byte(tc.content), 0o600)).To(Succeed())
+
+			table, err := LoadPriceTable(path)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(table.SpotFactor).To(Equal(tc.wantSpotFactor))
+			g.Expect(table.OnDemand["us-east-1"]).To(HaveKeyWithValue("m5.large", 0.096))
+		})
+	}
+}
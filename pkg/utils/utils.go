@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"math/rand/v2"
+	"time"
 
 	"k8s.io/utils/ptr"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -55,3 +57,13 @@ func ToInt32Pointer(to *int64) *int32 {
 
 	return ptr.To(int32(*to))
 }
+
+// Jitter returns a random duration between d and d*(1+maxFactor), used to spread out requeues
+// of many objects that would otherwise be scheduled at the same time. A non-positive maxFactor
+// disables jitter and d is returned unchanged.
+func Jitter(d time.Duration, maxFactor float64) time.Duration {
+	if maxFactor <= 0 || d <= 0 {
+		return d
+	}
+	return d + time.Duration(rand.Float64()*maxFactor*float64(d)) //nolint:gosec // jitter does not need a cryptographic source.
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestJitter(t *testing.T) {
+	tests := []struct {
+		name      string
+		duration  time.Duration
+		maxFactor float64
+		min       time.Duration
+		max       time.Duration
+	}{
+		{
+			name:      "jitter applied within bounds",
+			duration:  time.Minute,
+			maxFactor: 0.1,
+			min:       time.Minute,
+			max:       66 * time.Second,
+		},
+		{
+			name:      "zero factor disables jitter",
+			duration:  time.Minute,
+			maxFactor: 0,
+			min:       time.Minute,
+			max:       time.Minute,
+		},
+		{
+			name:      "negative factor disables jitter",
+			duration:  time.Minute,
+			maxFactor: -1,
+			min:       time.Minute,
+			max:       time.Minute,
+		},
+		{
+			name:      "zero duration is not jittered",
+			duration:  0,
+			maxFactor: 0.1,
+			min:       0,
+			max:       0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			for range 1000 {
+				got := Jitter(tc.duration, tc.maxFactor)
+				g.Expect(got).To(BeNumerically(">=", tc.min))
+				g.Expect(got).To(BeNumerically("<=", tc.max))
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"fmt"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+)
+
+// Taints returns n distinct NoSchedule taints with keys starting with prefix.
+func Taints(prefix string, n int) expinfrav1.Taints {
+	taints := make(expinfrav1.Taints, 0, n)
+	for i := range n {
+		taints = append(taints, expinfrav1.Taint{Key: fmt.Sprintf("%s-%d", prefix, i), Value: "true", Effect: expinfrav1.TaintEffectNoSchedule})
+	}
+	return taints
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// DescribeCache caches the output of AWS describe calls for a short time, so that the
+// reconciles of a large fleet don't describe the same resources over and over. Unlike
+// the other caches, entries can be dropped before they expire, which the clients using
+// it do after calls that change the described resources.
+type DescribeCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[string]describeCacheEntry
+}
+
+type describeCacheEntry struct {
+	value   any
+	expires time.Time
+}
+
+// DefaultDescribeCache is the describe cache shared by all the controllers. It's disabled
+// until a TTL is set.
+var DefaultDescribeCache = NewDescribeCache(0)
+
+// NewDescribeCache returns a describe cache keeping entries for ttl. A ttl of zero
+// disables the cache.
+func NewDescribeCache(ttl time.Duration) *DescribeCache {
+	return &DescribeCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]describeCacheEntry{},
+	}
+}
+
+// SetTTL changes how long entries are kept and drops the existing ones. A ttl of zero
+// disables the cache.
+func (c *DescribeCache) SetTTL(ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ttl = ttl
+	c.entries = map[string]describeCacheEntry{}
+}
+
+// Get returns the value cached for key, if it hasn't expired.
+func (c *DescribeCache) Get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// Add caches value for key. Expired entries are dropped at the same time, so that
+// the cache doesn't grow with resources that aren't described anymore.
+func (c *DescribeCache) Add(key string, value any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ttl <= 0 {
+		return
+	}
+	now := c.now()
+	for k, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = describeCacheEntry{value: value, expires: now.Add(c.ttl)}
+}
+
+// Invalidate drops the entries whose key starts with prefix.
+func (c *DescribeCache) Invalidate(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k := range c.entries {
+		if strings.HasPrefix(k, prefix) {
+			delete(c.entries, k)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDescribeCache(t *testing.T) {
+	t.Run("entries expire after the TTL", func(t *testing.T) {
+		g := NewWithT(t)
+		now := time.Now()
+		c := NewDescribeCache(time.Minute)
+		c.now = func() time.Time { return now }
+
+		c.Add("a", 1)
+		value, ok := c.Get("a")
+		g.Expect(ok).To(BeTrue())
+		g.Expect(value).To(Equal(1))
+
+		now = now.Add(59 * time.Second)
+		_, ok = c.Get("a")
+		g.Expect(ok).To(BeTrue())
+
+		now = now.Add(time.Second)
+		_, ok = c.Get("a")
+		g.Expect(ok).To(BeFalse())
+	})
+
+	t.Run("expired entries are dropped when adding", func(t *testing.T) {
+		g := NewWithT(t)
+		now := time.Now()
+		c := NewDescribeCache(time.Minute)
+		c.now = func() time.Time { return now }
+
+		c.Add("a", 1)
+		now = now.Add(2 * time.Minute)
+		c.Add("b", 2)
+		g.Expect(c.entries).To(HaveLen(1))
+		g.Expect(c.entries).To(HaveKey("b"))
+	})
+
+	t.Run("entries are invalidated by prefix", func(t *testing.T) {
+		g := NewWithT(t)
+		c := NewDescribeCache(time.Minute)
+
+		c.Add("eks/cluster/", 1)
+		c.Add("eks/cluster/nodegroup/ng", 2)
+		c.Add("eks/cluster-2/", 3)
+		c.Invalidate("eks/cluster/")
+
+		_, ok := c.Get("eks/cluster/")
+		g.Expect(ok).To(BeFalse())
+		_, ok = c.Get("eks/cluster/nodegroup/ng")
+		g.Expect(ok).To(BeFalse())
+		_, ok = c.Get("eks/cluster-2/")
+		g.Expect(ok).To(BeTrue())
+	})
+
+	t.Run("zero TTL disables the cache", func(t *testing.T) {
+		g := NewWithT(t)
+		c := NewDescribeCache(time.Minute)
+		c.Add("a", 1)
+
+		c.SetTTL(0)
+		_, ok := c.Get("a")
+		g.Expect(ok).To(BeFalse())
+		c.Add("a", 1)
+		_, ok = c.Get("a")
+		g.Expect(ok).To(BeFalse())
+	})
+}
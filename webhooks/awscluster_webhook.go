@@ -31,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/endpoints"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	"sigs.k8s.io/cluster-api/util/annotations"
 )
@@ -73,6 +74,7 @@ func (w *AWSCluster) ValidateCreate(_ context.Context, obj runtime.Object) (admi
 	allErrs = append(allErrs, w.validateSSHKeyName(r)...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
+	allErrs = append(allErrs, r.Spec.ControlPlaneVolumeEncryption.Validate(field.NewPath("spec", "controlPlaneVolumeEncryption"))...)
 	allErrs = append(allErrs, w.validateNetwork(r)...)
 
 	warnings, errs := w.validateControlPlaneLBs(r)
@@ -114,6 +116,12 @@ func (w *AWSCluster) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Ob
 		)
 	}
 
+	if !endpoints.IsPartitionUpdateAllowed(oldC.Spec.Partition, r.Spec.Partition, r.Spec.Region) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "partition"), r.Spec.Partition, "field is immutable"),
+		)
+	}
+
 	// Validate the control plane load balancers.
 	lbs := map[*infrav1.AWSLoadBalancerSpec]*infrav1.AWSLoadBalancerSpec{
 		oldC.Spec.ControlPlaneLoadBalancer:          r.Spec.ControlPlaneLoadBalancer,
@@ -166,6 +174,7 @@ func (w *AWSCluster) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Ob
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
+	allErrs = append(allErrs, r.Spec.ControlPlaneVolumeEncryption.Validate(field.NewPath("spec", "controlPlaneVolumeEncryption"))...)
 
 	if r.Spec.ControlPlaneLoadBalancer != nil {
 		if r.Spec.ControlPlaneLoadBalancer.LoadBalancerType == infrav1.LoadBalancerTypeClassic {
@@ -453,6 +462,10 @@ func (w *AWSCluster) validateControlPlaneLBs(r *infrav1.AWSCluster) (admission.W
 			if r.Spec.ControlPlaneLoadBalancer.TargetGroupIPType != nil {
 				allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "targetGroupIPType"), r.Spec.ControlPlaneLoadBalancer.TargetGroupIPType, "cannot set target group IP type if the LoadBalancer reconciliation is disabled"))
 			}
+
+			if r.Spec.ControlPlaneLoadBalancer.AccessLogs != nil {
+				allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "accessLogs"), r.Spec.ControlPlaneLoadBalancer.AccessLogs, "cannot configure access logs if the LoadBalancer reconciliation is disabled"))
+			}
 		}
 	}
 
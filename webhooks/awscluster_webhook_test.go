@@ -996,6 +996,29 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "accepts a KMS alias to encrypt the control plane volumes",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneVolumeEncryption: &infrav1.VolumeEncryption{
+						Encrypted:     ptr.To(true),
+						EncryptionKey: "alias/control-plane",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects a control plane volume encryption key that isn't a KMS key reference",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					ControlPlaneVolumeEncryption: &infrav1.VolumeEncryption{
+						EncryptionKey: "control-plane",
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1091,6 +1114,52 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "partition is immutable",
+			oldCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					Region:    "us-east-1",
+					Partition: "aws",
+				},
+			},
+			newCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					Region:    "us-east-1",
+					Partition: "aws-us-gov",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty partition can be set to the region's partition",
+			oldCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					Region: "cn-north-1",
+				},
+			},
+			newCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					Region:    "cn-north-1",
+					Partition: "aws-cn",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty partition can't be set to another partition",
+			oldCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					Region: "us-east-1",
+				},
+			},
+			newCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					Region:    "us-east-1",
+					Partition: "aws-us-gov",
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "controlPlaneLoadBalancer name is immutable",
 			oldCluster: &infrav1.AWSCluster{
@@ -70,6 +70,7 @@ func (*AWSClusterTemplate) ValidateCreate(_ context.Context, obj runtime.Object)
 
 	allErrs = append(allErrs, r.Spec.Template.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, validateSSHKeyName(r.Spec.Template.Spec.SSHKeyName)...)
+	allErrs = append(allErrs, r.Spec.Template.Spec.ControlPlaneVolumeEncryption.Validate(field.NewPath("spec", "template", "spec", "controlPlaneVolumeEncryption"))...)
 
 	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }